@@ -32,6 +32,15 @@ const (
 	ActiveTaskTypeAnalysis ActiveTaskType = "analysis"
 )
 
+// Defines values for DetectionSource.
+const (
+	ConfigScope    DetectionSource = "config-scope"
+	ContentPattern DetectionSource = "content-pattern"
+	Import         DetectionSource = "import"
+	RepoConfig     DetectionSource = "repo-config"
+	StrongFilename DetectionSource = "strong-filename"
+)
+
 // Defines values for GitHubAppInstallationAccountType.
 const (
 	GitHubAppInstallationAccountTypeOrganization GitHubAppInstallationAccountType = "organization"
@@ -128,6 +137,16 @@ const (
 	Vietnamese SpecLanguage = "Vietnamese"
 )
 
+// Defines values for TestKind.
+const (
+	Benchmark   TestKind = "benchmark"
+	E2e         TestKind = "e2e"
+	Example     TestKind = "example"
+	Fuzz        TestKind = "fuzz"
+	Integration TestKind = "integration"
+	Unit        TestKind = "unit"
+)
+
 // Defines values for TestStatus.
 const (
 	Active  TestStatus = "active"
@@ -421,6 +440,14 @@ type CompletedResponse struct {
 	Status string         `json:"status"`
 }
 
+// DetectionSource How the framework was detected:
+// - import: Explicit framework import
+// - strong-filename: Framework-specific file name (e.g., *.cy.ts)
+// - config-scope: Covered by a framework config file
+// - repo-config: Override in the repository's .specvital.yml
+// - content-pattern: Framework-specific code patterns
+type DetectionSource string
+
 // DevLoginRequest defines model for DevLoginRequest.
 type DevLoginRequest struct {
 	// UserID Optional user ID to login as (uses default test user if not provided)
@@ -443,6 +470,23 @@ type FailedResponse struct {
 	Status string `json:"status"`
 }
 
+// FileDetection Why the test file was attributed to its framework
+type FileDetection struct {
+	// Confidence Score of the signal that decided the framework
+	Confidence int `json:"confidence"`
+
+	// Evidence Indicators behind the decision
+	Evidence *[]string `json:"evidence,omitempty"`
+
+	// Source How the framework was detected:
+	// - import: Explicit framework import
+	// - strong-filename: Framework-specific file name (e.g., *.cy.ts)
+	// - config-scope: Covered by a framework config file
+	// - repo-config: Override in the repository's .specvital.yml
+	// - content-pattern: Framework-specific code patterns
+	Source DetectionSource `json:"source"`
+}
+
 // Framework Testing framework identifier
 type Framework = string
 
@@ -579,6 +623,16 @@ type GitHubWebhookPayload struct {
 	Sender              *WebhookSender       `json:"sender,omitempty"`
 }
 
+// KindSummary Number of tests per kind
+type KindSummary struct {
+	Benchmark   int `json:"benchmark"`
+	E2e         int `json:"e2e"`
+	Example     int `json:"example"`
+	Fuzz        int `json:"fuzz"`
+	Integration int `json:"integration"`
+	Unit        int `json:"unit"`
+}
+
 // LoginResponse defines model for LoginResponse.
 type LoginResponse struct {
 	// AuthURL GitHub OAuth authorization URL to redirect user
@@ -688,6 +742,40 @@ type ProblemDetail struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// Project Package or module owning the test file in a monorepo
+type Project struct {
+	// Kind Build system declaring the project (go, npm, cargo, gradle, dotnet, python)
+	Kind string `json:"kind"`
+
+	// Name Declared package or module name
+	Name string `json:"name"`
+
+	// Path Project directory relative to repository root ("." for the root)
+	Path string `json:"path"`
+}
+
+// ProjectSummary defines model for ProjectSummary.
+type ProjectSummary struct {
+	Active int `json:"active"`
+
+	// Files Number of test files in the project
+	Files   int `json:"files"`
+	Focused int `json:"focused"`
+
+	// Kind Build system declaring the project
+	Kind string `json:"kind"`
+
+	// Name Declared package or module name
+	Name string `json:"name"`
+
+	// Path Project directory relative to repository root
+	Path    string `json:"path"`
+	Skipped int    `json:"skipped"`
+	Todo    int    `json:"todo"`
+	Total   int    `json:"total"`
+	Xfail   int    `json:"xfail"`
+}
+
 // QueuedResponse defines model for QueuedResponse.
 type QueuedResponse struct {
 	Status string `json:"status"`
@@ -1075,6 +1163,12 @@ type Summary struct {
 	Focused    int                `json:"focused"`
 	Frameworks []FrameworkSummary `json:"frameworks"`
 
+	// Kinds Number of tests per kind
+	Kinds *KindSummary `json:"kinds,omitempty"`
+
+	// Projects Per-project breakdown for monorepos; omitted when no file belongs to a project
+	Projects *[]ProjectSummary `json:"projects,omitempty"`
+
 	// Skipped Number of skipped tests
 	Skipped int `json:"skipped"`
 
@@ -1096,6 +1190,15 @@ type TestCase struct {
 	// Framework Testing framework identifier
 	Framework Framework `json:"framework"`
 
+	// Kind Test kind classification:
+	// - unit: Default for tests without a more specific kind
+	// - integration: Tests under integration paths (e.g., /integration/)
+	// - e2e: End-to-end tests (Playwright, Cypress, or /e2e/ paths)
+	// - benchmark: Benchmarks (Go Benchmark*, criterion, BenchmarkDotNet)
+	// - fuzz: Fuzz tests (Go Fuzz*)
+	// - example: Executable examples (Go Example*)
+	Kind *TestKind `json:"kind,omitempty"`
+
 	// Line Line number where the test is defined
 	Line int `json:"line"`
 
@@ -1114,6 +1217,15 @@ type TestCase struct {
 	Status TestStatus `json:"status"`
 }
 
+// TestKind Test kind classification:
+// - unit: Default for tests without a more specific kind
+// - integration: Tests under integration paths (e.g., /integration/)
+// - e2e: End-to-end tests (Playwright, Cypress, or /e2e/ paths)
+// - benchmark: Benchmarks (Go Benchmark*, criterion, BenchmarkDotNet)
+// - fuzz: Fuzz tests (Go Fuzz*)
+// - example: Executable examples (Go Example*)
+type TestKind string
+
 // TestStatus Test status indicator:
 // - active: Normal test that will run
 // - focused: Test marked to run exclusively (e.g., it.only)
@@ -1142,12 +1254,18 @@ type TestStatusSummary struct {
 
 // TestSuite defines model for TestSuite.
 type TestSuite struct {
+	// Detection Why the test file was attributed to its framework
+	Detection *FileDetection `json:"detection,omitempty"`
+
 	// FilePath Path to the test file relative to repository root
 	FilePath string `json:"filePath"`
 
 	// Framework Testing framework identifier
 	Framework Framework `json:"framework"`
 
+	// Project Package or module owning the test file in a monorepo
+	Project *Project `json:"project,omitempty"`
+
 	// SuiteName Name of the test suite (describe block name)
 	SuiteName string     `json:"suiteName"`
 	Tests     []TestCase `json:"tests"`
@@ -1372,6 +1490,11 @@ type AnalyzeRepositoryParams struct {
 	// If provided, returns analysis for that commit only.
 	// If not found, returns 404 instead of queueing new analysis.
 	Commit *string `form:"commit,omitempty" json:"commit,omitempty"`
+
+	// Kind Only include tests of these kinds (repeatable).
+	// Suites without matching tests are omitted and summary counts
+	// reflect the filtered tests.
+	Kind *[]TestKind `form:"kind,omitempty" json:"kind,omitempty"`
 }
 
 // AuthCallbackParams defines parameters for AuthCallback.
@@ -2223,6 +2346,14 @@ func (siw *ServerInterfaceWrapper) AnalyzeRepository(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AnalyzeRepository(w, r, owner, repo, params)
 	}))
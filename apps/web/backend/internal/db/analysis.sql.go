@@ -858,7 +858,8 @@ SELECT
     tc.suite_id,
     tc.name,
     tc.line_number,
-    tc.status
+    tc.status,
+    tc.kind
 FROM test_cases tc
 WHERE tc.suite_id = ANY($1::uuid[])
 ORDER BY tc.suite_id, tc.line_number
@@ -870,6 +871,7 @@ type GetTestCasesBySuiteIDsRow struct {
 	Name       string      `json:"name"`
 	LineNumber pgtype.Int4 `json:"line_number"`
 	Status     TestStatus  `json:"status"`
+	Kind       TestKind    `json:"kind"`
 }
 
 func (q *Queries) GetTestCasesBySuiteIDs(ctx context.Context, dollar_1 []pgtype.UUID) ([]GetTestCasesBySuiteIDsRow, error) {
@@ -887,6 +889,7 @@ func (q *Queries) GetTestCasesBySuiteIDs(ctx context.Context, dollar_1 []pgtype.
 			&i.Name,
 			&i.LineNumber,
 			&i.Status,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -903,6 +906,8 @@ SELECT
     ts.id,
     tf.file_path,
     tf.framework,
+    tf.detection,
+    tf.project,
     ts.name
 FROM test_suites ts
 JOIN test_files tf ON ts.file_id = tf.id
@@ -914,6 +919,8 @@ type GetTestSuitesByAnalysisIDRow struct {
 	ID        pgtype.UUID `json:"id"`
 	FilePath  string      `json:"file_path"`
 	Framework pgtype.Text `json:"framework"`
+	Detection []byte      `json:"detection"`
+	Project   []byte      `json:"project"`
 	Name      string      `json:"name"`
 }
 
@@ -930,6 +937,8 @@ func (q *Queries) GetTestSuitesByAnalysisID(ctx context.Context, analysisID pgty
 			&i.ID,
 			&i.FilePath,
 			&i.Framework,
+			&i.Detection,
+			&i.Project,
 			&i.Name,
 		); err != nil {
 			return nil, err
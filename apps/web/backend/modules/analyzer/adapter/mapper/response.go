@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 
 type CompletedResponseOptions struct {
 	IsInMyHistory *bool
+	// Kinds restricts the response to tests of these kinds. Suites left
+	// without tests are dropped and the summary covers only matching tests.
+	Kinds []entity.TestKind
 }
 
 func ToCompletedResponse(analysis *entity.Analysis, opts ...CompletedResponseOptions) (api.AnalysisResponse, error) {
@@ -24,19 +28,30 @@ func ToCompletedResponse(analysis *entity.Analysis, opts ...CompletedResponseOpt
 		options = opts[0]
 	}
 
-	suites := make([]api.TestSuite, len(analysis.TestSuites))
+	suites := make([]api.TestSuite, 0, len(analysis.TestSuites))
 	frameworkStats := make(map[string]*api.FrameworkSummary)
+	projectStats := make(map[string]*api.ProjectSummary)
+	projectFiles := make(map[string]map[string]struct{})
+	var kinds api.KindSummary
+	var matchedTests int
 
-	for i, suite := range analysis.TestSuites {
-		tests := make([]api.TestCase, len(suite.TestCases))
-		for j, testCase := range suite.TestCases {
-			tests[j] = api.TestCase{
+	for _, suite := range analysis.TestSuites {
+		tests := make([]api.TestCase, 0, len(suite.TestCases))
+		for _, testCase := range suite.TestCases {
+			if len(options.Kinds) > 0 && !slices.Contains(options.Kinds, testCase.Kind) {
+				continue
+			}
+			kind := toAPITestKind(testCase.Kind)
+			tests = append(tests, api.TestCase{
 				FilePath:  suite.FilePath,
 				Framework: suite.Framework,
+				Kind:      &kind,
 				Line:      testCase.Line,
 				Name:      testCase.Name,
 				Status:    toAPITestStatus(testCase.Status),
-			}
+			})
+			matchedTests++
+			countKind(&kinds, kind)
 
 			if _, exists := frameworkStats[suite.Framework]; !exists {
 				frameworkStats[suite.Framework] = &api.FrameworkSummary{
@@ -45,26 +60,37 @@ func ToCompletedResponse(analysis *entity.Analysis, opts ...CompletedResponseOpt
 			}
 			fs := frameworkStats[suite.Framework]
 			fs.Total++
-			switch testCase.Status {
-			case entity.TestStatusActive:
-				fs.Active++
-			case entity.TestStatusFocused:
-				fs.Focused++
-			case entity.TestStatusSkipped:
-				fs.Skipped++
-			case entity.TestStatusTodo:
-				fs.Todo++
-			case entity.TestStatusXfail:
-				fs.Xfail++
+			statusCounter{&fs.Active, &fs.Focused, &fs.Skipped, &fs.Todo, &fs.Xfail}.add(testCase.Status)
+
+			if project := suite.Project; project != nil {
+				ps, exists := projectStats[project.Path]
+				if !exists {
+					ps = &api.ProjectSummary{Kind: project.Kind, Name: project.Name, Path: project.Path}
+					projectStats[project.Path] = ps
+					projectFiles[project.Path] = make(map[string]struct{})
+				}
+				ps.Total++
+				statusCounter{&ps.Active, &ps.Focused, &ps.Skipped, &ps.Todo, &ps.Xfail}.add(testCase.Status)
+				projectFiles[project.Path][suite.FilePath] = struct{}{}
 			}
 		}
 
-		suites[i] = api.TestSuite{
+		if len(options.Kinds) > 0 && len(tests) == 0 {
+			continue
+		}
+		suites = append(suites, api.TestSuite{
+			Detection: toAPIFileDetection(suite.Detection),
 			FilePath:  suite.FilePath,
 			Framework: suite.Framework,
+			Project:   toAPIProject(suite.Project),
 			SuiteName: suite.Name,
 			Tests:     tests,
-		}
+		})
+	}
+
+	total := analysis.TotalTests
+	if len(options.Kinds) > 0 {
+		total = matchedTests
 	}
 
 	frameworks := make([]api.FrameworkSummary, 0, len(frameworkStats))
@@ -82,6 +108,19 @@ func ToCompletedResponse(analysis *entity.Analysis, opts ...CompletedResponseOpt
 		return frameworks[i].Framework < frameworks[j].Framework
 	})
 
+	var projects *[]api.ProjectSummary
+	if len(projectStats) > 0 {
+		summaries := make([]api.ProjectSummary, 0, len(projectStats))
+		for path, ps := range projectStats {
+			ps.Files = len(projectFiles[path])
+			summaries = append(summaries, *ps)
+		}
+		sort.Slice(summaries, func(i, j int) bool {
+			return summaries[i].Path < summaries[j].Path
+		})
+		projects = &summaries
+	}
+
 	result := api.AnalysisResult{
 		AnalyzedAt:    analysis.CompletedAt,
 		BranchName:    analysis.BranchName,
@@ -97,9 +136,11 @@ func ToCompletedResponse(analysis *entity.Analysis, opts ...CompletedResponseOpt
 			Active:     totalActive,
 			Focused:    totalFocused,
 			Frameworks: frameworks,
+			Kinds:      &kinds,
+			Projects:   projects,
 			Skipped:    totalSkipped,
 			Todo:       totalTodo,
-			Total:      analysis.TotalTests,
+			Total:      total,
 			Xfail:      totalXfail,
 		},
 	}
@@ -196,3 +237,79 @@ func toAPITestStatus(status entity.TestStatus) api.TestStatus {
 		return api.Active
 	}
 }
+
+func toAPITestKind(kind entity.TestKind) api.TestKind {
+	switch kind {
+	case entity.TestKindBenchmark:
+		return api.Benchmark
+	case entity.TestKindE2E:
+		return api.E2e
+	case entity.TestKindExample:
+		return api.Example
+	case entity.TestKindFuzz:
+		return api.Fuzz
+	case entity.TestKindIntegration:
+		return api.Integration
+	default:
+		return api.Unit
+	}
+}
+
+// statusCounter points at the per-status counters of a summary.
+type statusCounter struct {
+	active, focused, skipped, todo, xfail *int
+}
+
+func (c statusCounter) add(status entity.TestStatus) {
+	switch status {
+	case entity.TestStatusActive:
+		*c.active++
+	case entity.TestStatusFocused:
+		*c.focused++
+	case entity.TestStatusSkipped:
+		*c.skipped++
+	case entity.TestStatusTodo:
+		*c.todo++
+	case entity.TestStatusXfail:
+		*c.xfail++
+	}
+}
+
+func toAPIProject(project *entity.Project) *api.Project {
+	if project == nil {
+		return nil
+	}
+	return &api.Project{Kind: project.Kind, Name: project.Name, Path: project.Path}
+}
+
+func toAPIFileDetection(detection *entity.FileDetection) *api.FileDetection {
+	if detection == nil {
+		return nil
+	}
+	result := &api.FileDetection{
+		Confidence: detection.Confidence,
+		Source:     api.DetectionSource(detection.Source),
+	}
+	if len(detection.Evidence) > 0 {
+		evidence := slices.Clone(detection.Evidence)
+		result.Evidence = &evidence
+	}
+	return result
+}
+
+func countKind(summary *api.KindSummary, kind api.TestKind) {
+	switch kind {
+	case api.Benchmark:
+		summary.Benchmark++
+	case api.E2e:
+		summary.E2e++
+	case api.Example:
+		summary.Example++
+	case api.Fuzz:
+		summary.Fuzz++
+	case api.Integration:
+		summary.Integration++
+	default:
+		summary.Unit++
+	}
+}
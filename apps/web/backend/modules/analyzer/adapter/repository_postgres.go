@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -343,6 +344,7 @@ func (r *PostgresRepository) GetTestSuitesWithCases(ctx context.Context, analysi
 			line = int(t.LineNumber.Int32)
 		}
 		testsBySuite[suiteID] = append(testsBySuite[suiteID], port.TestCaseRow{
+			Kind:   string(t.Kind),
 			Line:   line,
 			Name:   t.Name,
 			Status: string(t.Status),
@@ -357,10 +359,12 @@ func (r *PostgresRepository) GetTestSuitesWithCases(ctx context.Context, analysi
 			framework = s.Framework.String
 		}
 		suites[i] = port.TestSuiteWithCases{
+			Detection: toFileDetection(s.Detection),
 			FilePath:  s.FilePath,
 			Framework: framework,
 			ID:        suiteID,
 			Name:      s.Name,
+			Project:   toProject(s.Project),
 			Tests:     testsBySuite[suiteID],
 		}
 	}
@@ -368,6 +372,47 @@ func (r *PostgresRepository) GetTestSuitesWithCases(ctx context.Context, analysi
 	return suites, nil
 }
 
+// toFileDetection decodes test_files.detection. Files analyzed before
+// detection was recorded, or with an unreadable record, have none.
+func toFileDetection(data []byte) *entity.FileDetection {
+	if len(data) == 0 {
+		return nil
+	}
+	var record struct {
+		Confidence int      `json:"confidence"`
+		Evidence   []string `json:"evidence"`
+		Source     string   `json:"source"`
+	}
+	if err := json.Unmarshal(data, &record); err != nil || record.Source == "" {
+		return nil
+	}
+	return &entity.FileDetection{
+		Confidence: record.Confidence,
+		Evidence:   record.Evidence,
+		Source:     record.Source,
+	}
+}
+
+// toProject decodes test_files.project; files outside any package have none.
+func toProject(data []byte) *entity.Project {
+	if len(data) == 0 {
+		return nil
+	}
+	var record struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(data, &record); err != nil || record.Path == "" {
+		return nil
+	}
+	return &entity.Project{
+		Kind: record.Kind,
+		Name: record.Name,
+		Path: record.Path,
+	}
+}
+
 func (r *PostgresRepository) GetPaginatedRepositories(ctx context.Context, params port.PaginationParams) ([]port.PaginatedRepository, error) {
 	var userUUID pgtype.UUID
 	if params.UserID != "" {
@@ -17,14 +17,31 @@ type Analysis struct {
 }
 
 type TestSuite struct {
+	Detection *FileDetection
 	FilePath  string
 	Framework string
 	ID        string
 	Name      string
+	Project   *Project
 	TestCases []TestCase
 }
 
+// Project is the package or module owning a test file in a monorepo.
+type Project struct {
+	Kind string
+	Name string
+	Path string
+}
+
+// FileDetection explains why a test file was attributed to its framework.
+type FileDetection struct {
+	Confidence int
+	Evidence   []string
+	Source     string
+}
+
 type TestCase struct {
+	Kind   TestKind
 	Line   int
 	Name   string
 	Status TestStatus
@@ -1,5 +1,7 @@
 package entity
 
+import "slices"
+
 type AnalysisStatus string
 
 const (
@@ -35,6 +37,35 @@ func (s TestStatus) String() string {
 	return string(s)
 }
 
+type TestKind string
+
+const (
+	TestKindBenchmark   TestKind = "benchmark"
+	TestKindE2E         TestKind = "e2e"
+	TestKindExample     TestKind = "example"
+	TestKindFuzz        TestKind = "fuzz"
+	TestKindIntegration TestKind = "integration"
+	TestKindUnit        TestKind = "unit"
+)
+
+// TestKinds lists every test kind in reporting order.
+var TestKinds = []TestKind{
+	TestKindUnit,
+	TestKindIntegration,
+	TestKindE2E,
+	TestKindBenchmark,
+	TestKindFuzz,
+	TestKindExample,
+}
+
+func (k TestKind) IsValid() bool {
+	return slices.Contains(TestKinds, k)
+}
+
+func (k TestKind) String() string {
+	return string(k)
+}
+
 type UpdateStatus string
 
 const (
@@ -63,14 +63,17 @@ type CompletedAnalysis struct {
 }
 
 type TestSuiteWithCases struct {
+	Detection *entity.FileDetection
 	FilePath  string
 	Framework string
 	ID        string
 	Name      string
+	Project   *entity.Project
 	Tests     []TestCaseRow
 }
 
 type TestCaseRow struct {
+	Kind   string
 	Line   int
 	Name   string
 	Status string
@@ -78,6 +78,13 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 		}, nil
 	}
 
+	kinds, err := parseKindFilter(request.Params.Kind)
+	if err != nil {
+		return api.AnalyzeRepository400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
+		}, nil
+	}
+
 	userID := middleware.GetUserID(ctx)
 
 	// Specific commit query - use getAnalysis usecase
@@ -87,7 +94,7 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
 			}, nil
 		}
-		return h.analyzeRepositoryByCommit(ctx, owner, repo, *request.Params.Commit, userID, log)
+		return h.analyzeRepositoryByCommit(ctx, owner, repo, *request.Params.Commit, userID, kinds, log)
 	}
 
 	if userID == "" && h.anonymousRateLimiter != nil {
@@ -142,6 +149,7 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 
 	if result.Analysis != nil {
 		opts := h.buildHistoryOptions(ctx, userID, owner, repo)
+		opts.Kinds = kinds
 		response, mapErr := mapper.ToCompletedResponse(result.Analysis, opts)
 		if mapErr != nil {
 			log.Error(ctx, "failed to map completed response", "error", mapErr)
@@ -169,7 +177,7 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 	return newAnalyze202Response(response)
 }
 
-func (h *Handler) analyzeRepositoryByCommit(ctx context.Context, owner, repo, commitSHA, userID string, log *logger.Logger) (api.AnalyzeRepositoryResponseObject, error) {
+func (h *Handler) analyzeRepositoryByCommit(ctx context.Context, owner, repo, commitSHA, userID string, kinds []entity.TestKind, log *logger.Logger) (api.AnalyzeRepositoryResponseObject, error) {
 	result, err := h.getAnalysis.Execute(ctx, usecase.GetAnalysisInput{
 		CommitSHA: commitSHA,
 		Owner:     owner,
@@ -194,6 +202,7 @@ func (h *Handler) analyzeRepositoryByCommit(ctx context.Context, owner, repo, co
 	}
 
 	opts := h.buildHistoryOptions(ctx, userID, owner, repo)
+	opts.Kinds = kinds
 	response, mapErr := mapper.ToCompletedResponse(result.Analysis, opts)
 	if mapErr != nil {
 		log.Error(ctx, "failed to map completed response", "error", mapErr)
@@ -502,6 +511,21 @@ func validateCommitSHA(sha string) error {
 	return nil
 }
 
+func parseKindFilter(kinds *[]api.TestKind) ([]entity.TestKind, error) {
+	if kinds == nil {
+		return nil, nil
+	}
+	parsed := make([]entity.TestKind, 0, len(*kinds))
+	for _, k := range *kinds {
+		kind := entity.TestKind(k)
+		if !kind.IsValid() {
+			return nil, errors.Newf("invalid kind %q: must be one of unit, integration, e2e, benchmark, fuzz, example", k)
+		}
+		parsed = append(parsed, kind)
+	}
+	return parsed, nil
+}
+
 func validateRecentRepositoriesAuth(userID string, view *api.ViewFilterParam, ownership *api.OwnershipFilterParam) error {
 	if userID != "" {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/kubrickcode/specvital/apps/web/backend/internal/api"
+	"github.com/kubrickcode/specvital/apps/web/backend/modules/analyzer/domain/entity"
 	"github.com/kubrickcode/specvital/apps/web/backend/modules/analyzer/domain/port"
 )
 
@@ -119,6 +120,222 @@ func TestAnalyzeRepository(t *testing.T) {
 			t.Errorf("expected UpdateLastViewed(owner, repo), got (%s, %s)", repo.lastViewedOwner, repo.lastViewedRepo)
 		}
 	})
+
+	t.Run("returns 400 for invalid kind filter", func(t *testing.T) {
+		_, r := setupTestHandler()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/owner/repo?kind=smoke", nil)
+		rec := httptest.NewRecorder()
+
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("filters tests by kind", func(t *testing.T) {
+		parserVersion := "v1.0.0"
+		repo := &mockRepository{
+			completedAnalysis: &port.CompletedAnalysis{
+				ID:            "550e8400-e29b-41d4-a716-446655440000",
+				Owner:         "owner",
+				Repo:          "repo",
+				CommitSHA:     "abc123",
+				ParserVersion: &parserVersion,
+				CompletedAt:   time.Now(),
+				TotalSuites:   2,
+				TotalTests:    3,
+			},
+			suitesWithCases: []port.TestSuiteWithCases{
+				{
+					FilePath:  "src/user.test.ts",
+					Framework: "vitest",
+					ID:        "suite-1",
+					Name:      "UserService",
+					Tests: []port.TestCaseRow{
+						{Kind: "unit", Line: 3, Name: "creates user", Status: "active"},
+					},
+				},
+				{
+					FilePath:  "e2e/login.spec.ts",
+					Framework: "playwright",
+					ID:        "suite-2",
+					Name:      "login",
+					Tests: []port.TestCaseRow{
+						{Kind: "e2e", Line: 5, Name: "signs in", Status: "active"},
+						{Kind: "e2e", Line: 9, Name: "signs out", Status: "skipped"},
+					},
+				},
+			},
+		}
+		_, r := setupTestHandlerWithMocks(repo, &mockQueueService{}, &mockGitClient{commitSHA: "abc123"}, &mockTokenProvider{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/owner/repo?kind=e2e", nil)
+		rec := httptest.NewRecorder()
+
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		var resp api.CompletedResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if len(resp.Data.Suites) != 1 || resp.Data.Suites[0].SuiteName != "login" {
+			t.Fatalf("expected only the e2e suite, got %+v", resp.Data.Suites)
+		}
+		if resp.Data.Summary.Total != 2 {
+			t.Errorf("expected filtered total 2, got %d", resp.Data.Summary.Total)
+		}
+		if resp.Data.Summary.Kinds == nil || resp.Data.Summary.Kinds.E2e != 2 || resp.Data.Summary.Kinds.Unit != 0 {
+			t.Errorf("expected kinds summary with 2 e2e tests, got %+v", resp.Data.Summary.Kinds)
+		}
+		if kind := resp.Data.Suites[0].Tests[0].Kind; kind == nil || *kind != api.E2e {
+			t.Errorf("expected test kind e2e, got %v", kind)
+		}
+	})
+
+	t.Run("includes file detection", func(t *testing.T) {
+		parserVersion := "v1.0.0"
+		repo := &mockRepository{
+			completedAnalysis: &port.CompletedAnalysis{
+				ID:            "550e8400-e29b-41d4-a716-446655440000",
+				Owner:         "owner",
+				Repo:          "repo",
+				CommitSHA:     "abc123",
+				ParserVersion: &parserVersion,
+				CompletedAt:   time.Now(),
+				TotalSuites:   1,
+				TotalTests:    1,
+			},
+			suitesWithCases: []port.TestSuiteWithCases{
+				{
+					Detection: &entity.FileDetection{
+						Confidence: 80,
+						Evidence:   []string{"config: jest.config.js"},
+						Source:     "config-scope",
+					},
+					FilePath:  "src/user.test.ts",
+					Framework: "jest",
+					ID:        "suite-1",
+					Name:      "UserService",
+					Tests: []port.TestCaseRow{
+						{Kind: "unit", Line: 3, Name: "creates user", Status: "active"},
+					},
+				},
+			},
+		}
+		_, r := setupTestHandlerWithMocks(repo, &mockQueueService{}, &mockGitClient{commitSHA: "abc123"}, &mockTokenProvider{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/owner/repo", nil)
+		rec := httptest.NewRecorder()
+
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		var resp api.CompletedResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if len(resp.Data.Suites) != 1 {
+			t.Fatalf("expected 1 suite, got %d", len(resp.Data.Suites))
+		}
+		detection := resp.Data.Suites[0].Detection
+		if detection == nil {
+			t.Fatal("expected detection to be set")
+		}
+		if detection.Source != api.ConfigScope || detection.Confidence != 80 {
+			t.Errorf("unexpected detection %+v", detection)
+		}
+		if detection.Evidence == nil || len(*detection.Evidence) != 1 || (*detection.Evidence)[0] != "config: jest.config.js" {
+			t.Errorf("unexpected evidence %v", detection.Evidence)
+		}
+	})
+
+	t.Run("aggregates tests per project", func(t *testing.T) {
+		parserVersion := "v1.0.0"
+		ui := &entity.Project{Kind: "npm", Name: "@acme/ui", Path: "packages/ui"}
+		apiProject := &entity.Project{Kind: "go", Name: "github.com/acme/api", Path: "services/api"}
+		repo := &mockRepository{
+			completedAnalysis: &port.CompletedAnalysis{
+				ID:            "550e8400-e29b-41d4-a716-446655440000",
+				Owner:         "owner",
+				Repo:          "repo",
+				CommitSHA:     "abc123",
+				ParserVersion: &parserVersion,
+				CompletedAt:   time.Now(),
+				TotalSuites:   4,
+				TotalTests:    5,
+			},
+			suitesWithCases: []port.TestSuiteWithCases{
+				{
+					FilePath: "packages/ui/button.test.ts", Framework: "vitest", ID: "suite-1", Name: "Button", Project: ui,
+					Tests: []port.TestCaseRow{
+						{Kind: "unit", Line: 3, Name: "renders", Status: "active"},
+						{Kind: "unit", Line: 7, Name: "clicks", Status: "skipped"},
+					},
+				},
+				{
+					FilePath: "packages/ui/button.test.ts", Framework: "vitest", ID: "suite-2", Name: "Button disabled", Project: ui,
+					Tests: []port.TestCaseRow{{Kind: "unit", Line: 12, Name: "ignores clicks", Status: "active"}},
+				},
+				{
+					FilePath: "services/api/handler_test.go", Framework: "go-testing", ID: "suite-3", Name: "TestHandle", Project: apiProject,
+					Tests: []port.TestCaseRow{{Kind: "unit", Line: 5, Name: "TestHandle", Status: "active"}},
+				},
+				{
+					FilePath: "scripts/release.test.js", Framework: "vitest", ID: "suite-4", Name: "release",
+					Tests: []port.TestCaseRow{{Kind: "unit", Line: 1, Name: "releases", Status: "todo"}},
+				},
+			},
+		}
+		_, r := setupTestHandlerWithMocks(repo, &mockQueueService{}, &mockGitClient{commitSHA: "abc123"}, &mockTokenProvider{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/owner/repo", nil)
+		rec := httptest.NewRecorder()
+
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+
+		var resp api.CompletedResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if resp.Data.Summary.Projects == nil {
+			t.Fatal("expected project summaries")
+		}
+		projects := *resp.Data.Summary.Projects
+		if len(projects) != 2 {
+			t.Fatalf("expected 2 projects, got %+v", projects)
+		}
+		want := []api.ProjectSummary{
+			{Active: 2, Files: 1, Kind: "npm", Name: "@acme/ui", Path: "packages/ui", Skipped: 1, Total: 3},
+			{Active: 1, Files: 1, Kind: "go", Name: "github.com/acme/api", Path: "services/api", Total: 1},
+		}
+		for i := range want {
+			if projects[i] != want[i] {
+				t.Errorf("project %d: expected %+v, got %+v", i, want[i], projects[i])
+			}
+		}
+		if p := resp.Data.Suites[0].Project; p == nil || p.Name != "@acme/ui" {
+			t.Errorf("expected suite project @acme/ui, got %+v", p)
+		}
+		if p := resp.Data.Suites[3].Project; p != nil {
+			t.Errorf("expected no project for scripts/release.test.js, got %+v", p)
+		}
+	})
 }
 
 func TestGetAnalysisStatus(t *testing.T) {
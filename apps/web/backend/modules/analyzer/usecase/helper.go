@@ -56,6 +56,7 @@ func buildAnalysisFromCompleted(ctx context.Context, repository port.Repository,
 		testCases := make([]entity.TestCase, len(suite.Tests))
 		for j, t := range suite.Tests {
 			testCases[j] = entity.TestCase{
+				Kind:   mapToTestKind(t.Kind),
 				Line:   t.Line,
 				Name:   t.Name,
 				Status: mapToTestStatus(t.Status),
@@ -63,10 +64,12 @@ func buildAnalysisFromCompleted(ctx context.Context, repository port.Repository,
 		}
 
 		suites[i] = entity.TestSuite{
+			Detection: suite.Detection,
 			FilePath:  suite.FilePath,
 			Framework: suite.Framework,
 			ID:        suite.ID,
 			Name:      suite.Name,
+			Project:   suite.Project,
 			TestCases: testCases,
 		}
 	}
@@ -110,6 +113,14 @@ func mapToTestStatus(status string) entity.TestStatus {
 	}
 }
 
+func mapToTestKind(kind string) entity.TestKind {
+	if k := entity.TestKind(kind); k.IsValid() {
+		return k
+	}
+	// Rows written before kind classification default to unit
+	return entity.TestKindUnit
+}
+
 func mapQueueStateToAnalysisStatus(state string) entity.AnalysisStatus {
 	switch state {
 	case "available", "pending", "retryable", "scheduled":
@@ -61,6 +61,8 @@ SELECT
     ts.id,
     tf.file_path,
     tf.framework,
+    tf.detection,
+    tf.project,
     ts.name
 FROM test_suites ts
 JOIN test_files tf ON ts.file_id = tf.id
@@ -73,7 +75,8 @@ SELECT
     tc.suite_id,
     tc.name,
     tc.line_number,
-    tc.status
+    tc.status,
+    tc.kind
 FROM test_cases tc
 WHERE tc.suite_id = ANY($1::uuid[])
 ORDER BY tc.suite_id, tc.line_number;
@@ -987,12 +987,14 @@ export interface components {
             summary: components["schemas"]["Summary"];
         };
         TestSuite: {
+            detection?: components["schemas"]["FileDetection"];
             /**
              * @description Path to the test file relative to repository root
              * @example src/__tests__/App.test.tsx
              */
             filePath: string;
             framework: components["schemas"]["Framework"];
+            project?: components["schemas"]["Project"];
             /**
              * @description Name of the test suite (describe block name)
              * @example UserService
@@ -1004,6 +1006,7 @@ export interface components {
             /** @description Path to the test file */
             filePath: string;
             framework: components["schemas"]["Framework"];
+            kind?: components["schemas"]["TestKind"];
             /** @description Line number where the test is defined */
             line: number;
             /** @description Test modifier (e.g., only, skip) */
@@ -1023,17 +1026,59 @@ export interface components {
          * @enum {string}
          */
         TestStatus: "active" | "focused" | "skipped" | "todo" | "xfail";
+        /**
+         * @description Test kind classification:
+         *     - unit: Default for tests without a more specific kind
+         *     - integration: Tests under integration paths (e.g., /integration/)
+         *     - e2e: End-to-end tests (Playwright, Cypress, or /e2e/ paths)
+         *     - benchmark: Benchmarks (Go Benchmark*, criterion, BenchmarkDotNet)
+         *     - fuzz: Fuzz tests (Go Fuzz*)
+         *     - example: Executable examples (Go Example*)
+         *
+         * @enum {string}
+         */
+        TestKind: "unit" | "integration" | "e2e" | "benchmark" | "fuzz" | "example";
         /**
          * @description Testing framework identifier
          * @example vitest
          */
         Framework: string;
+        /** @description Why the test file was attributed to its framework */
+        FileDetection: {
+            /**
+             * @description Score of the signal that decided the framework
+             * @example 100
+             */
+            confidence: number;
+            /**
+             * @description Indicators behind the decision
+             * @example [
+             *       "import: vitest"
+             *     ]
+             */
+            evidence?: string[];
+            source: components["schemas"]["DetectionSource"];
+        };
+        /**
+         * @description How the framework was detected:
+         *     - import: Explicit framework import
+         *     - strong-filename: Framework-specific file name (e.g., *.cy.ts)
+         *     - config-scope: Covered by a framework config file
+         *     - repo-config: Override in the repository's .specvital.yml
+         *     - content-pattern: Framework-specific code patterns
+         *
+         * @enum {string}
+         */
+        DetectionSource: "import" | "strong-filename" | "config-scope" | "repo-config" | "content-pattern";
         Summary: {
             /** @description Number of active tests */
             active: number;
             /** @description Number of focused tests */
             focused: number;
             frameworks: components["schemas"]["FrameworkSummary"][];
+            kinds?: components["schemas"]["KindSummary"];
+            /** @description Per-project breakdown for monorepos; omitted when no file belongs to a project */
+            projects?: components["schemas"]["ProjectSummary"][];
             /** @description Number of skipped tests */
             skipped: number;
             /** @description Number of todo tests */
@@ -1043,6 +1088,15 @@ export interface components {
             /** @description Number of xfail tests */
             xfail: number;
         };
+        /** @description Number of tests per kind */
+        KindSummary: {
+            benchmark: number;
+            e2e: number;
+            example: number;
+            fuzz: number;
+            integration: number;
+            unit: number;
+        };
         FrameworkSummary: {
             active: number;
             focused: number;
@@ -1052,6 +1106,49 @@ export interface components {
             total: number;
             xfail: number;
         };
+        /** @description Package or module owning the test file in a monorepo */
+        Project: {
+            /**
+             * @description Build system declaring the project (go, npm, cargo, gradle, dotnet, python)
+             * @example npm
+             */
+            kind: string;
+            /**
+             * @description Declared package or module name
+             * @example @acme/ui
+             */
+            name: string;
+            /**
+             * @description Project directory relative to repository root ("." for the root)
+             * @example packages/ui
+             */
+            path: string;
+        };
+        ProjectSummary: {
+            active: number;
+            /** @description Number of test files in the project */
+            files: number;
+            focused: number;
+            /**
+             * @description Build system declaring the project
+             * @example npm
+             */
+            kind: string;
+            /**
+             * @description Declared package or module name
+             * @example @acme/ui
+             */
+            name: string;
+            /**
+             * @description Project directory relative to repository root
+             * @example packages/ui
+             */
+            path: string;
+            skipped: number;
+            todo: number;
+            total: number;
+            xfail: number;
+        };
         ProblemDetail: {
             /**
              * Format: uri
@@ -2197,6 +2294,11 @@ export interface operations {
                  *     If not found, returns 404 instead of queueing new analysis.
                  *      */
                 commit?: string;
+                /** @description Only include tests of these kinds (repeatable).
+                 *     Suites without matching tests are omitted and summary counts
+                 *     reflect the filtered tests.
+                 *      */
+                kind?: components["schemas"]["TestKind"][];
             };
             header?: never;
             path: {
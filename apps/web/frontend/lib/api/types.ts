@@ -12,9 +12,11 @@ export type AnalysisResult = components["schemas"]["AnalysisResult"];
 export type TestSuite = components["schemas"]["TestSuite"];
 export type TestCase = components["schemas"]["TestCase"];
 export type TestStatus = components["schemas"]["TestStatus"];
+export type TestKind = components["schemas"]["TestKind"];
 export type Framework = components["schemas"]["Framework"];
 export type Summary = components["schemas"]["Summary"];
 export type FrameworkSummary = components["schemas"]["FrameworkSummary"];
+export type KindSummary = components["schemas"]["KindSummary"];
 
 // API response types
 export type AnalysisResponse = components["schemas"]["AnalysisResponse"];
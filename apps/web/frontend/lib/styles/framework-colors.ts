@@ -5,9 +5,14 @@ type FrameworkColorConfig = {
 
 const FRAMEWORK_COLORS: Record<string, FrameworkColorConfig> = {
   // JavaScript/TypeScript
+  ava: { badge: "bg-indigo-100 text-indigo-800", solid: "#4b4bde" },
+  "bun-test": { badge: "bg-stone-100 text-stone-800", solid: "#14151a" },
   cypress: { badge: "bg-emerald-100 text-emerald-800", solid: "#1b9e77" },
+  "deno-test": { badge: "bg-neutral-100 text-neutral-800", solid: "#70ffaf" },
+  jasmine: { badge: "bg-fuchsia-100 text-fuchsia-800", solid: "#8a4182" },
   jest: { badge: "bg-red-100 text-red-800", solid: "#c21325" },
   mocha: { badge: "bg-amber-100 text-amber-800", solid: "#8d6748" },
+  "node-test": { badge: "bg-lime-100 text-lime-800", solid: "#5fa04e" },
   playwright: { badge: "bg-green-100 text-green-700", solid: "#2ead33" },
   vitest: { badge: "bg-green-100 text-green-800", solid: "#6da13f" },
 
@@ -17,6 +22,7 @@ const FRAMEWORK_COLORS: Record<string, FrameworkColorConfig> = {
 
   // Go
   go: { badge: "bg-cyan-100 text-cyan-800", solid: "#00add8" },
+  ginkgo: { badge: "bg-teal-100 text-teal-800", solid: "#2c8c8c" },
   "go-testing": { badge: "bg-cyan-100 text-cyan-800", solid: "#00add8" },
 
   // Java
@@ -29,6 +35,7 @@ const FRAMEWORK_COLORS: Record<string, FrameworkColorConfig> = {
   kotest: { badge: "bg-purple-100 text-purple-800", solid: "#7f52ff" },
 
   // C#
+  benchmarkdotnet: { badge: "bg-violet-100 text-violet-800", solid: "#68217a" },
   mstest: { badge: "bg-violet-100 text-violet-800", solid: "#512bd4" },
   nunit: { badge: "bg-violet-100 text-violet-800", solid: "#512bd4" },
   xunit: { badge: "bg-indigo-100 text-indigo-800", solid: "#68217a" },
@@ -43,13 +50,33 @@ const FRAMEWORK_COLORS: Record<string, FrameworkColorConfig> = {
   // Rust
   cargo: { badge: "bg-orange-100 text-orange-700", solid: "#f74c00" },
   "cargo-test": { badge: "bg-orange-100 text-orange-700", solid: "#f74c00" },
+  criterion: { badge: "bg-amber-100 text-amber-800", solid: "#f74c00" },
 
   // C++
+  "boost-test": { badge: "bg-slate-100 text-slate-800", solid: "#5c7d9a" },
+  catch2: { badge: "bg-teal-100 text-teal-800", solid: "#1fa27c" },
+  doctest: { badge: "bg-cyan-100 text-cyan-800", solid: "#0e7490" },
   "google-test": { badge: "bg-blue-100 text-blue-800", solid: "#4285f4" },
   googletest: { badge: "bg-blue-100 text-blue-800", solid: "#4285f4" },
 
   // Swift
   xctest: { badge: "bg-orange-100 text-orange-800", solid: "#f05138" },
+
+  // Dart
+  "dart-test": { badge: "bg-sky-100 text-sky-800", solid: "#0175c2" },
+  "flutter-test": { badge: "bg-blue-100 text-blue-800", solid: "#02569b" },
+
+  // Elixir / Erlang
+  exunit: { badge: "bg-purple-100 text-purple-800", solid: "#6e4a7e" },
+  "common-test": { badge: "bg-rose-100 text-rose-800", solid: "#a90533" },
+
+  // Scala
+  munit: { badge: "bg-indigo-100 text-indigo-800", solid: "#3f51b5" },
+  scalatest: { badge: "bg-red-100 text-red-800", solid: "#dc322f" },
+  specs2: { badge: "bg-orange-100 text-orange-800", solid: "#c2410c" },
+
+  // Gherkin
+  gherkin: { badge: "bg-green-100 text-green-800", solid: "#23d96c" },
 };
 
 const FALLBACK_BADGE_PALETTE = [
@@ -21,12 +21,13 @@ func main() {
 	}
 
 	if err := bootstrap.StartAnalyzer(bootstrap.AnalyzerConfig{
-		ServiceName:   "analyzer",
-		DatabaseURL:   cfg.DatabaseURL,
-		EncryptionKey: cfg.EncryptionKey,
-		Fairness:      cfg.Fairness,
-		QueueWorkers:  cfg.Queue.Analyzer,
-		Streaming:     cfg.Streaming,
+		ServiceName:    "analyzer",
+		DatabaseURL:    cfg.DatabaseURL,
+		EncryptionKey:  cfg.EncryptionKey,
+		Fairness:       cfg.Fairness,
+		ParserCacheDir: cfg.ParserCacheDir,
+		QueueWorkers:   cfg.Queue.Analyzer,
+		Streaming:      cfg.Streaming,
 	}); err != nil {
 		slog.Error("analyzer failed", "error", err)
 		os.Exit(1)
@@ -27,6 +27,11 @@ func BuildPhase1UserPrompt(input specview.Phase1Input, language specview.Languag
 		}
 		sb.WriteString("\n")
 
+		// Domain chosen by the repository owner in .specvital.yml
+		if file.SuggestedDomain != "" {
+			sb.WriteString(fmt.Sprintf("  domain: %s\n", file.SuggestedDomain))
+		}
+
 		// Domain hints (imports and calls)
 		if file.DomainHints != nil {
 			if len(file.DomainHints.Imports) > 0 {
@@ -98,6 +103,10 @@ func BuildPhase1UserPromptWithAnchors(input specview.Phase1Input, language specv
 		}
 		sb.WriteString("\n")
 
+		if file.SuggestedDomain != "" {
+			sb.WriteString(fmt.Sprintf("  domain: %s\n", file.SuggestedDomain))
+		}
+
 		if file.DomainHints != nil {
 			if len(file.DomainHints.Imports) > 0 {
 				sb.WriteString(fmt.Sprintf("  imports: %s\n", strings.Join(file.DomainHints.Imports, ", ")))
@@ -65,6 +65,35 @@ func TestBuildPhase1UserPrompt_WithDomainHints(t *testing.T) {
 	}
 }
 
+func TestBuildPhase1UserPrompt_WithSuggestedDomain(t *testing.T) {
+	input := specview.Phase1Input{
+		Files: []specview.FileInfo{
+			{
+				Path:            "src/billing/invoice.spec.ts",
+				SuggestedDomain: "Billing",
+				Tests: []specview.TestInfo{
+					{Index: 0, Name: "should issue invoice"},
+				},
+			},
+			{
+				Path: "src/cart.spec.ts",
+				Tests: []specview.TestInfo{
+					{Index: 1, Name: "should add item"},
+				},
+			},
+		},
+	}
+
+	prompt := BuildPhase1UserPrompt(input, "English")
+
+	if !strings.Contains(prompt, "[0] src/billing/invoice.spec.ts\n  domain: Billing\n") {
+		t.Error("prompt should contain the suggested domain under its file")
+	}
+	if strings.Count(prompt, "domain:") != 1 {
+		t.Error("files without a suggested domain should have no domain line")
+	}
+}
+
 func TestBuildPhase1UserPrompt_WithSuitePath(t *testing.T) {
 	input := specview.Phase1Input{
 		Files: []specview.FileInfo{
@@ -34,14 +34,55 @@ func convertCoreTestFile(coreFile domain.TestFile) analysis.TestFile {
 	}
 
 	return analysis.TestFile{
+		Detection:   convertDetection(coreFile.Detection),
 		DomainHints: convertDomainHints(coreFile.DomainHints),
+		Findings:    convertFindings(coreFile.Findings),
 		Framework:   coreFile.Framework,
 		Path:        coreFile.Path,
+		Project:     convertProject(coreFile.Project),
 		Suites:      domainSuites,
 		Tests:       domainTests,
 	}
 }
 
+func convertDetection(coreDetection *domain.Detection) *analysis.Detection {
+	if coreDetection == nil {
+		return nil
+	}
+	return &analysis.Detection{
+		Confidence: coreDetection.Confidence,
+		Evidence:   coreDetection.Evidence,
+		Source:     coreDetection.Source,
+	}
+}
+
+func convertFindings(coreFindings []domain.Finding) []analysis.Finding {
+	if len(coreFindings) == 0 {
+		return nil
+	}
+	findings := make([]analysis.Finding, len(coreFindings))
+	for i, f := range coreFindings {
+		findings[i] = analysis.Finding{
+			Location: analysis.Location{StartLine: f.Location.StartLine, EndLine: f.Location.EndLine},
+			Message:  f.Message,
+			Rule:     f.Rule,
+			Severity: string(f.Severity),
+		}
+	}
+	return findings
+}
+
+func convertProject(coreProject *domain.Project) *analysis.Project {
+	if coreProject == nil {
+		return nil
+	}
+	return &analysis.Project{
+		Kind: coreProject.Kind,
+		Name: coreProject.Name,
+		Path: coreProject.Path,
+	}
+}
+
 func convertDomainHints(coreHints *domain.DomainHints) *analysis.DomainHints {
 	if coreHints == nil {
 		return nil
@@ -70,6 +111,7 @@ func convertCoreTestSuite(coreSuite domain.TestSuite) analysis.TestSuite {
 			EndLine:   coreSuite.Location.EndLine,
 		},
 		Suites: domainSuites,
+		Tags:   coreSuite.Tags,
 		Tests:  domainTests,
 	}
 }
@@ -81,7 +123,12 @@ func convertCoreTest(coreTest domain.Test) analysis.Test {
 			StartLine: coreTest.Location.StartLine,
 			EndLine:   coreTest.Location.EndLine,
 		},
-		Status: convertCoreTestStatus(coreTest.Status),
+		Fingerprint: coreTest.Fingerprint,
+		Kind:        convertCoreTestKind(coreTest.Kind),
+		Metrics:     convertTestMetrics(coreTest.Metrics),
+		SkipReason:  coreTest.SkipReason,
+		Status:      convertCoreTestStatus(coreTest.Status),
+		Tags:        coreTest.Tags,
 	}
 }
 
@@ -100,6 +147,34 @@ func convertCoreTestStatus(coreStatus domain.TestStatus) analysis.TestStatus {
 	}
 }
 
+func convertTestMetrics(coreMetrics *domain.TestMetrics) *analysis.TestMetrics {
+	if coreMetrics == nil {
+		return nil
+	}
+	return &analysis.TestMetrics{
+		Assertions: coreMetrics.Assertions,
+		Depth:      coreMetrics.Depth,
+		Lines:      coreMetrics.Lines,
+	}
+}
+
+func convertCoreTestKind(coreKind domain.TestKind) analysis.TestKind {
+	switch coreKind {
+	case domain.TestKindBenchmark:
+		return analysis.TestKindBenchmark
+	case domain.TestKindE2E:
+		return analysis.TestKindE2E
+	case domain.TestKindExample:
+		return analysis.TestKindExample
+	case domain.TestKindFuzz:
+		return analysis.TestKindFuzz
+	case domain.TestKindIntegration:
+		return analysis.TestKindIntegration
+	default:
+		return analysis.TestKindUnit
+	}
+}
+
 // ConvertCoreFileResult converts a core FileResult to domain FileResult for streaming.
 func ConvertCoreFileResult(coreResult *coreparser.FileResult) analysis.FileResult {
 	if coreResult == nil {
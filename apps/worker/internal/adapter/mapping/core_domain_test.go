@@ -2,6 +2,7 @@ package mapping
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
@@ -74,6 +75,31 @@ func TestConvertCoreTestStatus(t *testing.T) {
 	}
 }
 
+func TestConvertCoreTestKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		coreKind domain.TestKind
+		expected analysis.TestKind
+	}{
+		{name: "unit", coreKind: domain.TestKindUnit, expected: analysis.TestKindUnit},
+		{name: "integration", coreKind: domain.TestKindIntegration, expected: analysis.TestKindIntegration},
+		{name: "e2e", coreKind: domain.TestKindE2E, expected: analysis.TestKindE2E},
+		{name: "benchmark", coreKind: domain.TestKindBenchmark, expected: analysis.TestKindBenchmark},
+		{name: "fuzz", coreKind: domain.TestKindFuzz, expected: analysis.TestKindFuzz},
+		{name: "example", coreKind: domain.TestKindExample, expected: analysis.TestKindExample},
+		{name: "unclassified defaults to unit", coreKind: "", expected: analysis.TestKindUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertCoreTestKind(tt.coreKind)
+			if result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestConvertCoreTestFile(t *testing.T) {
 	coreFile := domain.TestFile{
 		Path:      "test.ts",
@@ -137,6 +163,89 @@ func TestConvertCoreTestFile_WithDomainHints(t *testing.T) {
 	}
 }
 
+func TestConvertCoreTestFile_WithDetection(t *testing.T) {
+	coreFile := domain.TestFile{
+		Path:      "app.test.ts",
+		Framework: "vitest",
+		Detection: &domain.Detection{
+			Confidence: 80,
+			Evidence:   []string{"config: vitest.config.ts"},
+			Source:     "config-scope",
+		},
+	}
+
+	result := convertCoreTestFile(coreFile)
+
+	if result.Detection == nil {
+		t.Fatal("expected Detection to be non-nil")
+	}
+	if result.Detection.Source != "config-scope" || result.Detection.Confidence != 80 {
+		t.Errorf("unexpected detection %+v", result.Detection)
+	}
+	if len(result.Detection.Evidence) != 1 || result.Detection.Evidence[0] != "config: vitest.config.ts" {
+		t.Errorf("unexpected evidence %v", result.Detection.Evidence)
+	}
+
+	if convertCoreTestFile(domain.TestFile{Path: "a.test.ts"}).Detection != nil {
+		t.Error("expected nil Detection when the core file has none")
+	}
+}
+
+func TestConvertCoreTestFile_WithProject(t *testing.T) {
+	coreFile := domain.TestFile{
+		Path:      "packages/ui/button.test.ts",
+		Framework: "vitest",
+		Project:   &domain.Project{Kind: "npm", Name: "@acme/ui", Path: "packages/ui"},
+	}
+
+	result := convertCoreTestFile(coreFile)
+
+	if result.Project == nil {
+		t.Fatal("expected Project to be non-nil")
+	}
+	if *result.Project != (analysis.Project{Kind: "npm", Name: "@acme/ui", Path: "packages/ui"}) {
+		t.Errorf("unexpected project %+v", *result.Project)
+	}
+}
+
+func TestConvertCoreTestFile_WithFindings(t *testing.T) {
+	coreFile := domain.TestFile{
+		Path:      "cart.test.ts",
+		Framework: "vitest",
+		Findings: []domain.Finding{{
+			Location: domain.Location{File: "cart.test.ts", StartLine: 3, EndLine: 5},
+			Message:  `focused test "adds" makes the runner skip all other tests`,
+			Rule:     "focused-test",
+			Severity: domain.SeverityError,
+		}},
+	}
+
+	result := convertCoreTestFile(coreFile)
+
+	want := []analysis.Finding{{
+		Location: analysis.Location{StartLine: 3, EndLine: 5},
+		Message:  `focused test "adds" makes the runner skip all other tests`,
+		Rule:     "focused-test",
+		Severity: "error",
+	}}
+	if !reflect.DeepEqual(result.Findings, want) {
+		t.Errorf("expected findings %+v, got %+v", want, result.Findings)
+	}
+}
+
+func TestConvertTestMetrics(t *testing.T) {
+	if result := convertTestMetrics(nil); result != nil {
+		t.Errorf("expected nil for nil input, got %v", result)
+	}
+
+	result := convertTestMetrics(&domain.TestMetrics{Assertions: 3, Depth: 1, Lines: 7})
+
+	want := &analysis.TestMetrics{Assertions: 3, Depth: 1, Lines: 7}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("expected metrics %+v, got %+v", want, result)
+	}
+}
+
 func TestConvertDomainHints(t *testing.T) {
 	t.Run("nil input returns nil", func(t *testing.T) {
 		result := convertDomainHints(nil)
@@ -207,9 +316,14 @@ func TestConvertCoreTestSuite(t *testing.T) {
 					StartLine: 12,
 					EndLine:   13,
 				},
-				Status: domain.TestStatusSkipped,
+				Fingerprint: "93a4d870103f73a93da185454d9b8ee5",
+				Kind:        domain.TestKindIntegration,
+				SkipReason:  "flaky on CI",
+				Status:      domain.TestStatusSkipped,
+				Tags:        []string{"slow"},
 			},
 		},
+		Tags: []string{"integration"},
 	}
 
 	result := convertCoreTestSuite(coreSuite)
@@ -229,6 +343,21 @@ func TestConvertCoreTestSuite(t *testing.T) {
 	if result.Tests[0].Status != analysis.TestStatusSkipped {
 		t.Errorf("expected status skipped, got %v", result.Tests[0].Status)
 	}
+	if result.Tests[0].Kind != analysis.TestKindIntegration {
+		t.Errorf("expected kind integration, got %v", result.Tests[0].Kind)
+	}
+	if result.Tests[0].SkipReason != "flaky on CI" {
+		t.Errorf("expected skip reason 'flaky on CI', got %q", result.Tests[0].SkipReason)
+	}
+	if result.Tests[0].Fingerprint != "93a4d870103f73a93da185454d9b8ee5" {
+		t.Errorf("expected fingerprint to be copied, got %q", result.Tests[0].Fingerprint)
+	}
+	if len(result.Tags) != 1 || result.Tags[0] != "integration" {
+		t.Errorf("expected suite tags [integration], got %v", result.Tags)
+	}
+	if len(result.Tests[0].Tags) != 1 || result.Tests[0].Tags[0] != "slow" {
+		t.Errorf("expected test tags [slow], got %v", result.Tests[0].Tags)
+	}
 }
 
 func TestConvertCoreFileResult(t *testing.T) {
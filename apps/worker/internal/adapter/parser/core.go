@@ -2,20 +2,36 @@ package parser
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 
 	"github.com/kubrickcode/specvital/apps/worker/internal/adapter/mapping"
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
 	coreparser "github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
 	"github.com/kubrickcode/specvital/lib/source"
 )
 
 // CoreParser implements analysis.Parser using specvital/core's parser package.
-type CoreParser struct{}
+type CoreParser struct {
+	opts []coreparser.ScanOption
+}
 
 // NewCoreParser creates a new CoreParser.
-func NewCoreParser() *CoreParser {
-	return &CoreParser{}
+// The given options are applied to every scan (e.g., coreparser.WithResultCache).
+func NewCoreParser(opts ...coreparser.ScanOption) *CoreParser {
+	return &CoreParser{opts: opts}
+}
+
+// scanOptions returns the options of one scan. Each scan gets its own
+// progress logger, as SlogObserver counts files per scan; observers set
+// through NewCoreParser take precedence.
+func (p *CoreParser) scanOptions() []coreparser.ScanOption {
+	opts := make([]coreparser.ScanOption, 0, len(p.opts)+1)
+	opts = append(opts, coreparser.WithObserver(coreparser.NewSlogObserver(slog.Default())))
+	return append(opts, p.opts...)
 }
 
 // coreSourceProvider is implemented by sources that can provide
@@ -32,7 +48,7 @@ func (p *CoreParser) Scan(ctx context.Context, src analysis.Source) (*analysis.I
 		return nil, fmt.Errorf("source does not implement coreSourceProvider interface")
 	}
 
-	result, err := coreparser.Scan(ctx, provider.CoreSource())
+	result, err := coreparser.Scan(ctx, provider.CoreSource(), p.scanOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("core parser scan: %w", err)
 	}
@@ -48,7 +64,7 @@ func (p *CoreParser) ScanStream(ctx context.Context, src analysis.Source) (<-cha
 		return nil, fmt.Errorf("source does not implement coreSourceProvider interface")
 	}
 
-	coreCh, err := coreparser.ScanStreaming(ctx, provider.CoreSource())
+	coreCh, err := coreparser.ScanStreaming(ctx, provider.CoreSource(), p.scanOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("core parser scan stream: %w", err)
 	}
@@ -67,3 +83,29 @@ func (p *CoreParser) ScanStream(ctx context.Context, src analysis.Source) (<-cha
 
 	return domainCh, nil
 }
+
+// ReadConfig implements analysis.ConfigReader. The configuration is read
+// and validated the way Scan does, and returned as JSON for storage.
+func (p *CoreParser) ReadConfig(ctx context.Context, src analysis.Source) ([]byte, error) {
+	provider, ok := src.(coreSourceProvider)
+	if !ok {
+		return nil, fmt.Errorf("source does not implement coreSourceProvider interface")
+	}
+
+	cfg, err := repoconfig.Load(ctx, provider.CoreSource())
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	if _, err := cfg.Resolve(framework.DefaultRegistry()); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("encode repository config: %w", err)
+	}
+	return data, nil
+}
@@ -2,11 +2,16 @@ package parser
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
+	coreparser "github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/source"
 )
 
 func TestNewCoreParser(t *testing.T) {
@@ -60,4 +65,162 @@ func (m *mockInvalidSource) VerifyCommitExists(_ context.Context, _ string) (boo
 	return true, nil
 }
 
+func TestCoreParser_Scan_CustomFrameworks(t *testing.T) {
+	src := newLocalTestSource(t, map[string]string{
+		".specvital.yml": `version: 1
+frameworks:
+  - name: acme-spec
+    languages: [javascript]
+    detection:
+      filenames: ["*.acme.js"]
+    parse:
+      suites: [spec]
+      tests: [check]
+`,
+		"qa/cart.acme.js": `spec("Cart", () => {
+  check("adds items", () => {});
+});
+`,
+	})
+
+	inventory, err := NewCoreParser().Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if len(inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(inventory.Files))
+	}
+	if inventory.Files[0].Framework != "acme-spec" {
+		t.Errorf("expected acme-spec, got %q", inventory.Files[0].Framework)
+	}
+	suites := inventory.Files[0].Suites
+	if len(suites) != 1 || len(suites[0].Tests) != 1 || suites[0].Tests[0].Name != "adds items" {
+		t.Errorf("expected suite Cart with test %q, got %+v", "adds items", suites)
+	}
+}
+
+// completionObserver counts completed scans.
+type completionObserver struct {
+	coreparser.NopObserver
+	completed atomic.Int32
+}
+
+func (o *completionObserver) ScanCompleted(coreparser.ScanStats) {
+	o.completed.Add(1)
+}
+
+func TestCoreParser_Observer(t *testing.T) {
+	src := newLocalTestSource(t, map[string]string{
+		"cart_test.go": "package cart\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {}\n",
+	})
+	observer := &completionObserver{}
+	p := NewCoreParser(coreparser.WithObserver(observer))
+
+	if _, err := p.Scan(context.Background(), src); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	results, err := p.ScanStream(context.Background(), src)
+	if err != nil {
+		t.Fatalf("ScanStream failed: %v", err)
+	}
+	for range results {
+	}
+
+	if got := observer.completed.Load(); got != 2 {
+		t.Errorf("expected the configured observer to see 2 scans, got %d", got)
+	}
+}
+
+func TestCoreParser_Scan_InvalidCustomFrameworks(t *testing.T) {
+	src := newLocalTestSource(t, map[string]string{
+		".specvital.yml": "version: 1\nframeworks:\n  - name: acme-spec\n",
+	})
+
+	_, err := NewCoreParser().Scan(context.Background(), src)
+	if err == nil {
+		t.Fatal("expected error for invalid .specvital.yml")
+	}
+	if !strings.Contains(err.Error(), ".specvital.yml: frameworks[0] (acme-spec): languages") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+
+	if _, err := NewCoreParser().ScanStream(context.Background(), src); err == nil {
+		t.Fatal("expected ScanStream error for invalid .specvital.yml")
+	}
+	if _, err := NewCoreParser().ReadConfig(context.Background(), src); err == nil {
+		t.Fatal("expected ReadConfig error for invalid .specvital.yml")
+	}
+}
+
+func TestCoreParser_ReadConfig(t *testing.T) {
+	t.Run("returns the effective config as JSON", func(t *testing.T) {
+		src := newLocalTestSource(t, map[string]string{
+			".specvital.yaml": "version: 1\nexclude: [\"**/fixtures/**\"]\n",
+		})
+
+		data, err := NewCoreParser().ReadConfig(context.Background(), src)
+		if err != nil {
+			t.Fatalf("ReadConfig failed: %v", err)
+		}
+		want := `{"version":1,"exclude":["**/fixtures/**"],"path":".specvital.yaml"}`
+		if string(data) != want {
+			t.Errorf("expected %s, got %s", want, data)
+		}
+	})
+
+	t.Run("no config file", func(t *testing.T) {
+		src := newLocalTestSource(t, map[string]string{"a_test.go": "package a\n"})
+
+		data, err := NewCoreParser().ReadConfig(context.Background(), src)
+		if err != nil {
+			t.Fatalf("ReadConfig failed: %v", err)
+		}
+		if data != nil {
+			t.Errorf("expected nil config, got %s", data)
+		}
+	})
+
+	t.Run("unknown override framework", func(t *testing.T) {
+		src := newLocalTestSource(t, map[string]string{
+			".specvital.yml": "version: 1\noverrides: [{files: [\"qa/**\"], framework: nope}]\n",
+		})
+
+		_, err := NewCoreParser().ReadConfig(context.Background(), src)
+		if err == nil || !strings.Contains(err.Error(), `overrides[0].framework: unknown framework "nope"`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+// localTestSource implements analysis.Source and coreSourceProvider over a directory.
+type localTestSource struct {
+	mockInvalidSource
+	core source.Source
+}
+
+func (s *localTestSource) CoreSource() source.Source { return s.core }
+
+func newLocalTestSource(t *testing.T, files map[string]string) *localTestSource {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	core, err := source.NewLocalSource(dir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	t.Cleanup(func() { core.Close() })
+
+	return &localTestSource{core: core}
+}
+
 // Conversion tests moved to adapter/mapping/core_domain_test.go
@@ -74,6 +74,7 @@ type mockRepository struct {
 	findCachedBehaviorsFn       func(ctx context.Context, cacheKeyHashes [][]byte) (map[string]string, error)
 	findClassificationCacheFn   func(ctx context.Context, fileSignature []byte, language specview.Language, modelID string) (*specview.ClassificationCache, error)
 	findDocumentByContentHashFn func(ctx context.Context, userID string, contentHash []byte, language specview.Language, modelID string) (*specview.SpecDocument, error)
+	findLatestClassificationFn  func(ctx context.Context, analysisID string, language specview.Language, modelID string) (*specview.ClassificationCache, error)
 	getAnalysisContextFn        func(ctx context.Context, analysisID string) (*specview.AnalysisContext, error)
 	getTestDataByAnalysisIDFn   func(ctx context.Context, analysisID string) ([]specview.FileInfo, error)
 	recordUsageEventFn          func(ctx context.Context, userID string, documentID string, quotaAmount int) error
@@ -155,6 +156,13 @@ func (m *mockRepository) FindClassificationCache(ctx context.Context, fileSignat
 	return nil, nil
 }
 
+func (m *mockRepository) FindLatestClassificationCache(ctx context.Context, analysisID string, language specview.Language, modelID string) (*specview.ClassificationCache, error) {
+	if m.findLatestClassificationFn != nil {
+		return m.findLatestClassificationFn(ctx, analysisID, language, modelID)
+	}
+	return nil, nil
+}
+
 func (m *mockRepository) SaveClassificationCache(ctx context.Context, cache *specview.ClassificationCache) error {
 	if m.saveClassificationCacheFn != nil {
 		return m.saveClassificationCacheFn(ctx, cache)
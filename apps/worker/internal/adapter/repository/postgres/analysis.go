@@ -18,6 +18,7 @@ import (
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
 	"github.com/kubrickcode/specvital/apps/worker/internal/infra/db"
 	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
 )
 
 const defaultHost = "github.com"
@@ -209,6 +210,7 @@ func (r *AnalysisRepository) SaveAnalysisInventory(ctx context.Context, params a
 		TotalTests:  int32(totalTests),
 		CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
 		CommittedAt: pgtype.Timestamptz{Time: params.CommittedAt, Valid: !params.CommittedAt.IsZero()},
+		Config:      params.Config,
 	}); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -225,6 +227,8 @@ func (r *AnalysisRepository) SaveAnalysisInventory(ctx context.Context, params a
 		return fmt.Errorf("commit transaction: %w", err)
 	}
 
+	r.recordChangelog(ctx, pgID)
+
 	return nil
 }
 
@@ -348,6 +352,8 @@ func (r *AnalysisRepository) SaveAnalysisResult(ctx context.Context, params Save
 		return fmt.Errorf("commit transaction: %w", err)
 	}
 
+	r.recordChangelog(ctx, pgID)
+
 	return nil
 }
 
@@ -383,6 +389,24 @@ func mapTestStatus(status analysis.TestStatus) db.TestStatus {
 	}
 }
 
+func mapTestKind(kind analysis.TestKind) db.TestKind {
+	if kind == "" {
+		return db.TestKindUnit
+	}
+	return db.TestKind(kind)
+}
+
+// mapTestMetrics returns the assertion count, line count and nesting depth
+// columns, all NULL when the test was not measured.
+func mapTestMetrics(metrics *analysis.TestMetrics) (pgtype.Int4, pgtype.Int4, pgtype.Int4) {
+	if metrics == nil {
+		return pgtype.Int4{}, pgtype.Int4{}, pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(metrics.Assertions), Valid: true},
+		pgtype.Int4{Int32: int32(metrics.Lines), Valid: true},
+		pgtype.Int4{Int32: int32(metrics.Depth), Valid: true}
+}
+
 type flatSuite struct {
 	tempID     int
 	parentTemp int // -1 if root
@@ -394,6 +418,7 @@ type flatSuite struct {
 type flatTest struct {
 	suiteTempID int
 	test        analysis.Test
+	tags        []string // effective tags, including those of enclosing suites
 }
 
 func flattenInventory(inventory *analysis.Inventory, fileIDs map[string]pgtype.UUID) ([]flatSuite, []flatTest) {
@@ -409,7 +434,7 @@ func flattenInventory(inventory *analysis.Inventory, fileIDs map[string]pgtype.U
 		fileID := fileIDs[file.Path]
 
 		for _, suite := range file.Suites {
-			flattenSuiteRecursive(&suites, &tests, &tempID, -1, fileID, suite, 0)
+			flattenSuiteRecursive(&suites, &tests, &tempID, -1, fileID, suite, 0, nil)
 		}
 
 		if len(file.Tests) > 0 {
@@ -429,6 +454,7 @@ func flattenInventory(inventory *analysis.Inventory, fileIDs map[string]pgtype.U
 				tests = append(tests, flatTest{
 					suiteTempID: tempID,
 					test:        test,
+					tags:        domain.MergeTags(nil, test.Tags),
 				})
 			}
 			tempID++
@@ -438,8 +464,9 @@ func flattenInventory(inventory *analysis.Inventory, fileIDs map[string]pgtype.U
 	return suites, tests
 }
 
-func flattenSuiteRecursive(suites *[]flatSuite, tests *[]flatTest, tempID *int, parentTemp int, fileID pgtype.UUID, suite analysis.TestSuite, depth int) {
+func flattenSuiteRecursive(suites *[]flatSuite, tests *[]flatTest, tempID *int, parentTemp int, fileID pgtype.UUID, suite analysis.TestSuite, depth int, inheritedTags []string) {
 	currentTempID := *tempID
+	suiteTags := domain.MergeTags(inheritedTags, suite.Tags)
 	*suites = append(*suites, flatSuite{
 		tempID:     currentTempID,
 		parentTemp: parentTemp,
@@ -453,11 +480,12 @@ func flattenSuiteRecursive(suites *[]flatSuite, tests *[]flatTest, tempID *int,
 		*tests = append(*tests, flatTest{
 			suiteTempID: currentTempID,
 			test:        test,
+			tags:        domain.MergeTags(suiteTags, test.Tags),
 		})
 	}
 
 	for _, nested := range suite.Suites {
-		flattenSuiteRecursive(suites, tests, tempID, currentTempID, fileID, nested, depth+1)
+		flattenSuiteRecursive(suites, tests, tempID, currentTempID, fileID, nested, depth+1, suiteTags)
 	}
 }
 
@@ -537,13 +565,24 @@ func (r *AnalysisRepository) saveTestsCopyFrom(
 
 	rows := make([][]any, len(tests))
 	for i, t := range tests {
+		tags, err := marshalTags(t.tags)
+		if err != nil {
+			return fmt.Errorf("marshal tags for %q: %w", truncateString(t.test.Name, 50), err)
+		}
+		assertionCount, lineCount, nestingDepth := mapTestMetrics(t.test.Metrics)
 		rows[i] = []any{
 			suiteIDs[t.suiteTempID],
 			truncateString(t.test.Name, maxTestCaseNameLength),
 			pgtype.Int4{Int32: int32(t.test.Location.StartLine), Valid: true},
 			mapTestStatus(t.test.Status),
-			[]byte("[]"),
+			tags,
 			pgtype.Text{},
+			pgtype.Text{String: t.test.SkipReason, Valid: t.test.SkipReason != ""},
+			mapTestKind(t.test.Kind),
+			assertionCount,
+			lineCount,
+			nestingDepth,
+			pgtype.Text{String: t.test.Fingerprint, Valid: t.test.Fingerprint != ""},
 		}
 	}
 
@@ -559,6 +598,37 @@ func (r *AnalysisRepository) saveTestsCopyFrom(
 	return nil
 }
 
+// marshalTags encodes tags for the test_cases.tags jsonb column, using [] when empty.
+func marshalTags(tags []string) ([]byte, error) {
+	if len(tags) == 0 {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(tags)
+}
+
+// detectionRecord is the JSON stored in test_files.detection.
+type detectionRecord struct {
+	Confidence int      `json:"confidence"`
+	Evidence   []string `json:"evidence,omitempty"`
+	Source     string   `json:"source"`
+}
+
+// projectRecord is the JSON stored in test_files.project.
+type projectRecord struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// findingRecord is an element of the JSON array stored in test_files.findings.
+type findingRecord struct {
+	EndLine   int    `json:"endLine"`
+	Message   string `json:"message"`
+	Rule      string `json:"rule"`
+	Severity  string `json:"severity"`
+	StartLine int    `json:"startLine"`
+}
+
 func (r *AnalysisRepository) saveFilesBatch(
 	ctx context.Context,
 	tx pgx.Tx,
@@ -573,6 +643,9 @@ func (r *AnalysisRepository) saveFilesBatch(
 		path      string
 		framework pgtype.Text
 		hints     []byte
+		detection []byte
+		project   []byte
+		findings  []byte
 	}
 	prepared := make([]fileData, len(files))
 
@@ -585,16 +658,61 @@ func (r *AnalysisRepository) saveFilesBatch(
 				return nil, fmt.Errorf("marshal domain hints for %q: %w", file.Path, err)
 			}
 		}
+		var detectionJSON []byte
+		if file.Detection != nil {
+			var err error
+			detectionJSON, err = json.Marshal(detectionRecord{
+				Confidence: file.Detection.Confidence,
+				Evidence:   file.Detection.Evidence,
+				Source:     file.Detection.Source,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("marshal detection for %q: %w", file.Path, err)
+			}
+		}
+		var projectJSON []byte
+		if file.Project != nil {
+			var err error
+			projectJSON, err = json.Marshal(projectRecord{
+				Kind: file.Project.Kind,
+				Name: file.Project.Name,
+				Path: file.Project.Path,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("marshal project for %q: %w", file.Path, err)
+			}
+		}
+		var findingsJSON []byte
+		if len(file.Findings) > 0 {
+			records := make([]findingRecord, len(file.Findings))
+			for j, f := range file.Findings {
+				records[j] = findingRecord{
+					EndLine:   f.Location.EndLine,
+					Message:   f.Message,
+					Rule:      f.Rule,
+					Severity:  f.Severity,
+					StartLine: f.Location.StartLine,
+				}
+			}
+			var err error
+			findingsJSON, err = json.Marshal(records)
+			if err != nil {
+				return nil, fmt.Errorf("marshal findings for %q: %w", file.Path, err)
+			}
+		}
 		prepared[i] = fileData{
 			path:      file.Path,
 			framework: pgtype.Text{String: file.Framework, Valid: file.Framework != ""},
 			hints:     hintsJSON,
+			detection: detectionJSON,
+			project:   projectJSON,
+			findings:  findingsJSON,
 		}
 	}
 
 	batch := &pgx.Batch{}
 	for _, fd := range prepared {
-		batch.Queue(db.InsertTestFileBatch, analysisID, fd.path, fd.framework, fd.hints)
+		batch.Queue(db.InsertTestFileBatch, analysisID, fd.path, fd.framework, fd.hints, fd.detection, fd.project, fd.findings)
 	}
 
 	results := tx.SendBatch(ctx, batch)
@@ -728,6 +846,7 @@ func (r *AnalysisRepository) FinalizeAnalysis(ctx context.Context, params analys
 		TotalTests:  int32(params.TotalTests),
 		CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
 		CommittedAt: pgtype.Timestamptz{Time: params.CommittedAt, Valid: !params.CommittedAt.IsZero()},
+		Config:      params.Config,
 	}); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -744,5 +863,7 @@ func (r *AnalysisRepository) FinalizeAnalysis(ctx context.Context, params analys
 		return fmt.Errorf("commit transaction: %w", err)
 	}
 
+	r.recordChangelog(ctx, pgID)
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -520,6 +521,192 @@ func TestAnalysisRepository_SaveAnalysisInventory(t *testing.T) {
 			t.Errorf("expected status 'completed', got '%s'", status)
 		}
 	})
+
+	t.Run("should record the effective repository config", func(t *testing.T) {
+		analysisID, err := repo.CreateAnalysisRecord(ctx, analysis.CreateAnalysisRecordParams{
+			Owner:          "config-owner",
+			Repo:           "config-repo",
+			CommitSHA:      "cfg123",
+			Branch:         "main",
+			ExternalRepoID: "config-id",
+			ParserVersion:  testParserVersion,
+		})
+		if err != nil {
+			t.Fatalf("CreateAnalysisRecord failed: %v", err)
+		}
+
+		err = repo.SaveAnalysisInventory(ctx, analysis.SaveAnalysisInventoryParams{
+			AnalysisID: analysisID,
+			Config:     []byte(`{"version":1,"exclude":["**/fixtures/**"]}`),
+			Inventory:  &analysis.Inventory{Files: []analysis.TestFile{}},
+		})
+		if err != nil {
+			t.Fatalf("SaveAnalysisInventory failed: %v", err)
+		}
+
+		var exclude string
+		err = pool.QueryRow(ctx, "SELECT config->'exclude'->>0 FROM analyses WHERE id = $1", toPgUUID(analysisID)).
+			Scan(&exclude)
+		if err != nil {
+			t.Fatalf("failed to query config: %v", err)
+		}
+		if exclude != "**/fixtures/**" {
+			t.Errorf("expected stored exclude glob, got %q", exclude)
+		}
+	})
+
+	t.Run("should store file detection, project and findings", func(t *testing.T) {
+		analysisID, err := repo.CreateAnalysisRecord(ctx, analysis.CreateAnalysisRecordParams{
+			Owner:          "detection-owner",
+			Repo:           "detection-repo",
+			CommitSHA:      "det123",
+			Branch:         "main",
+			ExternalRepoID: "detection-id",
+			ParserVersion:  testParserVersion,
+		})
+		if err != nil {
+			t.Fatalf("CreateAnalysisRecord failed: %v", err)
+		}
+
+		err = repo.SaveAnalysisInventory(ctx, analysis.SaveAnalysisInventoryParams{
+			AnalysisID: analysisID,
+			Inventory: &analysis.Inventory{Files: []analysis.TestFile{
+				{
+					Path:      "src/app.test.ts",
+					Framework: "vitest",
+					Detection: &analysis.Detection{
+						Confidence: 100,
+						Evidence:   []string{"import: vitest"},
+						Source:     "import",
+					},
+					Findings: []analysis.Finding{{
+						Location: analysis.Location{StartLine: 3, EndLine: 5},
+						Message:  `focused test "works" makes the runner skip all other tests`,
+						Rule:     "focused-test",
+						Severity: "error",
+					}},
+					Project: &analysis.Project{Kind: "npm", Name: "@acme/app", Path: "."},
+					Tests:   []analysis.Test{{Name: "works", Status: analysis.TestStatusFocused}},
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("SaveAnalysisInventory failed: %v", err)
+		}
+
+		var source, evidence string
+		err = pool.QueryRow(ctx, "SELECT detection->>'source', detection->'evidence'->>0 FROM test_files WHERE analysis_id = $1", toPgUUID(analysisID)).
+			Scan(&source, &evidence)
+		if err != nil {
+			t.Fatalf("failed to query detection: %v", err)
+		}
+		if source != "import" || evidence != "import: vitest" {
+			t.Errorf("expected import detection, got source %q evidence %q", source, evidence)
+		}
+
+		var projectName string
+		err = pool.QueryRow(ctx, "SELECT project->>'name' FROM test_files WHERE analysis_id = $1", toPgUUID(analysisID)).
+			Scan(&projectName)
+		if err != nil {
+			t.Fatalf("failed to query project: %v", err)
+		}
+		if projectName != "@acme/app" {
+			t.Errorf("expected project @acme/app, got %q", projectName)
+		}
+
+		var rule string
+		var startLine int
+		err = pool.QueryRow(ctx, "SELECT findings->0->>'rule', (findings->0->>'startLine')::int FROM test_files WHERE analysis_id = $1", toPgUUID(analysisID)).
+			Scan(&rule, &startLine)
+		if err != nil {
+			t.Fatalf("failed to query findings: %v", err)
+		}
+		if rule != "focused-test" || startLine != 3 {
+			t.Errorf("expected focused-test finding on line 3, got %q on line %d", rule, startLine)
+		}
+	})
+
+	t.Run("should store test metrics and fingerprints", func(t *testing.T) {
+		analysisID, err := repo.CreateAnalysisRecord(ctx, analysis.CreateAnalysisRecordParams{
+			Owner:          "metrics-owner",
+			Repo:           "metrics-repo",
+			CommitSHA:      "met123",
+			Branch:         "main",
+			ExternalRepoID: "metrics-id",
+			ParserVersion:  testParserVersion,
+		})
+		if err != nil {
+			t.Fatalf("CreateAnalysisRecord failed: %v", err)
+		}
+
+		err = repo.SaveAnalysisInventory(ctx, analysis.SaveAnalysisInventoryParams{
+			AnalysisID: analysisID,
+			Inventory: &analysis.Inventory{Files: []analysis.TestFile{
+				{
+					Path:      "src/cart.test.ts",
+					Framework: "vitest",
+					Tests: []analysis.Test{
+						{
+							Name:        "adds",
+							Location:    analysis.Location{StartLine: 1, EndLine: 6},
+							Fingerprint: "93a4d870103f73a93da185454d9b8ee5",
+							Metrics:     &analysis.TestMetrics{Assertions: 2, Depth: 1, Lines: 6},
+							Status:      analysis.TestStatusActive,
+						},
+						{
+							Name:     "removes",
+							Location: analysis.Location{StartLine: 8, EndLine: 10},
+							Status:   analysis.TestStatusActive,
+						},
+					},
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("SaveAnalysisInventory failed: %v", err)
+		}
+
+		rows, err := pool.Query(ctx, `
+			SELECT tc.name, tc.assertion_count, tc.line_count, tc.nesting_depth, tc.fingerprint
+			FROM test_cases tc
+			JOIN test_suites ts ON ts.id = tc.suite_id
+			JOIN test_files tf ON tf.id = ts.file_id
+			WHERE tf.analysis_id = $1
+			ORDER BY tc.line_number`, toPgUUID(analysisID))
+		if err != nil {
+			t.Fatalf("failed to query test cases: %v", err)
+		}
+		defer rows.Close()
+
+		type metricsRow struct {
+			name                                    string
+			assertionCount, lineCount, nestingDepth pgtype.Int4
+			fingerprint                             pgtype.Text
+		}
+		var got []metricsRow
+		for rows.Next() {
+			var r metricsRow
+			if err := rows.Scan(&r.name, &r.assertionCount, &r.lineCount, &r.nestingDepth, &r.fingerprint); err != nil {
+				t.Fatalf("failed to scan test case: %v", err)
+			}
+			got = append(got, r)
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected 2 test cases, got %d", len(got))
+		}
+		if got[0].assertionCount.Int32 != 2 || got[0].lineCount.Int32 != 6 || got[0].nestingDepth.Int32 != 1 {
+			t.Errorf("unexpected metrics for %q: %+v", got[0].name, got[0])
+		}
+		if got[0].fingerprint.String != "93a4d870103f73a93da185454d9b8ee5" {
+			t.Errorf("unexpected fingerprint for %q: %q", got[0].name, got[0].fingerprint.String)
+		}
+		if got[1].assertionCount.Valid || got[1].lineCount.Valid || got[1].nestingDepth.Valid {
+			t.Errorf("expected NULL metrics for unmeasured test %q, got %+v", got[1].name, got[1])
+		}
+		if got[1].fingerprint.Valid {
+			t.Errorf("expected NULL fingerprint for %q, got %q", got[1].name, got[1].fingerprint.String)
+		}
+	})
 }
 
 func Test_truncateErrorMessage(t *testing.T) {
@@ -803,6 +990,65 @@ func Test_flattenInventory(t *testing.T) {
 			t.Errorf("expected implicit suite name to be file path, got %s", suites[0].suite.Name)
 		}
 	})
+
+	t.Run("tests inherit tags from enclosing suites", func(t *testing.T) {
+		inv := &analysis.Inventory{
+			Files: []analysis.TestFile{
+				{
+					Path: "test_repo.py",
+					Suites: []analysis.TestSuite{
+						{
+							Name: "TestRepo",
+							Tags: []string{"integration"},
+							Suites: []analysis.TestSuite{
+								{
+									Name:  "TestNested",
+									Tags:  []string{"db"},
+									Tests: []analysis.Test{{Name: "test_fetch", Tags: []string{"slow", "db"}}},
+								},
+							},
+						},
+					},
+					Tests: []analysis.Test{{Name: "test_plain"}},
+				},
+			},
+		}
+
+		_, tests := flattenInventory(inv, nil)
+
+		if len(tests) != 2 {
+			t.Fatalf("expected 2 tests, got %d", len(tests))
+		}
+		if got := strings.Join(tests[0].tags, ","); got != "integration,db,slow" {
+			t.Errorf("expected effective tags 'integration,db,slow', got '%s'", got)
+		}
+		if tests[1].tags != nil {
+			t.Errorf("expected no tags for untagged file-level test, got %v", tests[1].tags)
+		}
+	})
+}
+
+func Test_marshalTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want string
+	}{
+		{name: "nil encodes as empty array", tags: nil, want: "[]"},
+		{name: "tags encode as JSON array", tags: []string{"slow", "type:model"}, want: `["slow","type:model"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := marshalTags(tt.tags)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
 }
 
 func Test_groupByDepth(t *testing.T) {
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kubrickcode/specvital/apps/worker/internal/infra/db"
+	"github.com/kubrickcode/specvital/lib/parser/diff"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// recordChangelog saves the changelog of an analysis once it is committed as
+// completed. The changelog is derived from stored data, so failures are logged
+// instead of failing the analysis.
+func (r *AnalysisRepository) recordChangelog(ctx context.Context, analysisID pgtype.UUID) {
+	if err := r.saveChangelog(ctx, db.New(r.pool), analysisID); err != nil {
+		slog.ErrorContext(ctx, "failed to save changelog",
+			"error", err,
+			"analysis_id", fromPgUUID(analysisID),
+		)
+	}
+}
+
+// saveChangelog records how the tests stored for analysisID differ from those of
+// the previous completed analysis of the same codebase (see
+// FindPreviousCompletedAnalysisID). No changelog is recorded for the first
+// analysis of a codebase.
+func (r *AnalysisRepository) saveChangelog(ctx context.Context, queries *db.Queries, analysisID pgtype.UUID) error {
+	baseID, err := queries.FindPreviousCompletedAnalysisID(ctx, analysisID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("find previous analysis: %w", err)
+	}
+
+	base, err := loadStoredInventory(ctx, queries, baseID)
+	if err != nil {
+		return fmt.Errorf("load base inventory: %w", err)
+	}
+	head, err := loadStoredInventory(ctx, queries, analysisID)
+	if err != nil {
+		return fmt.Errorf("load head inventory: %w", err)
+	}
+
+	params, err := newChangelogParams(analysisID, baseID, diff.Compare(base, head))
+	if err != nil {
+		return err
+	}
+	if err := queries.InsertAnalysisChangelog(ctx, params); err != nil {
+		return fmt.Errorf("insert changelog: %w", err)
+	}
+	return nil
+}
+
+func newChangelogParams(analysisID, baseID pgtype.UUID, result *diff.Result) (db.InsertAnalysisChangelogParams, error) {
+	summary, err := json.Marshal(result.Summary)
+	if err != nil {
+		return db.InsertAnalysisChangelogParams{}, fmt.Errorf("marshal changelog summary: %w", err)
+	}
+	frameworks, err := json.Marshal(result.Frameworks)
+	if err != nil {
+		return db.InsertAnalysisChangelogParams{}, fmt.Errorf("marshal changelog frameworks: %w", err)
+	}
+	testChanges, err := json.Marshal(result.Tests)
+	if err != nil {
+		return db.InsertAnalysisChangelogParams{}, fmt.Errorf("marshal test changes: %w", err)
+	}
+	suiteChanges, err := json.Marshal(result.Suites)
+	if err != nil {
+		return db.InsertAnalysisChangelogParams{}, fmt.Errorf("marshal suite changes: %w", err)
+	}
+
+	return db.InsertAnalysisChangelogParams{
+		AnalysisID:     analysisID,
+		BaseAnalysisID: baseID,
+		Summary:        summary,
+		Frameworks:     frameworks,
+		TestChanges:    testChanges,
+		SuiteChanges:   suiteChanges,
+	}, nil
+}
+
+// storedSuite is a suite row with its children, used to rebuild the suite tree.
+type storedSuite struct {
+	children []*storedSuite
+	row      db.GetTestSuitesByAnalysisIDRow
+	tests    []domain.Test
+}
+
+// loadStoredInventory rebuilds the test hierarchy saved for an analysis.
+// Tests of the implicit suite created for top-level tests are restored as file-level tests.
+func loadStoredInventory(ctx context.Context, queries *db.Queries, analysisID pgtype.UUID) (*domain.Inventory, error) {
+	suiteRows, err := queries.GetTestSuitesByAnalysisID(ctx, analysisID)
+	if err != nil {
+		return nil, fmt.Errorf("get suites: %w", err)
+	}
+	caseRows, err := queries.GetTestCasesByAnalysisID(ctx, analysisID)
+	if err != nil {
+		return nil, fmt.Errorf("get test cases: %w", err)
+	}
+	return buildStoredInventory(suiteRows, caseRows), nil
+}
+
+// buildStoredInventory expects suite rows ordered by file path and depth,
+// so that parents precede their children.
+func buildStoredInventory(suiteRows []db.GetTestSuitesByAnalysisIDRow, caseRows []db.GetTestCasesByAnalysisIDRow) *domain.Inventory {
+	suites := make(map[pgtype.UUID]*storedSuite, len(suiteRows))
+	roots := make(map[string][]*storedSuite)
+	var files []domain.TestFile
+
+	for _, row := range suiteRows {
+		s := &storedSuite{row: row}
+		suites[row.ID] = s
+
+		if parent, ok := suites[row.ParentID]; row.ParentID.Valid && ok {
+			parent.children = append(parent.children, s)
+			continue
+		}
+		if _, seen := roots[row.FilePath]; !seen {
+			files = append(files, domain.TestFile{Framework: row.Framework.String, Path: row.FilePath})
+		}
+		roots[row.FilePath] = append(roots[row.FilePath], s)
+	}
+
+	for _, row := range caseRows {
+		s, ok := suites[row.SuiteID]
+		if !ok {
+			continue
+		}
+		s.tests = append(s.tests, domain.Test{
+			Location: domain.Location{File: s.row.FilePath, StartLine: int(row.LineNumber.Int32)},
+			Name:     row.Name,
+			Status:   domain.TestStatus(row.Status),
+		})
+	}
+
+	for i := range files {
+		file := &files[i]
+		for _, s := range roots[file.Path] {
+			if s.row.Name == file.Path {
+				file.Tests = append(file.Tests, s.tests...)
+				continue
+			}
+			file.Suites = append(file.Suites, s.toDomain())
+		}
+	}
+
+	return &domain.Inventory{Files: files}
+}
+
+func (s *storedSuite) toDomain() domain.TestSuite {
+	suite := domain.TestSuite{
+		Location: domain.Location{File: s.row.FilePath, StartLine: int(s.row.LineNumber.Int32)},
+		Name:     s.row.Name,
+		Tests:    s.tests,
+	}
+	for _, child := range s.children {
+		suite.Suites = append(suite.Suites, child.toDomain())
+	}
+	return suite
+}
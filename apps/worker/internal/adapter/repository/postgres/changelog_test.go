@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
+	"github.com/kubrickcode/specvital/apps/worker/internal/infra/db"
+	testdb "github.com/kubrickcode/specvital/apps/worker/internal/testutil/postgres"
+	"github.com/kubrickcode/specvital/lib/parser/diff"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func Test_buildStoredInventory(t *testing.T) {
+	suiteID := func(b byte) pgtype.UUID {
+		return pgtype.UUID{Bytes: [16]byte{b}, Valid: true}
+	}
+	framework := pgtype.Text{String: "jest", Valid: true}
+
+	suites := []db.GetTestSuitesByAnalysisIDRow{
+		{ID: suiteID(1), Name: "UserService", LineNumber: pgtype.Int4{Int32: 1, Valid: true}, FilePath: "a.test.ts", Framework: framework},
+		{ID: suiteID(2), Name: "a.test.ts", LineNumber: pgtype.Int4{Int32: 1, Valid: true}, FilePath: "a.test.ts", Framework: framework},
+		{ID: suiteID(3), ParentID: suiteID(1), Name: "create", LineNumber: pgtype.Int4{Int32: 3, Valid: true}, FilePath: "a.test.ts", Framework: framework},
+	}
+	cases := []db.GetTestCasesByAnalysisIDRow{
+		{SuiteID: suiteID(3), Name: "works", LineNumber: pgtype.Int4{Int32: 4, Valid: true}, Status: db.TestStatusActive},
+		{SuiteID: suiteID(2), Name: "top level", LineNumber: pgtype.Int4{Int32: 20, Valid: true}, Status: db.TestStatusSkipped},
+	}
+
+	inv := buildStoredInventory(suites, cases)
+
+	if len(inv.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(inv.Files))
+	}
+	file := inv.Files[0]
+	if file.Framework != "jest" || file.Path != "a.test.ts" {
+		t.Errorf("unexpected file: %s %s", file.Framework, file.Path)
+	}
+	if len(file.Tests) != 1 || file.Tests[0].Name != "top level" || file.Tests[0].Status != domain.TestStatusSkipped {
+		t.Errorf("expected implicit suite tests restored as file tests, got %+v", file.Tests)
+	}
+	if len(file.Suites) != 1 || len(file.Suites[0].Suites) != 1 {
+		t.Fatalf("expected nested suite, got %+v", file.Suites)
+	}
+	nested := file.Suites[0].Suites[0]
+	if nested.Name != "create" || len(nested.Tests) != 1 || nested.Tests[0].Location.StartLine != 4 {
+		t.Errorf("unexpected nested suite: %+v", nested)
+	}
+}
+
+func TestAnalysisRepository_Changelog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	pool, cleanup := testdb.SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewAnalysisRepository(pool)
+	ctx := context.Background()
+
+	committedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	save := func(t *testing.T, commit string, at time.Duration, tests []analysis.Test) analysis.UUID {
+		t.Helper()
+		analysisID, err := repo.CreateAnalysisRecord(ctx, analysis.CreateAnalysisRecordParams{
+			Owner:          "changelog-owner",
+			Repo:           "changelog-repo",
+			CommitSHA:      commit,
+			Branch:         "main",
+			ExternalRepoID: "changelog-id",
+			ParserVersion:  testParserVersion,
+		})
+		if err != nil {
+			t.Fatalf("CreateAnalysisRecord failed: %v", err)
+		}
+		err = repo.SaveAnalysisInventory(ctx, analysis.SaveAnalysisInventoryParams{
+			AnalysisID:  analysisID,
+			CommittedAt: committedAt.Add(at),
+			Inventory: &analysis.Inventory{Files: []analysis.TestFile{{
+				Path:      "service_test.go",
+				Framework: "go-testing",
+				Tests:     tests,
+			}}},
+		})
+		if err != nil {
+			t.Fatalf("SaveAnalysisInventory failed: %v", err)
+		}
+		return analysisID
+	}
+
+	first := save(t, "commit1", 0, []analysis.Test{
+		{Name: "TestCreate", Location: analysis.Location{StartLine: 10}, Status: analysis.TestStatusActive},
+		{Name: "TestLegacy", Location: analysis.Location{StartLine: 20}, Status: analysis.TestStatusActive},
+	})
+
+	t.Run("should not record a changelog for the first analysis", func(t *testing.T) {
+		var count int
+		if err := pool.QueryRow(ctx, "SELECT COUNT(*) FROM analysis_changelogs WHERE analysis_id = $1", toPgUUID(first)).Scan(&count); err != nil {
+			t.Fatalf("failed to query changelog: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("expected no changelog, got %d", count)
+		}
+	})
+
+	t.Run("should record changes against the previous analysis", func(t *testing.T) {
+		second := save(t, "commit2", 2*time.Hour, []analysis.Test{
+			{Name: "TestCreate", Location: analysis.Location{StartLine: 10}, Status: analysis.TestStatusSkipped},
+			{Name: "TestNew", Location: analysis.Location{StartLine: 30}, Status: analysis.TestStatusActive},
+		})
+
+		var baseID pgtype.UUID
+		var summaryJSON, testsJSON []byte
+		err := pool.QueryRow(ctx,
+			"SELECT base_analysis_id, summary, test_changes FROM analysis_changelogs WHERE analysis_id = $1",
+			toPgUUID(second),
+		).Scan(&baseID, &summaryJSON, &testsJSON)
+		if err != nil {
+			t.Fatalf("failed to query changelog: %v", err)
+		}
+
+		if fromPgUUID(baseID) != first {
+			t.Errorf("expected base analysis %s, got %s", first, fromPgUUID(baseID))
+		}
+
+		var summary diff.Summary
+		if err := json.Unmarshal(summaryJSON, &summary); err != nil {
+			t.Fatalf("failed to unmarshal summary: %v", err)
+		}
+		if summary.Added != 1 || summary.Removed != 1 || summary.StatusChanged != 1 {
+			t.Errorf("unexpected summary: %+v", summary)
+		}
+
+		var changes []diff.Change
+		if err := json.Unmarshal(testsJSON, &changes); err != nil {
+			t.Fatalf("failed to unmarshal test changes: %v", err)
+		}
+		if len(changes) != 3 {
+			t.Errorf("expected 3 test changes, got %d", len(changes))
+		}
+	})
+
+	t.Run("should compare an older commit with its predecessor", func(t *testing.T) {
+		older := save(t, "commit1b", time.Hour, []analysis.Test{
+			{Name: "TestCreate", Location: analysis.Location{StartLine: 10}, Status: analysis.TestStatusActive},
+		})
+
+		var baseID pgtype.UUID
+		var summaryJSON []byte
+		err := pool.QueryRow(ctx,
+			"SELECT base_analysis_id, summary FROM analysis_changelogs WHERE analysis_id = $1",
+			toPgUUID(older),
+		).Scan(&baseID, &summaryJSON)
+		if err != nil {
+			t.Fatalf("failed to query changelog: %v", err)
+		}
+
+		if fromPgUUID(baseID) != first {
+			t.Errorf("expected base analysis %s, got %s", first, fromPgUUID(baseID))
+		}
+
+		var summary diff.Summary
+		if err := json.Unmarshal(summaryJSON, &summary); err != nil {
+			t.Fatalf("failed to unmarshal summary: %v", err)
+		}
+		if summary.Added != 0 || summary.Removed != 1 {
+			t.Errorf("unexpected summary: %+v", summary)
+		}
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/analysis"
 	"github.com/kubrickcode/specvital/apps/worker/internal/domain/specview"
 	"github.com/kubrickcode/specvital/apps/worker/internal/infra/db"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
 )
 
 var _ specview.Repository = (*SpecDocumentRepository)(nil)
@@ -100,13 +101,41 @@ func (r *SpecDocumentRepository) GetAnalysisContext(
 		return nil, fmt.Errorf("get analysis context: %w", err)
 	}
 
+	viewHints, err := toViewHints(row.SpecView)
+	if err != nil {
+		return nil, fmt.Errorf("decode spec view hints: %w", err)
+	}
+
 	return &specview.AnalysisContext{
-		Host:  row.Host,
-		Owner: row.Owner,
-		Repo:  row.Repo,
+		Host:      row.Host,
+		Owner:     row.Owner,
+		Repo:      row.Repo,
+		ViewHints: viewHints,
 	}, nil
 }
 
+// toViewHints converts the specView section of the repository configuration
+// recorded with the analysis. Returns nil when the section is absent.
+func toViewHints(data []byte) (*specview.ViewHints, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var view repoconfig.SpecView
+	if err := json.Unmarshal(data, &view); err != nil {
+		return nil, err
+	}
+
+	hints := &specview.ViewHints{Exclude: view.Exclude}
+	for _, domain := range view.Domains {
+		hints.Domains = append(hints.Domains, specview.DomainSuggestion{
+			Files: domain.Files,
+			Name:  domain.Name,
+		})
+	}
+	return hints, nil
+}
+
 func (r *SpecDocumentRepository) GetTestDataByAnalysisID(
 	ctx context.Context,
 	analysisID string,
@@ -173,10 +202,11 @@ func (r *SpecDocumentRepository) aggregateTestData(
 		suitePath := r.buildSuitePath(row.SuiteID, suiteMap)
 
 		file.Tests = append(file.Tests, specview.TestInfo{
-			Index:      testIndex,
-			Name:       row.TestName,
-			SuitePath:  suitePath,
-			TestCaseID: fromPgUUID(row.TestCaseID).String(),
+			Fingerprint: row.TestFingerprint.String,
+			Index:       testIndex,
+			Name:        row.TestName,
+			SuitePath:   suitePath,
+			TestCaseID:  fromPgUUID(row.TestCaseID).String(),
 		})
 		testIndex++
 	}
@@ -612,6 +642,41 @@ func (r *SpecDocumentRepository) FindClassificationCache(
 		return nil, fmt.Errorf("find classification cache: %w", err)
 	}
 
+	return toClassificationCache(row)
+}
+
+// FindLatestClassificationCache looks up the most recent Phase 1 classification
+// cached for the codebase of the given analysis, whatever its file signature.
+// Returns nil without error if no cache is found.
+func (r *SpecDocumentRepository) FindLatestClassificationCache(
+	ctx context.Context,
+	analysisID string,
+	language specview.Language,
+	modelID string,
+) (*specview.ClassificationCache, error) {
+	parsedID, err := analysis.ParseUUID(analysisID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid analysis ID format", specview.ErrInvalidInput)
+	}
+
+	queries := db.New(r.pool)
+
+	row, err := queries.FindLatestClassificationCacheByAnalysis(ctx, db.FindLatestClassificationCacheByAnalysisParams{
+		ID:       toPgUUID(parsedID),
+		Language: string(language),
+		ModelID:  modelID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find latest classification cache: %w", err)
+	}
+
+	return toClassificationCache(row)
+}
+
+func toClassificationCache(row db.ClassificationCach) (*specview.ClassificationCache, error) {
 	var phase1Output specview.Phase1Output
 	if err := json.Unmarshal(row.Phase1Output, &phase1Output); err != nil {
 		return nil, fmt.Errorf("unmarshal phase1_output: %w", err)
@@ -627,7 +692,7 @@ func (r *SpecDocumentRepository) FindClassificationCache(
 		CreatedAt:            row.CreatedAt.Time,
 		FileSignature:        row.ContentHash,
 		ID:                   fromPgUUID(row.ID).String(),
-		Language:             language,
+		Language:             specview.Language(row.Language),
 		ModelID:              row.ModelID,
 		TestIndexMap:         testIndexMap,
 	}, nil
@@ -665,9 +730,21 @@ func (r *SpecDocumentRepository) SaveClassificationCache(
 		return fmt.Errorf("marshal test_index_map: %w", err)
 	}
 
+	// The analysis links the cache to its codebase; without one the cache is
+	// only found by its file signature.
+	var analysisID pgtype.UUID
+	if cache.AnalysisID != "" {
+		parsedID, err := analysis.ParseUUID(cache.AnalysisID)
+		if err != nil {
+			return fmt.Errorf("%w: invalid analysis ID format", specview.ErrInvalidInput)
+		}
+		analysisID = toPgUUID(parsedID)
+	}
+
 	queries := db.New(r.pool)
 
 	err = queries.UpsertClassificationCache(ctx, db.UpsertClassificationCacheParams{
+		AnalysisID:   analysisID,
 		ContentHash:  cache.FileSignature,
 		Language:     string(cache.Language),
 		ModelID:      cache.ModelID,
@@ -898,6 +898,53 @@ func TestSpecDocumentRepository_ClassificationCache(t *testing.T) {
 			t.Errorf("expected ErrInvalidInput, got %v", err)
 		}
 	})
+
+	t.Run("should find latest cache of the analysis codebase", func(t *testing.T) {
+		analysisRepo := NewAnalysisRepository(pool)
+		analysisID := setupTestAnalysisWithNestedSuites(t, ctx, analysisRepo, pool).String()
+
+		for i, name := range []string{"BeforeRename", "AfterRename"} {
+			cache := &specview.ClassificationCache{
+				AnalysisID:    analysisID,
+				FileSignature: []byte{0x5a, byte(i)},
+				Language:      "English",
+				ModelID:       "model-latest",
+				ClassificationResult: &specview.Phase1Output{
+					Domains: []specview.DomainGroup{{Name: name}},
+				},
+				TestIndexMap: map[string]specview.TestIdentity{},
+			}
+			if err := specRepo.SaveClassificationCache(ctx, cache); err != nil {
+				t.Fatalf("SaveClassificationCache (%s) failed: %v", name, err)
+			}
+		}
+
+		result, err := specRepo.FindLatestClassificationCache(ctx, analysisID, "English", "model-latest")
+		if err != nil {
+			t.Fatalf("FindLatestClassificationCache failed: %v", err)
+		}
+		if result == nil {
+			t.Fatal("expected to find cache")
+		}
+		if result.ClassificationResult.Domains[0].Name != "AfterRename" {
+			t.Errorf("expected AfterRename, got %s", result.ClassificationResult.Domains[0].Name)
+		}
+
+		result, err = specRepo.FindLatestClassificationCache(ctx, analysisID, "Korean", "model-latest")
+		if err != nil {
+			t.Fatalf("FindLatestClassificationCache (Korean) failed: %v", err)
+		}
+		if result != nil {
+			t.Error("expected nil for another language")
+		}
+	})
+
+	t.Run("should fail on invalid analysis ID for latest cache", func(t *testing.T) {
+		_, err := specRepo.FindLatestClassificationCache(ctx, "not-a-uuid", "English", "model")
+		if !errors.Is(err, specview.ErrInvalidInput) {
+			t.Errorf("expected ErrInvalidInput, got %v", err)
+		}
+	})
 }
 
 func TestSpecDocumentRepository_RetentionSnapshot(t *testing.T) {
@@ -1091,3 +1138,30 @@ func createNestedSuiteInventory() *parser.ScanResult {
 		},
 	}
 }
+
+func Test_toViewHints(t *testing.T) {
+	t.Run("absent section", func(t *testing.T) {
+		for _, data := range [][]byte{nil, []byte("null")} {
+			hints, err := toViewHints(data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hints != nil {
+				t.Errorf("expected nil hints for %q, got %+v", data, hints)
+			}
+		}
+	})
+
+	t.Run("converts the recorded section", func(t *testing.T) {
+		hints, err := toViewHints([]byte(`{"exclude":["src/legacy/**"],"domains":[{"name":"Billing","files":["src/billing/**"]}]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(hints.Exclude) != 1 || hints.Exclude[0] != "src/legacy/**" {
+			t.Errorf("unexpected exclude: %v", hints.Exclude)
+		}
+		if len(hints.Domains) != 1 || hints.Domains[0].Name != "Billing" || hints.Domains[0].Files[0] != "src/billing/**" {
+			t.Errorf("unexpected domains: %+v", hints.Domains)
+		}
+	})
+}
@@ -23,6 +23,7 @@ type AnalyzerConfig struct {
 	DatabaseURL     string
 	EncryptionKey   string
 	Fairness        config.FairnessConfig
+	ParserCacheDir  string // optional: per-file parse result cache shared across analyses
 	QueueWorkers    config.QueueWorkers
 	ServiceName     string
 	ShutdownTimeout time.Duration
@@ -78,11 +79,12 @@ func StartAnalyzer(cfg AnalyzerConfig) error {
 	}
 
 	container, err := app.NewAnalyzerContainer(ctx, app.ContainerConfig{
-		EncryptionKey: cfg.EncryptionKey,
-		Fairness:      cfg.Fairness,
-		ParserVersion: parserVersion,
-		Pool:          pool,
-		Streaming:     cfg.Streaming,
+		EncryptionKey:  cfg.EncryptionKey,
+		Fairness:       cfg.Fairness,
+		ParserCacheDir: cfg.ParserCacheDir,
+		ParserVersion:  parserVersion,
+		Pool:           pool,
+		Streaming:      cfg.Streaming,
 	})
 	if err != nil {
 		return fmt.Errorf("container: %w", err)
@@ -13,6 +13,9 @@ import (
 	infraqueue "github.com/kubrickcode/specvital/apps/worker/internal/infra/queue"
 	analysisuc "github.com/kubrickcode/specvital/apps/worker/internal/usecase/analysis"
 	"github.com/kubrickcode/specvital/lib/crypto"
+	coreparser "github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/cache"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 )
@@ -42,7 +45,21 @@ func NewAnalyzerContainer(ctx context.Context, cfg ContainerConfig) (*AnalyzerCo
 	userRepo := postgres.NewUserRepository(cfg.Pool, encryptor)
 	gitVCS := vcs.NewGitVCS()
 	githubAPIClient := vcs.NewGitHubAPIClient(nil)
-	coreParser := parser.NewCoreParser()
+	// Lint findings, test metrics and fingerprints are stored per analysis;
+	// repositories tune lint rules in their configuration file.
+	parserOpts := []coreparser.ScanOption{
+		coreparser.WithFingerprints(true),
+		coreparser.WithLint(&lint.Config{}),
+		coreparser.WithTestMetrics(true),
+	}
+	if cfg.ParserCacheDir != "" {
+		resultCache, err := cache.NewDiskCache(cfg.ParserCacheDir)
+		if err != nil {
+			return nil, fmt.Errorf("create parser cache: %w", err)
+		}
+		parserOpts = append(parserOpts, coreparser.WithResultCache(resultCache))
+	}
+	coreParser := parser.NewCoreParser(parserOpts...)
 	analyzeUC := analysisuc.NewAnalyzeUseCase(
 		analysisRepo, codebaseRepo, gitVCS, githubAPIClient, coreParser, userRepo,
 		analysisuc.WithParserVersion(cfg.ParserVersion),
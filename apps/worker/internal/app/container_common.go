@@ -16,6 +16,7 @@ type ContainerConfig struct {
 	GeminiPhase1Model string // optional: default gemini-2.5-flash
 	GeminiPhase2Model string // optional: default gemini-2.5-flash-lite
 	MockMode          bool   // enable mock AI provider for development/testing
+	ParserCacheDir    string // optional: directory for the per-file parse result cache
 	ParserVersion     string
 	Pool              *pgxpool.Pool
 	Streaming         config.StreamingConfig
@@ -7,11 +7,36 @@ type Inventory struct {
 type TestFile struct {
 	Path        string
 	Framework   string
+	Detection   *Detection
 	DomainHints *DomainHints
+	Findings    []Finding
+	Project     *Project
 	Suites      []TestSuite
 	Tests       []Test
 }
 
+// Project identifies the package or module owning the file in a monorepo.
+type Project struct {
+	Kind string
+	Name string
+	Path string
+}
+
+// Finding is a test smell reported by the linter.
+type Finding struct {
+	Location Location
+	Message  string
+	Rule     string
+	Severity string
+}
+
+// Detection records why the file was attributed to its framework.
+type Detection struct {
+	Confidence int
+	Evidence   []string
+	Source     string
+}
+
 type DomainHints struct {
 	Calls   []string
 	Imports []string
@@ -21,13 +46,26 @@ type TestSuite struct {
 	Name     string
 	Location Location
 	Suites   []TestSuite
+	Tags     []string
 	Tests    []Test
 }
 
 type Test struct {
-	Name     string
-	Location Location
-	Status   TestStatus
+	Name        string
+	Location    Location
+	Fingerprint string
+	Kind        TestKind
+	Metrics     *TestMetrics
+	SkipReason  string
+	Status      TestStatus
+	Tags        []string
+}
+
+// TestMetrics describes the body of a test for quality dashboards.
+type TestMetrics struct {
+	Assertions int
+	Depth      int
+	Lines      int
 }
 
 type Location struct {
@@ -44,3 +82,14 @@ const (
 	TestStatusTodo    TestStatus = "todo"
 	TestStatusXfail   TestStatus = "xfail"
 )
+
+type TestKind string
+
+const (
+	TestKindBenchmark   TestKind = "benchmark"
+	TestKindE2E         TestKind = "e2e"
+	TestKindExample     TestKind = "example"
+	TestKindFuzz        TestKind = "fuzz"
+	TestKindIntegration TestKind = "integration"
+	TestKindUnit        TestKind = "unit"
+)
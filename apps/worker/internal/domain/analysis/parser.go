@@ -6,6 +6,14 @@ type Parser interface {
 	Scan(ctx context.Context, src Source) (*Inventory, error)
 }
 
+// ConfigReader is implemented by parsers that honour a repository configuration
+// file (.specvital.yml), so the effective configuration is recorded with the analysis.
+type ConfigReader interface {
+	// ReadConfig returns the effective configuration as JSON, or nil when the
+	// repository has none. An invalid configuration is an error.
+	ReadConfig(ctx context.Context, src Source) ([]byte, error)
+}
+
 // StreamingParser provides file-by-file streaming interface for memory-efficient parsing.
 type StreamingParser interface {
 	Parser
@@ -54,7 +54,9 @@ func (p CreateAnalysisRecordParams) Validate() error {
 }
 
 type SaveAnalysisInventoryParams struct {
-	AnalysisID  UUID
+	AnalysisID UUID
+	// Config is the effective repository configuration as JSON (nil if none).
+	Config      []byte
 	CommittedAt time.Time
 	Inventory   *Inventory
 	UserID      *string
@@ -88,7 +90,9 @@ func (p SaveAnalysisBatchParams) Validate() error {
 
 // FinalizeAnalysisParams contains parameters for finalizing a streaming analysis.
 type FinalizeAnalysisParams struct {
-	AnalysisID  UUID
+	AnalysisID UUID
+	// Config is the effective repository configuration as JSON (nil if none).
+	Config      []byte
 	CommittedAt time.Time
 	TotalSuites int
 	TotalTests  int
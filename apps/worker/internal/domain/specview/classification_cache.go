@@ -9,6 +9,7 @@ import (
 // ClassificationCache represents a cached Phase 1 classification result.
 // Used for incremental caching: when tests change, only new tests are classified.
 type ClassificationCache struct {
+	AnalysisID           string                  // analysis the cache is saved for; links the cache to its codebase
 	ClassificationResult *Phase1Output           // Phase 1 output (domain/feature structure)
 	CreatedAt            time.Time               // cache creation timestamp
 	ExpiresAt            time.Time               // cache expiration timestamp
@@ -22,17 +23,27 @@ type ClassificationCache struct {
 // TestIdentity represents the identity and position of a test within Phase 1 output.
 // Used to track test positions for incremental updates.
 type TestIdentity struct {
-	DomainIndex  int    // index in Phase1Output.Domains
-	FeatureIndex int    // index in Phase1Output.Domains[].Features
-	FilePath     string // file containing the test
-	SuitePath    string // suite path within file
-	TestIndex    int    // index in Phase1Output.Domains[].Features[].TestIndices
+	DomainIndex     int    // index in Phase1Output.Domains
+	FeatureIndex    int    // index in Phase1Output.Domains[].Features
+	FilePath        string // file containing the test
+	Fingerprint     string // rename-stable hash of the test definition; empty if unknown
+	SuggestedDomain string // domain suggested for the file by the repository configuration
+	SuitePath       string // suite path within file
+	TestIndex       int    // index in Phase1Output.Domains[].Features[].TestIndices
 }
 
 // TestDiff represents the difference between cached tests and current tests.
 type TestDiff struct {
 	DeletedTests []TestIdentity // tests that were removed
+	KeptTests    []RenamedTest  // tests found under their cached key
 	NewTests     []TestInfo     // tests that were added (need placement)
+	RenamedTests []RenamedTest  // tests whose key changed but whose fingerprint matches a cached test
+}
+
+// RenamedTest pairs a cached test with the current test carrying its key or fingerprint.
+type RenamedTest struct {
+	Cached  TestIdentity // position of the test in the cached Phase1Output
+	Current TestInfo     // the test under its current key
 }
 
 // PlacementInput represents input for the new test placement AI call.
@@ -57,6 +68,10 @@ type TestPlacement struct {
 // GenerateFileSignature creates a deterministic hash from file paths.
 // Used as cache key component: same set of files -> same signature.
 // Hash = SHA256(sorted_normalized_file_paths)
+// A file's suggested domain is appended to its path, so changing it in the
+// repository configuration invalidates the cached classification.
+// Renaming or moving a file changes the signature; the codebase's latest
+// classification covers that case (see Repository.FindLatestClassificationCache).
 func GenerateFileSignature(files []FileInfo) []byte {
 	if len(files) == 0 {
 		return sha256.New().Sum(nil)
@@ -66,6 +81,9 @@ func GenerateFileSignature(files []FileInfo) []byte {
 	paths := make([]string, len(files))
 	for i, f := range files {
 		paths[i] = normalizeFilePath(f.Path)
+		if f.SuggestedDomain != "" {
+			paths[i] += testKeyDelimiter + f.SuggestedDomain
+		}
 	}
 
 	// Sort for deterministic ordering
@@ -102,17 +120,20 @@ func BuildTestIndexMap(output *Phase1Output, files []FileInfo) map[string]TestId
 		return make(map[string]TestIdentity)
 	}
 
-	// Build lookups: testIndex -> TestInfo and testIndex -> filePath
+	// Build lookups: testIndex -> TestInfo, testIndex -> filePath and testIndex -> suggested domain
 	testInfoByIndex := make(map[int]TestInfo)
 	filePathByTestIndex := make(map[int]string)
+	suggestedDomainByTestIndex := make(map[int]string)
 	for _, f := range files {
 		for _, t := range f.Tests {
 			testInfoByIndex[t.Index] = TestInfo{
-				Index:     t.Index,
-				Name:      t.Name,
-				SuitePath: t.SuitePath,
+				Fingerprint: t.Fingerprint,
+				Index:       t.Index,
+				Name:        t.Name,
+				SuitePath:   t.SuitePath,
 			}
 			filePathByTestIndex[t.Index] = f.Path
+			suggestedDomainByTestIndex[t.Index] = f.SuggestedDomain
 		}
 	}
 
@@ -129,11 +150,13 @@ func BuildTestIndexMap(output *Phase1Output, files []FileInfo) map[string]TestId
 
 				key := TestKey(filePath, testInfo.SuitePath, testInfo.Name)
 				indexMap[key] = TestIdentity{
-					DomainIndex:  di,
-					FeatureIndex: fi,
-					FilePath:     filePath,
-					SuitePath:    testInfo.SuitePath,
-					TestIndex:    ti,
+					DomainIndex:     di,
+					FeatureIndex:    fi,
+					FilePath:        filePath,
+					Fingerprint:     testInfo.Fingerprint,
+					SuggestedDomain: suggestedDomainByTestIndex[testIdx],
+					SuitePath:       testInfo.SuitePath,
+					TestIndex:       ti,
 				}
 			}
 		}
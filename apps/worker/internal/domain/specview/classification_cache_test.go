@@ -144,7 +144,7 @@ func TestBuildTestIndexMap(t *testing.T) {
 			{
 				Path: "test/auth_test.go",
 				Tests: []TestInfo{
-					{Index: 0, Name: "TestLogin", SuitePath: "AuthSuite"},
+					{Index: 0, Name: "TestLogin", SuitePath: "AuthSuite", Fingerprint: "fp-login"},
 					{Index: 1, Name: "TestLogout", SuitePath: "AuthSuite"},
 				},
 			},
@@ -192,6 +192,9 @@ func TestBuildTestIndexMap(t *testing.T) {
 		if identity0.FeatureIndex != 0 {
 			t.Errorf("expected FeatureIndex 0, got %d", identity0.FeatureIndex)
 		}
+		if identity0.Fingerprint != "fp-login" {
+			t.Errorf("expected Fingerprint 'fp-login', got %q", identity0.Fingerprint)
+		}
 
 		// Verify test 2 mapping
 		key2 := TestKey("test/user_test.go", "UserSuite", "TestCreateUser")
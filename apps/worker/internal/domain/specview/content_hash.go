@@ -26,6 +26,12 @@ func GenerateContentHash(files []FileInfo, language Language) []byte {
 		h.Write([]byte(normalizeFilePath(f.Path)))
 		h.Write([]byte{0}) // null separator
 
+		// Suggested domains change the classification, so they are part of the content
+		if f.SuggestedDomain != "" {
+			h.Write([]byte(f.SuggestedDomain))
+			h.Write([]byte{0}) // null separator
+		}
+
 		// Sort tests by normalized name for deterministic ordering
 		sortedTests := make([]TestInfo, len(f.Tests))
 		copy(sortedTests, f.Tests)
@@ -96,3 +102,25 @@ func GenerateCacheKeyHash(key BehaviorCacheKey) []byte {
 
 	return h.Sum(nil)
 }
+
+// GenerateFingerprintCacheKeyHash creates the secondary behavior cache key of a test from its
+// fingerprint instead of its name, suite path and file, so a renamed or moved test finds its
+// cached behavior.
+// Hash = SHA256("fingerprint" + "\x00" + fingerprint + "\x00" + NFC(language) + "\x00" + NFC(model_id))
+// The leading tag keeps fingerprint keys apart from name-based keys.
+func GenerateFingerprintCacheKeyHash(key BehaviorCacheKey) []byte {
+	h := sha256.New()
+
+	h.Write([]byte("fingerprint"))
+	h.Write([]byte{0})
+
+	h.Write([]byte(key.Fingerprint))
+	h.Write([]byte{0})
+
+	h.Write(norm.NFC.Bytes([]byte(key.Language)))
+	h.Write([]byte{0})
+
+	h.Write(norm.NFC.Bytes([]byte(key.ModelID)))
+
+	return h.Sum(nil)
+}
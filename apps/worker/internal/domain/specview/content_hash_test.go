@@ -118,6 +118,28 @@ func TestGenerateContentHash_FileNameChangeDifferent(t *testing.T) {
 	}
 }
 
+func TestGenerateContentHash_SuggestedDomainDifferent(t *testing.T) {
+	filesA := []FileInfo{
+		{Path: "src/invoice_test.ts", Tests: []TestInfo{{Index: 0, Name: "test"}}},
+	}
+	filesB := []FileInfo{
+		{Path: "src/invoice_test.ts", SuggestedDomain: "Billing", Tests: []TestInfo{{Index: 0, Name: "test"}}},
+	}
+
+	hashA := GenerateContentHash(filesA, "English")
+	hashB := GenerateContentHash(filesB, "English")
+
+	if bytes.Equal(hashA, hashB) {
+		t.Error("different suggested domains should produce different hashes")
+	}
+	if !bytes.Equal(GenerateFileSignature(filesA), GenerateFileSignature([]FileInfo{{Path: "src/invoice_test.ts"}})) {
+		t.Error("file signature without suggested domains should only depend on paths")
+	}
+	if bytes.Equal(GenerateFileSignature(filesA), GenerateFileSignature(filesB)) {
+		t.Error("different suggested domains should produce different file signatures")
+	}
+}
+
 func TestGenerateContentHash_TestNameChangeDifferent(t *testing.T) {
 	filesA := []FileInfo{
 		{Path: "src/test.ts", Tests: []TestInfo{{Index: 0, Name: "should do X"}}},
@@ -616,3 +638,46 @@ func TestGenerateCacheKeyHash_FilePathNormalization(t *testing.T) {
 		t.Error("file path normalization should produce same hash for unix and windows paths")
 	}
 }
+
+func TestGenerateFingerprintCacheKeyHash(t *testing.T) {
+	key := BehaviorCacheKey{
+		Fingerprint: "93a4d870103f73a93da185454d9b8ee5",
+		FilePath:    "src/auth/login_test.ts",
+		Language:    "English",
+		ModelID:     "model",
+		SuitePath:   "Login",
+		TestName:    "should login",
+	}
+	renamed := key
+	renamed.SuitePath = "Sign in"
+	renamed.TestName = "signs in"
+	moved := key
+	moved.FilePath = "src/session/sign_in_test.ts"
+
+	hash := GenerateFingerprintCacheKeyHash(key)
+
+	if len(hash) != 32 {
+		t.Errorf("expected SHA256 hash length 32, got %d", len(hash))
+	}
+	if !bytes.Equal(hash, GenerateFingerprintCacheKeyHash(renamed)) {
+		t.Error("renaming the test or suite should not change the fingerprint hash")
+	}
+	if !bytes.Equal(hash, GenerateFingerprintCacheKeyHash(moved)) {
+		t.Error("moving the test to another file should not change the fingerprint hash")
+	}
+	if bytes.Equal(hash, GenerateCacheKeyHash(key)) {
+		t.Error("fingerprint hash should differ from the name-based hash")
+	}
+
+	otherBody := key
+	otherBody.Fingerprint = "0f6c2b1e7d9a4c3b8e5f1a2d3c4b5a69"
+	if bytes.Equal(hash, GenerateFingerprintCacheKeyHash(otherBody)) {
+		t.Error("different fingerprints should produce different hashes")
+	}
+
+	otherModel := key
+	otherModel.ModelID = "other-model"
+	if bytes.Equal(hash, GenerateFingerprintCacheKeyHash(otherModel)) {
+		t.Error("different models should produce different fingerprint hashes")
+	}
+}
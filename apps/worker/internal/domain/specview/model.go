@@ -68,7 +68,9 @@ type FileInfo struct {
 	DomainHints *DomainHints
 	Framework   string
 	Path        string
-	Tests       []TestInfo
+	// SuggestedDomain is the domain the repository configuration assigns to the file.
+	SuggestedDomain string
+	Tests           []TestInfo
 }
 
 // DomainHints provides contextual information for domain classification.
@@ -79,10 +81,11 @@ type DomainHints struct {
 
 // TestInfo represents a single test within a file.
 type TestInfo struct {
-	Index      int // unique identifier for cross-referencing in Phase1Output.FeatureGroup.TestIndices
-	Name       string
-	SuitePath  string // nested suite path (e.g., "SuiteA > SuiteB")
-	TestCaseID string // FK to test_cases table
+	Fingerprint string // rename-stable hash of the test definition; empty if unknown
+	Index       int    // unique identifier for cross-referencing in Phase1Output.FeatureGroup.TestIndices
+	Name        string
+	SuitePath   string // nested suite path (e.g., "SuiteA > SuiteB")
+	TestCaseID  string // FK to test_cases table
 }
 
 // Phase1Output represents the result of domain classification.
@@ -189,6 +192,9 @@ type AnalysisContext struct {
 	Host  string
 	Owner string
 	Repo  string
+	// ViewHints are the spec view settings of the repository configuration
+	// the analysis was scanned with. Nil when it had none.
+	ViewHints *ViewHints
 }
 
 // BehaviorCacheEntry represents a cached behavior conversion result.
@@ -200,9 +206,10 @@ type BehaviorCacheEntry struct {
 
 // BehaviorCacheKey represents the components used to generate a cache key hash.
 type BehaviorCacheKey struct {
-	FilePath  string
-	Language  Language
-	ModelID   string
-	SuitePath string
-	TestName  string
+	FilePath    string
+	Fingerprint string // only used by GenerateFingerprintCacheKeyHash
+	Language    Language
+	ModelID     string
+	SuitePath   string
+	TestName    string
 }
@@ -43,6 +43,11 @@ type Repository interface {
 	// Returns nil without error if no cache is found.
 	FindClassificationCache(ctx context.Context, fileSignature []byte, language Language, modelID string) (*ClassificationCache, error)
 
+	// FindLatestClassificationCache looks up the most recent Phase 1 classification
+	// cached for the codebase of the given analysis, whatever its file signature.
+	// Returns nil without error if no cache is found.
+	FindLatestClassificationCache(ctx context.Context, analysisID string, language Language, modelID string) (*ClassificationCache, error)
+
 	// SaveClassificationCache saves or updates a Phase 1 classification cache.
 	// Uses upsert semantics: existing cache is replaced, new cache is inserted.
 	SaveClassificationCache(ctx context.Context, cache *ClassificationCache) error
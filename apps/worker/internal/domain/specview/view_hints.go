@@ -0,0 +1,53 @@
+package specview
+
+import (
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// ViewHints are the spec view settings of a repository configuration
+// (.specvital.yml). Globs are doublestar patterns relative to the repository root.
+type ViewHints struct {
+	// Domains suggest the business domain of matching files. The first match wins.
+	Domains []DomainSuggestion
+	// Exclude leaves matching files out of spec documents.
+	Exclude []string
+}
+
+// DomainSuggestion assigns Name as the domain of files matching Files.
+type DomainSuggestion struct {
+	Files []string
+	Name  string
+}
+
+// Apply drops excluded files and sets the suggested domain of the rest.
+// A nil receiver returns files unchanged.
+func (h *ViewHints) Apply(files []FileInfo) []FileInfo {
+	if h == nil {
+		return files
+	}
+
+	result := make([]FileInfo, 0, len(files))
+	for _, file := range files {
+		path := normalizeFilePath(file.Path)
+		if matchAnyGlob(h.Exclude, path) {
+			continue
+		}
+		for _, suggestion := range h.Domains {
+			if matchAnyGlob(suggestion.Files, path) {
+				file.SuggestedDomain = suggestion.Name
+				break
+			}
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+func matchAnyGlob(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := doublestar.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package specview
+
+import "testing"
+
+func TestViewHints_Apply(t *testing.T) {
+	files := []FileInfo{
+		{Path: "src/billing/invoice_test.go"},
+		{Path: "src/billing/internal/tax_test.go"},
+		{Path: "src/legacy/old_test.go"},
+		{Path: "src/cart_test.go"},
+	}
+
+	hints := &ViewHints{
+		Exclude: []string{"src/legacy/**"},
+		Domains: []DomainSuggestion{
+			{Name: "Tax", Files: []string{"**/tax_test.go"}},
+			{Name: "Billing", Files: []string{"src/billing/**"}},
+		},
+	}
+
+	got := hints.Apply(files)
+
+	want := map[string]string{
+		"src/billing/invoice_test.go":      "Billing",
+		"src/billing/internal/tax_test.go": "Tax",
+		"src/cart_test.go":                 "",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(got))
+	}
+	for _, file := range got {
+		domain, ok := want[file.Path]
+		if !ok {
+			t.Errorf("unexpected file %s", file.Path)
+			continue
+		}
+		if file.SuggestedDomain != domain {
+			t.Errorf("%s: expected suggested domain %q, got %q", file.Path, domain, file.SuggestedDomain)
+		}
+	}
+	if files[0].SuggestedDomain != "" {
+		t.Error("input files should not be modified")
+	}
+}
+
+func TestViewHints_Apply_Nil(t *testing.T) {
+	files := []FileInfo{{Path: "a_test.go"}}
+
+	var hints *ViewHints
+	got := hints.Apply(files)
+
+	if len(got) != 1 || got[0].SuggestedDomain != "" {
+		t.Errorf("nil hints should return files unchanged, got %+v", got)
+	}
+}
@@ -58,6 +58,7 @@ type Config struct {
 	GeminiPhase1Model string
 	GeminiPhase2Model string
 	MockMode          bool
+	ParserCacheDir    string
 	Queue             QueueConfig
 	Streaming         StreamingConfig
 }
@@ -81,6 +82,7 @@ func Load() (*Config, error) {
 		GeminiPhase1Model: os.Getenv("GEMINI_PHASE1_MODEL"),
 		GeminiPhase2Model: os.Getenv("GEMINI_PHASE2_MODEL"),
 		MockMode:          os.Getenv("MOCK_MODE") == "true",
+		ParserCacheDir:    os.Getenv("PARSER_CACHE_DIR"),
 		Queue:             loadQueueConfig(),
 		Streaming:         loadStreamingConfig(),
 	}, nil
@@ -5,7 +5,7 @@ INSERT INTO test_suites (file_id, parent_id, name, line_number, depth)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING id`
 
-var TestCaseCopyColumns = []string{"suite_id", "name", "line_number", "status", "tags", "modifier"}
+var TestCaseCopyColumns = []string{"suite_id", "name", "line_number", "status", "tags", "modifier", "skip_reason", "kind", "assertion_count", "line_count", "nesting_depth", "fingerprint"}
 
 const InsertSpecDomainBatch = `
 INSERT INTO spec_domains (document_id, name, description, sort_order, classification_confidence)
@@ -32,6 +32,6 @@ ON CONFLICT (cache_key_hash) DO UPDATE
 SET converted_description = EXCLUDED.converted_description`
 
 const InsertTestFileBatch = `
-INSERT INTO test_files (analysis_id, file_path, framework, domain_hints)
-VALUES ($1, $2, $3, $4)
+INSERT INTO test_files (analysis_id, file_path, framework, domain_hints, detection, project, findings)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id`
@@ -273,6 +273,52 @@ func (ns NullSubscriptionStatus) Value() (driver.Value, error) {
 	return string(ns.SubscriptionStatus), nil
 }
 
+type TestKind string
+
+const (
+	TestKindUnit        TestKind = "unit"
+	TestKindIntegration TestKind = "integration"
+	TestKindE2e         TestKind = "e2e"
+	TestKindBenchmark   TestKind = "benchmark"
+	TestKindFuzz        TestKind = "fuzz"
+	TestKindExample     TestKind = "example"
+)
+
+func (e *TestKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TestKind(s)
+	case string:
+		*e = TestKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TestKind: %T", src)
+	}
+	return nil
+}
+
+type NullTestKind struct {
+	TestKind TestKind `json:"test_kind"`
+	Valid    bool     `json:"valid"` // Valid is true if TestKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTestKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.TestKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TestKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTestKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TestKind), nil
+}
+
 type TestStatus string
 
 const (
@@ -374,6 +420,17 @@ type Analysis struct {
 	TotalTests    int32              `json:"total_tests"`
 	CommittedAt   pgtype.Timestamptz `json:"committed_at"`
 	ParserVersion string             `json:"parser_version"`
+	Config        []byte             `json:"config"`
+}
+
+type AnalysisChangelog struct {
+	AnalysisID     pgtype.UUID        `json:"analysis_id"`
+	BaseAnalysisID pgtype.UUID        `json:"base_analysis_id"`
+	Summary        []byte             `json:"summary"`
+	Frameworks     []byte             `json:"frameworks"`
+	TestChanges    []byte             `json:"test_changes"`
+	SuiteChanges   []byte             `json:"suite_changes"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 }
 
 type AtlasSchemaRevision struct {
@@ -406,6 +463,7 @@ type ClassificationCach struct {
 	Phase1Output []byte             `json:"phase1_output"`
 	TestIndexMap []byte             `json:"test_index_map"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	CodebaseID   pgtype.UUID        `json:"codebase_id"`
 }
 
 type Codebasis struct {
@@ -596,13 +654,19 @@ type SystemConfig struct {
 }
 
 type TestCase struct {
-	ID         pgtype.UUID `json:"id"`
-	SuiteID    pgtype.UUID `json:"suite_id"`
-	Name       string      `json:"name"`
-	LineNumber pgtype.Int4 `json:"line_number"`
-	Status     TestStatus  `json:"status"`
-	Tags       []byte      `json:"tags"`
-	Modifier   pgtype.Text `json:"modifier"`
+	ID             pgtype.UUID `json:"id"`
+	SuiteID        pgtype.UUID `json:"suite_id"`
+	Name           string      `json:"name"`
+	LineNumber     pgtype.Int4 `json:"line_number"`
+	Status         TestStatus  `json:"status"`
+	Tags           []byte      `json:"tags"`
+	Modifier       pgtype.Text `json:"modifier"`
+	SkipReason     pgtype.Text `json:"skip_reason"`
+	Kind           TestKind    `json:"kind"`
+	AssertionCount pgtype.Int4 `json:"assertion_count"`
+	LineCount      pgtype.Int4 `json:"line_count"`
+	NestingDepth   pgtype.Int4 `json:"nesting_depth"`
+	Fingerprint    pgtype.Text `json:"fingerprint"`
 }
 
 type TestFile struct {
@@ -611,6 +675,9 @@ type TestFile struct {
 	FilePath    string      `json:"file_path"`
 	Framework   pgtype.Text `json:"framework"`
 	DomainHints []byte      `json:"domain_hints"`
+	Detection   []byte      `json:"detection"`
+	Project     []byte      `json:"project"`
+	Findings    []byte      `json:"findings"`
 }
 
 type TestSuite struct {
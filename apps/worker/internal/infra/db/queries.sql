@@ -24,12 +24,12 @@ SELECT * FROM codebases WHERE id = $1;
 
 -- name: CreateAnalysis :one
 INSERT INTO analyses (id, codebase_id, commit_sha, branch_name, status, started_at, parser_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
 -- name: UpdateAnalysisCompleted :exec
 UPDATE analyses
-SET status = 'completed', total_suites = $2, total_tests = $3, completed_at = $4, committed_at = $5
+SET status = 'completed', total_suites = $2, total_tests = $3, completed_at = $4, committed_at = $5, config = $6
 WHERE id = $1;
 
 -- name: UpdateAnalysisFailed :exec
@@ -38,8 +38,8 @@ SET status = 'failed', error_message = $2, completed_at = $3
 WHERE id = $1;
 
 -- name: CreateTestCase :one
-INSERT INTO test_cases (suite_id, name, line_number, status, tags, modifier)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO test_cases (suite_id, name, line_number, status, tags, modifier, skip_reason, kind, assertion_count, line_count, nesting_depth, fingerprint)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
 -- name: GetTestSuitesByFileID :many
@@ -91,8 +91,8 @@ ON CONFLICT ON CONSTRAINT uq_user_analysis_history_user_analysis
 DO UPDATE SET updated_at = now();
 
 -- name: InsertTestFile :one
-INSERT INTO test_files (analysis_id, file_path, framework, domain_hints)
-VALUES ($1, $2, $3, $4)
+INSERT INTO test_files (analysis_id, file_path, framework, domain_hints, detection, project, findings)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id;
 
 -- name: InsertTestSuite :one
@@ -158,13 +158,57 @@ SELECT
     ts.name as suite_name,
     ts.depth as suite_depth,
     tc.id as test_case_id,
-    tc.name as test_name
+    tc.name as test_name,
+    tc.fingerprint as test_fingerprint
 FROM test_files tf
 JOIN test_suites ts ON ts.file_id = tf.id
 JOIN test_cases tc ON tc.suite_id = ts.id
 WHERE tf.analysis_id = $1
 ORDER BY tf.file_path, ts.depth, ts.name, tc.name;
 
+-- name: FindPreviousCompletedAnalysisID :one
+-- Picks the latest completed analysis of a commit no newer than the analyzed one,
+-- so re-analyzing an old commit is not compared with later commits.
+-- Analyses without a commit timestamp fall back to completion order.
+SELECT prev.id
+FROM analyses cur
+JOIN analyses prev ON prev.codebase_id = cur.codebase_id
+WHERE cur.id = sqlc.arg(analysis_id)
+  AND prev.id <> cur.id
+  AND prev.status = 'completed'
+  AND (cur.committed_at IS NULL OR prev.committed_at IS NULL OR prev.committed_at <= cur.committed_at)
+ORDER BY prev.committed_at DESC NULLS LAST, prev.completed_at DESC
+LIMIT 1;
+
+-- name: GetTestSuitesByAnalysisID :many
+SELECT
+    ts.id,
+    ts.parent_id,
+    ts.name,
+    ts.line_number,
+    tf.file_path,
+    tf.framework
+FROM test_suites ts
+JOIN test_files tf ON tf.id = ts.file_id
+WHERE tf.analysis_id = $1
+ORDER BY tf.file_path, ts.depth, ts.line_number, ts.id;
+
+-- name: GetTestCasesByAnalysisID :many
+SELECT
+    tc.suite_id,
+    tc.name,
+    tc.line_number,
+    tc.status
+FROM test_cases tc
+JOIN test_suites ts ON ts.id = tc.suite_id
+JOIN test_files tf ON tf.id = ts.file_id
+WHERE tf.analysis_id = $1
+ORDER BY tc.line_number, tc.id;
+
+-- name: InsertAnalysisChangelog :exec
+INSERT INTO analysis_changelogs (analysis_id, base_analysis_id, summary, frameworks, test_changes, suite_changes)
+VALUES ($1, $2, $3, $4, $5, $6);
+
 -- name: CheckAnalysisExists :one
 SELECT EXISTS(SELECT 1 FROM analyses WHERE id = $1) as exists;
 
@@ -194,7 +238,7 @@ INSERT INTO usage_events (user_id, event_type, analysis_id, quota_amount)
 VALUES ($1, 'analysis', $2, $3);
 
 -- name: GetAnalysisContext :one
-SELECT c.host, c.owner, c.name as repo
+SELECT c.host, c.owner, c.name as repo, a.config->'specView' as spec_view
 FROM analyses a
 JOIN codebases c ON a.codebase_id = c.id
 WHERE a.id = $1;
@@ -219,16 +263,25 @@ SET converted_description = EXCLUDED.converted_description;
 -- =============================================================================
 
 -- name: FindClassificationCacheByKey :one
-SELECT id, content_hash, language, model_id, phase1_output, test_index_map, created_at
+SELECT id, content_hash, language, model_id, phase1_output, test_index_map, created_at, codebase_id
 FROM classification_caches
 WHERE content_hash = $1 AND language = $2 AND model_id = $3;
 
+-- name: FindLatestClassificationCacheByAnalysis :one
+SELECT cc.id, cc.content_hash, cc.language, cc.model_id, cc.phase1_output, cc.test_index_map, cc.created_at, cc.codebase_id
+FROM classification_caches cc
+JOIN analyses a ON a.codebase_id = cc.codebase_id
+WHERE a.id = $1 AND cc.language = $2 AND cc.model_id = $3
+ORDER BY cc.created_at DESC
+LIMIT 1;
+
 -- name: UpsertClassificationCache :exec
-INSERT INTO classification_caches (content_hash, language, model_id, phase1_output, test_index_map)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO classification_caches (content_hash, language, model_id, phase1_output, test_index_map, codebase_id)
+VALUES ($1, $2, $3, $4, $5, (SELECT codebase_id FROM analyses WHERE id = sqlc.narg(analysis_id)))
 ON CONFLICT ON CONSTRAINT uq_classification_caches_key DO UPDATE
 SET phase1_output = EXCLUDED.phase1_output,
     test_index_map = EXCLUDED.test_index_map,
+    codebase_id = COALESCE(EXCLUDED.codebase_id, classification_caches.codebase_id),
     created_at = now();
 
 -- name: DeleteExpiredClassificationCaches :execrows
@@ -25,7 +25,7 @@ func (q *Queries) CheckAnalysisExists(ctx context.Context, id pgtype.UUID) (bool
 const createAnalysis = `-- name: CreateAnalysis :one
 INSERT INTO analyses (id, codebase_id, commit_sha, branch_name, status, started_at, parser_version)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, codebase_id, commit_sha, branch_name, status, error_message, started_at, completed_at, created_at, total_suites, total_tests, committed_at, parser_version
+RETURNING id, codebase_id, commit_sha, branch_name, status, error_message, started_at, completed_at, created_at, total_suites, total_tests, committed_at, parser_version, config
 `
 
 type CreateAnalysisParams struct {
@@ -63,23 +63,30 @@ func (q *Queries) CreateAnalysis(ctx context.Context, arg CreateAnalysisParams)
 		&i.TotalTests,
 		&i.CommittedAt,
 		&i.ParserVersion,
+		&i.Config,
 	)
 	return i, err
 }
 
 const createTestCase = `-- name: CreateTestCase :one
-INSERT INTO test_cases (suite_id, name, line_number, status, tags, modifier)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, suite_id, name, line_number, status, tags, modifier
+INSERT INTO test_cases (suite_id, name, line_number, status, tags, modifier, skip_reason, kind, assertion_count, line_count, nesting_depth, fingerprint)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, suite_id, name, line_number, status, tags, modifier, skip_reason, kind, assertion_count, line_count, nesting_depth, fingerprint
 `
 
 type CreateTestCaseParams struct {
-	SuiteID    pgtype.UUID `json:"suite_id"`
-	Name       string      `json:"name"`
-	LineNumber pgtype.Int4 `json:"line_number"`
-	Status     TestStatus  `json:"status"`
-	Tags       []byte      `json:"tags"`
-	Modifier   pgtype.Text `json:"modifier"`
+	SuiteID        pgtype.UUID `json:"suite_id"`
+	Name           string      `json:"name"`
+	LineNumber     pgtype.Int4 `json:"line_number"`
+	Status         TestStatus  `json:"status"`
+	Tags           []byte      `json:"tags"`
+	Modifier       pgtype.Text `json:"modifier"`
+	SkipReason     pgtype.Text `json:"skip_reason"`
+	Kind           TestKind    `json:"kind"`
+	AssertionCount pgtype.Int4 `json:"assertion_count"`
+	LineCount      pgtype.Int4 `json:"line_count"`
+	NestingDepth   pgtype.Int4 `json:"nesting_depth"`
+	Fingerprint    pgtype.Text `json:"fingerprint"`
 }
 
 func (q *Queries) CreateTestCase(ctx context.Context, arg CreateTestCaseParams) (TestCase, error) {
@@ -90,6 +97,12 @@ func (q *Queries) CreateTestCase(ctx context.Context, arg CreateTestCaseParams)
 		arg.Status,
 		arg.Tags,
 		arg.Modifier,
+		arg.SkipReason,
+		arg.Kind,
+		arg.AssertionCount,
+		arg.LineCount,
+		arg.NestingDepth,
+		arg.Fingerprint,
 	)
 	var i TestCase
 	err := row.Scan(
@@ -100,6 +113,12 @@ func (q *Queries) CreateTestCase(ctx context.Context, arg CreateTestCaseParams)
 		&i.Status,
 		&i.Tags,
 		&i.Modifier,
+		&i.SkipReason,
+		&i.Kind,
+		&i.AssertionCount,
+		&i.LineCount,
+		&i.NestingDepth,
+		&i.Fingerprint,
 	)
 	return i, err
 }
@@ -232,7 +251,7 @@ func (q *Queries) FindBehaviorCachesByHashes(ctx context.Context, dollar_1 [][]b
 
 const findClassificationCacheByKey = `-- name: FindClassificationCacheByKey :one
 
-SELECT id, content_hash, language, model_id, phase1_output, test_index_map, created_at
+SELECT id, content_hash, language, model_id, phase1_output, test_index_map, created_at, codebase_id
 FROM classification_caches
 WHERE content_hash = $1 AND language = $2 AND model_id = $3
 `
@@ -257,6 +276,7 @@ func (q *Queries) FindClassificationCacheByKey(ctx context.Context, arg FindClas
 		&i.Phase1Output,
 		&i.TestIndexMap,
 		&i.CreatedAt,
+		&i.CodebaseID,
 	)
 	return i, err
 }
@@ -375,6 +395,60 @@ func (q *Queries) FindCodebaseWithLastCommitByOwnerName(ctx context.Context, arg
 	return i, err
 }
 
+const findLatestClassificationCacheByAnalysis = `-- name: FindLatestClassificationCacheByAnalysis :one
+SELECT cc.id, cc.content_hash, cc.language, cc.model_id, cc.phase1_output, cc.test_index_map, cc.created_at, cc.codebase_id
+FROM classification_caches cc
+JOIN analyses a ON a.codebase_id = cc.codebase_id
+WHERE a.id = $1 AND cc.language = $2 AND cc.model_id = $3
+ORDER BY cc.created_at DESC
+LIMIT 1
+`
+
+type FindLatestClassificationCacheByAnalysisParams struct {
+	ID       pgtype.UUID `json:"id"`
+	Language string      `json:"language"`
+	ModelID  string      `json:"model_id"`
+}
+
+func (q *Queries) FindLatestClassificationCacheByAnalysis(ctx context.Context, arg FindLatestClassificationCacheByAnalysisParams) (ClassificationCach, error) {
+	row := q.db.QueryRow(ctx, findLatestClassificationCacheByAnalysis, arg.ID, arg.Language, arg.ModelID)
+	var i ClassificationCach
+	err := row.Scan(
+		&i.ID,
+		&i.ContentHash,
+		&i.Language,
+		&i.ModelID,
+		&i.Phase1Output,
+		&i.TestIndexMap,
+		&i.CreatedAt,
+		&i.CodebaseID,
+	)
+	return i, err
+}
+
+const findPreviousCompletedAnalysisID = `-- name: FindPreviousCompletedAnalysisID :one
+
+SELECT prev.id
+FROM analyses cur
+JOIN analyses prev ON prev.codebase_id = cur.codebase_id
+WHERE cur.id = $1
+  AND prev.id <> cur.id
+  AND prev.status = 'completed'
+  AND (cur.committed_at IS NULL OR prev.committed_at IS NULL OR prev.committed_at <= cur.committed_at)
+ORDER BY prev.committed_at DESC NULLS LAST, prev.completed_at DESC
+LIMIT 1
+`
+
+// Picks the latest completed analysis of a commit no newer than the analyzed one,
+// so re-analyzing an old commit is not compared with later commits.
+// Analyses without a commit timestamp fall back to completion order.
+func (q *Queries) FindPreviousCompletedAnalysisID(ctx context.Context, analysisID pgtype.UUID) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, findPreviousCompletedAnalysisID, analysisID)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const findSpecDocumentByContentHash = `-- name: FindSpecDocumentByContentHash :one
 SELECT sd.id, sd.analysis_id, sd.content_hash, sd.language, sd.executive_summary, sd.model_id, sd.created_at, sd.updated_at, sd.version, sd.user_id, sd.retention_days_at_creation FROM spec_documents sd
 WHERE sd.user_id = $1
@@ -422,22 +496,28 @@ func (q *Queries) FindSpecDocumentByContentHash(ctx context.Context, arg FindSpe
 }
 
 const getAnalysisContext = `-- name: GetAnalysisContext :one
-SELECT c.host, c.owner, c.name as repo
+SELECT c.host, c.owner, c.name as repo, a.config->'specView' as spec_view
 FROM analyses a
 JOIN codebases c ON a.codebase_id = c.id
 WHERE a.id = $1
 `
 
 type GetAnalysisContextRow struct {
-	Host  string `json:"host"`
-	Owner string `json:"owner"`
-	Repo  string `json:"repo"`
+	Host     string `json:"host"`
+	Owner    string `json:"owner"`
+	Repo     string `json:"repo"`
+	SpecView []byte `json:"spec_view"`
 }
 
 func (q *Queries) GetAnalysisContext(ctx context.Context, id pgtype.UUID) (GetAnalysisContextRow, error) {
 	row := q.db.QueryRow(ctx, getAnalysisContext, id)
 	var i GetAnalysisContextRow
-	err := row.Scan(&i.Host, &i.Owner, &i.Repo)
+	err := row.Scan(
+		&i.Host,
+		&i.Owner,
+		&i.Repo,
+		&i.SpecView,
+	)
 	return i, err
 }
 
@@ -539,8 +619,53 @@ func (q *Queries) GetSystemConfig(ctx context.Context, key string) (string, erro
 	return value, err
 }
 
+const getTestCasesByAnalysisID = `-- name: GetTestCasesByAnalysisID :many
+SELECT
+    tc.suite_id,
+    tc.name,
+    tc.line_number,
+    tc.status
+FROM test_cases tc
+JOIN test_suites ts ON ts.id = tc.suite_id
+JOIN test_files tf ON tf.id = ts.file_id
+WHERE tf.analysis_id = $1
+ORDER BY tc.line_number, tc.id
+`
+
+type GetTestCasesByAnalysisIDRow struct {
+	SuiteID    pgtype.UUID `json:"suite_id"`
+	Name       string      `json:"name"`
+	LineNumber pgtype.Int4 `json:"line_number"`
+	Status     TestStatus  `json:"status"`
+}
+
+func (q *Queries) GetTestCasesByAnalysisID(ctx context.Context, analysisID pgtype.UUID) ([]GetTestCasesByAnalysisIDRow, error) {
+	rows, err := q.db.Query(ctx, getTestCasesByAnalysisID, analysisID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTestCasesByAnalysisIDRow{}
+	for rows.Next() {
+		var i GetTestCasesByAnalysisIDRow
+		if err := rows.Scan(
+			&i.SuiteID,
+			&i.Name,
+			&i.LineNumber,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTestCasesBySuiteID = `-- name: GetTestCasesBySuiteID :many
-SELECT id, suite_id, name, line_number, status, tags, modifier FROM test_cases WHERE suite_id = $1 ORDER BY line_number
+SELECT id, suite_id, name, line_number, status, tags, modifier, skip_reason, kind, assertion_count, line_count, nesting_depth, fingerprint FROM test_cases WHERE suite_id = $1 ORDER BY line_number
 `
 
 func (q *Queries) GetTestCasesBySuiteID(ctx context.Context, suiteID pgtype.UUID) ([]TestCase, error) {
@@ -560,6 +685,12 @@ func (q *Queries) GetTestCasesBySuiteID(ctx context.Context, suiteID pgtype.UUID
 			&i.Status,
 			&i.Tags,
 			&i.Modifier,
+			&i.SkipReason,
+			&i.Kind,
+			&i.AssertionCount,
+			&i.LineCount,
+			&i.NestingDepth,
+			&i.Fingerprint,
 		); err != nil {
 			return nil, err
 		}
@@ -582,7 +713,8 @@ SELECT
     ts.name as suite_name,
     ts.depth as suite_depth,
     tc.id as test_case_id,
-    tc.name as test_name
+    tc.name as test_name,
+    tc.fingerprint as test_fingerprint
 FROM test_files tf
 JOIN test_suites ts ON ts.file_id = tf.id
 JOIN test_cases tc ON tc.suite_id = ts.id
@@ -591,16 +723,17 @@ ORDER BY tf.file_path, ts.depth, ts.name, tc.name
 `
 
 type GetTestDataByAnalysisIDRow struct {
-	FileID        pgtype.UUID `json:"file_id"`
-	FilePath      string      `json:"file_path"`
-	Framework     pgtype.Text `json:"framework"`
-	DomainHints   []byte      `json:"domain_hints"`
-	SuiteID       pgtype.UUID `json:"suite_id"`
-	SuiteParentID pgtype.UUID `json:"suite_parent_id"`
-	SuiteName     string      `json:"suite_name"`
-	SuiteDepth    int32       `json:"suite_depth"`
-	TestCaseID    pgtype.UUID `json:"test_case_id"`
-	TestName      string      `json:"test_name"`
+	FileID          pgtype.UUID `json:"file_id"`
+	FilePath        string      `json:"file_path"`
+	Framework       pgtype.Text `json:"framework"`
+	DomainHints     []byte      `json:"domain_hints"`
+	SuiteID         pgtype.UUID `json:"suite_id"`
+	SuiteParentID   pgtype.UUID `json:"suite_parent_id"`
+	SuiteName       string      `json:"suite_name"`
+	SuiteDepth      int32       `json:"suite_depth"`
+	TestCaseID      pgtype.UUID `json:"test_case_id"`
+	TestName        string      `json:"test_name"`
+	TestFingerprint pgtype.Text `json:"test_fingerprint"`
 }
 
 func (q *Queries) GetTestDataByAnalysisID(ctx context.Context, analysisID pgtype.UUID) ([]GetTestDataByAnalysisIDRow, error) {
@@ -623,6 +756,57 @@ func (q *Queries) GetTestDataByAnalysisID(ctx context.Context, analysisID pgtype
 			&i.SuiteDepth,
 			&i.TestCaseID,
 			&i.TestName,
+			&i.TestFingerprint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTestSuitesByAnalysisID = `-- name: GetTestSuitesByAnalysisID :many
+SELECT
+    ts.id,
+    ts.parent_id,
+    ts.name,
+    ts.line_number,
+    tf.file_path,
+    tf.framework
+FROM test_suites ts
+JOIN test_files tf ON tf.id = ts.file_id
+WHERE tf.analysis_id = $1
+ORDER BY tf.file_path, ts.depth, ts.line_number, ts.id
+`
+
+type GetTestSuitesByAnalysisIDRow struct {
+	ID         pgtype.UUID `json:"id"`
+	ParentID   pgtype.UUID `json:"parent_id"`
+	Name       string      `json:"name"`
+	LineNumber pgtype.Int4 `json:"line_number"`
+	FilePath   string      `json:"file_path"`
+	Framework  pgtype.Text `json:"framework"`
+}
+
+func (q *Queries) GetTestSuitesByAnalysisID(ctx context.Context, analysisID pgtype.UUID) ([]GetTestSuitesByAnalysisIDRow, error) {
+	rows, err := q.db.Query(ctx, getTestSuitesByAnalysisID, analysisID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTestSuitesByAnalysisIDRow{}
+	for rows.Next() {
+		var i GetTestSuitesByAnalysisIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.Name,
+			&i.LineNumber,
+			&i.FilePath,
+			&i.Framework,
 		); err != nil {
 			return nil, err
 		}
@@ -701,6 +885,32 @@ func (q *Queries) GetUserTier(ctx context.Context, userID pgtype.UUID) (PlanTier
 	return tier, err
 }
 
+const insertAnalysisChangelog = `-- name: InsertAnalysisChangelog :exec
+INSERT INTO analysis_changelogs (analysis_id, base_analysis_id, summary, frameworks, test_changes, suite_changes)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertAnalysisChangelogParams struct {
+	AnalysisID     pgtype.UUID `json:"analysis_id"`
+	BaseAnalysisID pgtype.UUID `json:"base_analysis_id"`
+	Summary        []byte      `json:"summary"`
+	Frameworks     []byte      `json:"frameworks"`
+	TestChanges    []byte      `json:"test_changes"`
+	SuiteChanges   []byte      `json:"suite_changes"`
+}
+
+func (q *Queries) InsertAnalysisChangelog(ctx context.Context, arg InsertAnalysisChangelogParams) error {
+	_, err := q.db.Exec(ctx, insertAnalysisChangelog,
+		arg.AnalysisID,
+		arg.BaseAnalysisID,
+		arg.Summary,
+		arg.Frameworks,
+		arg.TestChanges,
+		arg.SuiteChanges,
+	)
+	return err
+}
+
 const insertSpecDocument = `-- name: InsertSpecDocument :one
 INSERT INTO spec_documents (user_id, analysis_id, content_hash, language, executive_summary, model_id, version, retention_days_at_creation)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -787,8 +997,8 @@ func (q *Queries) InsertSpecFeature(ctx context.Context, arg InsertSpecFeaturePa
 }
 
 const insertTestFile = `-- name: InsertTestFile :one
-INSERT INTO test_files (analysis_id, file_path, framework, domain_hints)
-VALUES ($1, $2, $3, $4)
+INSERT INTO test_files (analysis_id, file_path, framework, domain_hints, detection, project, findings)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id
 `
 
@@ -797,6 +1007,9 @@ type InsertTestFileParams struct {
 	FilePath    string      `json:"file_path"`
 	Framework   pgtype.Text `json:"framework"`
 	DomainHints []byte      `json:"domain_hints"`
+	Detection   []byte      `json:"detection"`
+	Project     []byte      `json:"project"`
+	Findings    []byte      `json:"findings"`
 }
 
 func (q *Queries) InsertTestFile(ctx context.Context, arg InsertTestFileParams) (pgtype.UUID, error) {
@@ -805,6 +1018,9 @@ func (q *Queries) InsertTestFile(ctx context.Context, arg InsertTestFileParams)
 		arg.FilePath,
 		arg.Framework,
 		arg.DomainHints,
+		arg.Detection,
+		arg.Project,
+		arg.Findings,
 	)
 	var id pgtype.UUID
 	err := row.Scan(&id)
@@ -952,7 +1168,7 @@ func (q *Queries) UnmarkCodebaseStale(ctx context.Context, arg UnmarkCodebaseSta
 
 const updateAnalysisCompleted = `-- name: UpdateAnalysisCompleted :exec
 UPDATE analyses
-SET status = 'completed', total_suites = $2, total_tests = $3, completed_at = $4, committed_at = $5
+SET status = 'completed', total_suites = $2, total_tests = $3, completed_at = $4, committed_at = $5, config = $6
 WHERE id = $1
 `
 
@@ -962,6 +1178,7 @@ type UpdateAnalysisCompletedParams struct {
 	TotalTests  int32              `json:"total_tests"`
 	CompletedAt pgtype.Timestamptz `json:"completed_at"`
 	CommittedAt pgtype.Timestamptz `json:"committed_at"`
+	Config      []byte             `json:"config"`
 }
 
 func (q *Queries) UpdateAnalysisCompleted(ctx context.Context, arg UpdateAnalysisCompletedParams) error {
@@ -971,6 +1188,7 @@ func (q *Queries) UpdateAnalysisCompleted(ctx context.Context, arg UpdateAnalysi
 		arg.TotalTests,
 		arg.CompletedAt,
 		arg.CommittedAt,
+		arg.Config,
 	)
 	return err
 }
@@ -1058,20 +1276,22 @@ func (q *Queries) UpsertBehaviorCache(ctx context.Context, arg UpsertBehaviorCac
 }
 
 const upsertClassificationCache = `-- name: UpsertClassificationCache :exec
-INSERT INTO classification_caches (content_hash, language, model_id, phase1_output, test_index_map)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO classification_caches (content_hash, language, model_id, phase1_output, test_index_map, codebase_id)
+VALUES ($1, $2, $3, $4, $5, (SELECT codebase_id FROM analyses WHERE id = $6))
 ON CONFLICT ON CONSTRAINT uq_classification_caches_key DO UPDATE
 SET phase1_output = EXCLUDED.phase1_output,
     test_index_map = EXCLUDED.test_index_map,
+    codebase_id = COALESCE(EXCLUDED.codebase_id, classification_caches.codebase_id),
     created_at = now()
 `
 
 type UpsertClassificationCacheParams struct {
-	ContentHash  []byte `json:"content_hash"`
-	Language     string `json:"language"`
-	ModelID      string `json:"model_id"`
-	Phase1Output []byte `json:"phase1_output"`
-	TestIndexMap []byte `json:"test_index_map"`
+	ContentHash  []byte      `json:"content_hash"`
+	Language     string      `json:"language"`
+	ModelID      string      `json:"model_id"`
+	Phase1Output []byte      `json:"phase1_output"`
+	TestIndexMap []byte      `json:"test_index_map"`
+	AnalysisID   pgtype.UUID `json:"analysis_id"`
 }
 
 func (q *Queries) UpsertClassificationCache(ctx context.Context, arg UpsertClassificationCacheParams) error {
@@ -1081,6 +1301,7 @@ func (q *Queries) UpsertClassificationCache(ctx context.Context, arg UpsertClass
 		arg.ModelID,
 		arg.Phase1Output,
 		arg.TestIndexMap,
+		arg.AnalysisID,
 	)
 	return err
 }
@@ -154,7 +154,23 @@ CREATE TABLE public.analyses (
     total_suites integer DEFAULT 0 NOT NULL,
     total_tests integer DEFAULT 0 NOT NULL,
     committed_at timestamp with time zone,
-    parser_version character varying(100) DEFAULT 'legacy'::character varying NOT NULL
+    parser_version character varying(100) DEFAULT 'legacy'::character varying NOT NULL,
+    config jsonb
+);
+
+
+--
+-- Name: analysis_changelogs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.analysis_changelogs (
+    analysis_id uuid NOT NULL,
+    base_analysis_id uuid,
+    summary jsonb NOT NULL,
+    frameworks jsonb DEFAULT '[]'::jsonb NOT NULL,
+    test_changes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    suite_changes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -201,7 +217,8 @@ CREATE TABLE public.classification_caches (
     model_id character varying(100) NOT NULL,
     phase1_output jsonb NOT NULL,
     test_index_map jsonb NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    codebase_id uuid
 );
 
 
@@ -520,7 +537,13 @@ CREATE TABLE public.test_cases (
     line_number integer,
     status public.test_status DEFAULT 'active'::public.test_status NOT NULL,
     tags jsonb DEFAULT '[]'::jsonb NOT NULL,
-    modifier character varying(50)
+    modifier character varying(50),
+    skip_reason text,
+    kind character varying(20) DEFAULT 'unit'::character varying NOT NULL,
+    assertion_count integer,
+    line_count integer,
+    nesting_depth integer,
+    fingerprint character varying(32)
 );
 
 
@@ -533,7 +556,10 @@ CREATE TABLE public.test_files (
     analysis_id uuid NOT NULL,
     file_path character varying(1000) NOT NULL,
     framework character varying(50),
-    domain_hints jsonb
+    domain_hints jsonb,
+    detection jsonb,
+    project jsonb,
+    findings jsonb
 );
 
 
@@ -699,6 +725,14 @@ ALTER TABLE ONLY public.analyses
     ADD CONSTRAINT analyses_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: analysis_changelogs analysis_changelogs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_changelogs
+    ADD CONSTRAINT analysis_changelogs_pkey PRIMARY KEY (analysis_id);
+
+
 --
 -- Name: atlas_schema_revisions atlas_schema_revisions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1097,6 +1131,13 @@ CREATE INDEX idx_analyses_codebase_status ON public.analyses USING btree (codeba
 CREATE INDEX idx_analyses_created ON public.analyses USING btree (codebase_id, created_at);
 
 
+--
+-- Name: idx_analysis_changelogs_base_analysis; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_analysis_changelogs_base_analysis ON public.analysis_changelogs USING btree (base_analysis_id);
+
+
 --
 -- Name: idx_behavior_caches_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1111,6 +1152,13 @@ CREATE INDEX idx_behavior_caches_created_at ON public.behavior_caches USING btre
 CREATE INDEX idx_classification_caches_created_at ON public.classification_caches USING btree (created_at);
 
 
+--
+-- Name: idx_classification_caches_codebase; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_classification_caches_codebase ON public.classification_caches USING btree (codebase_id, language, model_id, created_at);
+
+
 --
 -- Name: idx_codebases_external_repo_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1497,6 +1545,30 @@ ALTER TABLE ONLY public.analyses
     ADD CONSTRAINT fk_analyses_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
 
 
+--
+-- Name: analysis_changelogs fk_analysis_changelogs_analysis; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_changelogs
+    ADD CONSTRAINT fk_analysis_changelogs_analysis FOREIGN KEY (analysis_id) REFERENCES public.analyses(id) ON DELETE CASCADE;
+
+
+--
+-- Name: analysis_changelogs fk_analysis_changelogs_base_analysis; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_changelogs
+    ADD CONSTRAINT fk_analysis_changelogs_base_analysis FOREIGN KEY (base_analysis_id) REFERENCES public.analyses(id) ON DELETE SET NULL;
+
+
+--
+-- Name: classification_caches fk_classification_caches_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.classification_caches
+    ADD CONSTRAINT fk_classification_caches_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE SET NULL;
+
+
 --
 -- Name: github_app_installations fk_github_app_installations_installer; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -28,6 +28,7 @@ type AnalyzeUseCase struct {
 	batchSize       int
 	cloneSem        *semaphore.Weighted
 	codebaseRepo    analysis.CodebaseRepository
+	configReader    analysis.ConfigReader
 	parser          analysis.Parser
 	parserVersion   string
 	repository      analysis.Repository
@@ -122,6 +123,9 @@ func NewAnalyzeUseCase(
 		vcsAPIClient:  vcsAPIClient,
 	}
 
+	if configReader, ok := parser.(analysis.ConfigReader); ok {
+		uc.configReader = configReader
+	}
 	if streamingParser, ok := parser.(analysis.StreamingParser); ok {
 		uc.streamingParser = streamingParser
 	}
@@ -193,11 +197,30 @@ func (uc *AnalyzeUseCase) Execute(ctx context.Context, req analysis.AnalyzeReque
 		}
 	}()
 
+	config, err := uc.readConfig(timeoutCtx, src)
+	if err != nil {
+		return err
+	}
+
 	if uc.canUseStreaming() {
-		return uc.executeStreaming(timeoutCtx, src, analysisID, req.UserID)
+		return uc.executeStreaming(timeoutCtx, src, analysisID, req.UserID, config)
 	}
 
-	return uc.executeBatch(timeoutCtx, src, analysisID, req)
+	return uc.executeBatch(timeoutCtx, src, analysisID, req, config)
+}
+
+// readConfig returns the repository configuration the scan will honour, so it
+// is recorded with the analysis. An invalid file fails the analysis with the
+// validation errors as the failure reason.
+func (uc *AnalyzeUseCase) readConfig(ctx context.Context, src analysis.Source) ([]byte, error) {
+	if uc.configReader == nil {
+		return nil, nil
+	}
+	config, err := uc.configReader.ReadConfig(ctx, src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	return config, nil
 }
 
 // executeBatch performs traditional batch analysis (full memory loading).
@@ -206,6 +229,7 @@ func (uc *AnalyzeUseCase) executeBatch(
 	src analysis.Source,
 	analysisID analysis.UUID,
 	req analysis.AnalyzeRequest,
+	config []byte,
 ) error {
 	inventory, err := uc.parser.Scan(ctx, src)
 	if err != nil {
@@ -223,6 +247,7 @@ func (uc *AnalyzeUseCase) executeBatch(
 
 	saveParams := analysis.SaveAnalysisInventoryParams{
 		AnalysisID:  analysisID,
+		Config:      config,
 		CommittedAt: src.CommittedAt(),
 		Inventory:   inventory,
 		UserID:      req.UserID,
@@ -473,6 +498,7 @@ func (uc *AnalyzeUseCase) executeStreaming(
 	src analysis.Source,
 	analysisID analysis.UUID,
 	userID *string,
+	config []byte,
 ) error {
 	streamingStart := time.Now()
 
@@ -557,6 +583,7 @@ func (uc *AnalyzeUseCase) executeStreaming(
 
 	finalizeParams := analysis.FinalizeAnalysisParams{
 		AnalysisID:  analysisID,
+		Config:      config,
 		CommittedAt: src.CommittedAt(),
 		TotalSuites: totalSuites,
 		TotalTests:  totalTests,
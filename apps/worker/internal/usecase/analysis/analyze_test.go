@@ -3,6 +3,7 @@ package analysis
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -96,6 +97,18 @@ func (m *mockStreamingParser) ScanStream(ctx context.Context, src analysis.Sourc
 	return ch, nil
 }
 
+type mockConfigParser struct {
+	mockStreamingParser
+	readConfigFn func(ctx context.Context, src analysis.Source) ([]byte, error)
+}
+
+func (m *mockConfigParser) ReadConfig(ctx context.Context, src analysis.Source) ([]byte, error) {
+	if m.readConfigFn != nil {
+		return m.readConfigFn(ctx, src)
+	}
+	return nil, nil
+}
+
 type mockRepository struct {
 	createAnalysisRecordFn  func(ctx context.Context, params analysis.CreateAnalysisRecordParams) (analysis.UUID, error)
 	recordFailureFn         func(ctx context.Context, analysisID analysis.UUID, errMessage string) error
@@ -1815,3 +1828,98 @@ func TestAnalyzeUseCase_StreamingOptions(t *testing.T) {
 		}
 	})
 }
+
+func TestAnalyzeUseCase_RepoConfig(t *testing.T) {
+	config := []byte(`{"version":1,"exclude":["**/fixtures/**"]}`)
+
+	t.Run("batch mode - config saved with inventory", func(t *testing.T) {
+		src := newSuccessfulSource()
+		var saved []byte
+		repo := &mockRepository{
+			saveAnalysisInventoryFn: func(ctx context.Context, params analysis.SaveAnalysisInventoryParams) error {
+				saved = params.Config
+				return nil
+			},
+		}
+		parser := &mockConfigParser{
+			readConfigFn: func(ctx context.Context, src analysis.Source) ([]byte, error) {
+				return config, nil
+			},
+		}
+
+		uc := NewAnalyzeUseCase(repo, newSuccessfulCodebaseRepository(), newSuccessfulVCS(src), newSuccessfulVCSAPIClient(), parser, nil,
+			WithParserVersion(testParserVersion),
+		)
+		if err := uc.Execute(context.Background(), newValidRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(saved) != string(config) {
+			t.Errorf("expected config %s, got %s", config, saved)
+		}
+	})
+
+	t.Run("streaming mode - config saved on finalize", func(t *testing.T) {
+		src := newSuccessfulSource()
+		var saved []byte
+		streamingRepo := &mockStreamingRepository{
+			finalizeAnalysisFn: func(ctx context.Context, params analysis.FinalizeAnalysisParams) error {
+				saved = params.Config
+				return nil
+			},
+		}
+		parser := &mockConfigParser{
+			readConfigFn: func(ctx context.Context, src analysis.Source) ([]byte, error) {
+				return config, nil
+			},
+		}
+
+		uc := NewAnalyzeUseCase(streamingRepo, newSuccessfulCodebaseRepository(), newSuccessfulVCS(src), newSuccessfulVCSAPIClient(), parser, nil,
+			WithParserVersion(testParserVersion),
+		)
+		if err := uc.Execute(context.Background(), newValidRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(saved) != string(config) {
+			t.Errorf("expected config %s, got %s", config, saved)
+		}
+	})
+
+	t.Run("invalid config - failure recorded without scanning", func(t *testing.T) {
+		src := newSuccessfulSource()
+		var failure string
+		scanned := false
+		repo := &mockRepository{
+			recordFailureFn: func(ctx context.Context, analysisID analysis.UUID, errMessage string) error {
+				failure = errMessage
+				return nil
+			},
+		}
+		parser := &mockConfigParser{
+			mockStreamingParser: mockStreamingParser{
+				mockParser: mockParser{
+					scanFn: func(ctx context.Context, src analysis.Source) (*analysis.Inventory, error) {
+						scanned = true
+						return &analysis.Inventory{}, nil
+					},
+				},
+			},
+			readConfigFn: func(ctx context.Context, src analysis.Source) ([]byte, error) {
+				return nil, errors.New(".specvital.yml: version: unsupported version 2 (current version is 1)")
+			},
+		}
+
+		uc := NewAnalyzeUseCase(repo, newSuccessfulCodebaseRepository(), newSuccessfulVCS(src), newSuccessfulVCSAPIClient(), parser, nil,
+			WithParserVersion(testParserVersion),
+		)
+		err := uc.Execute(context.Background(), newValidRequest())
+		if !errors.Is(err, ErrInvalidConfig) {
+			t.Fatalf("expected ErrInvalidConfig, got %v", err)
+		}
+		if scanned {
+			t.Error("scan should not run with an invalid config")
+		}
+		if !strings.Contains(failure, "unsupported version 2") {
+			t.Errorf("expected validation error as failure reason, got %q", failure)
+		}
+	})
+}
@@ -6,6 +6,7 @@ var (
 	ErrCloneFailed              = errors.New("clone failed")
 	ErrCodebaseResolutionFailed = errors.New("codebase resolution failed")
 	ErrHeadCommitFailed         = errors.New("head commit lookup failed")
+	ErrInvalidConfig            = errors.New("invalid repository configuration")
 	ErrRaceConditionDetected    = errors.New("race condition detected: repository state changed during analysis")
 	ErrSaveFailed               = errors.New("save failed")
 	ErrScanFailed               = errors.New("scan failed")
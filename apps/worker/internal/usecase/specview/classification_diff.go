@@ -10,6 +10,9 @@ import (
 // Returns:
 // - NewTests: tests in current files that are not in cache (need AI placement)
 // - DeletedTests: tests in cache that are no longer in current files (need index removal)
+// - RenamedTests: tests whose key changed but whose fingerprint pairs them with a cached test
+// (keep their cached placement)
+// - KeptTests: tests found under their cached key (keep their cached placement)
 func CalculateTestDiff(
 	cachedIndexMap map[string]specview.TestIdentity,
 	currentFiles []specview.FileInfo,
@@ -20,33 +23,154 @@ func CalculateTestDiff(
 
 	currentKeySet := buildCurrentKeySet(currentFiles)
 
-	var newTests []specview.TestInfo
-	var deletedTests []specview.TestIdentity
+	var addedTests []specview.TestInfo
+	var keptTests []specview.RenamedTest
+	var removedTests []specview.TestIdentity
 
-	// Find new tests: in current but not in cache
+	// Find added tests: in current but not in cache
 	for _, file := range currentFiles {
 		for _, test := range file.Tests {
 			key := specview.TestKey(file.Path, test.SuitePath, test.Name)
-			if _, exists := cachedIndexMap[key]; !exists {
-				newTests = append(newTests, test)
+			identity, exists := cachedIndexMap[key]
+			if !exists {
+				addedTests = append(addedTests, test)
+				continue
 			}
+			keptTests = append(keptTests, specview.RenamedTest{Cached: identity, Current: test})
 		}
 	}
 
-	// Find deleted tests: in cache but not in current
+	// Find removed tests: in cache but not in current
 	for key, identity := range cachedIndexMap {
 		if _, exists := currentKeySet[key]; !exists {
-			deletedTests = append(deletedTests, identity)
+			removedTests = append(removedTests, identity)
 		}
 	}
 
-	// Sort deleted tests for deterministic output
-	sortDeletedTests(deletedTests)
+	// Sort removed tests for deterministic output
+	sortDeletedTests(removedTests)
+
+	renamedTests, newTests, deletedTests := matchRenamedTests(addedTests, removedTests)
 
 	return specview.TestDiff{
 		DeletedTests: deletedTests,
+		KeptTests:    keptTests,
 		NewTests:     newTests,
+		RenamedTests: renamedTests,
+	}
+}
+
+// SuggestedDomainsChanged reports whether a cache saved under another file
+// signature disagrees with the suggested domains of the current files: a kept
+// or renamed test's file now suggests another domain, or a new test's file
+// suggests one. Such a cache cannot be reused, as its placements ignore them.
+func SuggestedDomainsChanged(diff specview.TestDiff, currentFiles []specview.FileInfo) bool {
+	suggestedByIndex := make(map[int]string)
+	for _, file := range currentFiles {
+		for _, test := range file.Tests {
+			suggestedByIndex[test.Index] = file.SuggestedDomain
+		}
+	}
+
+	for _, pairs := range [][]specview.RenamedTest{diff.KeptTests, diff.RenamedTests} {
+		for _, pair := range pairs {
+			if pair.Cached.SuggestedDomain != suggestedByIndex[pair.Current.Index] {
+				return true
+			}
+		}
+	}
+	for _, test := range diff.NewTests {
+		if suggestedByIndex[test.Index] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchRenamedTests pairs added and removed tests sharing a fingerprint.
+// Only fingerprints occurring once on each side are paired: tests with
+// identical bodies cannot be told apart and stay additions and deletions.
+// Returns the pairs and the remaining added and removed tests, in input order.
+func matchRenamedTests(
+	added []specview.TestInfo,
+	removed []specview.TestIdentity,
+) ([]specview.RenamedTest, []specview.TestInfo, []specview.TestIdentity) {
+	addedCount := make(map[string]int)
+	for _, test := range added {
+		if test.Fingerprint != "" {
+			addedCount[test.Fingerprint]++
+		}
+	}
+	removedByFingerprint := make(map[string]specview.TestIdentity)
+	removedCount := make(map[string]int)
+	for _, identity := range removed {
+		if identity.Fingerprint != "" {
+			removedByFingerprint[identity.Fingerprint] = identity
+			removedCount[identity.Fingerprint]++
+		}
+	}
+
+	var renamed []specview.RenamedTest
+	var newTests []specview.TestInfo
+	matched := make(map[string]bool)
+	for _, test := range added {
+		fp := test.Fingerprint
+		if fp != "" && addedCount[fp] == 1 && removedCount[fp] == 1 {
+			renamed = append(renamed, specview.RenamedTest{
+				Cached:  removedByFingerprint[fp],
+				Current: test,
+			})
+			matched[fp] = true
+			continue
+		}
+		newTests = append(newTests, test)
+	}
+
+	var deleted []specview.TestIdentity
+	for _, identity := range removed {
+		if !matched[identity.Fingerprint] {
+			deleted = append(deleted, identity)
+		}
+	}
+
+	return renamed, newTests, deleted
+}
+
+// ApplyRenamedTests points the cached positions of renamed (or kept) tests at
+// their current test index. Returns a new Phase1Output; positions are unchanged,
+// so deleted test identities still apply to the result.
+func ApplyRenamedTests(
+	output *specview.Phase1Output,
+	renamedTests []specview.RenamedTest,
+) *specview.Phase1Output {
+	if output == nil || len(renamedTests) == 0 {
+		return output
+	}
+
+	updated := &specview.Phase1Output{
+		Domains: make([]specview.DomainGroup, len(output.Domains)),
+	}
+	for di, domain := range output.Domains {
+		domain.Features = append([]specview.FeatureGroup(nil), domain.Features...)
+		for fi := range domain.Features {
+			domain.Features[fi].TestIndices = append([]int(nil), domain.Features[fi].TestIndices...)
+		}
+		updated.Domains[di] = domain
 	}
+
+	for _, r := range renamedTests {
+		pos := r.Cached
+		if pos.DomainIndex >= len(updated.Domains) {
+			continue
+		}
+		features := updated.Domains[pos.DomainIndex].Features
+		if pos.FeatureIndex >= len(features) || pos.TestIndex >= len(features[pos.FeatureIndex].TestIndices) {
+			continue
+		}
+		features[pos.FeatureIndex].TestIndices[pos.TestIndex] = r.Current.Index
+	}
+
+	return updated
 }
 
 // sortDeletedTests sorts by (DomainIndex, FeatureIndex, TestIndex) for deterministic order.
@@ -247,6 +247,180 @@ func TestCalculateTestDiff(t *testing.T) {
 			t.Errorf("expected 2 deleted tests, got %d", len(diff.DeletedTests))
 		}
 	})
+
+	t.Run("renamed test with matching fingerprint keeps its placement", func(t *testing.T) {
+		files := []specview.FileInfo{
+			{
+				Path: "test/auth_test.go",
+				Tests: []specview.TestInfo{
+					{Index: 0, Name: "TestLogin", SuitePath: "AuthSuite", Fingerprint: "fp-login"},
+					{Index: 1, Name: "TestSignOut", SuitePath: "AuthSuite", Fingerprint: "fp-logout"},
+				},
+			},
+		}
+		cachedMap := map[string]specview.TestIdentity{
+			specview.TestKey("test/auth_test.go", "AuthSuite", "TestLogin"):  {DomainIndex: 0, FeatureIndex: 0, TestIndex: 0, Fingerprint: "fp-login"},
+			specview.TestKey("test/auth_test.go", "AuthSuite", "TestLogout"): {DomainIndex: 0, FeatureIndex: 1, TestIndex: 0, Fingerprint: "fp-logout"},
+		}
+
+		diff := CalculateTestDiff(cachedMap, files)
+
+		if len(diff.NewTests) != 0 {
+			t.Errorf("expected 0 new tests, got %d", len(diff.NewTests))
+		}
+		if len(diff.DeletedTests) != 0 {
+			t.Errorf("expected 0 deleted tests, got %d", len(diff.DeletedTests))
+		}
+		if len(diff.RenamedTests) != 1 {
+			t.Fatalf("expected 1 renamed test, got %d", len(diff.RenamedTests))
+		}
+		renamed := diff.RenamedTests[0]
+		if renamed.Current.Name != "TestSignOut" || renamed.Cached.FeatureIndex != 1 {
+			t.Errorf("expected TestSignOut paired with feature 1, got %q paired with feature %d",
+				renamed.Current.Name, renamed.Cached.FeatureIndex)
+		}
+	})
+
+	t.Run("shared fingerprints are not paired", func(t *testing.T) {
+		files := []specview.FileInfo{
+			{
+				Path: "test/auth_test.go",
+				Tests: []specview.TestInfo{
+					{Index: 0, Name: "TestA2", Fingerprint: "fp-empty"},
+					{Index: 1, Name: "TestB2", Fingerprint: "fp-empty"},
+				},
+			},
+		}
+		cachedMap := map[string]specview.TestIdentity{
+			specview.TestKey("test/auth_test.go", "", "TestA"): {DomainIndex: 0, FeatureIndex: 0, TestIndex: 0, Fingerprint: "fp-empty"},
+		}
+
+		diff := CalculateTestDiff(cachedMap, files)
+
+		if len(diff.RenamedTests) != 0 {
+			t.Errorf("expected 0 renamed tests, got %d", len(diff.RenamedTests))
+		}
+		if len(diff.NewTests) != 2 {
+			t.Errorf("expected 2 new tests, got %d", len(diff.NewTests))
+		}
+		if len(diff.DeletedTests) != 1 {
+			t.Errorf("expected 1 deleted test, got %d", len(diff.DeletedTests))
+		}
+	})
+
+	t.Run("kept test is paired with its current index", func(t *testing.T) {
+		files := []specview.FileInfo{
+			{
+				Path:  "test/auth_test.go",
+				Tests: []specview.TestInfo{{Index: 4, Name: "TestLogin"}},
+			},
+		}
+		cachedMap := map[string]specview.TestIdentity{
+			specview.TestKey("test/auth_test.go", "", "TestLogin"): {DomainIndex: 1, FeatureIndex: 0, TestIndex: 2},
+		}
+
+		diff := CalculateTestDiff(cachedMap, files)
+
+		if len(diff.KeptTests) != 1 {
+			t.Fatalf("expected 1 kept test, got %d", len(diff.KeptTests))
+		}
+		kept := diff.KeptTests[0]
+		if kept.Current.Index != 4 || kept.Cached.DomainIndex != 1 || kept.Cached.TestIndex != 2 {
+			t.Errorf("expected index 4 paired with domain 1 position 2, got %d paired with domain %d position %d",
+				kept.Current.Index, kept.Cached.DomainIndex, kept.Cached.TestIndex)
+		}
+	})
+}
+
+func TestSuggestedDomainsChanged(t *testing.T) {
+	files := []specview.FileInfo{
+		{
+			Path:            "test/invoice_test.go",
+			SuggestedDomain: "Billing",
+			Tests:           []specview.TestInfo{{Index: 0, Name: "TestInvoice"}},
+		},
+		{
+			Path:  "test/auth_test.go",
+			Tests: []specview.TestInfo{{Index: 1, Name: "TestLogin"}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		diff specview.TestDiff
+		want bool
+	}{
+		{
+			name: "same suggestion",
+			diff: specview.TestDiff{KeptTests: []specview.RenamedTest{
+				{Cached: specview.TestIdentity{SuggestedDomain: "Billing"}, Current: files[0].Tests[0]},
+			}},
+			want: false,
+		},
+		{
+			name: "kept test suggestion changed",
+			diff: specview.TestDiff{KeptTests: []specview.RenamedTest{
+				{Cached: specview.TestIdentity{SuggestedDomain: "Payments"}, Current: files[0].Tests[0]},
+			}},
+			want: true,
+		},
+		{
+			name: "renamed test gained a suggestion",
+			diff: specview.TestDiff{RenamedTests: []specview.RenamedTest{
+				{Cached: specview.TestIdentity{}, Current: files[0].Tests[0]},
+			}},
+			want: true,
+		},
+		{
+			name: "new test without suggestion",
+			diff: specview.TestDiff{NewTests: []specview.TestInfo{files[1].Tests[0]}},
+			want: false,
+		},
+		{
+			name: "new test with suggestion",
+			diff: specview.TestDiff{NewTests: []specview.TestInfo{files[0].Tests[0]}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuggestedDomainsChanged(tt.diff, files); got != tt.want {
+				t.Errorf("SuggestedDomainsChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRenamedTests(t *testing.T) {
+	output := &specview.Phase1Output{
+		Domains: []specview.DomainGroup{
+			{
+				Name: "Auth",
+				Features: []specview.FeatureGroup{
+					{Name: "Login", TestIndices: []int{0, 1}},
+				},
+			},
+		},
+	}
+	renamed := []specview.RenamedTest{
+		{
+			Cached:  specview.TestIdentity{DomainIndex: 0, FeatureIndex: 0, TestIndex: 1},
+			Current: specview.TestInfo{Index: 5, Name: "TestSignIn"},
+		},
+	}
+
+	result := ApplyRenamedTests(output, renamed)
+
+	if got := result.Domains[0].Features[0].TestIndices; len(got) != 2 || got[0] != 0 || got[1] != 5 {
+		t.Errorf("expected test indices [0 5], got %v", got)
+	}
+	if got := output.Domains[0].Features[0].TestIndices[1]; got != 1 {
+		t.Errorf("expected input to be left unchanged, got index %d", got)
+	}
+	if ApplyRenamedTests(output, nil) != output {
+		t.Error("expected output to be returned as is without renamed tests")
+	}
 }
 
 func TestRemoveDeletedTestIndices(t *testing.T) {
@@ -162,6 +162,7 @@ func (uc *GenerateSpecViewUseCase) Execute(
 		uc.logExecutionError(ctx, req.AnalysisID, "load_data", startTime, err)
 		return nil, err
 	}
+	files = analysisCtx.ViewHints.Apply(files)
 
 	if len(files) == 0 {
 		slog.WarnContext(ctx, "no test files found",
@@ -362,7 +363,9 @@ func (uc *GenerateSpecViewUseCase) executePhase1(
 // executePhase1WithCache performs Phase 1 classification with incremental caching.
 // Cache flow:
 // - forceRegenerate=true: full classification, save cache
-// - cache miss: full classification, save cache
+// - cache miss: fall back to the codebase's latest classification, since a
+// file rename or move changes the file signature
+// - fallback miss: full classification, save cache
 // - cache hit + no changes: return cached output (zero token usage)
 // - cache hit + deletions only: remove indices, update cache
 // - cache hit + additions: call placement AI, fallback to Uncategorized on failure
@@ -395,23 +398,41 @@ func (uc *GenerateSpecViewUseCase) executePhase1WithCache(
 	}
 
 	// Cache miss
+	signatureChanged := false
 	if cache == nil {
-		slog.InfoContext(ctx, "classification cache miss",
-			"analysis_id", analysisID,
-		)
-		return uc.executePhase1AndSaveCache(ctx, files, lang, modelID, analysisID, fileSignature)
+		cache = uc.findCodebaseClassificationCache(ctx, files, lang, modelID, analysisID)
+		if cache == nil {
+			slog.InfoContext(ctx, "classification cache miss",
+				"analysis_id", analysisID,
+			)
+			return uc.executePhase1AndSaveCache(ctx, files, lang, modelID, analysisID, fileSignature)
+		}
+		// Save under the current signature, keeping the fallback for other analyses
+		cache.FileSignature = fileSignature
+		signatureChanged = true
 	}
+	cache.AnalysisID = analysisID
 
 	// Cache hit - calculate diff
 	diff := CalculateTestDiff(cache.TestIndexMap, files)
 
+	// Kept and renamed tests keep their cached placement under their current
+	// index, which shifts when tests before them are added, removed or moved
+	cachedOutput := ApplyRenamedTests(cache.ClassificationResult, diff.KeptTests)
+	cachedOutput = ApplyRenamedTests(cachedOutput, diff.RenamedTests)
+
 	// No changes
 	if len(diff.NewTests) == 0 && len(diff.DeletedTests) == 0 {
 		slog.InfoContext(ctx, "classification cache hit (no changes)",
 			"analysis_id", analysisID,
 			"domain_count", len(cache.ClassificationResult.Domains),
+			"renamed_count", len(diff.RenamedTests),
+			"signature_changed", signatureChanged,
 		)
-		return cache.ClassificationResult, &specview.TokenUsage{}, nil
+		if len(diff.RenamedTests) > 0 || signatureChanged {
+			uc.updateClassificationCache(ctx, cache, cachedOutput, files)
+		}
+		return cachedOutput, &specview.TokenUsage{}, nil
 	}
 
 	// Deletions only
@@ -419,8 +440,9 @@ func (uc *GenerateSpecViewUseCase) executePhase1WithCache(
 		slog.InfoContext(ctx, "classification cache hit (deletions only)",
 			"analysis_id", analysisID,
 			"deleted_count", len(diff.DeletedTests),
+			"renamed_count", len(diff.RenamedTests),
 		)
-		updatedOutput := RemoveDeletedTestIndices(cache.ClassificationResult, diff.DeletedTests)
+		updatedOutput := RemoveDeletedTestIndices(cachedOutput, diff.DeletedTests)
 		uc.updateClassificationCache(ctx, cache, updatedOutput, files)
 		return updatedOutput, &specview.TokenUsage{}, nil
 	}
@@ -430,12 +452,13 @@ func (uc *GenerateSpecViewUseCase) executePhase1WithCache(
 		"analysis_id", analysisID,
 		"new_count", len(diff.NewTests),
 		"deleted_count", len(diff.DeletedTests),
+		"renamed_count", len(diff.RenamedTests),
 	)
 
 	// First apply deletions if any
-	baseOutput := cache.ClassificationResult
+	baseOutput := cachedOutput
 	if len(diff.DeletedTests) > 0 {
-		baseOutput = RemoveDeletedTestIndices(cache.ClassificationResult, diff.DeletedTests)
+		baseOutput = RemoveDeletedTestIndices(cachedOutput, diff.DeletedTests)
 	}
 
 	// Call placement AI for new tests
@@ -470,6 +493,43 @@ func (uc *GenerateSpecViewUseCase) executePhase1WithCache(
 	return updatedOutput, usage, nil
 }
 
+// findCodebaseClassificationCache looks up the codebase's latest classification
+// when the file signature misses. Returns nil if there is none, the lookup
+// fails, or the suggested domains of the files changed since it was saved.
+func (uc *GenerateSpecViewUseCase) findCodebaseClassificationCache(
+	ctx context.Context,
+	files []specview.FileInfo,
+	lang specview.Language,
+	modelID string,
+	analysisID string,
+) *specview.ClassificationCache {
+	cache, err := uc.repository.FindLatestClassificationCache(ctx, analysisID, lang, modelID)
+	if err != nil {
+		slog.WarnContext(ctx, "codebase classification cache lookup failed, proceeding without cache",
+			"analysis_id", analysisID,
+			"error", err,
+		)
+		return nil
+	}
+	if cache == nil {
+		return nil
+	}
+
+	if SuggestedDomainsChanged(CalculateTestDiff(cache.TestIndexMap, files), files) {
+		slog.InfoContext(ctx, "codebase classification cache skipped (suggested domains changed)",
+			"analysis_id", analysisID,
+			"cache_id", cache.ID,
+		)
+		return nil
+	}
+
+	slog.InfoContext(ctx, "classification cache hit (codebase fallback)",
+		"analysis_id", analysisID,
+		"cache_id", cache.ID,
+	)
+	return cache
+}
+
 // executePhase1AndSaveCache runs full Phase 1 classification and saves the result to cache.
 func (uc *GenerateSpecViewUseCase) executePhase1AndSaveCache(
 	ctx context.Context,
@@ -486,6 +546,7 @@ func (uc *GenerateSpecViewUseCase) executePhase1AndSaveCache(
 
 	// Build and save cache (non-blocking on error)
 	cache := &specview.ClassificationCache{
+		AnalysisID:           analysisID,
 		ClassificationResult: output,
 		FileSignature:        fileSignature,
 		Language:             lang,
@@ -568,7 +629,7 @@ func (uc *GenerateSpecViewUseCase) executePhase2(
 
 	// Lookup behavior cache (skip if forceRegenerate)
 	var cachedBehaviors map[string]string
-	var testHashMap map[int]string
+	var testHashMap map[int]behaviorCacheHashes
 	cacheStats := &internalCacheStats{totalTests: totalTests}
 
 	if !forceRegenerate {
@@ -586,7 +647,7 @@ func (uc *GenerateSpecViewUseCase) executePhase2(
 				"error", err,
 			)
 			cachedBehaviors = make(map[string]string)
-			testHashMap = make(map[int]string)
+			testHashMap = make(map[int]behaviorCacheHashes)
 		}
 		cacheStats.cacheHits = len(cachedBehaviors)
 		cacheStats.cacheMisses = totalTests - cacheStats.cacheHits
@@ -732,8 +793,18 @@ func buildTestFilePathMap(files []specview.FileInfo) map[int]string {
 	return m
 }
 
+// behaviorCacheHashes are the hex-encoded behavior cache key hashes of a test.
+type behaviorCacheHashes struct {
+	// fingerprint keys the behavior by test body; empty unless the test's
+	// fingerprint is unique within the analysis.
+	fingerprint string
+	key         string
+}
+
 // lookupBehaviorCache looks up cached behaviors for all tests in phase 1 output.
-// Returns (cachedBehaviors map[hexHash]description, testHashMap map[testIndex]hexHash, error).
+// A test missing under its key uses the behavior cached under its fingerprint,
+// so renamed tests keep their behavior.
+// Returns (cachedBehaviors map[keyHash]description, testHashMap map[testIndex]hashes, error).
 func (uc *GenerateSpecViewUseCase) lookupBehaviorCache(
 	ctx context.Context,
 	phase1Output *specview.Phase1Output,
@@ -741,20 +812,25 @@ func (uc *GenerateSpecViewUseCase) lookupBehaviorCache(
 	testFilePathMap map[int]string,
 	lang specview.Language,
 	modelID string,
-) (map[string]string, map[int]string, error) {
+) (map[string]string, map[int]behaviorCacheHashes, error) {
 	testHashMap := uc.buildTestHashMap(phase1Output, testIndexMap, testFilePathMap, lang, modelID)
 
 	// Collect all hashes for batch lookup
 	var allHashes [][]byte
 	hexToHash := make(map[string][]byte)
-	for _, hexHash := range testHashMap {
-		if _, exists := hexToHash[hexHash]; !exists {
-			hash, err := hex.DecodeString(hexHash)
-			if err != nil {
-				continue // skip invalid hex (should not happen as we generate them)
+	for _, hashes := range testHashMap {
+		for _, hexHash := range []string{hashes.key, hashes.fingerprint} {
+			if hexHash == "" {
+				continue
+			}
+			if _, exists := hexToHash[hexHash]; !exists {
+				hash, err := hex.DecodeString(hexHash)
+				if err != nil {
+					continue // skip invalid hex (should not happen as we generate them)
+				}
+				allHashes = append(allHashes, hash)
+				hexToHash[hexHash] = hash
 			}
-			allHashes = append(allHashes, hash)
-			hexToHash[hexHash] = hash
 		}
 	}
 
@@ -763,11 +839,21 @@ func (uc *GenerateSpecViewUseCase) lookupBehaviorCache(
 	}
 
 	// Batch lookup from repository
-	cachedBehaviors, err := uc.repository.FindCachedBehaviors(ctx, allHashes)
+	found, err := uc.repository.FindCachedBehaviors(ctx, allHashes)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Resolve every test to its key hash, falling back to its fingerprint
+	cachedBehaviors := make(map[string]string, len(found))
+	for _, hashes := range testHashMap {
+		if desc, ok := found[hashes.key]; ok {
+			cachedBehaviors[hashes.key] = desc
+		} else if desc, ok := found[hashes.fingerprint]; ok && hashes.fingerprint != "" {
+			cachedBehaviors[hashes.key] = desc
+		}
+	}
+
 	return cachedBehaviors, testHashMap, nil
 }
 
@@ -778,7 +864,7 @@ func (uc *GenerateSpecViewUseCase) buildTestHashMap(
 	testFilePathMap map[int]string,
 	lang specview.Language,
 	modelID string,
-) map[int]string {
+) map[int]behaviorCacheHashes {
 	// Pre-calculate total tests for efficient map allocation
 	totalTests := 0
 	for _, domain := range phase1Output.Domains {
@@ -786,7 +872,20 @@ func (uc *GenerateSpecViewUseCase) buildTestHashMap(
 			totalTests += len(feature.TestIndices)
 		}
 	}
-	result := make(map[int]string, totalTests)
+	result := make(map[int]behaviorCacheHashes, totalTests)
+
+	// Tests with identical bodies cannot be told apart by fingerprint, so
+	// like matchRenamedTests only fingerprints unique in the repository are used
+	fingerprintCount := make(map[string]int)
+	for _, domain := range phase1Output.Domains {
+		for _, feature := range domain.Features {
+			for _, testIdx := range feature.TestIndices {
+				if testInfo, ok := testIndexMap[testIdx]; ok && testInfo.Fingerprint != "" {
+					fingerprintCount[testInfo.Fingerprint]++
+				}
+			}
+		}
+	}
 
 	for _, domain := range phase1Output.Domains {
 		for _, feature := range domain.Features {
@@ -804,8 +903,14 @@ func (uc *GenerateSpecViewUseCase) buildTestHashMap(
 					SuitePath: testInfo.SuitePath,
 					TestName:  testInfo.Name,
 				}
-				hash := specview.GenerateCacheKeyHash(key)
-				result[testIdx] = hex.EncodeToString(hash)
+				hashes := behaviorCacheHashes{
+					key: hex.EncodeToString(specview.GenerateCacheKeyHash(key)),
+				}
+				if fingerprintCount[testInfo.Fingerprint] == 1 {
+					key.Fingerprint = testInfo.Fingerprint
+					hashes.fingerprint = hex.EncodeToString(specview.GenerateFingerprintCacheKeyHash(key))
+				}
+				result[testIdx] = hashes
 			}
 		}
 	}
@@ -896,7 +1001,7 @@ func (uc *GenerateSpecViewUseCase) convertFeatureWithCache(
 	task featureTask,
 	lang specview.Language,
 	testIndexMap map[int]specview.TestInfo,
-	testHashMap map[int]string,
+	testHashMap map[int]behaviorCacheHashes,
 	cachedBehaviors map[string]string,
 ) ([]specview.BehaviorSpec, *specview.TokenUsage, int, []specview.BehaviorCacheEntry) {
 	featureCtx, cancel := context.WithTimeout(ctx, DefaultPhase2FeatureTimeout)
@@ -912,9 +1017,9 @@ func (uc *GenerateSpecViewUseCase) convertFeatureWithCache(
 			continue
 		}
 
-		hexHash, hasHash := testHashMap[idx]
+		hashes, hasHash := testHashMap[idx]
 		if hasHash {
-			if cachedDesc, isCached := cachedBehaviors[hexHash]; isCached {
+			if cachedDesc, isCached := cachedBehaviors[hashes.key]; isCached {
 				// Use cached result
 				cachedResults = append(cachedResults, specview.BehaviorSpec{
 					Confidence:  1.0, // cached results are trusted
@@ -957,10 +1062,18 @@ func (uc *GenerateSpecViewUseCase) convertFeatureWithCache(
 		return allBehaviors, nil, 1, nil
 	}
 
-	// Prepare cache entries to save for successful AI conversions
+	// Prepare cache entries to save for successful AI conversions,
+	// under the test key and, when usable, the test fingerprint
 	var newCacheEntries []specview.BehaviorCacheEntry
 	for _, behavior := range output.Behaviors {
-		if hexHash, ok := testHashMap[behavior.TestIndex]; ok {
+		hashes, ok := testHashMap[behavior.TestIndex]
+		if !ok {
+			continue
+		}
+		for _, hexHash := range []string{hashes.key, hashes.fingerprint} {
+			if hexHash == "" {
+				continue
+			}
 			hash, err := hex.DecodeString(hexHash)
 			if err != nil {
 				continue // skip invalid hex (should not happen as we generate them)
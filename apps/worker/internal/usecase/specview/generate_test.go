@@ -18,6 +18,7 @@ type mockRepository struct {
 	findCachedBehaviorsFn       func(ctx context.Context, cacheKeyHashes [][]byte) (map[string]string, error)
 	findClassificationCacheFn   func(ctx context.Context, fileSignature []byte, language specview.Language, modelID string) (*specview.ClassificationCache, error)
 	findDocumentByContentHashFn func(ctx context.Context, userID string, contentHash []byte, language specview.Language, modelID string) (*specview.SpecDocument, error)
+	findLatestClassificationFn  func(ctx context.Context, analysisID string, language specview.Language, modelID string) (*specview.ClassificationCache, error)
 	getAnalysisContextFn        func(ctx context.Context, analysisID string) (*specview.AnalysisContext, error)
 	getTestDataByAnalysisIDFn   func(ctx context.Context, analysisID string) ([]specview.FileInfo, error)
 	recordUsageEventFn          func(ctx context.Context, userID string, documentID string, quotaAmount int) error
@@ -90,6 +91,13 @@ func (m *mockRepository) FindClassificationCache(ctx context.Context, fileSignat
 	return nil, nil
 }
 
+func (m *mockRepository) FindLatestClassificationCache(ctx context.Context, analysisID string, language specview.Language, modelID string) (*specview.ClassificationCache, error) {
+	if m.findLatestClassificationFn != nil {
+		return m.findLatestClassificationFn(ctx, analysisID, language, modelID)
+	}
+	return nil, nil
+}
+
 func (m *mockRepository) SaveClassificationCache(ctx context.Context, cache *specview.ClassificationCache) error {
 	if m.saveClassificationCacheFn != nil {
 		return m.saveClassificationCacheFn(ctx, cache)
@@ -751,6 +759,137 @@ func TestBuildTestIndexMap(t *testing.T) {
 	}
 }
 
+func TestExecutePhase1WithCache_FileRename(t *testing.T) {
+	const analysisID = "550e8400-e29b-41d4-a716-446655440000"
+
+	// Before the rename, test/account_test.go sorted first, so its tests took
+	// indices 0-1 and the auth tests 2-3.
+	previousFiles := []specview.FileInfo{
+		{
+			Path: "test/account_test.go",
+			Tests: []specview.TestInfo{
+				{Index: 0, Name: "TestCreateUser", Fingerprint: "fp-create"},
+				{Index: 1, Name: "TestDeleteUser", Fingerprint: "fp-delete"},
+			},
+		},
+		{
+			Path: "test/auth_test.go",
+			Tests: []specview.TestInfo{
+				{Index: 2, Name: "TestLogin", Fingerprint: "fp-login"},
+				{Index: 3, Name: "TestLogout", Fingerprint: "fp-logout"},
+			},
+		},
+	}
+	previousOutput := newPhase1Output()
+	previousOutput.Domains[0].Features[0].TestIndices = []int{2}
+	previousOutput.Domains[0].Features[1].TestIndices = []int{3}
+	previousOutput.Domains[1].Features[0].TestIndices = []int{0, 1}
+
+	currentFiles := func() []specview.FileInfo {
+		files := newTestFiles()
+		fingerprints := []string{"fp-login", "fp-logout", "fp-create", "fp-delete"}
+		for i := range files {
+			for j := range files[i].Tests {
+				files[i].Tests[j].Fingerprint = fingerprints[files[i].Tests[j].Index]
+			}
+		}
+		return files
+	}
+
+	newRepository := func(saved **specview.ClassificationCache) *mockRepository {
+		return &mockRepository{
+			findLatestClassificationFn: func(_ context.Context, id string, _ specview.Language, _ string) (*specview.ClassificationCache, error) {
+				if id != analysisID {
+					t.Errorf("expected lookup for analysis %s, got %s", analysisID, id)
+				}
+				return &specview.ClassificationCache{
+					ClassificationResult: previousOutput,
+					FileSignature:        specview.GenerateFileSignature(previousFiles),
+					ID:                   "cache-001",
+					Language:             "Korean",
+					ModelID:              "test-model",
+					TestIndexMap:         specview.BuildTestIndexMap(previousOutput, previousFiles),
+				}, nil
+			},
+			saveClassificationCacheFn: func(_ context.Context, cache *specview.ClassificationCache) error {
+				*saved = cache
+				return nil
+			},
+		}
+	}
+
+	t.Run("file rename keeps placements from the codebase's latest classification", func(t *testing.T) {
+		var saved *specview.ClassificationCache
+		aiProvider := &mockAIProvider{
+			classifyDomainsFn: func(_ context.Context, _ specview.Phase1Input) (*specview.Phase1Output, *specview.TokenUsage, error) {
+				t.Error("expected no full classification")
+				return newPhase1Output(), nil, nil
+			},
+			placeNewTestsFn: func(_ context.Context, _ specview.PlacementInput) (*specview.PlacementOutput, *specview.TokenUsage, error) {
+				t.Error("expected no placement")
+				return &specview.PlacementOutput{}, nil, nil
+			},
+		}
+		uc := NewGenerateSpecViewUseCase(newRepository(&saved), aiProvider, "test-model")
+		files := currentFiles()
+
+		output, _, err := uc.executePhase1WithCache(context.Background(), files, "Korean", "test-model", analysisID, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := newPhase1Output()
+		for di, domain := range want.Domains {
+			for fi, feature := range domain.Features {
+				got := output.Domains[di].Features[fi].TestIndices
+				if len(got) != len(feature.TestIndices) {
+					t.Fatalf("expected %s indices %v, got %v", feature.Name, feature.TestIndices, got)
+				}
+				for i := range got {
+					if got[i] != feature.TestIndices[i] {
+						t.Errorf("expected %s indices %v, got %v", feature.Name, feature.TestIndices, got)
+					}
+				}
+			}
+		}
+
+		if saved == nil {
+			t.Fatal("expected cache to be saved under the new file signature")
+		}
+		if !bytes.Equal(saved.FileSignature, specview.GenerateFileSignature(files)) {
+			t.Error("expected saved cache to use the current file signature")
+		}
+		if saved.AnalysisID != analysisID {
+			t.Errorf("expected saved cache to link analysis %s, got %q", analysisID, saved.AnalysisID)
+		}
+	})
+
+	t.Run("suggested domain change falls back to full classification", func(t *testing.T) {
+		var saved *specview.ClassificationCache
+		classifyCalled := false
+		aiProvider := &mockAIProvider{
+			classifyDomainsFn: func(_ context.Context, _ specview.Phase1Input) (*specview.Phase1Output, *specview.TokenUsage, error) {
+				classifyCalled = true
+				return newPhase1Output(), &specview.TokenUsage{}, nil
+			},
+		}
+		uc := NewGenerateSpecViewUseCase(newRepository(&saved), aiProvider, "test-model")
+		files := currentFiles()
+		files[1].SuggestedDomain = "Accounts"
+
+		if _, _, err := uc.executePhase1WithCache(context.Background(), files, "Korean", "test-model", analysisID, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !classifyCalled {
+			t.Error("expected full classification when suggested domains changed")
+		}
+		if saved == nil || saved.AnalysisID != analysisID {
+			t.Error("expected new cache to be saved for the analysis")
+		}
+	})
+}
+
 func TestGenerateFallbackBehaviors(t *testing.T) {
 	uc := &GenerateSpecViewUseCase{}
 	tests := []specview.TestForConversion{
@@ -1640,6 +1779,140 @@ func TestBehaviorCacheIntegration(t *testing.T) {
 			t.Errorf("expected AI to be called with 2 uncached tests, got %d", len(aiCalledWithTests))
 		}
 	})
+
+	t.Run("renamed or moved test uses behavior cached under its fingerprint", func(t *testing.T) {
+		files := newTestFiles()
+		files[0].Tests[0].Fingerprint = "fp-login"
+		files[0].Tests[1].Fingerprint = "fp-logout"
+		phase1Output := newPhase1Output()
+
+		fingerprintHash := hex.EncodeToString(specview.GenerateFingerprintCacheKeyHash(specview.BehaviorCacheKey{
+			// Cached while the test lived in another file
+			Fingerprint: "fp-login",
+			FilePath:    "test/session_test.go",
+			Language:    "Korean",
+			ModelID:     "gemini-2.5-flash",
+		}))
+
+		var aiCalledWithTests []specview.TestForConversion
+		var savedCacheEntries []specview.BehaviorCacheEntry
+		var savedDoc *specview.SpecDocument
+		repo := &mockRepository{
+			getTestDataByAnalysisIDFn: func(ctx context.Context, analysisID string) ([]specview.FileInfo, error) {
+				return files, nil
+			},
+			findCachedBehaviorsFn: func(ctx context.Context, cacheKeyHashes [][]byte) (map[string]string, error) {
+				// Only the fingerprint of the renamed login test is cached
+				result := make(map[string]string)
+				for _, hash := range cacheKeyHashes {
+					if hex.EncodeToString(hash) == fingerprintHash {
+						result[fingerprintHash] = "User can log in"
+					}
+				}
+				return result, nil
+			},
+			saveBehaviorCacheFn: func(ctx context.Context, entries []specview.BehaviorCacheEntry) error {
+				savedCacheEntries = entries
+				return nil
+			},
+			saveDocumentFn: func(ctx context.Context, doc *specview.SpecDocument) error {
+				savedDoc = doc
+				doc.ID = "doc-001"
+				return nil
+			},
+		}
+
+		aiProvider := &mockAIProvider{
+			classifyDomainsFn: func(ctx context.Context, input specview.Phase1Input) (*specview.Phase1Output, *specview.TokenUsage, error) {
+				return phase1Output, nil, nil
+			},
+			convertTestNamesFn: func(ctx context.Context, input specview.Phase2Input) (*specview.Phase2Output, *specview.TokenUsage, error) {
+				aiCalledWithTests = append(aiCalledWithTests, input.Tests...)
+				behaviors := make([]specview.BehaviorSpec, len(input.Tests))
+				for i, test := range input.Tests {
+					behaviors[i] = specview.BehaviorSpec{
+						TestIndex:   test.Index,
+						Description: "Generated: " + test.Name,
+						Confidence:  0.9,
+					}
+				}
+				return &specview.Phase2Output{Behaviors: behaviors}, nil, nil
+			},
+		}
+
+		uc := NewGenerateSpecViewUseCase(repo, aiProvider, "gemini-2.5-flash")
+
+		if _, err := uc.Execute(context.Background(), newValidRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, test := range aiCalledWithTests {
+			if test.Name == "TestLogin" {
+				t.Error("expected TestLogin to be served from the fingerprint cache")
+			}
+		}
+		if got := savedDoc.Domains[0].Features[0].Behaviors[0].Description; got != "User can log in" {
+			t.Errorf("expected cached description, got %q", got)
+		}
+		// TestLogout is saved under its key and its fingerprint, the user tests under their keys
+		if len(savedCacheEntries) != 4 {
+			t.Errorf("expected 4 cache entries to be saved, got %d", len(savedCacheEntries))
+		}
+	})
+
+	t.Run("fingerprint shared by tests in different files is not used", func(t *testing.T) {
+		files := newTestFiles()
+		files[0].Tests[0].Fingerprint = "fp-shared"
+		files[1].Tests[0].Fingerprint = "fp-shared"
+
+		fingerprintHash := hex.EncodeToString(specview.GenerateFingerprintCacheKeyHash(specview.BehaviorCacheKey{
+			Fingerprint: "fp-shared",
+			Language:    "Korean",
+			ModelID:     "gemini-2.5-flash",
+		}))
+
+		var aiCalledWithTests []specview.TestForConversion
+		repo := &mockRepository{
+			getTestDataByAnalysisIDFn: func(ctx context.Context, analysisID string) ([]specview.FileInfo, error) {
+				return files, nil
+			},
+			findCachedBehaviorsFn: func(ctx context.Context, cacheKeyHashes [][]byte) (map[string]string, error) {
+				for _, hash := range cacheKeyHashes {
+					if hex.EncodeToString(hash) == fingerprintHash {
+						t.Error("expected a shared fingerprint not to be looked up")
+					}
+				}
+				return map[string]string{fingerprintHash: "Cached elsewhere"}, nil
+			},
+			saveDocumentFn: func(ctx context.Context, doc *specview.SpecDocument) error {
+				doc.ID = "doc-001"
+				return nil
+			},
+		}
+
+		aiProvider := &mockAIProvider{
+			classifyDomainsFn: func(ctx context.Context, input specview.Phase1Input) (*specview.Phase1Output, *specview.TokenUsage, error) {
+				return newPhase1Output(), nil, nil
+			},
+			convertTestNamesFn: func(ctx context.Context, input specview.Phase2Input) (*specview.Phase2Output, *specview.TokenUsage, error) {
+				aiCalledWithTests = append(aiCalledWithTests, input.Tests...)
+				behaviors := make([]specview.BehaviorSpec, len(input.Tests))
+				for i, test := range input.Tests {
+					behaviors[i] = specview.BehaviorSpec{TestIndex: test.Index, Description: "Generated: " + test.Name, Confidence: 0.9}
+				}
+				return &specview.Phase2Output{Behaviors: behaviors}, nil, nil
+			},
+		}
+
+		uc := NewGenerateSpecViewUseCase(repo, aiProvider, "gemini-2.5-flash")
+
+		if _, err := uc.Execute(context.Background(), newValidRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(aiCalledWithTests) != 4 {
+			t.Errorf("expected all 4 tests to be converted, got %d", len(aiCalledWithTests))
+		}
+	})
 }
 
 func TestBehaviorCacheStats(t *testing.T) {
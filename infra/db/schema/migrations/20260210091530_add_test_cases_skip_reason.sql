@@ -0,0 +1,2 @@
+-- Modify "test_cases" table
+ALTER TABLE "public"."test_cases" ADD COLUMN "skip_reason" text NULL;
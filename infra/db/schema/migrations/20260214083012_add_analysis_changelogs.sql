@@ -0,0 +1,15 @@
+-- Create "analysis_changelogs" table
+CREATE TABLE "public"."analysis_changelogs" (
+  "analysis_id" uuid NOT NULL,
+  "base_analysis_id" uuid NULL,
+  "summary" jsonb NOT NULL,
+  "frameworks" jsonb NOT NULL DEFAULT '[]',
+  "test_changes" jsonb NOT NULL DEFAULT '[]',
+  "suite_changes" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("analysis_id"),
+  CONSTRAINT "fk_analysis_changelogs_analysis" FOREIGN KEY ("analysis_id") REFERENCES "public"."analyses" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_analysis_changelogs_base_analysis" FOREIGN KEY ("base_analysis_id") REFERENCES "public"."analyses" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "idx_analysis_changelogs_base_analysis" to table: "analysis_changelogs"
+CREATE INDEX "idx_analysis_changelogs_base_analysis" ON "public"."analysis_changelogs" ("base_analysis_id");
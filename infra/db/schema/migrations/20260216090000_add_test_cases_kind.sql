@@ -0,0 +1,4 @@
+-- Create enum type "test_kind"
+CREATE TYPE "public"."test_kind" AS ENUM ('unit', 'integration', 'e2e', 'benchmark', 'fuzz', 'example');
+-- Modify "test_cases" table
+ALTER TABLE "public"."test_cases" ADD COLUMN "kind" "public"."test_kind" NOT NULL DEFAULT 'unit';
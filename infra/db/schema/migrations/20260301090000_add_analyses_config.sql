@@ -0,0 +1,2 @@
+-- Modify "analyses" table
+ALTER TABLE "public"."analyses" ADD COLUMN "config" jsonb NULL;
@@ -0,0 +1,2 @@
+-- Modify "test_files" table
+ALTER TABLE "public"."test_files" ADD COLUMN "findings" jsonb NULL;
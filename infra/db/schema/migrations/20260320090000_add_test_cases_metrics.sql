@@ -0,0 +1,2 @@
+-- Modify "test_cases" table
+ALTER TABLE "public"."test_cases" ADD COLUMN "assertion_count" integer NULL, ADD COLUMN "line_count" integer NULL, ADD COLUMN "nesting_depth" integer NULL;
@@ -0,0 +1,2 @@
+-- Modify "test_cases" table
+ALTER TABLE "public"."test_cases" ADD COLUMN "fingerprint" character varying(32) NULL;
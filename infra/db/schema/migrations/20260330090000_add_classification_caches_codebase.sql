@@ -0,0 +1,4 @@
+-- Modify "classification_caches" table
+ALTER TABLE "public"."classification_caches" ADD COLUMN "codebase_id" uuid NULL, ADD CONSTRAINT "fk_classification_caches_codebase" FOREIGN KEY ("codebase_id") REFERENCES "public"."codebases" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_classification_caches_codebase" to table: "classification_caches"
+CREATE INDEX "idx_classification_caches_codebase" ON "public"."classification_caches" ("codebase_id", "language", "model_id", "created_at");
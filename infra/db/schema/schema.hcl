@@ -14,6 +14,11 @@ enum "test_status" {
   values = ["active", "skipped", "todo", "focused", "xfail"]
 }
 
+enum "test_kind" {
+  schema = schema.public
+  values = ["unit", "integration", "e2e", "benchmark", "fuzz", "example"]
+}
+
 enum "oauth_provider" {
   schema = schema.public
   values = ["github"]
@@ -219,6 +224,11 @@ table "analyses" {
     default = "legacy"
   }
 
+  column "config" {
+    type = jsonb
+    null = true
+  }
+
   primary_key {
     columns = [column.id]
   }
@@ -270,6 +280,21 @@ table "test_files" {
     null = true
   }
 
+  column "detection" {
+    type = jsonb
+    null = true
+  }
+
+  column "project" {
+    type = jsonb
+    null = true
+  }
+
+  column "findings" {
+    type = jsonb
+    null = true
+  }
+
   primary_key {
     columns = [column.id]
   }
@@ -386,6 +411,36 @@ table "test_cases" {
     default = "[]"
   }
 
+  column "skip_reason" {
+    type = text
+    null = true
+  }
+
+  column "kind" {
+    type    = enum.test_kind
+    default = "unit"
+  }
+
+  column "assertion_count" {
+    type = integer
+    null = true
+  }
+
+  column "line_count" {
+    type = integer
+    null = true
+  }
+
+  column "nesting_depth" {
+    type = integer
+    null = true
+  }
+
+  column "fingerprint" {
+    type = varchar(32)
+    null = true
+  }
+
   primary_key {
     columns = [column.id]
   }
@@ -405,6 +460,69 @@ table "test_cases" {
   }
 }
 
+// Test changes between an analysis and the previous completed analysis of the same codebase
+table "analysis_changelogs" {
+  schema = schema.public
+
+  column "analysis_id" {
+    type = uuid
+  }
+
+  // NULL for the first analysis of a codebase or once the base analysis is deleted
+  column "base_analysis_id" {
+    type = uuid
+    null = true
+  }
+
+  // Test change counts: {added, removed, renamed, moved, statusChanged, baseTests, headTests}
+  column "summary" {
+    type = jsonb
+  }
+
+  // Per-framework test change counts
+  column "frameworks" {
+    type    = jsonb
+    default = "[]"
+  }
+
+  // Changed tests: [{kind, base, head}]
+  column "test_changes" {
+    type    = jsonb
+    default = "[]"
+  }
+
+  // Changed suites: [{kind, base, head}]
+  column "suite_changes" {
+    type    = jsonb
+    default = "[]"
+  }
+
+  column "created_at" {
+    type    = timestamptz
+    default = sql("now()")
+  }
+
+  primary_key {
+    columns = [column.analysis_id]
+  }
+
+  foreign_key "fk_analysis_changelogs_analysis" {
+    columns     = [column.analysis_id]
+    ref_columns = [table.analyses.column.id]
+    on_delete   = CASCADE
+  }
+
+  foreign_key "fk_analysis_changelogs_base_analysis" {
+    columns     = [column.base_analysis_id]
+    ref_columns = [table.analyses.column.id]
+    on_delete   = SET_NULL
+  }
+
+  index "idx_analysis_changelogs_base_analysis" {
+    columns = [column.base_analysis_id]
+  }
+}
+
 // ==============================================================================
 // Auth Tables
 // ==============================================================================
@@ -1632,10 +1750,23 @@ table "classification_caches" {
     default = sql("now()")
   }
 
+  // Codebase of the analysis the cache was last saved for. A file rename
+  // changes content_hash, so the codebase's latest cache is the fallback.
+  column "codebase_id" {
+    type = uuid
+    null = true
+  }
+
   primary_key {
     columns = [column.id]
   }
 
+  foreign_key "fk_classification_caches_codebase" {
+    columns     = [column.codebase_id]
+    ref_columns = [table.codebases.column.id]
+    on_delete   = SET_NULL
+  }
+
   unique "uq_classification_caches_key" {
     columns = [column.content_hash, column.language, column.model_id]
   }
@@ -1643,6 +1774,10 @@ table "classification_caches" {
   index "idx_classification_caches_created_at" {
     columns = [column.created_at]
   }
+
+  index "idx_classification_caches_codebase" {
+    columns = [column.codebase_id, column.language, column.model_id, column.created_at]
+  }
 }
 
 table "user_github_org_memberships" {
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/kubrickcode/specvital/lib/parser/diff"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// diffOutput is the JSON document written by "specvital diff -format json".
+type diffOutput struct {
+	Added         []testRow               `json:"added"`
+	Frameworks    []diff.FrameworkSummary `json:"frameworks"`
+	Moved         []testChange            `json:"moved"`
+	Removed       []testRow               `json:"removed"`
+	Renamed       []testChange            `json:"renamed"`
+	StatusChanged []statusChange          `json:"statusChanged"`
+	Suites        []diff.Change           `json:"suites"`
+	Summary       diff.Summary            `json:"summary"`
+}
+
+type statusChange struct {
+	From domain.TestStatus `json:"from"`
+	Test testRow           `json:"test"`
+}
+
+// testChange is a test that was renamed or moved, possibly with a new status.
+type testChange struct {
+	From testRow `json:"from"`
+	To   testRow `json:"to"`
+}
+
+func (d diffOutput) hasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.StatusChanged) > 0 ||
+		len(d.Renamed) > 0 || len(d.Moved) > 0 || len(d.Suites) > 0
+}
+
+func runDiff(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("diff", "diff [flags] <base> <head>", stderr)
+	exitCode := fs.Bool("exit-code", false, "Exit with status 1 when the inventories differ")
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	filter := cf.filter()
+
+	base, err := loadInventory(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return fmt.Errorf("base: %w", err)
+	}
+	head, err := loadInventory(ctx, fs.Arg(1), cf)
+	if err != nil {
+		return fmt.Errorf("head: %w", err)
+	}
+
+	result := diffInventories(filter.Apply(base), filter.Apply(head))
+
+	if err := writeDiff(stdout, cf.format, result); err != nil {
+		return err
+	}
+
+	if *exitCode && result.hasChanges() {
+		return errDiffFound
+	}
+	return nil
+}
+
+func writeDiff(w io.Writer, format string, result diffOutput) error {
+	if format == formatJSON {
+		return writeJSON(w, result)
+	}
+
+	t := &table{headers: []string{"CHANGE", "PATH", "LINE", "STATUS", "TEST"}}
+	for _, row := range result.Added {
+		t.addRow(string(diff.KindAdded), row.Path, strconv.Itoa(row.Line), string(row.Status), row.displayName())
+	}
+	for _, row := range result.Removed {
+		t.addRow(string(diff.KindRemoved), row.Path, strconv.Itoa(row.Line), string(row.Status), row.displayName())
+	}
+	for _, change := range result.Renamed {
+		row := change.To
+		name := fmt.Sprintf("%s -> %s", change.From.displayName(), row.displayName())
+		t.addRow(string(diff.KindRenamed), row.Path, strconv.Itoa(row.Line), changedStatus(change), name)
+	}
+	for _, change := range result.Moved {
+		row := change.To
+		path := fmt.Sprintf("%s -> %s", change.From.Path, row.Path)
+		t.addRow(string(diff.KindMoved), path, strconv.Itoa(row.Line), changedStatus(change), row.displayName())
+	}
+	for _, change := range result.StatusChanged {
+		row := change.Test
+		status := fmt.Sprintf("%s -> %s", change.From, row.Status)
+		t.addRow(string(diff.KindStatusChanged), row.Path, strconv.Itoa(row.Line), status, row.displayName())
+	}
+	if err := t.render(w, format); err != nil {
+		return err
+	}
+
+	s := result.Summary
+	_, err := fmt.Fprintf(w, "\n%d -> %d tests: +%d added, -%d removed, %d renamed, %d moved, %d status changed\n",
+		s.BaseTests, s.HeadTests, s.Added, s.Removed, s.Renamed, s.Moved, s.StatusChanged)
+	return err
+}
+
+func changedStatus(change testChange) string {
+	if change.From.Status == change.To.Status {
+		return string(change.To.Status)
+	}
+	return fmt.Sprintf("%s -> %s", change.From.Status, change.To.Status)
+}
+
+// diffInventories compares base and head with the diff package and
+// groups the test changes by kind for display.
+func diffInventories(base, head *domain.Inventory) diffOutput {
+	result := diff.Compare(base, head)
+	baseRows := indexRows(base)
+	headRows := indexRows(head)
+
+	out := diffOutput{
+		Added:         []testRow{},
+		Frameworks:    result.Frameworks,
+		Moved:         []testChange{},
+		Removed:       []testRow{},
+		Renamed:       []testChange{},
+		StatusChanged: []statusChange{},
+		Suites:        result.Suites,
+		Summary:       result.Summary,
+	}
+
+	for _, change := range result.Tests {
+		switch change.Kind {
+		case diff.KindAdded:
+			out.Added = append(out.Added, headRows.lookup(change.Head))
+		case diff.KindRemoved:
+			out.Removed = append(out.Removed, baseRows.lookup(change.Base))
+		case diff.KindRenamed:
+			out.Renamed = append(out.Renamed, testChange{From: baseRows.lookup(change.Base), To: headRows.lookup(change.Head)})
+		case diff.KindMoved:
+			out.Moved = append(out.Moved, testChange{From: baseRows.lookup(change.Base), To: headRows.lookup(change.Head)})
+		case diff.KindStatusChanged:
+			out.StatusChanged = append(out.StatusChanged, statusChange{From: change.Base.Status, Test: headRows.lookup(change.Head)})
+		}
+	}
+	return out
+}
+
+// rowIndex finds the full row, including tags and skip details, for a diff entry.
+type rowIndex map[string]testRow
+
+func indexRows(inv *domain.Inventory) rowIndex {
+	index := rowIndex{}
+	if inv == nil {
+		return index
+	}
+	for _, row := range flattenTests(inv) {
+		index[row.key()+"\x00"+strconv.Itoa(row.Line)] = row
+	}
+	return index
+}
+
+func (idx rowIndex) lookup(e *diff.Entry) testRow {
+	row := testRow{
+		Framework: e.Framework,
+		Line:      e.Line,
+		Name:      e.Name,
+		Path:      e.Path,
+		Status:    e.Status,
+		SuitePath: e.SuitePath,
+	}
+	if full, ok := idx[row.key()+"\x00"+strconv.Itoa(row.Line)]; ok {
+		return full
+	}
+	return row
+}
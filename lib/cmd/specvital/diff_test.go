@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestDiffInventories(t *testing.T) {
+	t.Run("identical inventories have no changes", func(t *testing.T) {
+		got := diffInventories(sampleInventory(), sampleInventory())
+
+		assert.False(t, got.hasChanges())
+		assert.Equal(t, 5, got.Summary.BaseTests)
+		assert.Equal(t, 5, got.Summary.HeadTests)
+	})
+
+	t.Run("detects added, removed and status-changed tests", func(t *testing.T) {
+		base := sampleInventory()
+		head := sampleInventory()
+		head.Files[1].Tests = []domain.Test{
+			{Name: "TestHandler", Status: domain.TestStatusSkipped},
+			{Name: "TestNew", Status: domain.TestStatusActive},
+		}
+
+		got := diffInventories(base, head)
+
+		assert.Len(t, got.Added, 1)
+		assert.Equal(t, "TestNew", got.Added[0].Name)
+		assert.Len(t, got.Removed, 1)
+		assert.Equal(t, "TestLegacy", got.Removed[0].Name)
+		assert.Len(t, got.StatusChanged, 1)
+		assert.Equal(t, domain.TestStatusActive, got.StatusChanged[0].From)
+		assert.Equal(t, domain.TestStatusSkipped, got.StatusChanged[0].Test.Status)
+	})
+
+	t.Run("detects renamed and moved tests", func(t *testing.T) {
+		base := sampleInventory()
+		head := sampleInventory()
+		head.Files[0].Suites[0].Tests[0].Name = "creates a user"
+		head.Files[1].Tests = head.Files[1].Tests[:1]
+		head.Files = append(head.Files, domain.TestFile{
+			Path:      "api/legacy_test.go",
+			Framework: "go-testing",
+			Tests:     []domain.Test{{Name: "TestLegacy", Status: domain.TestStatusTodo}},
+		})
+
+		got := diffInventories(base, head)
+
+		assert.Empty(t, got.Added)
+		assert.Empty(t, got.Removed)
+		assert.Len(t, got.Renamed, 1)
+		assert.Equal(t, "creates user", got.Renamed[0].From.Name)
+		assert.Equal(t, []string{"integration", "smoke"}, got.Renamed[0].To.Tags)
+		assert.Len(t, got.Moved, 1)
+		assert.Equal(t, "api/legacy_test.go", got.Moved[0].To.Path)
+		assert.True(t, got.hasChanges())
+	})
+
+	t.Run("matches duplicate names in order", func(t *testing.T) {
+		base := &domain.Inventory{Files: []domain.TestFile{{
+			Path:  "a_test.go",
+			Tests: []domain.Test{{Name: "(dynamic)"}, {Name: "(dynamic)"}},
+		}}}
+		head := &domain.Inventory{Files: []domain.TestFile{{
+			Path:  "a_test.go",
+			Tests: []domain.Test{{Name: "(dynamic)"}},
+		}}}
+
+		got := diffInventories(base, head)
+
+		assert.Empty(t, got.Added)
+		assert.Len(t, got.Removed, 1)
+	})
+}
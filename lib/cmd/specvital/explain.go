@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/detection"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// Reasons reported by explain for files without a framework.
+const (
+	reasonNotCandidate = "not a test file candidate: its name or location does not follow a test file convention, or it is excluded"
+	reasonNoFramework  = "no framework detected: no import, filename, config file or content signal matched"
+)
+
+// explainOutput is the JSON document written by "specvital explain -format json".
+type explainOutput struct {
+	Detection *domain.Detection `json:"detection"`
+	Framework string            `json:"framework"`
+	Path      string            `json:"path"`
+	// Reason explains why a file has no framework.
+	Reason string `json:"reason,omitempty"`
+}
+
+// explainObserver records what a scan learned about a single file.
+type explainObserver struct {
+	parser.NopObserver
+	path string
+
+	mu         sync.Mutex
+	discovered bool
+	detection  *domain.Detection
+}
+
+func (o *explainObserver) FileDiscovered(path string) {
+	if filepath.ToSlash(path) != o.path {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.discovered = true
+}
+
+func (o *explainObserver) FileDetected(path string, result detection.Result) {
+	if filepath.ToSlash(path) != o.path {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.detection = &domain.Detection{
+		Confidence: result.Confidence,
+		Evidence:   result.Evidence,
+		Source:     string(result.Source),
+	}
+}
+
+// undetected describes the file when the scan attributed no framework to it.
+func (o *explainObserver) undetected() explainOutput {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	out := explainOutput{Detection: o.detection, Path: o.path, Reason: reasonNotCandidate}
+	if o.discovered {
+		out.Reason = reasonNoFramework
+	}
+	return out
+}
+
+func runExplain(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("explain", "explain [flags] <file>", stderr)
+	var root string
+	fs.StringVar(&root, "root", ".", "Directory or Git repository URL the file belongs to")
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	path, err := explainPath(root, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	// Scanning the whole root, restricted to the file, keeps config scopes
+	// and the repository configuration in effect. Cached files are not
+	// detected again, and the observer replaces the progress bar.
+	cf.paths = listFlag{quoteGlob(path)}
+	cf.cacheDir = ""
+	cf.progress = false
+	observer := &explainObserver{path: path}
+	result, err := scanTarget(ctx, root, cf, parser.WithObserver(observer))
+	if err != nil {
+		return err
+	}
+
+	for _, scanErr := range result.Errors {
+		if filepath.ToSlash(scanErr.Path) == path {
+			return fmt.Errorf("%s: %w", path, scanErr.Err)
+		}
+	}
+
+	var file *domain.TestFile
+	for i := range result.Inventory.Files {
+		if filepath.ToSlash(result.Inventory.Files[i].Path) == path {
+			file = &result.Inventory.Files[i]
+			break
+		}
+	}
+	if file == nil {
+		if err := writeExplain(stdout, observer.undetected(), cf.format); err != nil {
+			return err
+		}
+		return errNotDetected
+	}
+
+	out := explainOutput{Detection: file.Detection, Framework: file.Framework, Path: path}
+	return writeExplain(stdout, out, cf.format)
+}
+
+func writeExplain(w io.Writer, out explainOutput, format string) error {
+	if format == formatJSON {
+		return writeJSON(w, out)
+	}
+
+	t := &table{headers: []string{"PATH", "FRAMEWORK", "SOURCE", "CONFIDENCE", "EVIDENCE"}}
+	row := []string{out.Path, out.Framework, "", "", out.Reason}
+	if d := out.Detection; d != nil {
+		row[2], row[3] = d.Source, strconv.Itoa(d.Confidence)
+		if len(d.Evidence) > 0 {
+			row[4] = strings.Join(d.Evidence, "; ")
+		}
+	}
+	t.addRow(row...)
+	return t.render(w, format)
+}
+
+// quoteGlob escapes the glob metacharacters of path, so that a path such as
+// app/[id]/page.test.js matches only itself.
+func quoteGlob(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if strings.ContainsRune(`*?[]{}\`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// explainPath returns file as a slash-separated path relative to root.
+// Files of a local root may also be given as absolute paths.
+func explainPath(root, file string) (string, error) {
+	if isRemoteTarget(root) || !filepath.IsAbs(file) {
+		return filepath.ToSlash(filepath.Clean(file)), nil
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", root, err)
+	}
+	absFile, err := filepath.Abs(file)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", file, err)
+	}
+	rel, err := filepath.Rel(absRoot, absFile)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is outside %s", file, root)
+	}
+	return filepath.ToSlash(rel), nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+var validStatuses = []domain.TestStatus{
+	domain.TestStatusActive,
+	domain.TestStatusSkipped,
+	domain.TestStatusTodo,
+	domain.TestStatusFocused,
+	domain.TestStatusXfail,
+}
+
+func isValidStatus(status string) bool {
+	return slices.Contains(validStatuses, domain.TestStatus(status))
+}
+
+func isValidKind(kind string) bool {
+	return domain.TestKind(kind).IsValid()
+}
+
+// inventoryFilter narrows an inventory down to the files and tests of interest.
+// Empty fields match everything.
+type inventoryFilter struct {
+	// Frameworks keeps files detected as one of these frameworks.
+	Frameworks []string
+	// Paths keeps files matching any of these doublestar globs (relative to the root).
+	Paths []string
+	// Kinds keeps tests classified as one of these kinds.
+	// Suites and files left without tests are dropped.
+	Kinds []string
+	// Statuses keeps tests with one of these statuses.
+	// Suites and files left without tests are dropped.
+	Statuses []string
+}
+
+// Apply returns a filtered copy of inv. The input is not modified.
+func (f inventoryFilter) Apply(inv *domain.Inventory) *domain.Inventory {
+	filtered := &domain.Inventory{
+		RootPath: inv.RootPath,
+		Files:    make([]domain.TestFile, 0, len(inv.Files)),
+	}
+
+	for _, file := range inv.Files {
+		if !f.matchFile(file) {
+			continue
+		}
+
+		if len(f.Statuses) == 0 && len(f.Kinds) == 0 {
+			filtered.Files = append(filtered.Files, file)
+			continue
+		}
+
+		file.Tests = f.filterTests(file.Tests)
+		file.Suites = f.filterSuites(file.Suites)
+		if file.CountTests() > 0 {
+			filtered.Files = append(filtered.Files, file)
+		}
+	}
+
+	return filtered
+}
+
+func (f inventoryFilter) matchFile(file domain.TestFile) bool {
+	if len(f.Frameworks) > 0 && !slices.Contains(f.Frameworks, file.Framework) {
+		return false
+	}
+
+	if len(f.Paths) == 0 {
+		return true
+	}
+
+	path := filepath.ToSlash(file.Path)
+	for _, pattern := range f.Paths {
+		if matched, err := doublestar.Match(pattern, path); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (f inventoryFilter) matchTest(test domain.Test) bool {
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, string(test.Status)) {
+		return false
+	}
+	return len(f.Kinds) == 0 || slices.Contains(f.Kinds, string(test.Kind))
+}
+
+func (f inventoryFilter) filterTests(tests []domain.Test) []domain.Test {
+	var kept []domain.Test
+	for _, test := range tests {
+		if f.matchTest(test) {
+			kept = append(kept, test)
+		}
+	}
+	return kept
+}
+
+func (f inventoryFilter) filterSuites(suites []domain.TestSuite) []domain.TestSuite {
+	var kept []domain.TestSuite
+	for _, suite := range suites {
+		suite.Tests = f.filterTests(suite.Tests)
+		suite.Suites = f.filterSuites(suite.Suites)
+		if suite.CountTests() > 0 {
+			kept = append(kept, suite)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func sampleInventory() *domain.Inventory {
+	return &domain.Inventory{
+		RootPath: "/repo",
+		Files: []domain.TestFile{
+			{
+				Path:      "web/src/user.test.ts",
+				Framework: "jest",
+				Suites: []domain.TestSuite{
+					{
+						Name: "UserService",
+						Tags: []string{"integration"},
+						Tests: []domain.Test{
+							{Name: "creates user", Status: domain.TestStatusActive, Tags: []string{"smoke"}},
+							{Name: "deletes user", Status: domain.TestStatusSkipped},
+						},
+						Suites: []domain.TestSuite{
+							{
+								Name:  "validation",
+								Tests: []domain.Test{{Name: "rejects empty name", Status: domain.TestStatusActive}},
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:      "api/handler_test.go",
+				Framework: "go-testing",
+				Tests: []domain.Test{
+					{Name: "TestHandler", Status: domain.TestStatusActive, Kind: domain.TestKindUnit},
+					{Name: "TestLegacy", Status: domain.TestStatusTodo, Kind: domain.TestKindIntegration},
+				},
+			},
+		},
+	}
+}
+
+func TestInventoryFilter_Apply(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    inventoryFilter
+		wantFiles []string
+		wantTests int
+	}{
+		{
+			name:      "empty filter keeps everything",
+			filter:    inventoryFilter{},
+			wantFiles: []string{"web/src/user.test.ts", "api/handler_test.go"},
+			wantTests: 5,
+		},
+		{
+			name:      "framework filter",
+			filter:    inventoryFilter{Frameworks: []string{"go-testing"}},
+			wantFiles: []string{"api/handler_test.go"},
+			wantTests: 2,
+		},
+		{
+			name:      "path glob filter",
+			filter:    inventoryFilter{Paths: []string{"web/**"}},
+			wantFiles: []string{"web/src/user.test.ts"},
+			wantTests: 3,
+		},
+		{
+			name:      "status filter drops files without matching tests",
+			filter:    inventoryFilter{Statuses: []string{"skipped"}},
+			wantFiles: []string{"web/src/user.test.ts"},
+			wantTests: 1,
+		},
+		{
+			name:      "kind filter",
+			filter:    inventoryFilter{Kinds: []string{"integration"}},
+			wantFiles: []string{"api/handler_test.go"},
+			wantTests: 1,
+		},
+		{
+			name:      "combined filters",
+			filter:    inventoryFilter{Frameworks: []string{"jest"}, Statuses: []string{"todo"}},
+			wantFiles: nil,
+			wantTests: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := sampleInventory()
+
+			got := tt.filter.Apply(inv)
+
+			var paths []string
+			for _, f := range got.Files {
+				paths = append(paths, f.Path)
+			}
+			assert.Equal(t, tt.wantFiles, paths)
+			assert.Equal(t, tt.wantTests, got.CountTests())
+			assert.Equal(t, 5, inv.CountTests(), "input inventory must not be modified")
+		})
+	}
+}
+
+func TestInventoryFilter_Apply_PrunesEmptySuites(t *testing.T) {
+	filter := inventoryFilter{Statuses: []string{"skipped"}}
+
+	got := filter.Apply(sampleInventory())
+
+	suite := got.Files[0].Suites[0]
+	assert.Len(t, suite.Tests, 1)
+	assert.Empty(t, suite.Suites, "nested suite without skipped tests should be dropped")
+}
+
+func TestFlattenTests(t *testing.T) {
+	rows := flattenTests(sampleInventory())
+
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.displayName()
+	}
+	assert.Equal(t, []string{
+		"UserService > creates user",
+		"UserService > deletes user",
+		"UserService > validation > rejects empty name",
+		"TestHandler",
+		"TestLegacy",
+	}, names)
+
+	assert.Equal(t, []string{"integration", "smoke"}, rows[0].Tags)
+	assert.Equal(t, []string{"integration"}, rows[2].Tags, "suite tags should be inherited by nested tests")
+	assert.Nil(t, rows[3].Tags)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+)
+
+var (
+	// errUsage is returned when command-line arguments are invalid.
+	// The reason has already been printed to stderr by then.
+	errUsage = errors.New("invalid usage")
+	// errHelp is returned when -h or -help was requested.
+	errHelp = errors.New("help requested")
+)
+
+// listFlag is a repeatable flag that also accepts comma-separated values.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// commonFlags holds flags shared by every command.
+type commonFlags struct {
+	branch   string
+	cacheDir string
+	exclude  listFlag
+	expand   bool
+	format   string
+	// extraFormats are output formats a command accepts besides the shared ones.
+	extraFormats []string
+	frameworks   listFlag
+	kinds        listFlag
+	// lint enables the test smell linter with these rule settings.
+	lint  *lint.Config
+	paths listFlag
+	// progress renders a scan progress bar on stderr.
+	progress bool
+	// sourceGraph is set by commands reporting the tests↔sources graph.
+	sourceGraph bool
+	statuses    listFlag
+	stderr      io.Writer
+	timeout     time.Duration
+	workers     int
+}
+
+func newFlagSet(name, usage string, stderr io.Writer) (*flag.FlagSet, *commonFlags) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	cf := &commonFlags{stderr: stderr}
+	fs.StringVar(&cf.format, "format", formatTable, "Output format: json, table, markdown")
+	fs.Var(&cf.paths, "path", "Only include test files matching this glob (repeatable, comma-separated)")
+	fs.Var(&cf.frameworks, "framework", "Only include these frameworks (repeatable, comma-separated)")
+	fs.Var(&cf.statuses, "status", "Only include tests with these statuses: active, skipped, todo, focused, xfail")
+	fs.Var(&cf.kinds, "kind", "Only include tests of these kinds: unit, integration, e2e, benchmark, fuzz, example")
+	fs.Var(&cf.exclude, "exclude", "Additional directory names to skip (repeatable, comma-separated)")
+	fs.BoolVar(&cf.expand, "expand-parameterized", false, "Report each statically known case of a parameterized test separately")
+	fs.StringVar(&cf.branch, "branch", "", "Branch to clone when the target is a Git repository")
+	fs.StringVar(&cf.cacheDir, "cache-dir", "", "Directory for per-file scan results; unchanged files are not re-parsed")
+	fs.DurationVar(&cf.timeout, "timeout", 0, "Scan timeout (default: parser default)")
+	fs.IntVar(&cf.workers, "workers", 0, "Concurrent file parsers (default: GOMAXPROCS)")
+	fs.BoolVar(&cf.progress, "progress", false, "Show a scan progress bar on stderr")
+
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: specvital %s\n\nFlags:\n", usage)
+		fs.PrintDefaults()
+	}
+
+	return fs, cf
+}
+
+// parseFlags parses args and validates the shared flags.
+func parseFlags(fs *flag.FlagSet, cf *commonFlags, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return errHelp
+		}
+		return errUsage
+	}
+
+	if !isValidFormat(cf.format) && !slices.Contains(cf.extraFormats, cf.format) {
+		formats := append([]string{formatJSON, formatTable, formatMarkdown}, cf.extraFormats...)
+		fmt.Fprintf(fs.Output(), "invalid -format %q: must be one of %s\n", cf.format, strings.Join(formats, ", "))
+		return errUsage
+	}
+
+	for _, status := range cf.statuses {
+		if !isValidStatus(status) {
+			fmt.Fprintf(fs.Output(), "invalid -status %q: must be one of active, skipped, todo, focused, xfail\n", status)
+			return errUsage
+		}
+	}
+
+	for _, kind := range cf.kinds {
+		if !isValidKind(kind) {
+			fmt.Fprintf(fs.Output(), "invalid -kind %q: must be one of unit, integration, e2e, benchmark, fuzz, example\n", kind)
+			return errUsage
+		}
+	}
+
+	return nil
+}
+
+func (cf *commonFlags) filter() inventoryFilter {
+	return inventoryFilter{
+		Frameworks: cf.frameworks,
+		Kinds:      cf.kinds,
+		Paths:      cf.paths,
+		Statuses:   cf.statuses,
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// suitePathSeparator joins nested suite names for display.
+const suitePathSeparator = " > "
+
+// testRow is a single test flattened out of its file and suite hierarchy.
+type testRow struct {
+	Framework  string            `json:"framework"`
+	Kind       domain.TestKind   `json:"kind,omitempty"`
+	Line       int               `json:"line"`
+	Modifier   string            `json:"modifier,omitempty"`
+	Name       string            `json:"name"`
+	Path       string            `json:"path"`
+	SkipReason string            `json:"skipReason,omitempty"`
+	Status     domain.TestStatus `json:"status"`
+	SuitePath  []string          `json:"suitePath,omitempty"`
+	// Tags include those inherited from enclosing suites.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// key identifies a test by file, suite path and name.
+func (r testRow) key() string {
+	return r.Path + "\x00" + strings.Join(r.SuitePath, "\x00") + "\x00" + r.Name
+}
+
+func (r testRow) displayName() string {
+	if len(r.SuitePath) == 0 {
+		return r.Name
+	}
+	return strings.Join(r.SuitePath, suitePathSeparator) + suitePathSeparator + r.Name
+}
+
+// flattenTests returns every test in inv in file order, depth-first within each file.
+func flattenTests(inv *domain.Inventory) []testRow {
+	var rows []testRow
+	for _, file := range inv.Files {
+		for _, test := range file.Tests {
+			rows = append(rows, newTestRow(file, nil, nil, test))
+		}
+		for _, suite := range file.Suites {
+			rows = flattenSuite(rows, file, nil, nil, suite)
+		}
+	}
+	return rows
+}
+
+func flattenSuite(rows []testRow, file domain.TestFile, parents, parentTags []string, suite domain.TestSuite) []testRow {
+	path := append(append([]string(nil), parents...), suite.Name)
+	tags := domain.MergeTags(parentTags, suite.Tags)
+
+	for _, test := range suite.Tests {
+		rows = append(rows, newTestRow(file, path, tags, test))
+	}
+	for _, nested := range suite.Suites {
+		rows = flattenSuite(rows, file, path, tags, nested)
+	}
+	return rows
+}
+
+func newTestRow(file domain.TestFile, suitePath, suiteTags []string, test domain.Test) testRow {
+	return testRow{
+		Framework:  file.Framework,
+		Kind:       test.Kind,
+		Line:       test.Location.StartLine,
+		Modifier:   test.Modifier,
+		Name:       test.Name,
+		Path:       file.Path,
+		SkipReason: test.SkipReason,
+		Status:     test.Status,
+		SuitePath:  suitePath,
+		Tags:       domain.MergeTags(suiteTags, test.Tags),
+	}
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+)
+
+const (
+	formatSARIF = "sarif"
+
+	// failOnNone never fails the lint command because of findings.
+	failOnNone = "none"
+)
+
+// lintOutput is the JSON document written by "specvital lint -format json".
+type lintOutput struct {
+	Findings []domain.Finding `json:"findings"`
+}
+
+func runLint(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("lint", "lint [flags] <target>", stderr)
+	cf.extraFormats = []string{formatSARIF}
+	fs.Lookup("format").Usage = "Output format: json, table, markdown, sarif"
+	var failOn string
+	var ruleFlags listFlag
+	fs.StringVar(&failOn, "fail-on", string(domain.SeverityError), "Exit with status 1 when a finding has this severity or higher: error, warning, note, none")
+	fs.Var(&ruleFlags, "rule", "Set a rule severity as rule=severity, severity being error, warning, note or off (repeatable, comma-separated)")
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	if severityRank(domain.Severity(failOn)) == 0 && failOn != failOnNone {
+		fmt.Fprintf(fs.Output(), "invalid -fail-on %q: must be one of error, warning, note, none\n", failOn)
+		return errUsage
+	}
+
+	cfg := &lint.Config{Rules: make(map[string]string, len(ruleFlags))}
+	for _, rule := range ruleFlags {
+		id, severity, ok := strings.Cut(rule, "=")
+		if !ok {
+			fmt.Fprintf(fs.Output(), "invalid -rule %q: want rule=severity\n", rule)
+			return errUsage
+		}
+		cfg.Rules[id] = severity
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(fs.Output(), "invalid -rule: %v\n", err)
+		return errUsage
+	}
+
+	cf.lint = cfg
+	result, err := scanTarget(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return err
+	}
+	for _, scanErr := range result.Errors {
+		fmt.Fprintf(stderr, "warning: %v\n", scanErr)
+	}
+
+	findings := collectFindings(cf.filter().Apply(result.Inventory))
+	switch cf.format {
+	case formatJSON:
+		err = writeJSON(stdout, lintOutput{Findings: findings})
+	case formatSARIF:
+		err = writeJSON(stdout, newSARIFLog(findings))
+	default:
+		t := &table{headers: []string{"PATH", "LINE", "RULE", "SEVERITY", "MESSAGE"}}
+		for _, f := range findings {
+			t.addRow(f.Location.File, strconv.Itoa(f.Location.StartLine), f.Rule, string(f.Severity), f.Message)
+		}
+		err = t.render(stdout, cf.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if threshold := severityRank(domain.Severity(failOn)); threshold > 0 {
+		for _, f := range findings {
+			if severityRank(f.Severity) >= threshold {
+				return errLintFailed
+			}
+		}
+	}
+	return nil
+}
+
+// collectFindings returns the findings of every file in inventory order.
+func collectFindings(inv *domain.Inventory) []domain.Finding {
+	findings := []domain.Finding{}
+	for _, file := range inv.Files {
+		findings = append(findings, file.Findings...)
+	}
+	return findings
+}
+
+// severityRank orders severities; unknown severities rank 0.
+func severityRank(severity domain.Severity) int {
+	switch severity {
+	case domain.SeverityError:
+		return 3
+	case domain.SeverityWarning:
+		return 2
+	case domain.SeverityNote:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sarifLog is a SARIF 2.1.0 log, the format code scanning services such as
+// GitHub accept for annotating pull requests.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+// sarifRegion uses 1-based lines and columns.
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// newSARIFLog converts findings to a SARIF log with one run. Every rule is
+// listed so consumers can show rule descriptions for any result.
+func newSARIFLog(findings []domain.Finding) sarifLog {
+	rules := lint.Rules()
+	driver := sarifDriver{
+		Name:           "specvital",
+		InformationURI: "https://specvital.com",
+		Version:        parser.Version(),
+		Rules:          make([]sarifRule, len(rules)),
+	}
+	ruleIndex := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		driver.Rules[i] = sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: string(rule.DefaultSeverity)},
+		}
+		ruleIndex[rule.ID] = i
+	}
+
+	results := make([]sarifResult, len(findings))
+	for i, f := range findings {
+		region := sarifRegion{
+			StartLine:   f.Location.StartLine,
+			StartColumn: f.Location.StartCol + 1,
+		}
+		if f.Location.EndLine > 0 {
+			region.EndLine = f.Location.EndLine
+			region.EndColumn = f.Location.EndCol + 1
+		}
+		results[i] = sarifResult{
+			RuleID:    f.Rule,
+			RuleIndex: ruleIndex[f.Rule],
+			Level:     string(f.Severity),
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: f.Location.File, URIBaseID: "%SRCROOT%"},
+				Region:           region,
+			}}},
+		}
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+}
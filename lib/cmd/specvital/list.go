@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+func runList(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("list", "list [flags] <target>", stderr)
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	inv, err := loadInventory(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return err
+	}
+
+	rows := flattenTests(cf.filter().Apply(inv))
+
+	if cf.format == formatJSON {
+		if rows == nil {
+			rows = []testRow{}
+		}
+		return writeJSON(stdout, rows)
+	}
+
+	t := &table{headers: []string{"PATH", "LINE", "STATUS", "TEST"}}
+	for _, row := range rows {
+		t.addRow(row.Path, strconv.Itoa(row.Line), string(row.Status), row.displayName())
+	}
+	return t.render(stdout, cf.format)
+}
@@ -0,0 +1,120 @@
+// Command specvital scans a repository for test files and reports the test inventory.
+//
+// It runs the same parser that powers specvital.com, so inventories produced locally
+// or in CI match what the web platform shows for the same commit.
+//
+// Usage:
+//
+//	specvital <command> [flags] <target>
+//
+// A target is a local directory, a Git repository URL, or (for diff) a JSON
+// inventory previously written by "specvital scan -format json".
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/all"
+)
+
+var (
+	// errDiffFound signals that diff found changes and -exit-code was requested.
+	errDiffFound = errors.New("differences found")
+	// errLintFailed signals that lint reported findings at or above -fail-on.
+	errLintFailed = errors.New("lint findings reported")
+	// errNotDetected signals that explain found no framework for the file.
+	// Its detection result has already been printed.
+	errNotDetected = errors.New("not detected as a test file")
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string, stdout, stderr io.Writer) error
+}
+
+func commands() []command {
+	return []command{
+		{name: "scan", summary: "Scan a target and print the full test inventory", run: runScan},
+		{name: "list", summary: "List individual tests in a target", run: runList},
+		{name: "stats", summary: "Summarize test counts by framework and status", run: runStats},
+		{name: "diff", summary: "Compare the test inventories of two targets", run: runDiff},
+		{name: "explain", summary: "Show why a file was attributed to its test framework", run: runExplain},
+		{name: "sources", summary: "Map production files to the tests importing them", run: runSources},
+		{name: "lint", summary: "Report test smells such as focused tests and missing assertions", run: runLint},
+	}
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) < 1 {
+		printUsage(stderr)
+		return 2
+	}
+
+	name := args[0]
+	if name == "-h" || name == "-help" || name == "--help" || name == "help" {
+		printUsage(stdout)
+		return 0
+	}
+
+	for _, cmd := range commands() {
+		if cmd.name != name {
+			continue
+		}
+
+		err := cmd.run(ctx, args[1:], stdout, stderr)
+		switch {
+		case err == nil, errors.Is(err, errHelp):
+			return 0
+		case errors.Is(err, errDiffFound), errors.Is(err, errLintFailed), errors.Is(err, errNotDetected):
+			return 1
+		case errors.Is(err, errUsage):
+			return 2
+		default:
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	fmt.Fprintf(stderr, "Error: unknown command %q\n\n", name)
+	printUsage(stderr)
+	return 2
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: specvital <command> [flags] <target>")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Targets:")
+	fmt.Fprintln(w, "  <dir>        Local directory (e.g., .)")
+	fmt.Fprintln(w, "  <repo-url>   Git repository URL (e.g., https://github.com/owner/repo)")
+	fmt.Fprintln(w, "  <file.json>  Inventory written by 'specvital scan -format json' (diff only)")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Examples:")
+	fmt.Fprintln(w, "  specvital scan .")
+	fmt.Fprintln(w, "  specvital list -framework jest -status skipped,todo ./web")
+	fmt.Fprintln(w, "  specvital stats -format markdown https://github.com/owner/repo")
+	fmt.Fprintln(w, "  specvital diff -exit-code base.json .")
+	fmt.Fprintln(w, "  specvital explain -root ./web src/app.test.ts")
+	fmt.Fprintln(w, "  specvital sources -for src/billing/invoice.ts .")
+	fmt.Fprintln(w, "  specvital lint -format sarif . > specvital.sarif")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Run 'specvital <command> -h' for command flags.")
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func runCLI(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	code, _, stderr := runCLI(t, "frobnicate")
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown command "frobnicate"`)
+}
+
+func TestRun_InvalidFormat(t *testing.T) {
+	code, _, stderr := runCLI(t, "scan", "-format", "xml", t.TempDir())
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "invalid -format")
+}
+
+func TestRun_ScanAndDiff(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "pkg/math_test.go", `package pkg
+
+import "testing"
+
+func TestAdd(t *testing.T) {}
+func TestSub(t *testing.T) {}
+`)
+
+	code, stdout, stderr := runCLI(t, "scan", "-format", "json", dir)
+	require.Equal(t, 0, code, stderr)
+
+	var out scanOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	require.Len(t, out.Files, 1)
+	assert.Equal(t, "go-testing", out.Files[0].Framework)
+	assert.Equal(t, 2, out.Stats.TestCount)
+
+	baseline := filepath.Join(t.TempDir(), "base.json")
+	require.NoError(t, os.WriteFile(baseline, []byte(stdout), 0o644))
+
+	code, _, _ = runCLI(t, "diff", "-exit-code", baseline, dir)
+	assert.Equal(t, 0, code, "unchanged tree should not report differences")
+
+	writeFixture(t, dir, "pkg/math_test.go", `package pkg
+
+import "testing"
+
+func TestAdd(t *testing.T) {}
+func TestMul(t *testing.T) {}
+`)
+
+	code, stdout, _ = runCLI(t, "diff", "-exit-code", "-format", "json", baseline, dir)
+	assert.Equal(t, 1, code)
+
+	var diff diffOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diff))
+	assert.Equal(t, 1, diff.Summary.Added)
+	assert.Equal(t, 1, diff.Summary.Removed)
+}
+
+func TestRun_ScanProgress(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "pkg/math_test.go", `package pkg
+
+import "testing"
+
+func TestAdd(t *testing.T) {}
+`)
+
+	code, _, stderr := runCLI(t, "stats", "-progress", dir)
+	require.Equal(t, 0, code, stderr)
+
+	assert.Contains(t, stderr, "100% 1/1 files")
+	assert.True(t, strings.HasSuffix(stderr, "\n"), "progress line should end with a newline")
+
+	code, _, stderr = runCLI(t, "stats", dir)
+	require.Equal(t, 0, code, stderr)
+	assert.Empty(t, stderr)
+}
+
+func TestRun_Explain(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "src/app.test.ts", `import { it } from "vitest";
+
+it("works", () => {});
+`)
+	writeFixture(t, dir, "src/util.ts", `export const x = 1;`)
+
+	code, stdout, stderr := runCLI(t, "explain", "-root", dir, "-format", "json", "src/app.test.ts")
+	require.Equal(t, 0, code, stderr)
+
+	var out explainOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	assert.Equal(t, "vitest", out.Framework)
+	require.NotNil(t, out.Detection)
+	assert.Equal(t, "import", out.Detection.Source)
+	assert.Equal(t, 100, out.Detection.Confidence)
+	assert.Equal(t, []string{"import: vitest"}, out.Detection.Evidence)
+
+	code, stdout, stderr = runCLI(t, "explain", "-root", dir, filepath.Join(dir, "src", "app.test.ts"))
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "import: vitest")
+
+	writeFixture(t, dir, "app/[id]/page.test.js", `import { it } from "vitest";
+
+it("renders", () => {});
+`)
+	code, stdout, stderr = runCLI(t, "explain", "-root", dir, "-format", "json", "app/[id]/page.test.js")
+	require.Equal(t, 0, code, stderr)
+	out = explainOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	assert.Equal(t, "vitest", out.Framework)
+	assert.Equal(t, "app/[id]/page.test.js", out.Path)
+
+	code, stdout, stderr = runCLI(t, "explain", "-root", dir, "-format", "json", "src/util.ts")
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stderr)
+	out = explainOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	assert.Empty(t, out.Framework)
+	assert.Nil(t, out.Detection)
+	assert.Equal(t, reasonNotCandidate, out.Reason)
+
+	writeFixture(t, dir, "src/plain.test.ts", `export const x = 1;`)
+	code, stdout, _ = runCLI(t, "explain", "-root", dir, "src/plain.test.ts")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "unknown")
+	assert.Contains(t, stdout, reasonNoFramework)
+}
+
+func TestRun_Sources(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "src/billing/invoice.test.ts", `import { it } from "vitest";
+import { total } from "./invoice";
+
+it("totals", () => {});
+`)
+	writeFixture(t, dir, "src/billing/invoice.ts", `export const total = 1;`)
+	writeFixture(t, dir, "src/reports/monthly.ts", `export const monthly = 1;`)
+
+	code, stdout, stderr := runCLI(t, "sources", "-format", "json", "-for", "./src/billing/invoice.ts", dir)
+	require.Equal(t, 0, code, stderr)
+
+	var out sourcesOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	assert.Equal(t, []sourceTests{{Path: "src/billing/invoice.ts", Tests: []string{"src/billing/invoice.test.ts"}}}, out.Sources)
+
+	code, stdout, stderr = runCLI(t, "sources", "-untested", dir)
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "src/reports")
+	assert.NotContains(t, stdout, "src/billing")
+
+	code, _, _ = runCLI(t, "sources", "-untested", "-for", "src/billing/invoice.ts", dir)
+	assert.Equal(t, 2, code)
+}
+
+func TestRun_Lint(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "cart.test.ts", `import { it, expect } from "vitest";
+
+it.only("adds", () => {
+  expect(1).toBe(1);
+});
+
+it("removes", () => {});
+`)
+
+	code, stdout, stderr := runCLI(t, "lint", "-format", "json", dir)
+	require.Equal(t, 1, code, stderr)
+
+	var out lintOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	require.Len(t, out.Findings, 2)
+	assert.Equal(t, "focused-test", out.Findings[0].Rule)
+	assert.Equal(t, "empty-test", out.Findings[1].Rule)
+
+	code, stdout, stderr = runCLI(t, "lint", "-format", "sarif", "-rule", "focused-test=off", dir)
+	require.Equal(t, 0, code, stderr)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(stdout), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Results, 1)
+	result := log.Runs[0].Results[0]
+	assert.Equal(t, "empty-test", result.RuleID)
+	assert.Equal(t, "warning", result.Level)
+	assert.Equal(t, "cart.test.ts", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, sarifRegion{StartLine: 7, StartColumn: 1, EndLine: 7, EndColumn: 24}, result.Locations[0].PhysicalLocation.Region)
+
+	code, _, _ = runCLI(t, "lint", "-fail-on", "warning", "-rule", "focused-test=off", dir)
+	assert.Equal(t, 1, code)
+
+	code, _, _ = runCLI(t, "lint", "-rule", "no-such-rule=error", dir)
+	assert.Equal(t, 2, code)
+
+	code, _, _ = runCLI(t, "scan", "-format", "sarif", dir)
+	assert.Equal(t, 2, code)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatJSON     = "json"
+	formatMarkdown = "markdown"
+	formatTable    = "table"
+)
+
+func isValidFormat(format string) bool {
+	switch format {
+	case formatJSON, formatMarkdown, formatTable:
+		return true
+	default:
+		return false
+	}
+}
+
+// table is a format-independent tabular report rendered as plain text or Markdown.
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+func (t *table) addRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// render writes the table in the given text format (table or markdown).
+func (t *table) render(w io.Writer, format string) error {
+	if format == formatMarkdown {
+		return t.renderMarkdown(w)
+	}
+	return t.renderText(w)
+}
+
+func (t *table) renderText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (t *table) renderMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("| " + strings.Join(t.headers, " | ") + " |\n")
+	separators := make([]string, len(t.headers))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	b.WriteString("| " + strings.Join(separators, " | ") + " |\n")
+
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escapeMarkdownCell(cell)
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+)
+
+const (
+	progressBarWidth = 30
+	// progressRedraw bounds how often the bar is redrawn.
+	progressRedraw = 100 * time.Millisecond
+)
+
+// progressBar renders scan progress on a single terminal line, redrawn in
+// place with a carriage return and ended with a newline when the scan completes.
+type progressBar struct {
+	parser.NopObserver
+
+	mu         sync.Mutex
+	w          io.Writer
+	discovered int
+	done       int
+	failed     int
+	// total is the number of files to scan, or -1 while discovery runs.
+	total    int
+	lastDraw time.Time
+	drawn    bool
+}
+
+func newProgressBar(w io.Writer) *progressBar {
+	return &progressBar{w: w, total: -1}
+}
+
+func (b *progressBar) FileDiscovered(string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.discovered++
+	b.draw(false)
+}
+
+func (b *progressBar) DiscoveryCompleted(files int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.total = files
+	b.draw(false)
+}
+
+func (b *progressBar) FileParsed(*parser.FileResult, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done++
+	b.draw(false)
+}
+
+func (b *progressBar) FileFailed(string, error, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done++
+	b.failed++
+	b.draw(false)
+}
+
+func (b *progressBar) ScanCompleted(parser.ScanStats) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.total < 0 {
+		b.total = b.done
+	}
+	b.draw(true)
+	fmt.Fprintln(b.w)
+	b.drawn = false
+}
+
+// draw redraws the bar unless it was drawn within progressRedraw.
+// Callers must hold mu.
+func (b *progressBar) draw(force bool) {
+	now := time.Now()
+	if !force && b.drawn && now.Sub(b.lastDraw) < progressRedraw {
+		return
+	}
+	b.lastDraw = now
+	b.drawn = true
+
+	failed := ""
+	if b.failed > 0 {
+		failed = fmt.Sprintf(", %d failed", b.failed)
+	}
+
+	if b.total < 0 {
+		fmt.Fprintf(b.w, "\rScanning: %d/%d files (discovering)%s", b.done, b.discovered, failed)
+		return
+	}
+
+	pct := 100
+	if b.total > 0 {
+		pct = b.done * 100 / b.total
+	}
+	filled := progressBarWidth * pct / 100
+	fmt.Fprintf(b.w, "\r[%s%s] %3d%% %d/%d files%s",
+		strings.Repeat("#", filled), strings.Repeat(".", progressBarWidth-filled),
+		pct, b.done, b.total, failed)
+}
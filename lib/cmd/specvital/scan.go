@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+func runScan(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("scan", "scan [flags] <target>", stderr)
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	result, err := scanTarget(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return err
+	}
+	for _, scanErr := range result.Errors {
+		fmt.Fprintf(stderr, "warning: %v\n", scanErr)
+	}
+
+	inv := cf.filter().Apply(result.Inventory)
+
+	if cf.format == formatJSON {
+		return writeJSON(stdout, scanOutput{
+			Files:    inv.Files,
+			RootPath: inv.RootPath,
+			Stats: &scanStatsOutput{
+				Duration:     result.Stats.Duration.String(),
+				FilesCached:  result.Stats.FilesCached,
+				FilesFailed:  result.Stats.FilesFailed,
+				FilesMatched: result.Stats.FilesMatched,
+				FilesScanned: result.Stats.FilesScanned,
+				TestCount:    inv.CountTests(),
+			},
+		})
+	}
+
+	t := &table{headers: []string{"PATH", "FRAMEWORK", "TESTS"}}
+	for _, file := range inv.Files {
+		t.addRow(file.Path, file.Framework, strconv.Itoa(file.CountTests()))
+	}
+	if err := t.render(stdout, cf.format); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stdout, "\n%d files, %d tests (%s)\n", len(inv.Files), inv.CountTests(), result.Stats.Duration.Round(time.Millisecond))
+	return err
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// sourcesOutput is the JSON document written by "specvital sources -format json".
+type sourcesOutput struct {
+	Sources []sourceTests `json:"sources"`
+}
+
+type sourceTests struct {
+	Path  string   `json:"path"`
+	Tests []string `json:"tests"`
+}
+
+// untestedOutput is the JSON document written by "specvital sources -untested -format json".
+type untestedOutput struct {
+	Directories []string `json:"directories"`
+}
+
+func runSources(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("sources", "sources [flags] <target>", stderr)
+	var forPaths listFlag
+	var untested bool
+	fs.Var(&forPaths, "for", "Only report these production files (repeatable, comma-separated)")
+	fs.BoolVar(&untested, "untested", false, "List source directories no test imports from")
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	if untested && len(forPaths) > 0 {
+		fmt.Fprintln(fs.Output(), "-for and -untested are mutually exclusive")
+		return errUsage
+	}
+
+	cf.sourceGraph = true
+	result, err := scanTarget(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return err
+	}
+	for _, scanErr := range result.Errors {
+		fmt.Fprintf(stderr, "warning: %v\n", scanErr)
+	}
+	graph := result.Inventory.SourceGraph
+
+	if untested {
+		dirs := graph.UntestedDirs()
+		if dirs == nil {
+			dirs = []string{}
+		}
+		if cf.format == formatJSON {
+			return writeJSON(stdout, untestedOutput{Directories: dirs})
+		}
+		t := &table{headers: []string{"DIRECTORY"}}
+		for _, dir := range dirs {
+			t.addRow(dir)
+		}
+		return t.render(stdout, cf.format)
+	}
+
+	for i, p := range forPaths {
+		forPaths[i] = filepath.ToSlash(filepath.Clean(p))
+	}
+	out := sourcesOutput{Sources: collectSourceTests(graph, forPaths)}
+	if cf.format == formatJSON {
+		return writeJSON(stdout, out)
+	}
+
+	t := &table{headers: []string{"SOURCE", "TESTS", "TEST FILES"}}
+	for _, s := range out.Sources {
+		t.addRow(s.Path, strconv.Itoa(len(s.Tests)), strings.Join(s.Tests, ", "))
+	}
+	return t.render(stdout, cf.format)
+}
+
+// collectSourceTests returns the tests importing each production file, in
+// source order. Without paths every source file is reported.
+func collectSourceTests(graph *domain.SourceGraph, paths []string) []sourceTests {
+	if graph == nil {
+		return []sourceTests{}
+	}
+	if len(paths) == 0 {
+		paths = graph.Sources
+	}
+
+	out := make([]sourceTests, 0, len(paths))
+	for _, p := range paths {
+		tests := graph.TestsFor(p)
+		if tests == nil {
+			tests = []string{}
+		}
+		out = append(out, sourceTests{Path: p, Tests: tests})
+	}
+	return out
+}
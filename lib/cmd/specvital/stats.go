@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// statsOutput is the JSON document written by "specvital stats -format json".
+type statsOutput struct {
+	ByFramework []frameworkStats `json:"byFramework"`
+	ByKind      map[string]int   `json:"byKind"`
+	ByStatus    map[string]int   `json:"byStatus"`
+	Files       int              `json:"files"`
+	Tests       int              `json:"tests"`
+}
+
+type frameworkStats struct {
+	Files     int            `json:"files"`
+	Framework string         `json:"framework"`
+	Statuses  map[string]int `json:"statuses"`
+	Tests     int            `json:"tests"`
+}
+
+func runStats(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, cf := newFlagSet("stats", "stats [flags] <target>", stderr)
+	if err := parseFlags(fs, cf, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	inv, err := loadInventory(ctx, fs.Arg(0), cf)
+	if err != nil {
+		return err
+	}
+
+	stats := computeStats(cf.filter().Apply(inv))
+
+	if cf.format == formatJSON {
+		return writeJSON(stdout, stats)
+	}
+
+	headers := []string{"FRAMEWORK", "FILES", "TESTS"}
+	for _, status := range validStatuses {
+		headers = append(headers, string(status))
+	}
+
+	t := &table{headers: headers}
+	for _, fw := range stats.ByFramework {
+		t.addRow(statsRow(fw.Framework, fw.Files, fw.Tests, fw.Statuses)...)
+	}
+	t.addRow(statsRow("TOTAL", stats.Files, stats.Tests, stats.ByStatus)...)
+
+	return t.render(stdout, cf.format)
+}
+
+func statsRow(label string, files, tests int, statuses map[string]int) []string {
+	row := []string{label, strconv.Itoa(files), strconv.Itoa(tests)}
+	for _, status := range validStatuses {
+		row = append(row, strconv.Itoa(statuses[string(status)]))
+	}
+	return row
+}
+
+// computeStats aggregates file and test counts per framework, per kind and per status.
+// Frameworks are sorted by test count (descending), then by name.
+func computeStats(inv *domain.Inventory) statsOutput {
+	out := statsOutput{
+		ByFramework: []frameworkStats{},
+		ByKind:      map[string]int{},
+		ByStatus:    map[string]int{},
+		Files:       len(inv.Files),
+	}
+
+	byFramework := map[string]*frameworkStats{}
+	for _, file := range inv.Files {
+		fw, ok := byFramework[file.Framework]
+		if !ok {
+			fw = &frameworkStats{Framework: file.Framework, Statuses: map[string]int{}}
+			byFramework[file.Framework] = fw
+		}
+		fw.Files++
+	}
+
+	for _, row := range flattenTests(inv) {
+		fw := byFramework[row.Framework]
+		fw.Tests++
+		fw.Statuses[string(row.Status)]++
+		out.Tests++
+		out.ByStatus[string(row.Status)]++
+		if row.Kind != "" {
+			out.ByKind[string(row.Kind)]++
+		}
+	}
+
+	for _, fw := range byFramework {
+		out.ByFramework = append(out.ByFramework, *fw)
+	}
+	sort.Slice(out.ByFramework, func(i, j int) bool {
+		if out.ByFramework[i].Tests != out.ByFramework[j].Tests {
+			return out.ByFramework[i].Tests > out.ByFramework[j].Tests
+		}
+		return out.ByFramework[i].Framework < out.ByFramework[j].Framework
+	})
+
+	return out
+}
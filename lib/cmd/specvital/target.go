@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/cache"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// scanOutput is the JSON document written by "specvital scan -format json".
+// Its files and rootPath fields match domain.Inventory, so it can be fed back to diff.
+type scanOutput struct {
+	Files    []domain.TestFile `json:"files"`
+	RootPath string            `json:"rootPath"`
+	Stats    *scanStatsOutput  `json:"stats,omitempty"`
+}
+
+type scanStatsOutput struct {
+	Duration     string `json:"duration"`
+	FilesCached  int    `json:"filesCached"`
+	FilesFailed  int    `json:"filesFailed"`
+	FilesMatched int    `json:"filesMatched"`
+	FilesScanned int    `json:"filesScanned"`
+	TestCount    int    `json:"testCount"`
+}
+
+// isRemoteTarget reports whether target refers to a Git repository rather than a local path.
+func isRemoteTarget(target string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git@"} {
+		if strings.HasPrefix(target, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInventoryFile reports whether target is an existing JSON inventory file.
+func isInventoryFile(target string) bool {
+	if !strings.HasSuffix(strings.ToLower(target), ".json") {
+		return false
+	}
+	info, err := os.Stat(target)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// openSource opens a local directory or clones a Git repository.
+// The caller must close the returned source.
+func openSource(ctx context.Context, target, branch string) (source.Source, error) {
+	if isRemoteTarget(target) {
+		src, err := source.NewGitSource(ctx, target, &source.GitOptions{Branch: branch})
+		if err != nil {
+			return nil, fmt.Errorf("clone %s: %w", target, err)
+		}
+		return src, nil
+	}
+
+	src, err := source.NewLocalSource(target)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", target, err)
+	}
+	return src, nil
+}
+
+// scanTarget scans target with the options derived from cf, followed by extra.
+func scanTarget(ctx context.Context, target string, cf *commonFlags, extra ...parser.ScanOption) (*parser.ScanResult, error) {
+	src, err := openSource(ctx, target, cf.branch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = src.Close() }()
+
+	opts := []parser.ScanOption{
+		parser.WithDomainHints(false),
+		parser.WithParameterizedExpansion(cf.expand),
+		parser.WithSourceGraph(cf.sourceGraph),
+		parser.WithWorkers(cf.workers),
+		parser.WithTimeout(cf.timeout),
+	}
+	if cf.lint != nil {
+		opts = append(opts, parser.WithLint(cf.lint))
+	}
+	if len(cf.exclude) > 0 {
+		opts = append(opts, parser.WithExcludePatterns(cf.exclude))
+	}
+	if len(cf.paths) > 0 {
+		opts = append(opts, parser.WithPatterns(cf.paths))
+	}
+	if cf.cacheDir != "" {
+		resultCache, err := cache.NewDiskCache(cf.cacheDir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, parser.WithResultCache(resultCache))
+	}
+	if cf.progress {
+		opts = append(opts, parser.WithObserver(newProgressBar(cf.stderr)))
+	}
+	opts = append(opts, extra...)
+
+	result, err := parser.Scan(ctx, src, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("scan %s: %w", target, err)
+	}
+	return result, nil
+}
+
+// loadInventory returns the inventory for target, reading it from a JSON file
+// when target is one and scanning otherwise.
+func loadInventory(ctx context.Context, target string, cf *commonFlags) (*domain.Inventory, error) {
+	if !isInventoryFile(target) {
+		result, err := scanTarget(ctx, target, cf)
+		if err != nil {
+			return nil, err
+		}
+		return result.Inventory, nil
+	}
+
+	data, err := os.ReadFile(target)
+	if err != nil {
+		return nil, fmt.Errorf("read inventory %s: %w", target, err)
+	}
+
+	var out scanOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("decode inventory %s: %w", target, err)
+	}
+
+	return &domain.Inventory{Files: out.Files, RootPath: out.RootPath}, nil
+}
@@ -0,0 +1,196 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+)
+
+const (
+	// modulePath is the import path of this module, used to find its version in build info.
+	modulePath = "github.com/kubrickcode/specvital/lib"
+
+	// cacheFormatVersion is bumped whenever the cached representation changes
+	// in a way not covered by the module version (e.g., during development).
+	cacheFormatVersion = "3"
+)
+
+// ResultCache stores per-file scan results across scans.
+// Implementations must be safe for concurrent use; Get and Put are called
+// from parser workers. A failing cache must never fail the scan: Get should
+// report a miss and Put errors are ignored by the scanner.
+type ResultCache interface {
+	// Get returns the result stored under key, if any.
+	Get(ctx context.Context, key string) (*CachedResult, bool)
+
+	// Put stores result under key.
+	Put(ctx context.Context, key string, result *CachedResult) error
+}
+
+// CachedResult is the reusable outcome of detecting and parsing one file.
+type CachedResult struct {
+	// Confidence is the detection source recorded for the file.
+	Confidence string `json:"confidence"`
+
+	// File is the parsed test file, or nil if no framework was detected.
+	File *domain.TestFile `json:"file,omitempty"`
+}
+
+var (
+	versionOnce sync.Once
+	version     string
+)
+
+// Version returns the version of the parser module as recorded in the build info.
+// For development builds of this module it falls back to the VCS revision,
+// suffixed with "+dirty" when the working tree had uncommitted changes.
+func Version() string {
+	versionOnce.Do(func() {
+		version = readVersion()
+	})
+	return version
+}
+
+func readVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+
+	if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+
+	var revision string
+	var modified bool
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision == "" {
+		return "(devel)"
+	}
+	if modified {
+		return revision + "+dirty"
+	}
+	return revision
+}
+
+// cacheKey derives the cache key for a file from its path and content,
+// combined with the scan-wide inputs digested by prepareCacheKeys.
+func (s *Scanner) cacheKey(path string, content []byte) string {
+	contentHash := sha256.Sum256(content)
+	return hashParts(s.cacheSalt, path, hex.EncodeToString(contentHash[:]))
+}
+
+// prepareCacheKeys digests everything besides the file itself that affects its result:
+// the project scope used for framework detection, the registered frameworks,
+// the repository configuration, the options that change parser output, and
+// the parser version.
+// Must be called before parsing starts, as workers read the result without locking.
+func (s *Scanner) prepareCacheKeys(rootPath string) {
+	if s.options.ResultCache == nil {
+		return
+	}
+	s.cacheSalt = hashParts(
+		cacheFormatVersion,
+		Version(),
+		hashProjectScope(s.projectScope, rootPath),
+		registryFingerprint(s.registry),
+		strconv.FormatBool(s.options.ExpandParameterized),
+		strconv.FormatBool(s.options.ExtractDomainHints),
+		strconv.FormatBool(s.options.TestMetrics),
+		strconv.FormatBool(s.options.Fingerprints),
+		kindRulesFingerprint(s.options.KindRules),
+		lintFingerprint(s.options.Lint),
+		s.repoConfig.Fingerprint(),
+	)
+}
+
+func hashParts(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hashProjectScope returns a stable digest of the project scope.
+// Config paths are absolute, so the root is stripped to let clones of the
+// same repository in different directories share cache entries.
+func hashProjectScope(scope *framework.AggregatedProjectScope, rootPath string) string {
+	if scope == nil {
+		return "none"
+	}
+
+	// encoding/json sorts map keys, so equal scopes encode identically.
+	data, err := json.Marshal(scope)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%+v", scope))
+	}
+	if rootPath != "" {
+		data = bytes.ReplaceAll(data, []byte(rootPath), []byte("$ROOT"))
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func kindRulesFingerprint(rules []KindRule) string {
+	data, _ := json.Marshal(rules)
+	return string(data)
+}
+
+func lintFingerprint(cfg *lint.Config) string {
+	data, _ := json.Marshal(cfg)
+	return string(data)
+}
+
+// fingerprinter is implemented by parsers built from data rather than code
+// (e.g., declarative custom frameworks), whose output changes with that data.
+type fingerprinter interface {
+	Fingerprint() string
+}
+
+// registryFingerprint identifies the registered frameworks by name and, for
+// data-driven parsers, by the fingerprint of their definition.
+func registryFingerprint(registry *framework.Registry) string {
+	defs := registry.All()
+	names := make([]string, 0, len(defs))
+	for _, def := range defs {
+		name := def.Name
+		if fp, ok := def.Parser.(fingerprinter); ok {
+			name += "@" + fp.Fingerprint()
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data, _ := json.Marshal(names)
+	return string(data)
+}
@@ -0,0 +1,121 @@
+// Package cache provides ResultCache implementations for the scanner.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+)
+
+var _ parser.ResultCache = (*DiskCache)(nil)
+
+// ErrInvalidKey is returned when a cache key cannot be mapped to a file name.
+var ErrInvalidKey = errors.New("cache: invalid key")
+
+// DiskCache stores scan results as JSON files under a directory, one file per key.
+// Entries are sharded by the first two key characters to keep directories small.
+// Writes are atomic, so concurrent scanners may share a directory.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a cache rooted at dir, creating the directory if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache: directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cache: create directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Dir returns the cache directory.
+func (c *DiskCache) Dir() string {
+	return c.dir
+}
+
+// Get returns the result stored under key.
+// Missing, unreadable or corrupt entries are reported as misses.
+func (c *DiskCache) Get(ctx context.Context, key string) (*parser.CachedResult, bool) {
+	if ctx.Err() != nil {
+		return nil, false
+	}
+
+	path, err := c.entryPath(key)
+	if err != nil {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var result parser.CachedResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+	return &result, true
+}
+
+// Put stores result under key, replacing any existing entry.
+func (c *DiskCache) Put(ctx context.Context, key string, result *parser.CachedResult) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path, err := c.entryPath(key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("cache: encode %s: %w", key, err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("cache: create shard: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("cache: create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("cache: write %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("cache: write %s: %w", key, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("cache: commit %s: %w", key, err)
+	}
+	return nil
+}
+
+func (c *DiskCache) entryPath(key string) (string, error) {
+	if len(key) < 3 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, r := range key {
+		isHex := (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')
+		if !isHex {
+			return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return filepath.Join(c.dir, key[:2], key+".json"), nil
+}
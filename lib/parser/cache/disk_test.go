@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+const testKey = "ab12cd34"
+
+func TestDiskCache_PutGet(t *testing.T) {
+	c, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, ok := c.Get(ctx, testKey); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	want := &parser.CachedResult{
+		Confidence: "import",
+		File: &domain.TestFile{
+			Path:      "a.test.ts",
+			Framework: "jest",
+			Language:  domain.LanguageTypeScript,
+			Tests: []domain.Test{{
+				Name:       "works",
+				Status:     domain.TestStatusSkipped,
+				SkipReason: "flaky",
+				Location:   domain.Location{File: "a.test.ts", StartLine: 3, EndLine: 5},
+			}},
+		},
+	}
+	if err := c.Put(ctx, testKey, want); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, ok := c.Get(ctx, testKey)
+	if !ok {
+		t.Fatal("expected hit after Put")
+	}
+	if got.Confidence != want.Confidence {
+		t.Errorf("Confidence = %q, want %q", got.Confidence, want.Confidence)
+	}
+	if got.File == nil || len(got.File.Tests) != 1 {
+		t.Fatalf("File = %+v, want 1 test", got.File)
+	}
+	if !reflect.DeepEqual(got.File.Tests[0], want.File.Tests[0]) {
+		t.Errorf("Test = %+v, want %+v", got.File.Tests[0], want.File.Tests[0])
+	}
+}
+
+func TestDiskCache_SkippedFile(t *testing.T) {
+	c, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := c.Put(ctx, testKey, &parser.CachedResult{Confidence: "unknown"}); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, ok := c.Get(ctx, testKey)
+	if !ok {
+		t.Fatal("expected hit after Put")
+	}
+	if got.File != nil {
+		t.Errorf("File = %+v, want nil", got.File)
+	}
+}
+
+func TestDiskCache_CorruptEntryIsMiss(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	shard := filepath.Join(dir, testKey[:2])
+	if err := os.MkdirAll(shard, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(shard, testKey+".json"), []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := c.Get(context.Background(), testKey); ok {
+		t.Error("expected miss for corrupt entry")
+	}
+}
+
+func TestDiskCache_InvalidKey(t *testing.T) {
+	c, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	for _, key := range []string{"", "ab", "../../etc/passwd", "ABCDEF"} {
+		err := c.Put(context.Background(), key, &parser.CachedResult{})
+		if !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+func TestNewDiskCache_RequiresDir(t *testing.T) {
+	if _, err := NewDiskCache(""); err == nil {
+		t.Error("expected error for empty directory")
+	}
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// ChangedScanResult is the outcome of ScanChanged.
+type ChangedScanResult struct {
+	*ScanResult
+
+	// BaseSHA is the commit the changes were computed against: the merge base
+	// of the requested base and HEAD.
+	BaseSHA string
+
+	// DeletedPaths lists test file candidates that existed in BaseSHA but not
+	// in HEAD, slash-separated and relative to the source root.
+	DeletedPaths []string
+}
+
+// ScanChanged scans only the test files HEAD of src added or modified since it forked
+// from base, as in a pull request.
+// Files are filtered with the same rules as a full scan (skip patterns, test file naming,
+// Patterns and MaxFileSize), and config files are still read from the whole tree,
+// since framework detection depends on them.
+//
+// base is resolved by source.GitSource.Changes: pass the GitOptions.BaseRef used to
+// clone src (or an empty string) to compare against the fetched base commit.
+// The inventory contains only the changed files; merge it with a previous scan of
+// BaseSHA, removing DeletedPaths, to obtain the full inventory of HEAD.
+//
+// The caller is responsible for calling src.Close() when done.
+func (s *Scanner) ScanChanged(ctx context.Context, src *source.GitSource, base string) (*ChangedScanResult, error) {
+	changes, err := src.Changes(ctx, base)
+	if err != nil {
+		return nil, fmt.Errorf("compute changes: %w", err)
+	}
+
+	if err := s.ensureRepoConfig(ctx, src); err != nil {
+		return nil, err
+	}
+	s.ensureProjectScope(ctx, src)
+	s.ensureProjects(ctx, src)
+
+	skipSet := buildSkipSet(append(DefaultSkipPatterns, s.options.ExcludePatterns...))
+
+	var files []string
+	for _, path := range changes.Changed {
+		relPath := filepath.FromSlash(path)
+		if !s.isChangedCandidate(src.Root(), relPath, skipSet) {
+			continue
+		}
+		if s.options.MaxFileSize > 0 {
+			info, err := src.Stat(ctx, relPath)
+			if err != nil || !info.Mode().IsRegular() || info.Size() > s.options.MaxFileSize {
+				continue
+			}
+		}
+		files = append(files, relPath)
+	}
+
+	deleted := []string{}
+	for _, path := range changes.Deleted {
+		if s.isChangedCandidate(src.Root(), filepath.FromSlash(path), skipSet) {
+			deleted = append(deleted, path)
+		}
+	}
+
+	result, err := s.ScanFiles(ctx, src, files)
+	if s.projectScope != nil {
+		result.Stats.ConfigsFound = len(s.projectScope.Configs)
+	}
+	result.Stats.ProjectsFound = s.projects.Len()
+
+	return &ChangedScanResult{
+		ScanResult:   result,
+		BaseSHA:      changes.BaseSHA,
+		DeletedPaths: deleted,
+	}, err
+}
+
+// isChangedCandidate applies the discovery filters of a full scan to a single relative path.
+func (s *Scanner) isChangedCandidate(rootPath, relPath string, skipSet map[string]bool) bool {
+	dirs := strings.Split(filepath.Dir(relPath), string(filepath.Separator))
+	dir := rootPath
+	for _, name := range dirs {
+		if name == "." {
+			break
+		}
+		dir = filepath.Join(dir, name)
+		if shouldSkipDir(dir, rootPath, skipSet) {
+			return false
+		}
+	}
+
+	if !s.isTestFileCandidate(relPath) || !s.repoConfig.Selects(relPath) {
+		return false
+	}
+
+	if len(s.options.Patterns) > 0 {
+		return matchesAnyPattern(filepath.Join(rootPath, relPath), rootPath, s.options.Patterns)
+	}
+	return true
+}
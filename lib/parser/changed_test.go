@@ -0,0 +1,134 @@
+package parser_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+func TestScanChanged(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	// base: kept_test.go, removed_test.go, src/unchanged.test.ts
+	// head: kept_test.go modified, removed_test.go deleted, added_test.go,
+	//       README.md and node_modules/pkg/dep.test.js added
+	repoDir := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	write := func(path, content string) {
+		t.Helper()
+		full := filepath.Join(repoDir, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git("init", "-q")
+	git("config", "user.email", "test@test.com")
+	git("config", "user.name", "Test")
+	write("kept_test.go", "package a\n\nimport \"testing\"\n\nfunc TestKept(t *testing.T) {}\n")
+	write("removed_test.go", "package a\n\nimport \"testing\"\n\nfunc TestRemoved(t *testing.T) {}\n")
+	write("src/unchanged.test.ts", "it('works', () => {});\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "base")
+	baseSHA := git("rev-parse", "HEAD")
+
+	write("kept_test.go", "package a\n\nimport \"testing\"\n\nfunc TestKept(t *testing.T) {}\n\nfunc TestMore(t *testing.T) {}\n")
+	write("added_test.go", "package a\n\nimport \"testing\"\n\nfunc TestAdded(t *testing.T) {}\n")
+	write("README.md", "# readme\n")
+	write("node_modules/pkg/dep.test.js", "it('dep', () => {});\n")
+	git("add", "-f", ".")
+	git("rm", "-q", "removed_test.go")
+	git("commit", "-q", "-m", "head")
+
+	ctx := context.Background()
+
+	t.Run("should scan only test files changed since base", func(t *testing.T) {
+		src, err := source.NewGitSource(ctx, "file://"+repoDir, &source.GitOptions{BaseRef: baseSHA})
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		result, err := parser.NewScanner().ScanChanged(ctx, src, baseSHA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.BaseSHA != baseSHA {
+			t.Errorf("BaseSHA = %s, want %s", result.BaseSHA, baseSHA)
+		}
+
+		var paths []string
+		for _, file := range result.Inventory.Files {
+			paths = append(paths, file.Path)
+		}
+		if got := strings.Join(paths, ","); got != "added_test.go,kept_test.go" {
+			t.Errorf("scanned files = %s, want added_test.go,kept_test.go", got)
+		}
+		if result.Stats.FilesScanned != 2 {
+			t.Errorf("FilesScanned = %d, want 2", result.Stats.FilesScanned)
+		}
+		if result.Inventory.CountTests() != 3 {
+			t.Errorf("CountTests() = %d, want 3", result.Inventory.CountTests())
+		}
+
+		if got := strings.Join(result.DeletedPaths, ","); got != "removed_test.go" {
+			t.Errorf("DeletedPaths = %s, want removed_test.go", got)
+		}
+	})
+
+	t.Run("should apply scan patterns to changed files", func(t *testing.T) {
+		src, err := source.NewGitSource(ctx, "file://"+repoDir, &source.GitOptions{BaseRef: baseSHA})
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		scanner := parser.NewScanner(parser.WithPatterns([]string{"added_*"}))
+		result, err := scanner.ScanChanged(ctx, src, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(result.Inventory.Files) != 1 || result.Inventory.Files[0].Path != "added_test.go" {
+			t.Errorf("unexpected files: %+v", result.Inventory.Files)
+		}
+		if len(result.DeletedPaths) != 0 {
+			t.Errorf("DeletedPaths = %v, want none", result.DeletedPaths)
+		}
+	})
+
+	t.Run("should fail without a fetched base commit", func(t *testing.T) {
+		src, err := source.NewGitSource(ctx, "file://"+repoDir, nil)
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		_, err = parser.NewScanner().ScanChanged(ctx, src, baseSHA)
+		if !errors.Is(err, source.ErrBaseCommitUnavailable) {
+			t.Errorf("expected ErrBaseCommitUnavailable, got %v", err)
+		}
+	})
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/kubrickcode/specvital/lib/parser/framework"
 )
 
+// scopeConfidence is reported for files attributed by config scope: weaker
+// than an explicit import, stronger than content heuristics.
+const scopeConfidence = 80
+
 // Detector performs framework detection using early-return approach.
 // Detection priority (highest to lowest):
 // 1. Import statements - explicit developer intent (immediate return)
@@ -45,12 +49,18 @@ func (d *Detector) Detect(ctx context.Context, filePath string, content []byte)
 		return Unknown()
 	}
 
-	// Go test files are detected by naming convention (*_test.go)
+	// Go test files are detected by naming convention (*_test.go).
+	// Specialized frameworks built on top of testing (Ginkgo) are confirmed by import.
 	if lang == domain.LanguageGo {
-		if strings.HasSuffix(filepath.Base(filePath), "_test.go") {
-			return Confirmed("go-testing", SourceContentPattern)
+		if !strings.HasSuffix(filepath.Base(filePath), "_test.go") {
+			return Unknown()
 		}
-		return Unknown()
+		fw, mr := d.detectFromImport(ctx, lang, content, d.registry.FindByLanguage(lang))
+		if fw != "" && fw != framework.FrameworkGoTesting {
+			return Confirmed(fw, SourceImport).WithEvidence(mr.Confidence, mr.Evidence...)
+		}
+		return Confirmed(framework.FrameworkGoTesting, SourceContentPattern).
+			WithEvidence(100, "filename: *_test.go")
 	}
 
 	frameworks := d.registry.FindByLanguage(lang)
@@ -58,36 +68,35 @@ func (d *Detector) Detect(ctx context.Context, filePath string, content []byte)
 		return Unknown()
 	}
 
-	if fw := d.detectFromImport(ctx, lang, content, frameworks); fw != "" {
-		return Confirmed(fw, SourceImport)
+	if fw, mr := d.detectFromImport(ctx, lang, content, frameworks); fw != "" {
+		return Confirmed(fw, SourceImport).WithEvidence(mr.Confidence, mr.Evidence...)
 	}
 
-	if fw := d.detectFromStrongFilename(ctx, filePath, frameworks); fw != "" {
-		return Confirmed(fw, SourceStrongFilename)
+	if fw, mr := d.detectFromStrongFilename(ctx, filePath, frameworks); fw != "" {
+		return Confirmed(fw, SourceStrongFilename).WithEvidence(mr.Confidence, mr.Evidence...)
 	}
 
 	if result := d.detectFromScope(ctx, filePath, lang, content); result.Framework != "" {
 		return result
 	}
 
-	if fw := d.detectFromContent(ctx, content, frameworks); fw != "" {
-		return Confirmed(fw, SourceContentPattern)
+	if fw, mr := d.detectFromContent(ctx, content, frameworks); fw != "" {
+		return Confirmed(fw, SourceContentPattern).WithEvidence(mr.Confidence, mr.Evidence...)
 	}
 
 	return Unknown()
 }
 
 // detectFromImport checks for framework-specific import statements.
-// Returns framework name if found, empty string otherwise.
-func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, content []byte, frameworks []*framework.Definition) string {
+// Returns framework name and the deciding match if found, empty string otherwise.
+func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, content []byte, frameworks []*framework.Definition) (string, framework.MatchResult) {
 	var imports []string
 
 	switch lang {
 	case domain.LanguageTypeScript, domain.LanguageJavaScript:
 		imports = extraction.ExtractJSImports(ctx, content)
 	case domain.LanguageGo:
-		// Go uses testing package directly, not detected via imports
-		return ""
+		imports = extraction.ExtractGoImports(ctx, content)
 	case domain.LanguageJava:
 		imports = extraction.ExtractJavaImports(ctx, content)
 	case domain.LanguagePython:
@@ -97,18 +106,24 @@ func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, c
 	case domain.LanguageRuby:
 		imports = extraction.ExtractRubyRequires(ctx, content)
 	case domain.LanguageRust:
-		// Rust built-in tests use #[test] attribute, not imports.
-		// Third-party frameworks (rstest, proptest, criterion) are not yet supported.
-		// Future: implement ExtractRustImports for `use rstest::rstest;` etc.
-		return ""
+		// Built-in #[test] needs no import; third-party crates (rstest, criterion) do.
+		imports = extraction.ExtractRustImports(ctx, content)
+	case domain.LanguageCpp:
+		imports = extraction.ExtractCppIncludes(ctx, content)
 	case domain.LanguagePHP:
 		imports = extraction.ExtractPHPUses(ctx, content)
 	case domain.LanguageSwift:
 		imports = extraction.ExtractSwiftImports(ctx, content)
+	case domain.LanguageDart:
+		imports = extraction.ExtractDartImports(ctx, content)
+	case domain.LanguageElixir:
+		imports = extraction.ExtractElixirModules(ctx, content)
+	case domain.LanguageScala:
+		imports = extraction.ExtractScalaImports(ctx, content)
 	}
 
 	if len(imports) == 0 {
-		return ""
+		return "", framework.NoMatch()
 	}
 
 	for _, fw := range frameworks {
@@ -121,20 +136,20 @@ func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, c
 
 				mr := matcher.Match(ctx, signal)
 				if mr.Confidence > 0 && !mr.Negative {
-					return fw.Name
+					return fw.Name, mr
 				}
 			}
 		}
 	}
 
-	return ""
+	return "", framework.NoMatch()
 }
 
 // detectFromStrongFilename checks for framework-specific strong filename patterns.
 // Strong patterns are those with DefiniteMatch (Confidence=100), like *.cy.{js,ts,jsx,tsx} for Cypress.
 // These patterns represent explicit developer intent and should override config scope detection.
-// Returns framework name if found, empty string otherwise.
-func (d *Detector) detectFromStrongFilename(ctx context.Context, filePath string, frameworks []*framework.Definition) string {
+// Returns framework name and the deciding match if found, empty string otherwise.
+func (d *Detector) detectFromStrongFilename(ctx context.Context, filePath string, frameworks []*framework.Definition) (string, framework.MatchResult) {
 	filename := filepath.Base(filePath)
 
 	for _, fw := range frameworks {
@@ -149,12 +164,15 @@ func (d *Detector) detectFromStrongFilename(ctx context.Context, filePath string
 			// This ensures only explicit patterns like *.cy.ts override scope detection,
 			// while weaker patterns (e.g., test_*.py with Confidence=20) fall through.
 			if mr.Confidence == 100 && !mr.Negative {
-				return fw.Name
+				if len(mr.Evidence) == 0 {
+					mr.Evidence = []string{"filename: " + filename}
+				}
+				return fw.Name, mr
 			}
 		}
 	}
 
-	return ""
+	return "", framework.NoMatch()
 }
 
 // detectFromScope checks if file is within a config scope.
@@ -242,12 +260,16 @@ func (d *Detector) detectFromScope(ctx context.Context, filePath string, lang do
 		}
 	}
 
-	return ConfirmedWithScope(best.scope.Framework, best.scope)
+	evidence := []string{"config: " + best.path}
+	if best.scope.GlobalsMode {
+		evidence = append(evidence, "globals: true")
+	}
+	return ConfirmedWithScope(best.scope.Framework, best.scope).WithEvidence(scopeConfidence, evidence...)
 }
 
 // detectFromContent checks for framework-specific content patterns.
-// Returns framework name if found, empty string otherwise.
-func (d *Detector) detectFromContent(ctx context.Context, content []byte, frameworks []*framework.Definition) string {
+// Returns framework name and the deciding match if found, empty string otherwise.
+func (d *Detector) detectFromContent(ctx context.Context, content []byte, frameworks []*framework.Definition) (string, framework.MatchResult) {
 	for _, fw := range frameworks {
 		for _, matcher := range fw.Matchers {
 			signal := framework.Signal{
@@ -258,12 +280,12 @@ func (d *Detector) detectFromContent(ctx context.Context, content []byte, framew
 
 			mr := matcher.Match(ctx, signal)
 			if mr.Confidence > 0 && !mr.Negative {
-				return fw.Name
+				return fw.Name, mr
 			}
 		}
 	}
 
-	return ""
+	return "", framework.NoMatch()
 }
 
 func detectLanguage(filePath string) domain.Language {
@@ -294,6 +316,16 @@ func detectLanguage(filePath string) domain.Language {
 		return domain.LanguagePHP
 	case ".swift":
 		return domain.LanguageSwift
+	case ".feature":
+		return domain.LanguageGherkin
+	case ".dart":
+		return domain.LanguageDart
+	case ".ex", ".exs":
+		return domain.LanguageElixir
+	case ".erl":
+		return domain.LanguageErlang
+	case ".scala":
+		return domain.LanguageScala
 	default:
 		return ""
 	}
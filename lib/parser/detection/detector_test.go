@@ -361,6 +361,11 @@ func TestDetectLanguage(t *testing.T) {
 		{"/project/test.cjs", domain.LanguageJavaScript},
 		{"/project/test.go", domain.LanguageGo},
 		{"/project/test.py", domain.LanguagePython},
+		{"/project/features/login.feature", domain.LanguageGherkin},
+		{"/project/test/calculator_test.dart", domain.LanguageDart},
+		{"/project/test/cart_test.exs", domain.LanguageElixir},
+		{"/project/test/cart_SUITE.erl", domain.LanguageErlang},
+		{"/project/src/test/scala/CartSpec.scala", domain.LanguageScala},
 		{"/project/test.txt", ""},
 	}
 
@@ -433,6 +438,46 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestDetector_GoSpecializedFramework(t *testing.T) {
+	registry := framework.NewRegistry()
+	registry.Register(&framework.Definition{
+		Name:      "go-testing",
+		Languages: []domain.Language{domain.LanguageGo},
+		Matchers:  []framework.Matcher{matchers.NewImportMatcher("testing")},
+		Priority:  framework.PriorityGeneric,
+	})
+	registry.Register(&framework.Definition{
+		Name:      "ginkgo",
+		Languages: []domain.Language{domain.LanguageGo},
+		Matchers:  []framework.Matcher{matchers.NewImportMatcher("github.com/onsi/ginkgo/")},
+		Priority:  framework.PrioritySpecialized,
+	})
+
+	detector := NewDetector(registry)
+
+	content := []byte(`
+package books_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("Book", func() {})
+`)
+
+	result := detector.Detect(context.Background(), "/project/books_test.go", content)
+
+	if result.Framework != "ginkgo" {
+		t.Errorf("expected framework 'ginkgo', got '%s'", result.Framework)
+	}
+
+	if result.Source != SourceImport {
+		t.Errorf("expected source 'import', got '%s'", result.Source)
+	}
+}
+
 // TestDetector_GoNonTestFile tests that non-test Go files are not detected.
 func TestDetector_GoNonTestFile(t *testing.T) {
 	registry := framework.NewRegistry()
@@ -804,3 +849,126 @@ func (m *cypressFilenameMatcher) Match(_ context.Context, signal framework.Signa
 
 	return framework.NoMatch()
 }
+
+// TestDetector_RustImport tests that Rust crates imported with `use` select their framework.
+func TestDetector_RustImport(t *testing.T) {
+	registry := framework.NewRegistry()
+	registry.Register(&framework.Definition{
+		Name:      "cargo-test",
+		Languages: []domain.Language{domain.LanguageRust},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("rstest"),
+		},
+	})
+	registry.Register(&framework.Definition{
+		Name:      "criterion",
+		Languages: []domain.Language{domain.LanguageRust},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("criterion"),
+		},
+	})
+
+	detector := NewDetector(registry)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"rstest import", "use rstest::rstest;\n\n#[rstest]\nfn works() {}\n", "cargo-test"},
+		{"criterion import", "use criterion::{criterion_group, Criterion};\n", "criterion"},
+		{"std only", "use std::collections::HashMap;\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := detector.Detect(context.Background(), "/project/benches/a.rs", []byte(tt.content))
+
+			if result.Framework != tt.want {
+				t.Errorf("expected framework '%s', got '%s'", tt.want, result.Framework)
+			}
+			if tt.want != "" && result.Source != SourceImport {
+				t.Errorf("expected source 'import', got '%s'", result.Source)
+			}
+		})
+	}
+}
+
+// TestDetector_Evidence tests that each detection level reports why it matched.
+func TestDetector_Evidence(t *testing.T) {
+	registry := framework.NewRegistry()
+	registry.Register(&framework.Definition{
+		Name:      "vitest",
+		Languages: []domain.Language{domain.LanguageTypeScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("vitest", "vitest/"),
+		},
+	})
+	registry.Register(&framework.Definition{
+		Name:      "playwright",
+		Languages: []domain.Language{domain.LanguageTypeScript},
+		Matchers: []framework.Matcher{
+			matchers.NewContentMatcherFromStrings(`test\.describe\(`),
+		},
+	})
+
+	detector := NewDetector(registry)
+	projectScope := framework.NewProjectScope()
+	projectScope.AddConfig("/project/app/vitest.config.ts", &framework.ConfigScope{
+		ConfigPath:  "/project/app/vitest.config.ts",
+		BaseDir:     "/project/app",
+		Framework:   "vitest",
+		GlobalsMode: true,
+	})
+	detector.SetProjectScope(projectScope)
+
+	tests := []struct {
+		name           string
+		path           string
+		content        string
+		wantSource     DetectionSource
+		wantConfidence int
+		wantEvidence   string
+	}{
+		{
+			name:           "import",
+			path:           "/project/lib/a.test.ts",
+			content:        `import { it } from 'vitest';`,
+			wantSource:     SourceImport,
+			wantConfidence: 100,
+			wantEvidence:   "import: vitest",
+		},
+		{
+			name:           "config scope",
+			path:           "/project/app/a.test.ts",
+			content:        `it('works', () => {});`,
+			wantSource:     SourceConfigScope,
+			wantConfidence: scopeConfidence,
+			wantEvidence:   "config: /project/app/vitest.config.ts",
+		},
+		{
+			name:           "content pattern",
+			path:           "/project/e2e/a.spec.ts",
+			content:        `test.describe('x', () => {});`,
+			wantSource:     SourceContentPattern,
+			wantConfidence: 40,
+			wantEvidence:   `pattern: test\.describe\(`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := detector.Detect(context.Background(), tt.path, []byte(tt.content))
+
+			if result.Source != tt.wantSource {
+				t.Errorf("expected source %q, got %q", tt.wantSource, result.Source)
+			}
+			if result.Confidence != tt.wantConfidence {
+				t.Errorf("expected confidence %d, got %d", tt.wantConfidence, result.Confidence)
+			}
+			if len(result.Evidence) == 0 || result.Evidence[0] != tt.wantEvidence {
+				t.Errorf("expected evidence %q, got %v", tt.wantEvidence, result.Evidence)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+)
+
+// C++ include patterns (the header path is extracted):
+// - #include <catch2/catch_test_macros.hpp>
+// - #include "doctest.h"
+// - #  include <boost/test/unit_test.hpp>
+
+var cppIncludePattern = regexp.MustCompile(`(?m)^\s*#\s*include\s*[<"]([^>"\n]+)[>"]`)
+
+// ExtractCppIncludes extracts header paths from C/C++ #include directives.
+func ExtractCppIncludes(_ context.Context, content []byte) []string {
+	matches := cppIncludePattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	includes := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		header := string(match[1])
+		if header == "" {
+			continue
+		}
+
+		if _, ok := seen[header]; ok {
+			continue
+		}
+
+		seen[header] = struct{}{}
+		includes = append(includes, header)
+	}
+
+	return includes
+}
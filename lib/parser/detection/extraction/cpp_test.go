@@ -0,0 +1,60 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractCppIncludes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "system include",
+			content: `#include <catch2/catch_test_macros.hpp>
+`,
+			expected: []string{"catch2/catch_test_macros.hpp"},
+		},
+		{
+			name: "quoted and system includes",
+			content: `#include "doctest.h"
+#include <vector>
+#include "../src/math.h"
+`,
+			expected: []string{"doctest.h", "vector", "../src/math.h"},
+		},
+		{
+			name: "spacing after hash",
+			content: `#  include <boost/test/unit_test.hpp>
+   #include<gtest/gtest.h>
+`,
+			expected: []string{"boost/test/unit_test.hpp", "gtest/gtest.h"},
+		},
+		{
+			name: "dedup duplicate includes",
+			content: `#include <vector>
+#include <vector>
+`,
+			expected: []string{"vector"},
+		},
+		{
+			name: "no includes",
+			content: `// #define INCLUDE
+int main() { return 0; }
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractCppIncludes(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractCppIncludes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+)
+
+// Dart import patterns:
+// - import 'package:test/test.dart';
+// - import "package:flutter_test/flutter_test.dart" show testWidgets;
+// - export 'src/helpers.dart';
+// - import 'package:my_app/my_app.dart' as app;
+
+var dartImportPattern = regexp.MustCompile(`(?m)^\s*(?:import|export)\s+r?['"]([^'"]+)['"]`)
+
+// ExtractDartImports extracts URIs from Dart import and export directives.
+func ExtractDartImports(_ context.Context, content []byte) []string {
+	matches := dartImportPattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		uri := string(match[1])
+		if uri == "" {
+			continue
+		}
+
+		if _, ok := seen[uri]; ok {
+			continue
+		}
+
+		seen[uri] = struct{}{}
+		imports = append(imports, uri)
+	}
+
+	return imports
+}
@@ -0,0 +1,62 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractDartImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "package imports",
+			content: `import 'package:test/test.dart';
+import "package:my_app/calculator.dart";
+`,
+			expected: []string{"package:test/test.dart", "package:my_app/calculator.dart"},
+		},
+		{
+			name: "combinators and prefixes",
+			content: `import 'package:flutter_test/flutter_test.dart' show testWidgets;
+import 'package:my_app/my_app.dart' as app;
+import 'dart:async' hide Timer;
+`,
+			expected: []string{"package:flutter_test/flutter_test.dart", "package:my_app/my_app.dart", "dart:async"},
+		},
+		{
+			name: "relative import and export",
+			content: `import '../helpers.dart';
+export 'src/fixtures.dart';
+`,
+			expected: []string{"../helpers.dart", "src/fixtures.dart"},
+		},
+		{
+			name: "dedup duplicate imports",
+			content: `import 'package:test/test.dart';
+import 'package:test/test.dart';
+`,
+			expected: []string{"package:test/test.dart"},
+		},
+		{
+			name: "no imports",
+			content: `void main() {
+  print('import "not/an/import.dart"');
+}
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractDartImports(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractDartImports() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+)
+
+// Elixir module directives:
+// - use ExUnit.Case, async: true
+// - import Plug.Conn
+// - alias MyApp.Accounts.User
+// - require Logger
+
+var elixirDirectivePattern = regexp.MustCompile(`(?m)^\s*(?:use|import|alias|require)\s+([A-Z][A-Za-z0-9_]*(?:\.[A-Z][A-Za-z0-9_]*)*)`)
+
+// ExtractElixirModules extracts module names from use, import, alias and require directives.
+func ExtractElixirModules(_ context.Context, content []byte) []string {
+	matches := elixirDirectivePattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	modules := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		module := string(match[1])
+		if _, ok := seen[module]; ok {
+			continue
+		}
+
+		seen[module] = struct{}{}
+		modules = append(modules, module)
+	}
+
+	return modules
+}
@@ -0,0 +1,55 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractElixirModules(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "use with options",
+			content: `defmodule MyApp.CartTest do
+  use ExUnit.Case, async: true
+`,
+			expected: []string{"ExUnit.Case"},
+		},
+		{
+			name: "all directives",
+			content: `  use MyAppWeb.ConnCase
+  import Plug.Conn
+  alias MyApp.Accounts.User
+  require Logger
+`,
+			expected: []string{"MyAppWeb.ConnCase", "Plug.Conn", "MyApp.Accounts.User", "Logger"},
+		},
+		{
+			name: "dedup and ignore atoms",
+			content: `  import Ecto.Query
+  import Ecto.Query, only: [from: 2]
+  use :erlang_module
+`,
+			expected: []string{"Ecto.Query"},
+		},
+		{
+			name:     "no directives",
+			content:  `IO.puts("use ExUnit.Case")`,
+			expected: nil,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractElixirModules(ctx, []byte(tt.content))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
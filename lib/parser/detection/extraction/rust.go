@@ -0,0 +1,62 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+)
+
+// Rust import patterns (the crate name is extracted):
+// - use rstest::rstest;
+// - use proptest::prelude::*;
+// - use criterion::{criterion_group, criterion_main, Criterion};
+// - pub use ::test_case::test_case;
+// - extern crate criterion;
+// - #[macro_use] extern crate proptest;
+
+var (
+	rustUsePattern    = regexp.MustCompile(`(?m)^\s*(?:pub(?:\([^)]*\))?\s+)?use\s+(?:::)?([A-Za-z_][A-Za-z0-9_]*)`)
+	rustExternPattern = regexp.MustCompile(`(?m)^\s*(?:#\[[^\]]*\]\s*)?extern\s+crate\s+([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// rustPathKeywords are path roots that refer to the current crate rather than a dependency.
+var rustPathKeywords = map[string]struct{}{
+	"crate": {},
+	"self":  {},
+	"super": {},
+}
+
+// ExtractRustImports extracts crate names from Rust use declarations and extern crate items.
+func ExtractRustImports(_ context.Context, content []byte) []string {
+	var matches [][][]byte
+	matches = append(matches, rustUsePattern.FindAllSubmatch(content, -1)...)
+	matches = append(matches, rustExternPattern.FindAllSubmatch(content, -1)...)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		crateName := string(match[1])
+		if _, ok := rustPathKeywords[crateName]; ok {
+			continue
+		}
+
+		if _, ok := seen[crateName]; ok {
+			continue
+		}
+
+		seen[crateName] = struct{}{}
+		imports = append(imports, crateName)
+	}
+
+	if len(imports) == 0 {
+		return nil
+	}
+	return imports
+}
@@ -0,0 +1,85 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractRustImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "simple use",
+			content: `use rstest::rstest;
+`,
+			expected: []string{"rstest"},
+		},
+		{
+			name: "glob and grouped use",
+			content: `use proptest::prelude::*;
+use criterion::{black_box, criterion_group, criterion_main, Criterion};
+`,
+			expected: []string{"proptest", "criterion"},
+		},
+		{
+			name: "visibility and leading colons",
+			content: `pub use ::test_case::test_case;
+pub(crate) use tokio::runtime;
+`,
+			expected: []string{"test_case", "tokio"},
+		},
+		{
+			name: "extern crate",
+			content: `#[macro_use] extern crate proptest;
+extern crate criterion;
+`,
+			expected: []string{"proptest", "criterion"},
+		},
+		{
+			name: "indented use in test module",
+			content: `#[cfg(test)]
+mod tests {
+    use super::*;
+    use rstest::*;
+}
+`,
+			expected: []string{"rstest"},
+		},
+		{
+			name: "skip crate-relative paths",
+			content: `use crate::parser;
+use self::helpers;
+use super::*;
+`,
+			expected: nil,
+		},
+		{
+			name: "dedup duplicate crates",
+			content: `use rstest::rstest;
+use rstest::fixture;
+`,
+			expected: []string{"rstest"},
+		},
+		{
+			name: "no imports",
+			content: `fn add(a: i32, b: i32) -> i32 {
+    a + b
+}
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractRustImports(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractRustImports() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
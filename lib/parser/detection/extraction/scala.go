@@ -0,0 +1,67 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Scala import patterns:
+// - import org.scalatest.funsuite.AnyFunSuite
+// - import org.scalatest._ / import org.scalatest.*
+// - import org.scalatest.{BeforeAndAfter, Matchers}
+// - import munit.FunSuite, cats.effect.IO
+
+var (
+	scalaImportPattern = regexp.MustCompile(`(?m)^\s*import\s+([^\n;]+)`)
+	scalaPathPattern   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*`)
+)
+
+// ExtractScalaImports extracts import paths from Scala import clauses.
+// Wildcards and selector groups are reduced to the package they select from.
+func ExtractScalaImports(_ context.Context, content []byte) []string {
+	matches := scalaImportPattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		for _, clause := range splitScalaImportClauses(string(match[1])) {
+			path := scalaPathPattern.FindString(strings.TrimSpace(clause))
+			path = strings.TrimSuffix(path, "._")
+			if path == "" {
+				continue
+			}
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+			imports = append(imports, path)
+		}
+	}
+
+	return imports
+}
+
+// splitScalaImportClauses splits "a.B, c.{D, E}" on commas outside braces.
+func splitScalaImportClauses(s string) []string {
+	var clauses []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				clauses = append(clauses, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(clauses, s[start:])
+}
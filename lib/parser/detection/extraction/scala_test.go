@@ -0,0 +1,53 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractScalaImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "member import",
+			content:  "import org.scalatest.funsuite.AnyFunSuite\n",
+			expected: []string{"org.scalatest.funsuite.AnyFunSuite"},
+		},
+		{
+			name:     "wildcards",
+			content:  "import org.scalatest._\nimport munit.*\n",
+			expected: []string{"org.scalatest", "munit"},
+		},
+		{
+			name:     "selector group",
+			content:  "import org.specs2.mutable.{Specification, BeforeAfter}\n",
+			expected: []string{"org.specs2.mutable"},
+		},
+		{
+			name:     "multiple clauses",
+			content:  "  import cats.effect.IO, munit.{CatsEffectSuite => Suite}\n",
+			expected: []string{"cats.effect.IO", "munit"},
+		},
+		{
+			name:     "no imports",
+			content:  "package shop\n\nclass Cart\n",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractScalaImports(context.Background(), []byte(tt.content))
+			if len(got) == 0 && len(tt.expected) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractScalaImports() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ const (
 	// SourceConfigScope indicates detection via config file scope.
 	SourceConfigScope DetectionSource = "config-scope"
 
+	// SourceRepoConfig indicates the framework was forced by an override in
+	// the repository configuration file (.specvital.yml).
+	SourceRepoConfig DetectionSource = "repo-config"
+
 	// SourceContentPattern indicates detection via content pattern matching.
 	SourceContentPattern DetectionSource = "content-pattern"
 
@@ -36,6 +40,12 @@ type Result struct {
 	// Scope is the config scope that applies to this file (if scope-based detection succeeded).
 	// May be nil if no config scope applies.
 	Scope interface{} // framework.ConfigScope, but avoid import cycle
+
+	// Confidence is the score (0-100) of the signal that decided the framework.
+	Confidence int
+
+	// Evidence lists the indicators behind the decision (e.g., "import: vitest").
+	Evidence []string
 }
 
 // IsDetected returns true if a framework was detected.
@@ -50,6 +60,14 @@ func (r Result) String() string {
 	return fmt.Sprintf("%s (source: %s)", r.Framework, r.Source)
 }
 
+// WithEvidence returns a copy of r carrying the confidence and evidence of
+// the signal that decided it.
+func (r Result) WithEvidence(confidence int, evidence ...string) Result {
+	r.Confidence = confidence
+	r.Evidence = evidence
+	return r
+}
+
 // Unknown returns a Result indicating no framework was detected.
 func Unknown() Result {
 	return Result{
@@ -0,0 +1,522 @@
+// Package diff compares two test inventories and reports how tests and suites changed.
+//
+// Tests and suites are matched in three passes:
+//  1. Exact: same file, suite path and name. Duplicates are matched in order of appearance.
+//  2. Moved: same framework, suite path and name in a different file.
+//  3. Renamed: same file and suite path with a similar name (see WithRenameThreshold).
+//     Candidates are paired greedily by similarity, then by distance between their lines.
+//
+// Suites are matched before tests, outermost first. A test or suite whose
+// enclosing suite was renamed or moved is matched against its new location,
+// so only the enclosing suite is reported.
+package diff
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// DefaultRenameThreshold is the minimum name similarity for a rename.
+const DefaultRenameThreshold = 0.6
+
+// keySeparator joins key parts; it cannot appear in file paths or test names.
+const keySeparator = "\x00"
+
+// ChangeKind classifies a change between two inventories.
+type ChangeKind string
+
+const (
+	// KindAdded is an entry present only in head.
+	KindAdded ChangeKind = "added"
+	// KindRemoved is an entry present only in base.
+	KindRemoved ChangeKind = "removed"
+	// KindRenamed is an entry whose name changed within the same file and suite.
+	KindRenamed ChangeKind = "renamed"
+	// KindMoved is an entry that moved to another file under the same suite path and name.
+	KindMoved ChangeKind = "moved"
+	// KindStatusChanged is an otherwise unchanged entry whose status changed.
+	KindStatusChanged ChangeKind = "status-changed"
+)
+
+// Entry identifies a test or suite within one inventory.
+type Entry struct {
+	// Framework is the framework of the file containing the entry.
+	Framework string `json:"framework"`
+	// Line is the 1-based start line of the entry.
+	Line int `json:"line"`
+	// Name is the test or suite name.
+	Name string `json:"name"`
+	// Path is the file path.
+	Path string `json:"path"`
+	// Status is the entry status.
+	Status domain.TestStatus `json:"status"`
+	// SuitePath lists the names of the enclosing suites, outermost first.
+	SuitePath []string `json:"suitePath,omitempty"`
+}
+
+// Change describes one changed test or suite.
+type Change struct {
+	// Base is the entry in the base inventory; nil for added entries.
+	Base *Entry `json:"base,omitempty"`
+	// Head is the entry in the head inventory; nil for removed entries.
+	Head *Entry `json:"head,omitempty"`
+	// Kind classifies the change.
+	Kind ChangeKind `json:"kind"`
+}
+
+// StatusChanged reports whether the entry exists in both inventories with different statuses.
+// Renamed and moved entries may also have changed status.
+func (c Change) StatusChanged() bool {
+	return c.Base != nil && c.Head != nil && c.Base.Status != c.Head.Status
+}
+
+// Summary counts test changes.
+type Summary struct {
+	Added         int `json:"added"`
+	BaseTests     int `json:"baseTests"`
+	HeadTests     int `json:"headTests"`
+	Moved         int `json:"moved"`
+	Removed       int `json:"removed"`
+	Renamed       int `json:"renamed"`
+	StatusChanged int `json:"statusChanged"`
+}
+
+// FrameworkSummary counts test changes within one framework.
+// Changes are attributed to the head framework, or the base framework for removed tests.
+type FrameworkSummary struct {
+	Summary
+	Framework string `json:"framework"`
+}
+
+// Result is the outcome of comparing two inventories.
+type Result struct {
+	// Frameworks summarizes test changes per framework, sorted by framework name.
+	Frameworks []FrameworkSummary `json:"frameworks"`
+	// Suites lists suite changes, ordered by file and line.
+	Suites []Change `json:"suites"`
+	// Summary counts test changes across all frameworks.
+	Summary Summary `json:"summary"`
+	// Tests lists test changes, ordered by file and line.
+	Tests []Change `json:"tests"`
+}
+
+// HasChanges reports whether any test or suite changed.
+func (r *Result) HasChanges() bool {
+	return len(r.Tests) > 0 || len(r.Suites) > 0
+}
+
+// Options configures Compare.
+type Options struct {
+	// RenameThreshold is the minimum name similarity (0..1) for two entries
+	// in the same file and suite to be reported as a rename.
+	RenameThreshold float64
+}
+
+// Option is a functional option for configuring Compare.
+type Option func(*Options)
+
+// WithRenameThreshold sets the minimum name similarity for renames.
+// Values outside (0, 1] are ignored; 1 effectively disables rename detection.
+func WithRenameThreshold(threshold float64) Option {
+	return func(o *Options) {
+		if threshold > 0 && threshold <= 1 {
+			o.RenameThreshold = threshold
+		}
+	}
+}
+
+// Compare returns the changes from base to head. Nil inventories are treated as empty.
+func Compare(base, head *domain.Inventory, opts ...Option) *Result {
+	options := Options{RenameThreshold: DefaultRenameThreshold}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	baseTests, baseSuites := flatten(base)
+	headTests, headSuites := flatten(head)
+
+	m := &matcher{
+		locations: make(map[string]location),
+		threshold: options.RenameThreshold,
+	}
+
+	result := &Result{
+		Suites: []Change{},
+		Tests:  []Change{},
+	}
+
+	// Match suites level by level so nested suites see where their parents went.
+	for depth := 0; ; depth++ {
+		baseLevel := atDepth(baseSuites, depth)
+		headLevel := atDepth(headSuites, depth)
+		if len(baseLevel) == 0 && len(headLevel) == 0 {
+			break
+		}
+		result.Suites = append(result.Suites, m.match(baseLevel, headLevel, true)...)
+	}
+	result.Tests = m.match(baseTests, headTests, false)
+
+	sortChanges(result.Suites)
+	sortChanges(result.Tests)
+
+	result.Summary, result.Frameworks = summarize(baseTests, headTests, result.Tests)
+	return result
+}
+
+// location is the file and suite path an entry lives in.
+type location struct {
+	path      string
+	suitePath []string
+}
+
+func (l location) key() string {
+	return l.path + keySeparator + strings.Join(l.suitePath, keySeparator)
+}
+
+// node is an entry together with the location it is matched at.
+// For base entries the location is translated through matched enclosing suites.
+type node struct {
+	entry Entry
+	at    location
+}
+
+func (n node) exactKey() string {
+	return n.at.key() + keySeparator + n.entry.Name
+}
+
+func (n node) moveKey() string {
+	return n.entry.Framework + keySeparator + strings.Join(n.at.suitePath, keySeparator) + keySeparator + n.entry.Name
+}
+
+// childLocation is the location of entries nested directly in the suite n.
+func (n node) childLocation() location {
+	return location{path: n.at.path, suitePath: appendName(n.at.suitePath, n.entry.Name)}
+}
+
+type matcher struct {
+	// locations maps the original location of base entries nested in a matched suite
+	// to the location of the corresponding head suite's children.
+	locations map[string]location
+	threshold float64
+}
+
+func (m *matcher) translate(e Entry) location {
+	orig := location{path: e.Path, suitePath: e.SuitePath}
+	if loc, ok := m.locations[orig.key()]; ok {
+		return loc
+	}
+	return orig
+}
+
+type pair struct {
+	base int
+	head int
+	kind ChangeKind
+}
+
+// match pairs base and head entries and returns the resulting changes.
+// When suites is true, matched pairs are recorded so that nested entries can be translated.
+func (m *matcher) match(baseEntries, headEntries []Entry, suites bool) []Change {
+	base := make([]node, len(baseEntries))
+	for i, e := range baseEntries {
+		base[i] = node{entry: e, at: m.translate(e)}
+	}
+	head := make([]node, len(headEntries))
+	for i, e := range headEntries {
+		head[i] = node{entry: e, at: location{path: e.Path, suitePath: e.SuitePath}}
+	}
+
+	baseMatched := make([]bool, len(base))
+	headMatched := make([]bool, len(head))
+	var pairs []pair
+
+	// Pass 1: exact location and name.
+	byKey := make(map[string][]int)
+	for i, n := range base {
+		byKey[n.exactKey()] = append(byKey[n.exactKey()], i)
+	}
+	for j, n := range head {
+		key := n.exactKey()
+		candidates := byKey[key]
+		if len(candidates) == 0 {
+			continue
+		}
+		i := candidates[0]
+		byKey[key] = candidates[1:]
+		baseMatched[i], headMatched[j] = true, true
+		pairs = append(pairs, pair{base: i, head: j, kind: KindStatusChanged})
+	}
+
+	// Pass 2: same name and suite path in another file.
+	byMoveKey := make(map[string][]int)
+	for i, n := range base {
+		if !baseMatched[i] {
+			byMoveKey[n.moveKey()] = append(byMoveKey[n.moveKey()], i)
+		}
+	}
+	for j, n := range head {
+		if headMatched[j] {
+			continue
+		}
+		key := n.moveKey()
+		candidates := byMoveKey[key]
+		for k, i := range candidates {
+			if base[i].at.path == n.at.path {
+				continue
+			}
+			byMoveKey[key] = append(candidates[:k:k], candidates[k+1:]...)
+			baseMatched[i], headMatched[j] = true, true
+			pairs = append(pairs, pair{base: i, head: j, kind: KindMoved})
+			break
+		}
+	}
+
+	// Pass 3: similar name in the same file and suite path.
+	pairs = append(pairs, m.matchRenames(base, head, baseMatched, headMatched)...)
+
+	var changes []Change
+	for _, p := range pairs {
+		b, h := base[p.base], head[p.head]
+		if suites {
+			m.locations[location{path: b.entry.Path, suitePath: appendName(b.entry.SuitePath, b.entry.Name)}.key()] = h.childLocation()
+		}
+		if p.kind == KindStatusChanged && b.entry.Status == h.entry.Status {
+			continue
+		}
+		changes = append(changes, Change{Base: entryPtr(b.entry), Head: entryPtr(h.entry), Kind: p.kind})
+	}
+	for i, n := range base {
+		if !baseMatched[i] {
+			changes = append(changes, Change{Base: entryPtr(n.entry), Kind: KindRemoved})
+		}
+	}
+	for j, n := range head {
+		if !headMatched[j] {
+			changes = append(changes, Change{Head: entryPtr(n.entry), Kind: KindAdded})
+		}
+	}
+	return changes
+}
+
+type renameCandidate struct {
+	base     int
+	distance int
+	head     int
+	score    float64
+}
+
+func (m *matcher) matchRenames(base, head []node, baseMatched, headMatched []bool) []pair {
+	unmatchedBase := make(map[string][]int)
+	for i, n := range base {
+		if !baseMatched[i] {
+			unmatchedBase[n.at.key()] = append(unmatchedBase[n.at.key()], i)
+		}
+	}
+
+	var candidates []renameCandidate
+	for j, n := range head {
+		if headMatched[j] {
+			continue
+		}
+		for _, i := range unmatchedBase[n.at.key()] {
+			score := similarity(base[i].entry.Name, n.entry.Name)
+			if score < m.threshold {
+				continue
+			}
+			candidates = append(candidates, renameCandidate{
+				base:     i,
+				distance: abs(base[i].entry.Line - n.entry.Line),
+				head:     j,
+				score:    score,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		ca, cb := candidates[a], candidates[b]
+		if ca.score != cb.score {
+			return ca.score > cb.score
+		}
+		return ca.distance < cb.distance
+	})
+
+	var pairs []pair
+	for _, c := range candidates {
+		if baseMatched[c.base] || headMatched[c.head] {
+			continue
+		}
+		baseMatched[c.base], headMatched[c.head] = true, true
+		pairs = append(pairs, pair{base: c.base, head: c.head, kind: KindRenamed})
+	}
+	return pairs
+}
+
+// similarity returns the Sørensen–Dice coefficient of the character bigrams
+// of a and b, ignoring case. Identical names score 1, names sharing no bigram 0.
+func similarity(a, b string) float64 {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if a == b {
+		return 1
+	}
+
+	aBigrams := bigrams(a)
+	bBigrams := bigrams(b)
+	total := 0
+	for _, n := range aBigrams {
+		total += n
+	}
+	for _, n := range bBigrams {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	shared := 0
+	for bg, n := range aBigrams {
+		shared += min(n, bBigrams[bg])
+	}
+	return 2 * float64(shared) / float64(total)
+}
+
+func bigrams(s string) map[string]int {
+	runes := []rune(s)
+	counts := make(map[string]int, len(runes))
+	for i := 0; i+1 < len(runes); i++ {
+		counts[string(runes[i:i+2])]++
+	}
+	return counts
+}
+
+// flatten returns every test and suite in inv in file order, depth-first within each file.
+func flatten(inv *domain.Inventory) (tests, suites []Entry) {
+	if inv == nil {
+		return nil, nil
+	}
+	for _, file := range inv.Files {
+		for _, test := range file.Tests {
+			tests = append(tests, newEntry(file, nil, test.Name, test.Status, test.Location))
+		}
+		for _, suite := range file.Suites {
+			tests, suites = flattenSuite(tests, suites, file, nil, suite)
+		}
+	}
+	return tests, suites
+}
+
+func flattenSuite(tests, suites []Entry, file domain.TestFile, parents []string, suite domain.TestSuite) ([]Entry, []Entry) {
+	suites = append(suites, newEntry(file, parents, suite.Name, suite.Status, suite.Location))
+
+	path := appendName(parents, suite.Name)
+	for _, test := range suite.Tests {
+		tests = append(tests, newEntry(file, path, test.Name, test.Status, test.Location))
+	}
+	for _, nested := range suite.Suites {
+		tests, suites = flattenSuite(tests, suites, file, path, nested)
+	}
+	return tests, suites
+}
+
+func newEntry(file domain.TestFile, suitePath []string, name string, status domain.TestStatus, loc domain.Location) Entry {
+	return Entry{
+		Framework: file.Framework,
+		Line:      loc.StartLine,
+		Name:      name,
+		Path:      file.Path,
+		Status:    status,
+		SuitePath: suitePath,
+	}
+}
+
+func atDepth(entries []Entry, depth int) []Entry {
+	var level []Entry
+	for _, e := range entries {
+		if len(e.SuitePath) == depth {
+			level = append(level, e)
+		}
+	}
+	return level
+}
+
+func summarize(baseTests, headTests []Entry, changes []Change) (Summary, []FrameworkSummary) {
+	var total Summary
+	byFramework := make(map[string]*FrameworkSummary)
+	framework := func(name string) *FrameworkSummary {
+		fs, ok := byFramework[name]
+		if !ok {
+			fs = &FrameworkSummary{Framework: name}
+			byFramework[name] = fs
+		}
+		return fs
+	}
+
+	for _, e := range baseTests {
+		total.BaseTests++
+		framework(e.Framework).BaseTests++
+	}
+	for _, e := range headTests {
+		total.HeadTests++
+		framework(e.Framework).HeadTests++
+	}
+	for _, c := range changes {
+		fs := framework(c.entry().Framework)
+		for _, s := range []*Summary{&total, &fs.Summary} {
+			switch c.Kind {
+			case KindAdded:
+				s.Added++
+			case KindRemoved:
+				s.Removed++
+			case KindRenamed:
+				s.Renamed++
+			case KindMoved:
+				s.Moved++
+			case KindStatusChanged:
+				s.StatusChanged++
+			}
+		}
+	}
+
+	frameworks := make([]FrameworkSummary, 0, len(byFramework))
+	for _, fs := range byFramework {
+		frameworks = append(frameworks, *fs)
+	}
+	sort.Slice(frameworks, func(i, j int) bool {
+		return frameworks[i].Framework < frameworks[j].Framework
+	})
+	return total, frameworks
+}
+
+// entry returns the head entry, or the base entry for removed changes.
+func (c Change) entry() *Entry {
+	if c.Head != nil {
+		return c.Head
+	}
+	return c.Base
+}
+
+func sortChanges(changes []Change) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i].entry(), changes[j].entry()
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Line < b.Line
+	})
+}
+
+func appendName(parents []string, name string) []string {
+	return append(append([]string(nil), parents...), name)
+}
+
+func entryPtr(e Entry) *Entry {
+	return &e
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
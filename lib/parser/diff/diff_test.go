@@ -0,0 +1,269 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func test(name string, line int) domain.Test {
+	return domain.Test{Name: name, Status: domain.TestStatusActive, Location: domain.Location{StartLine: line}}
+}
+
+func inventory(files ...domain.TestFile) *domain.Inventory {
+	return &domain.Inventory{Files: files}
+}
+
+func kinds(changes []Change) []ChangeKind {
+	out := make([]ChangeKind, len(changes))
+	for i, c := range changes {
+		out[i] = c.Kind
+	}
+	return out
+}
+
+func assertKinds(t *testing.T, changes []Change, want ...ChangeKind) {
+	t.Helper()
+	got := kinds(changes)
+	if len(got) != len(want) {
+		t.Fatalf("changes = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("changes = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report no changes for identical inventories", func(t *testing.T) {
+		t.Parallel()
+
+		file := domain.TestFile{
+			Framework: "jest",
+			Path:      "a.test.ts",
+			Suites:    []domain.TestSuite{{Name: "suite", Tests: []domain.Test{test("works", 2)}}},
+			Tests:     []domain.Test{test("top", 10)},
+		}
+
+		got := Compare(inventory(file), inventory(file))
+
+		if got.HasChanges() {
+			t.Errorf("HasChanges() = true, changes: %+v", got.Tests)
+		}
+		if got.Summary.BaseTests != 2 || got.Summary.HeadTests != 2 {
+			t.Errorf("Summary = %+v, want 2 base and 2 head tests", got.Summary)
+		}
+	})
+
+	t.Run("should treat nil inventories as empty", func(t *testing.T) {
+		t.Parallel()
+
+		head := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{test("TestA", 1)}})
+
+		got := Compare(nil, head)
+
+		assertKinds(t, got.Tests, KindAdded)
+		if got.Summary.Added != 1 || got.Summary.BaseTests != 0 {
+			t.Errorf("Summary = %+v", got.Summary)
+		}
+	})
+
+	t.Run("should detect added, removed and status-changed tests", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{
+			test("TestHandler", 1),
+			test("TestLegacy", 5),
+		}})
+		skipped := test("TestHandler", 1)
+		skipped.Status = domain.TestStatusSkipped
+		head := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{
+			skipped,
+			test("TestNew", 5),
+		}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindStatusChanged, KindRemoved, KindAdded)
+		change := got.Tests[0]
+		if change.Base.Status != domain.TestStatusActive || change.Head.Status != domain.TestStatusSkipped {
+			t.Errorf("status change = %s -> %s", change.Base.Status, change.Head.Status)
+		}
+		if !change.StatusChanged() {
+			t.Error("StatusChanged() = false, want true")
+		}
+	})
+
+	t.Run("should detect renamed tests in the same suite", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a.test.ts", Suites: []domain.TestSuite{{
+			Name:  "user",
+			Tests: []domain.Test{test("creates user", 2), test("deletes user", 6)},
+		}}})
+		head := inventory(domain.TestFile{Path: "a.test.ts", Suites: []domain.TestSuite{{
+			Name:  "user",
+			Tests: []domain.Test{test("creates a user", 2), test("deletes user", 6)},
+		}}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindRenamed)
+		if got.Tests[0].Base.Name != "creates user" || got.Tests[0].Head.Name != "creates a user" {
+			t.Errorf("rename = %q -> %q", got.Tests[0].Base.Name, got.Tests[0].Head.Name)
+		}
+		if got.Summary.Renamed != 1 || got.Summary.Added != 0 || got.Summary.Removed != 0 {
+			t.Errorf("Summary = %+v", got.Summary)
+		}
+	})
+
+	t.Run("should prefer the closest location among equally similar renames", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a_test.py", Tests: []domain.Test{
+			test("test_parse_a", 1),
+			test("test_parse_b", 20),
+		}})
+		head := inventory(domain.TestFile{Path: "a_test.py", Tests: []domain.Test{
+			test("test_parse_c", 21),
+		}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindRemoved, KindRenamed)
+		if got.Tests[1].Base.Name != "test_parse_b" {
+			t.Errorf("renamed from %q, want test_parse_b", got.Tests[1].Base.Name)
+		}
+	})
+
+	t.Run("should not treat dissimilar names as renames", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{test("TestSub", 3)}})
+		head := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{test("TestMul", 3)}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindRemoved, KindAdded)
+	})
+
+	t.Run("should disable renames with threshold 1", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a.test.ts", Tests: []domain.Test{test("creates user", 1)}})
+		head := inventory(domain.TestFile{Path: "a.test.ts", Tests: []domain.Test{test("creates a user", 1)}})
+
+		got := Compare(base, head, WithRenameThreshold(1))
+
+		assertKinds(t, got.Tests, KindRemoved, KindAdded)
+	})
+
+	t.Run("should detect tests moved between files", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(
+			domain.TestFile{Framework: "go-testing", Path: "a_test.go", Tests: []domain.Test{test("TestA", 1), test("TestB", 5)}},
+		)
+		head := inventory(
+			domain.TestFile{Framework: "go-testing", Path: "a_test.go", Tests: []domain.Test{test("TestA", 1)}},
+			domain.TestFile{Framework: "go-testing", Path: "b_test.go", Tests: []domain.Test{test("TestB", 1)}},
+		)
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindMoved)
+		if got.Tests[0].Base.Path != "a_test.go" || got.Tests[0].Head.Path != "b_test.go" {
+			t.Errorf("move = %s -> %s", got.Tests[0].Base.Path, got.Tests[0].Head.Path)
+		}
+	})
+
+	t.Run("should report a renamed suite without reporting its tests", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a.test.ts", Suites: []domain.TestSuite{{
+			Name: "UserService",
+			Suites: []domain.TestSuite{{
+				Name:  "create",
+				Tests: []domain.Test{test("works", 3)},
+			}},
+		}}})
+		head := inventory(domain.TestFile{Path: "a.test.ts", Suites: []domain.TestSuite{{
+			Name: "UserServices",
+			Suites: []domain.TestSuite{{
+				Name:  "create",
+				Tests: []domain.Test{test("works", 3)},
+			}},
+		}}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Suites, KindRenamed)
+		assertKinds(t, got.Tests)
+	})
+
+	t.Run("should match duplicate names in order", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{test("(dynamic)", 1), test("(dynamic)", 2)}})
+		head := inventory(domain.TestFile{Path: "a_test.go", Tests: []domain.Test{test("(dynamic)", 1)}})
+
+		got := Compare(base, head)
+
+		assertKinds(t, got.Tests, KindRemoved)
+		if got.Tests[0].Base.Line != 2 {
+			t.Errorf("removed line = %d, want 2", got.Tests[0].Base.Line)
+		}
+	})
+
+	t.Run("should summarize per framework", func(t *testing.T) {
+		t.Parallel()
+
+		base := inventory(
+			domain.TestFile{Framework: "jest", Path: "a.test.ts", Tests: []domain.Test{test("old", 1)}},
+			domain.TestFile{Framework: "pytest", Path: "test_a.py", Tests: []domain.Test{test("test_a", 1)}},
+		)
+		head := inventory(
+			domain.TestFile{Framework: "jest", Path: "a.test.ts"},
+			domain.TestFile{Framework: "pytest", Path: "test_a.py", Tests: []domain.Test{test("test_a", 1), test("test_xyz", 5)}},
+		)
+
+		got := Compare(base, head)
+
+		if len(got.Frameworks) != 2 {
+			t.Fatalf("Frameworks = %+v, want 2", got.Frameworks)
+		}
+		jest, pytest := got.Frameworks[0], got.Frameworks[1]
+		if jest.Framework != "jest" || jest.Removed != 1 || jest.HeadTests != 0 {
+			t.Errorf("jest = %+v", jest)
+		}
+		if pytest.Framework != "pytest" || pytest.Added != 1 || pytest.BaseTests != 1 || pytest.HeadTests != 2 {
+			t.Errorf("pytest = %+v", pytest)
+		}
+	})
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{a: "works", b: "works", min: 1, max: 1},
+		{a: "Works", b: "works", min: 1, max: 1},
+		{a: "creates user", b: "creates a user", min: 0.9, max: 1},
+		{a: "TestSub", b: "TestMul", min: 0, max: DefaultRenameThreshold - 0.01},
+		{a: "a", b: "b", min: 0, max: 0},
+	}
+
+	for _, tt := range tests {
+		got := similarity(tt.a, tt.b)
+		if got < tt.min || got > tt.max {
+			t.Errorf("similarity(%q, %q) = %v, want in [%v, %v]", tt.a, tt.b, got, tt.min, tt.max)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+// Severity ranks a lint finding. Values match SARIF result levels.
+type Severity string
+
+const (
+	// SeverityError marks findings that should fail a check (e.g., a committed .only).
+	SeverityError Severity = "error"
+	// SeverityWarning marks likely problems worth fixing.
+	SeverityWarning Severity = "warning"
+	// SeverityNote marks stylistic or informational findings.
+	SeverityNote Severity = "note"
+)
+
+// Finding is a test smell reported by a lint rule.
+type Finding struct {
+	// Location is the source range the finding points at.
+	Location Location `json:"location"`
+	// Message describes the problem.
+	Message string `json:"message"`
+	// Rule is the ID of the rule that reported the finding (e.g., "focused-test").
+	Rule string `json:"rule"`
+	// Severity is the configured severity of the rule.
+	Severity Severity `json:"severity"`
+}
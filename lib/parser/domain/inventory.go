@@ -9,16 +9,43 @@ type DomainHints struct {
 	Imports []string `json:"imports,omitempty"`
 }
 
+// Detection explains why a file was attributed to its framework.
+type Detection struct {
+	// Confidence is the score (0-100) of the deciding signal.
+	Confidence int `json:"confidence"`
+	// Evidence lists the indicators behind the decision (e.g., "import: vitest").
+	Evidence []string `json:"evidence,omitempty"`
+	// Source is the detection level that decided the framework
+	// (e.g., "import", "strong-filename", "config-scope").
+	Source string `json:"source"`
+}
+
+// Project identifies the package or module that owns a test file in a monorepo.
+type Project struct {
+	// Kind is the build system declaring the project (e.g., "go", "npm", "cargo").
+	Kind string `json:"kind"`
+	// Name is the declared package or module name; defaults to the directory name.
+	Name string `json:"name"`
+	// Path is the slash-separated project directory relative to the source root ("." for the root).
+	Path string `json:"path"`
+}
+
 // TestFile represents a parsed test file.
 type TestFile struct {
+	// Detection explains how Framework was chosen.
+	Detection *Detection `json:"detection,omitempty"`
 	// DomainHints contains metadata for AI-based domain classification.
 	DomainHints *DomainHints `json:"domainHints,omitempty"`
+	// Findings are the test smells reported by lint rules, if linting is enabled.
+	Findings []Finding `json:"findings,omitempty"`
 	// Framework is the detected test framework (e.g., "jest", "vitest").
 	Framework string `json:"framework"`
 	// Language is the programming language of this file.
 	Language Language `json:"language"`
 	// Path is the file path.
 	Path string `json:"path"`
+	// Project is the package or module owning this file, if any.
+	Project *Project `json:"project,omitempty"`
 	// Suites contains the test suites in this file.
 	Suites []TestSuite `json:"suites,omitempty"`
 	// Tests contains the top-level tests in this file (outside any suite).
@@ -40,6 +67,9 @@ type Inventory struct {
 	Files []TestFile `json:"files"`
 	// RootPath is the root directory path of the scanned project.
 	RootPath string `json:"rootPath"`
+	// SourceGraph links test files to the production files they import.
+	// Only built when requested (see parser.WithSourceGraph).
+	SourceGraph *SourceGraph `json:"sourceGraph,omitempty"`
 }
 
 // CountTests returns the total number of tests across all files.
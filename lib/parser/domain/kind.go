@@ -0,0 +1,41 @@
+package domain
+
+// TestKind classifies what a test exercises.
+// Maps to test_cases.kind: unit, integration, e2e, benchmark, fuzz, example
+type TestKind string
+
+// Test kind values aligned with DB schema.
+const (
+	// TestKindUnit is the default for tests with no framework or path evidence of another kind.
+	TestKindUnit TestKind = "unit"
+	// TestKindIntegration indicates a test exercising real collaborators (databases, services).
+	TestKindIntegration TestKind = "integration"
+	// TestKindE2E indicates an end-to-end test driving the whole application (Playwright, Cypress).
+	TestKindE2E TestKind = "e2e"
+	// TestKindBenchmark indicates a performance benchmark (Go Benchmark*, Criterion).
+	TestKindBenchmark TestKind = "benchmark"
+	// TestKindFuzz indicates a fuzz target (Go Fuzz*).
+	TestKindFuzz TestKind = "fuzz"
+	// TestKindExample indicates a documentation example verified by the test runner (Go Example*).
+	TestKindExample TestKind = "example"
+)
+
+// TestKinds lists every kind in a stable order.
+var TestKinds = []TestKind{
+	TestKindUnit,
+	TestKindIntegration,
+	TestKindE2E,
+	TestKindBenchmark,
+	TestKindFuzz,
+	TestKindExample,
+}
+
+// IsValid reports whether k is a known kind.
+func (k TestKind) IsValid() bool {
+	for _, kind := range TestKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
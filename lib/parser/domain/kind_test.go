@@ -0,0 +1,19 @@
+package domain
+
+import "testing"
+
+func TestTestKind_IsValid(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range TestKinds {
+		if !kind.IsValid() {
+			t.Errorf("expected %q to be valid", kind)
+		}
+	}
+
+	for _, kind := range []TestKind{"", "smoke", "E2E"} {
+		if kind.IsValid() {
+			t.Errorf("expected %q to be invalid", kind)
+		}
+	}
+}
@@ -8,6 +8,10 @@ type Language string
 const (
 	LanguageCpp        Language = "cpp"
 	LanguageCSharp     Language = "csharp"
+	LanguageDart       Language = "dart"
+	LanguageElixir     Language = "elixir"
+	LanguageErlang     Language = "erlang"
+	LanguageGherkin    Language = "gherkin"
 	LanguageGo         Language = "go"
 	LanguageJava       Language = "java"
 	LanguageJavaScript Language = "javascript"
@@ -16,6 +20,7 @@ const (
 	LanguagePython     Language = "python"
 	LanguageRuby       Language = "ruby"
 	LanguageRust       Language = "rust"
+	LanguageScala      Language = "scala"
 	LanguageSwift      Language = "swift"
 	LanguageTSX        Language = "tsx"
 	LanguageTypeScript Language = "typescript"
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"path"
+	"sort"
+)
+
+// SourceGraph links test files to the production source files they import.
+// All paths are slash-separated and relative to the scanned root.
+type SourceGraph struct {
+	// Edges maps each test file to the production files it imports, sorted.
+	// Test files whose imports resolve to no production file are omitted.
+	Edges map[string][]string `json:"edges"`
+	// Sources lists every production source file of the resolved languages, sorted.
+	Sources []string `json:"sources"`
+}
+
+// TestsFor returns the test files importing the production file, sorted.
+func (g *SourceGraph) TestsFor(sourcePath string) []string {
+	if g == nil {
+		return nil
+	}
+
+	var tests []string
+	for test, sources := range g.Edges {
+		i := sort.SearchStrings(sources, sourcePath)
+		if i < len(sources) && sources[i] == sourcePath {
+			tests = append(tests, test)
+		}
+	}
+	sort.Strings(tests)
+	return tests
+}
+
+// UntestedDirs returns the directories holding production files of which
+// no test imports any, sorted.
+func (g *SourceGraph) UntestedDirs() []string {
+	if g == nil {
+		return nil
+	}
+
+	tested := make(map[string]bool)
+	for _, sources := range g.Edges {
+		for _, source := range sources {
+			tested[path.Dir(source)] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, source := range g.Sources {
+		dir := path.Dir(source)
+		if tested[dir] || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
@@ -10,6 +10,47 @@ type Test struct {
 	Status TestStatus `json:"status"`
 	// Modifier is the original framework marker (skip, todo, fixme, @Disabled, etc.).
 	Modifier string `json:"modifier,omitempty"`
+	// SkipReason is the literal reason given for skipping, disabling or expecting failure
+	// (e.g., "flaky on CI" from @Disabled("flaky on CI")). Empty if none is written in source.
+	SkipReason string `json:"skipReason,omitempty"`
+	// Tags are framework-native labels declared on this test (pytest markers, @Tag, groups, etc.).
+	// Tags declared on enclosing suites are not repeated here.
+	Tags []string `json:"tags,omitempty"`
+	// Kind classifies the test (unit, integration, e2e, benchmark, fuzz, example).
+	// Parsers set it from framework semantics; the scanner fills the rest from
+	// the framework default and path rules.
+	Kind TestKind `json:"kind,omitempty"`
+	// Template links an expanded parameterized case back to the test it was generated from.
+	// Only set when parameterized expansion is enabled.
+	Template *TestTemplate `json:"template,omitempty"`
+	// Metrics measures the test body. Only set when test metrics are enabled
+	// and the language's syntax tree is available.
+	Metrics *TestMetrics `json:"metrics,omitempty"`
+	// Fingerprint is a hex hash of the test definition ignoring whitespace,
+	// comments and the title; it stays the same when the test is renamed or
+	// moved. Only set when fingerprints are enabled and the language's syntax
+	// tree is available.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// TestMetrics are size and assertion metrics of a test definition.
+type TestMetrics struct {
+	// Assertions is the number of assertion calls and assert statements.
+	Assertions int `json:"assertions"`
+	// Depth is the deepest nesting of control flow (if, loops, switch, try)
+	// in the body; 0 for straight-line tests.
+	Depth int `json:"depth"`
+	// Lines counts the lines of code of the definition, without blank and
+	// comment-only lines.
+	Lines int `json:"lines"`
+}
+
+// TestTemplate identifies the parameterized test a case was expanded from.
+type TestTemplate struct {
+	// Index is the 0-based position of the case within the template's parameter set.
+	Index int `json:"index"`
+	// Name is the unexpanded test name (e.g., "adds %i + %i", "test_add").
+	Name string `json:"name"`
 }
 
 // TestSuite represents a test suite (describe, test.describe).
@@ -22,8 +63,12 @@ type TestSuite struct {
 	Status TestStatus `json:"status"`
 	// Modifier is the original framework marker (skip, todo, fixme, @Disabled, etc.).
 	Modifier string `json:"modifier,omitempty"`
+	// SkipReason is the literal reason given for skipping or disabling the suite.
+	SkipReason string `json:"skipReason,omitempty"`
 	// Suites contains nested test suites.
 	Suites []TestSuite `json:"suites,omitempty"`
+	// Tags are framework-native labels declared on this suite; they apply to all nested tests.
+	Tags []string `json:"tags,omitempty"`
 	// Tests contains the tests in this suite.
 	Tests []Test `json:"tests,omitempty"`
 }
@@ -36,3 +81,27 @@ func (s *TestSuite) CountTests() int {
 	}
 	return count
 }
+
+// MergeTags returns inherited followed by the own tags not already present,
+// yielding the effective tags of a test nested under tagged suites.
+// Returns nil if both are empty.
+func MergeTags(inherited, own []string) []string {
+	var merged []string
+	for _, group := range [][]string{inherited, own} {
+		for _, tag := range group {
+			if !containsTag(merged, tag) {
+				merged = append(merged, tag)
+			}
+		}
+	}
+	return merged
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
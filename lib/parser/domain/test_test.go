@@ -63,3 +63,47 @@ func TestTestSuite_CountTests(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inherited []string
+		own       []string
+		want      []string
+	}{
+		{
+			name: "should return nil when both are empty",
+			want: nil,
+		},
+		{
+			name:      "should keep inherited tags first",
+			inherited: []string{"integration"},
+			own:       []string{"slow"},
+			want:      []string{"integration", "slow"},
+		},
+		{
+			name:      "should drop duplicates",
+			inherited: []string{"db", "slow"},
+			own:       []string{"slow", "db", "smoke"},
+			want:      []string{"db", "slow", "smoke"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MergeTags(tt.inherited, tt.own)
+			if len(got) != len(tt.want) || (tt.want == nil) != (got == nil) {
+				t.Fatalf("MergeTags() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("MergeTags()[%d] = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
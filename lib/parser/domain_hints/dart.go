@@ -0,0 +1,281 @@
+package domain_hints
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// DartExtractor extracts domain hints from Dart source code.
+//
+// There is no Dart grammar in the tree-sitter bindings, so imports are read
+// from directives and calls are matched after comments and string contents
+// have been blanked out.
+type DartExtractor struct{}
+
+var (
+	// import 'package:my_app/cart.dart'; export 'src/helpers.dart';
+	dartImportPattern = regexp.MustCompile(`(?m)^\s*(?:import|export)\s+r?['"]([^'"]+)['"]`)
+
+	// cart.addItem(, CartService(, repository?.findById(, Future<int>.value(
+	dartCallPattern = regexp.MustCompile(`([A-Za-z_$][\w$]*(?:\s*\??\.\s*[A-Za-z_$][\w$]*)*)\s*(?:<[\w$<>?, ]*>)?\s*\(`)
+)
+
+func (e *DartExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(source),
+		Calls:   e.extractCalls(blankDartCommentsAndStrings(source)),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *DartExtractor) extractImports(source []byte) []string {
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, match := range dartImportPattern.FindAllSubmatch(source, -1) {
+		uri := string(match[1])
+		if ShouldFilterImportNoise(uri) || isDartStdlibImport(uri) || isDartTestFrameworkImport(uri) {
+			continue
+		}
+		if _, exists := seen[uri]; exists {
+			continue
+		}
+		seen[uri] = struct{}{}
+		imports = append(imports, uri)
+	}
+
+	return imports
+}
+
+func (e *DartExtractor) extractCalls(code []byte) []string {
+	matches := dartCallPattern.FindAllSubmatchIndex(code, -1)
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		// Skip declarations and member continuations: "void add(", ").then(".
+		if isDartDeclarationOrChain(code, match[2]) {
+			continue
+		}
+
+		call := strings.ReplaceAll(string(code[match[2]:match[3]]), "?", "")
+		call = normalizeCall(call)
+		if call == "" {
+			continue
+		}
+		if ShouldFilterNoise(call) {
+			continue
+		}
+		if isDartKeyword(call) || isDartTestFrameworkCall(call) {
+			continue
+		}
+		if _, exists := seen[call]; exists {
+			continue
+		}
+		seen[call] = struct{}{}
+		calls = append(calls, call)
+	}
+
+	return calls
+}
+
+// isDartDeclarationOrChain reports whether the identifier starting at start
+// names a declared function or continues a chain (").then(", "..add(").
+func isDartDeclarationOrChain(code []byte, start int) bool {
+	i := start - 1
+	for i >= 0 && (code[i] == ' ' || code[i] == '\t') {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	if code[i] == '.' {
+		return true
+	}
+	// A preceding type (void, int, Future<void>) makes this a declaration.
+	if code[i] == '>' || code[i] == '?' {
+		return true
+	}
+	end := i + 1
+	for i >= 0 && (isDartIdentByte(code[i])) {
+		i--
+	}
+	if end == i+1 {
+		return false
+	}
+	_, expression := dartExpressionKeywords[string(code[i+1:end])]
+	return !expression
+}
+
+func isDartIdentByte(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// blankDartCommentsAndStrings replaces comments and string literal contents with
+// spaces, keeping byte offsets and line breaks, so patterns only see code.
+func blankDartCommentsAndStrings(source []byte) []byte {
+	code := make([]byte, len(source))
+	copy(code, source)
+
+	blank := func(from, to int) {
+		for k := from; k < to && k < len(code); k++ {
+			if code[k] != '\n' {
+				code[k] = ' '
+			}
+		}
+	}
+
+	for i := 0; i < len(code); {
+		switch {
+		case code[i] == '/' && i+1 < len(code) && code[i+1] == '/':
+			end := i
+			for end < len(code) && code[end] != '\n' {
+				end++
+			}
+			blank(i, end)
+			i = end
+		case code[i] == '/' && i+1 < len(code) && code[i+1] == '*':
+			depth, end := 0, i
+			for end < len(code) {
+				if code[end] == '/' && end+1 < len(code) && code[end+1] == '*' {
+					depth++
+					end += 2
+					continue
+				}
+				if code[end] == '*' && end+1 < len(code) && code[end+1] == '/' {
+					depth--
+					end += 2
+					if depth == 0 {
+						break
+					}
+					continue
+				}
+				end++
+			}
+			blank(i, end)
+			i = end
+		case code[i] == '\'' || code[i] == '"':
+			raw := i > 0 && (code[i-1] == 'r' || code[i-1] == 'R') && (i < 2 || !isDartIdentByte(code[i-2]))
+			end := skipDartString(code, i, raw)
+			blank(i, end)
+			i = end
+		default:
+			i++
+		}
+	}
+
+	return code
+}
+
+// skipDartString returns the offset just past the string literal opening at start.
+func skipDartString(code []byte, start int, raw bool) int {
+	quote := code[start]
+	triple := start+2 < len(code) && code[start+1] == quote && code[start+2] == quote
+	i := start + 1
+	if triple {
+		i = start + 3
+	}
+
+	for i < len(code) {
+		switch {
+		case code[i] == '\\' && !raw:
+			i += 2
+		case code[i] == '\n' && !triple:
+			return i
+		case code[i] == quote && !triple:
+			return i + 1
+		case code[i] == quote && i+2 < len(code) && code[i+1] == quote && code[i+2] == quote:
+			return i + 3
+		default:
+			i++
+		}
+	}
+
+	return len(code)
+}
+
+// isDartStdlibImport reports whether the URI is a dart: core library.
+func isDartStdlibImport(uri string) bool {
+	return strings.HasPrefix(uri, "dart:")
+}
+
+// dartTestFrameworkImports lists test packages that carry no domain signal.
+var dartTestFrameworkImports = []string{
+	"package:test/",
+	"package:test_api/",
+	"package:flutter_test/",
+	"package:integration_test/",
+	"package:golden_toolkit/",
+	"package:mockito/",
+	"package:mocktail/",
+	"package:bloc_test/",
+}
+
+func isDartTestFrameworkImport(uri string) bool {
+	for _, prefix := range dartTestFrameworkImports {
+		if strings.HasPrefix(uri, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dartExpressionKeywords may directly precede a call expression.
+var dartExpressionKeywords = map[string]struct{}{
+	"return": {}, "await": {}, "yield": {}, "new": {}, "const": {},
+	"throw": {}, "else": {}, "in": {}, "is": {}, "as": {}, "case": {},
+}
+
+// dartKeywords look like calls ("if (", "for (") but are statements.
+var dartKeywords = map[string]struct{}{
+	"if": {}, "for": {}, "while": {}, "switch": {}, "catch": {}, "assert": {},
+	"return": {}, "super": {}, "this": {}, "late": {}, "sync": {}, "async": {},
+}
+
+func isDartKeyword(call string) bool {
+	_, exists := dartKeywords[call]
+	return exists
+}
+
+// dartTestFrameworkCalls contains base names from package:test, flutter_test,
+// mockito/mocktail and Dart core helpers that should be excluded from domain hints.
+var dartTestFrameworkCalls = map[string]struct{}{
+	// package:test structure
+	"group": {}, "test": {}, "setUp": {}, "tearDown": {},
+	"setUpAll": {}, "tearDownAll": {}, "addTearDown": {},
+	// package:test matchers
+	"expect": {}, "expectLater": {}, "equals": {}, "isA": {}, "throwsA": {},
+	"contains": {}, "completion": {}, "emits": {}, "emitsInOrder": {},
+	"predicate": {}, "closeTo": {}, "hasLength": {}, "fail": {},
+	"markTestSkipped": {}, "printOnFailure": {},
+	// flutter_test
+	"testWidgets": {}, "testGoldens": {}, "tester": {}, "find": {},
+	"matchesGoldenFile": {}, "screenMatchesGolden": {},
+	"TestWidgetsFlutterBinding": {}, "IntegrationTestWidgetsFlutterBinding": {},
+	// mockito / mocktail
+	"when": {}, "verify": {}, "verifyNever": {}, "verifyNoMoreInteractions": {},
+	"any": {}, "anyNamed": {}, "captureAny": {}, "registerFallbackValue": {},
+	"reset": {}, "thenReturn": {}, "thenAnswer": {}, "thenThrow": {},
+	// Dart core (no domain signal)
+	"print": {}, "identical": {}, "Future": {}, "Stream": {}, "Duration": {},
+	"DateTime": {}, "List": {}, "Map": {}, "Set": {}, "Exception": {},
+	"StateError": {}, "ArgumentError": {}, "UnimplementedError": {},
+}
+
+func isDartTestFrameworkCall(call string) bool {
+	baseName := call
+	if idx := strings.Index(call, "."); idx > 0 {
+		baseName = call[:idx]
+	}
+	_, exists := dartTestFrameworkCalls[baseName]
+	return exists
+}
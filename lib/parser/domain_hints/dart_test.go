@@ -0,0 +1,136 @@
+package domain_hints
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestDartExtractor_Extract_Imports(t *testing.T) {
+	source := []byte(`
+import 'dart:async';
+import 'package:test/test.dart';
+import 'package:mocktail/mocktail.dart';
+import 'package:shop/cart/cart_service.dart' as cart;
+import "../fixtures/products.dart";
+export 'package:shop/payment/gateway.dart';
+`)
+
+	extractor := &DartExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{
+		"package:shop/cart/cart_service.dart",
+		"../fixtures/products.dart",
+		"package:shop/payment/gateway.dart",
+	}
+	if !reflect.DeepEqual(hints.Imports, expected) {
+		t.Errorf("expected imports %v, got %v", expected, hints.Imports)
+	}
+}
+
+func TestDartExtractor_Extract_Calls(t *testing.T) {
+	source := []byte(`
+import 'package:test/test.dart';
+
+void main() {
+  group('CartService', () {
+    late CartService service;
+
+    setUp(() {
+      service = CartService(InMemoryRepository());
+    });
+
+    test('adds an item', () async {
+      await service.addItem(Product(id: 1));
+      final total = service.total();
+      repository?.findById(1);
+      expect(total, equals(10));
+      // paymentGateway.charge(total);
+      print('orderService.place()');
+    });
+  });
+}
+
+Future<void> helper() async {}
+`)
+
+	extractor := &DartExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	callSet := make(map[string]bool)
+	for _, call := range hints.Calls {
+		callSet[call] = true
+	}
+
+	for _, call := range []string{"CartService", "InMemoryRepository", "service.addItem", "Product", "service.total", "repository.findById"} {
+		if !callSet[call] {
+			t.Errorf("expected call %q to be included, got %v", call, hints.Calls)
+		}
+	}
+	for _, call := range []string{"group", "setUp", "test", "expect", "equals", "print", "main", "helper", "paymentGateway.charge", "orderService.place"} {
+		if callSet[call] {
+			t.Errorf("expected call %q to be excluded, got %v", call, hints.Calls)
+		}
+	}
+}
+
+func TestDartExtractor_Extract_FlutterWidgetTest(t *testing.T) {
+	source := []byte(`
+import 'package:flutter_test/flutter_test.dart';
+import 'package:counter/counter_page.dart';
+
+void main() {
+  testWidgets('increments', (WidgetTester tester) async {
+    await tester.pumpWidget(const CounterPage());
+    await tester.tap(find.byIcon(Icons.add));
+    expect(find.text('1'), findsOneWidget);
+  });
+}
+`)
+
+	extractor := &DartExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+	if !reflect.DeepEqual(hints.Imports, []string{"package:counter/counter_page.dart"}) {
+		t.Errorf("unexpected imports %v", hints.Imports)
+	}
+	if !reflect.DeepEqual(hints.Calls, []string{"CounterPage"}) {
+		t.Errorf("expected only CounterPage call, got %v", hints.Calls)
+	}
+}
+
+func TestDartExtractor_Extract_EmptyFile(t *testing.T) {
+	source := []byte(`// empty file`)
+
+	extractor := &DartExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints != nil {
+		t.Errorf("expected nil for empty file, got %+v", hints)
+	}
+}
+
+func TestGetExtractor_Dart(t *testing.T) {
+	ext := GetExtractor(domain.LanguageDart)
+	if ext == nil {
+		t.Fatal("expected extractor for Dart, got nil")
+	}
+
+	if _, ok := ext.(*DartExtractor); !ok {
+		t.Errorf("expected DartExtractor, got %T", ext)
+	}
+}
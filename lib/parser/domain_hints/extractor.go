@@ -39,6 +39,8 @@ func GetExtractor(lang domain.Language) Extractor {
 		return &SwiftExtractor{}
 	case domain.LanguageCpp:
 		return &CppExtractor{}
+	case domain.LanguageDart:
+		return &DartExtractor{}
 	default:
 		return nil
 	}
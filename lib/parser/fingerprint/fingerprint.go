@@ -0,0 +1,145 @@
+// Package fingerprint computes normalized fingerprints of test definitions.
+//
+// A fingerprint hashes the tokens of a test's syntax node, leaving out
+// whitespace, comments and the title, so it survives renaming the test,
+// reformatting it and moving it to another file or suite. Go methods hash
+// only their body, as moving one to another suite changes its receiver. Consumers use it
+// to recognize a test whose (path, suite path, name) identity changed.
+//
+// Fingerprints are computed on the tree-sitter tree of the file, so they are
+// only available for languages tspool can parse.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// Size is the length in bytes of a fingerprint before hex encoding.
+const Size = 16
+
+// titleSlack bounds the quoting and prefix characters around a title
+// literal (r#"..."#, @"...", f'...').
+const titleSlack = 6
+
+// Supports reports whether tests in lang can be fingerprinted, which needs a
+// tree-sitter grammar. Dart, Erlang and Gherkin have none.
+func Supports(lang domain.Language) bool {
+	return tspool.Supports(lang)
+}
+
+// Apply sets Fingerprint on the tests of file whose definition is found in
+// tree, the syntax tree of content. Files without a tree, or in languages
+// Supports rejects, are left unchanged.
+func Apply(file *domain.TestFile, tree *sitter.Tree, content []byte) {
+	if tree == nil || !Supports(file.Language) {
+		return
+	}
+
+	f := &fingerprinter{
+		byLocation: make(map[locationKey]string),
+		content:    content,
+		root:       tree.RootNode(),
+	}
+	f.applyTests(file.Tests)
+	for i := range file.Suites {
+		f.applySuite(&file.Suites[i])
+	}
+}
+
+// locationKey identifies a test definition. Tests written on the same lines
+// differ by title; expanded parameterized cases share their template's title.
+type locationKey struct {
+	loc  domain.Location
+	name string
+}
+
+type fingerprinter struct {
+	byLocation map[locationKey]string
+	content    []byte
+	root       *sitter.Node
+}
+
+func (f *fingerprinter) applySuite(suite *domain.TestSuite) {
+	f.applyTests(suite.Tests)
+	for i := range suite.Suites {
+		f.applySuite(&suite.Suites[i])
+	}
+}
+
+func (f *fingerprinter) applyTests(tests []domain.Test) {
+	for i := range tests {
+		title := tests[i].Name
+		if tests[i].Template != nil {
+			title = tests[i].Template.Name
+		}
+		key := locationKey{loc: tests[i].Location, name: title}
+		sum, ok := f.byLocation[key]
+		if !ok {
+			if node := tspool.FindNode(f.root, key.loc); node != nil {
+				sum = f.hash(definition(node, title))
+			}
+			f.byLocation[key] = sum
+		}
+		tests[i].Fingerprint = sum
+	}
+}
+
+// definition returns the node to hash for a test's syntax node and the title
+// to skip in it. A Go method's receiver names its suite, and its signature
+// holds the title, so only the body is kept.
+func definition(node *sitter.Node, title string) (*sitter.Node, string) {
+	if node.Type() == "method_declaration" {
+		if body := node.ChildByFieldName("body"); body != nil && node.ChildByFieldName("receiver") != nil {
+			return body, ""
+		}
+	}
+	return node, title
+}
+
+// hash digests the leaf tokens of node, skipping comments, statement
+// terminators and the first node whose text is the title.
+func (f *fingerprinter) hash(node *sitter.Node, title string) string {
+	h := sha256.New()
+	titleSkipped := title == ""
+	var visit func(n *sitter.Node, depth int)
+	visit = func(n *sitter.Node, depth int) {
+		if depth > tspool.MaxTreeDepth || strings.Contains(n.Type(), "comment") {
+			return
+		}
+		if !titleSkipped && int(n.EndByte()-n.StartByte()) <= len(title)+titleSlack &&
+			unquote(n.Content(f.content)) == title {
+			titleSkipped = true
+			return
+		}
+		if n.ChildCount() == 0 {
+			// Statement terminators vary with line breaks (Go inserts them at
+			// newlines), so they are formatting rather than code.
+			if text := strings.TrimSpace(n.Content(f.content)); text != "" && text != ";" {
+				h.Write([]byte(text))
+				h.Write([]byte{0})
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			visit(n.Child(i), depth+1)
+		}
+	}
+	visit(node, 0)
+	return hex.EncodeToString(h.Sum(nil)[:Size])
+}
+
+// unquote strips string delimiters and prefixes (r"...", @"...", b'...', `...`,
+// r#"..."#) from a title literal. Other text is returned unchanged.
+func unquote(text string) string {
+	if i := strings.IndexAny(text, "\"'`"); i >= 0 && i <= 2 {
+		text = text[i:]
+	}
+	return strings.Trim(text, "\"'`#")
+}
@@ -0,0 +1,172 @@
+package fingerprint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/fingerprint"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/gotesting"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/jest"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/pytest"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+func fingerprints(t *testing.T, def *framework.Definition, filename, source string) map[string]string {
+	t.Helper()
+
+	file, err := def.Parser.Parse(context.Background(), []byte(source), filename)
+	require.NoError(t, err)
+	tree, err := tspool.Parse(context.Background(), file.Language, []byte(source))
+	require.NoError(t, err)
+	defer tree.Close()
+	fingerprint.Apply(file, tree, []byte(source))
+
+	out := make(map[string]string)
+	var collect func(tests []domain.Test, suites []domain.TestSuite)
+	collect = func(tests []domain.Test, suites []domain.TestSuite) {
+		for _, test := range tests {
+			out[test.Name] = test.Fingerprint
+		}
+		for _, suite := range suites {
+			collect(suite.Tests, suite.Suites)
+		}
+	}
+	collect(file.Tests, file.Suites)
+	return out
+}
+
+func TestApply_JavaScript(t *testing.T) {
+	original := fingerprints(t, jest.NewDefinition(), "cart.test.ts", `describe("cart", () => {
+  it("adds an item", () => {
+    const cart = add([], 1);
+    expect(cart).toHaveLength(1);
+  });
+
+  it("removes an item", () => {
+    expect(remove([1], 1)).toEqual([]);
+  });
+});
+`)
+	changed := fingerprints(t, jest.NewDefinition(), "basket.test.ts", `describe("basket", () => {
+  // Renamed, reformatted and commented.
+  it('adds one item', () => {
+    const cart = add( [], 1 );
+
+    expect(cart).toHaveLength(1); // one item
+  });
+
+  it("removes an item", () => {
+    expect(remove([1], 2)).toEqual([]);
+  });
+});
+`)
+
+	require.Len(t, original, 2)
+	assert.Len(t, original["adds an item"], 2*fingerprint.Size)
+	assert.Equal(t, original["adds an item"], changed["adds one item"])
+	assert.NotEqual(t, original["removes an item"], changed["removes an item"])
+	assert.NotEqual(t, original["adds an item"], original["removes an item"])
+}
+
+func TestApply_Go(t *testing.T) {
+	original := fingerprints(t, gotesting.NewDefinition(), "cart_test.go", `package cart
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if got := Add(1); got != 1 {
+		t.Errorf("got %d", got)
+	}
+}
+`)
+	renamed := fingerprints(t, gotesting.NewDefinition(), "cart_test.go", `package cart
+
+import "testing"
+
+// TestAddOne adds one item.
+func TestAddOne(t *testing.T) {
+	if got := Add(1); got != 1 { t.Errorf("got %d", got) }
+}
+`)
+
+	require.NotEmpty(t, original["TestAdd"])
+	assert.Equal(t, original["TestAdd"], renamed["TestAddOne"])
+}
+
+func TestApply_GoSuiteMethod(t *testing.T) {
+	original := fingerprints(t, gotesting.NewDefinition(), "cart_test.go", `package cart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CartSuite struct{ suite.Suite }
+
+func (s *CartSuite) TestAdd() {
+	s.Equal(1, Add(1))
+}
+
+func (s *CartSuite) TestRemove() {
+	s.Equal(0, Remove(1))
+}
+
+func TestCartSuite(t *testing.T) { suite.Run(t, new(CartSuite)) }
+`)
+	moved := fingerprints(t, gotesting.NewDefinition(), "basket_test.go", `package cart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BasketSuite struct{ suite.Suite }
+
+func (s BasketSuite) TestAddOne() {
+	s.Equal(1, Add(1))
+}
+
+func TestBasketSuite(t *testing.T) { suite.Run(t, new(BasketSuite)) }
+`)
+
+	require.NotEmpty(t, original["TestAdd"])
+	assert.Equal(t, original["TestAdd"], moved["TestAddOne"])
+	assert.NotEqual(t, original["TestAdd"], original["TestRemove"])
+}
+
+func TestApply_Python(t *testing.T) {
+	got := fingerprints(t, pytest.NewDefinition(), "test_cart.py", `def test_add():
+    """Adds an item."""
+    assert add(1) == 1
+
+
+def test_add_again():
+    assert add(1) == 1
+`)
+
+	require.NotEmpty(t, got["test_add"])
+	assert.NotEqual(t, got["test_add"], got["test_add_again"], "docstrings are part of the body")
+}
+
+func TestApply_UnsupportedLanguage(t *testing.T) {
+	file := &domain.TestFile{
+		Language: domain.LanguageDart,
+		Tests:    []domain.Test{{Name: "adds", Location: domain.Location{StartLine: 1, EndLine: 3}}},
+	}
+	source := []byte("test('adds', () {\n  expect(1, 1);\n});\n")
+	// tspool parses languages without a grammar as TypeScript.
+	tree, err := tspool.Parse(context.Background(), file.Language, source)
+	require.NoError(t, err)
+	defer tree.Close()
+	fingerprint.Apply(file, tree, source)
+
+	assert.False(t, fingerprint.Supports(file.Language))
+	assert.Empty(t, file.Tests[0].Fingerprint)
+}
@@ -23,26 +23,45 @@ const (
 
 // Common framework names as constants to ensure consistency.
 const (
-	FrameworkCargoTest    = "cargo-test"
-	FrameworkCypress      = "cypress"
-	FrameworkGoTesting    = "go-testing"
-	FrameworkGTest        = "gtest"
-	FrameworkJest         = "jest"
-	FrameworkJUnit4       = "junit4"
-	FrameworkJUnit5       = "junit5"
-	FrameworkKotest       = "kotest"
-	FrameworkMinitest     = "minitest"
-	FrameworkMocha        = "mocha"
-	FrameworkMSTest       = "mstest"
-	FrameworkNUnit        = "nunit"
-	FrameworkPHPUnit      = "phpunit"
-	FrameworkPlaywright   = "playwright"
-	FrameworkPytest       = "pytest"
-	FrameworkRSpec        = "rspec"
-	FrameworkSwiftTesting = "swift-testing"
-	FrameworkTestNG       = "testng"
-	FrameworkUnittest     = "unittest"
-	FrameworkVitest       = "vitest"
-	FrameworkXCTest       = "xctest"
-	FrameworkXUnit        = "xunit"
+	FrameworkAVA             = "ava"
+	FrameworkBenchmarkDotNet = "benchmarkdotnet"
+	FrameworkBoostTest       = "boost-test"
+	FrameworkBunTest         = "bun-test"
+	FrameworkCargoTest       = "cargo-test"
+	FrameworkCatch2          = "catch2"
+	FrameworkCommonTest      = "common-test"
+	FrameworkCriterion       = "criterion"
+	FrameworkCypress         = "cypress"
+	FrameworkDartTest        = "dart-test"
+	FrameworkDenoTest        = "deno-test"
+	FrameworkDoctest         = "doctest"
+	FrameworkExUnit          = "exunit"
+	FrameworkFlutterTest     = "flutter-test"
+	FrameworkGherkin         = "gherkin"
+	FrameworkGinkgo          = "ginkgo"
+	FrameworkGoTesting       = "go-testing"
+	FrameworkGTest           = "gtest"
+	FrameworkJasmine         = "jasmine"
+	FrameworkJest            = "jest"
+	FrameworkJUnit4          = "junit4"
+	FrameworkJUnit5          = "junit5"
+	FrameworkKotest          = "kotest"
+	FrameworkMinitest        = "minitest"
+	FrameworkMocha           = "mocha"
+	FrameworkMSTest          = "mstest"
+	FrameworkMUnit           = "munit"
+	FrameworkNodeTest        = "node-test"
+	FrameworkNUnit           = "nunit"
+	FrameworkPHPUnit         = "phpunit"
+	FrameworkPlaywright      = "playwright"
+	FrameworkPytest          = "pytest"
+	FrameworkRSpec           = "rspec"
+	FrameworkScalaTest       = "scalatest"
+	FrameworkSpecs2          = "specs2"
+	FrameworkSwiftTesting    = "swift-testing"
+	FrameworkTestNG          = "testng"
+	FrameworkUnittest        = "unittest"
+	FrameworkVitest          = "vitest"
+	FrameworkXCTest          = "xctest"
+	FrameworkXUnit           = "xunit"
 )
@@ -0,0 +1,69 @@
+package declarative
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+// supportedLanguages are the languages with a tree-sitter grammar.
+// TypeScript covers .tsx files.
+var supportedLanguages = map[domain.Language]bool{
+	domain.LanguageCpp:        true,
+	domain.LanguageCSharp:     true,
+	domain.LanguageElixir:     true,
+	domain.LanguageGo:         true,
+	domain.LanguageJava:       true,
+	domain.LanguageJavaScript: true,
+	domain.LanguageKotlin:     true,
+	domain.LanguagePHP:        true,
+	domain.LanguagePython:     true,
+	domain.LanguageRuby:       true,
+	domain.LanguageRust:       true,
+	domain.LanguageScala:      true,
+	domain.LanguageSwift:      true,
+	domain.LanguageTypeScript: true,
+}
+
+// grammarsFor returns the grammars files of the language are parsed with.
+func grammarsFor(lang domain.Language) []domain.Language {
+	if lang == domain.LanguageTypeScript {
+		return []domain.Language{domain.LanguageTypeScript, domain.LanguageTSX}
+	}
+	return []domain.Language{lang}
+}
+
+// FilenameMatcher matches file base names against glob patterns.
+// It also makes matching files test candidates during discovery.
+type FilenameMatcher struct {
+	Patterns []string
+}
+
+func (m *FilenameMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if pattern, ok := m.match(signal.Value); ok {
+		return framework.DefiniteMatch("filename: " + pattern)
+	}
+	return framework.NoMatch()
+}
+
+func (m *FilenameMatcher) IsCandidate(filePath string) bool {
+	_, ok := m.match(filePath)
+	return ok
+}
+
+func (m *FilenameMatcher) match(filePath string) (string, bool) {
+	base := filepath.Base(filePath)
+	for _, pattern := range m.Patterns {
+		if ok, _ := path.Match(pattern, base); ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
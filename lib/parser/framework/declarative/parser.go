@@ -0,0 +1,410 @@
+package declarative
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// Query capture names recognised in parse.query.
+const (
+	captureSuite = "suite"
+	captureTest  = "test"
+	captureName  = "name"
+	captureSkip  = "skip"
+	captureFocus = "focus"
+	captureTodo  = "todo"
+)
+
+// callShape describes the call node of a language for call-name parsing.
+type callShape struct {
+	node string
+	// callee is the field holding the called function or method name.
+	callee string
+	// receiver is the optional field prefixed to the callee ("obj.method").
+	receiver  string
+	arguments string
+}
+
+var callShapes = map[domain.Language]callShape{
+	domain.LanguageCSharp:     {node: "invocation_expression", callee: "function", arguments: "arguments"},
+	domain.LanguageGo:         {node: "call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguageJava:       {node: "method_invocation", callee: "name", receiver: "object", arguments: "arguments"},
+	domain.LanguageJavaScript: {node: "call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguagePHP:        {node: "function_call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguagePython:     {node: "call", callee: "function", arguments: "arguments"},
+	domain.LanguageRuby:       {node: "call", callee: "method", receiver: "receiver", arguments: "arguments"},
+	domain.LanguageRust:       {node: "call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguageScala:      {node: "call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguageTSX:        {node: "call_expression", callee: "function", arguments: "arguments"},
+	domain.LanguageTypeScript: {node: "call_expression", callee: "function", arguments: "arguments"},
+}
+
+// Parser extracts suites and tests declared by a custom framework spec.
+type Parser struct {
+	name        string
+	suites      map[string]bool
+	tests       map[string]bool
+	skip        map[string]bool
+	focus       map[string]bool
+	todo        map[string]bool
+	query       string
+	fingerprint string
+}
+
+// Fingerprint identifies the spec the parser was built from, so cached
+// results are invalidated when the custom framework file changes.
+func (p *Parser) Fingerprint() string {
+	return p.fingerprint
+}
+
+func (p *Parser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	lang := fileLanguage(filename)
+	if lang == "" {
+		return nil, fmt.Errorf("%s: unsupported file extension", p.name)
+	}
+
+	tree, err := tspool.Parse(ctx, lang, source)
+	if err != nil {
+		return nil, err
+	}
+	defer tree.Close()
+
+	var entries []*entry
+	if p.query != "" {
+		entries, err = p.queryEntries(tree.RootNode(), source, lang)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		w := &walker{parser: p, shape: callShapes[lang], source: source}
+		entries = w.children(tree.RootNode(), nil)
+	}
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  lang,
+		Framework: p.name,
+	}
+	for _, e := range entries {
+		if e.suite {
+			file.Suites = append(file.Suites, e.toSuite(filename))
+		} else {
+			file.Tests = append(file.Tests, e.toTest(filename))
+		}
+	}
+	return file, nil
+}
+
+// entry is a suite or test found in the source before it is converted to
+// the domain model.
+type entry struct {
+	node     *sitter.Node
+	suite    bool
+	name     string
+	status   domain.TestStatus
+	modifier string
+	children []*entry
+}
+
+func (e *entry) toTest(filename string) domain.Test {
+	return domain.Test{
+		Name:     e.name,
+		Status:   e.status,
+		Modifier: e.modifier,
+		Location: location(e.node, filename),
+	}
+}
+
+func (e *entry) toSuite(filename string) domain.TestSuite {
+	suite := domain.TestSuite{
+		Name:     e.name,
+		Status:   e.status,
+		Modifier: e.modifier,
+		Location: location(e.node, filename),
+	}
+	for _, child := range e.children {
+		if child.suite {
+			suite.Suites = append(suite.Suites, child.toSuite(filename))
+		} else {
+			suite.Tests = append(suite.Tests, child.toTest(filename))
+		}
+	}
+	return suite
+}
+
+// walker finds calls to the declared suite and test names.
+type walker struct {
+	parser *Parser
+	shape  callShape
+	source []byte
+}
+
+// children returns the entries below node, skipping the head call of a
+// curried suite (spec("name") { ... }) so it is not matched twice.
+func (w *walker) children(node *sitter.Node, skip *sitter.Node) []*entry {
+	var entries []*entry
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if skip != nil && child.Equal(skip) {
+			continue
+		}
+		entries = append(entries, w.visit(child)...)
+	}
+	return entries
+}
+
+func (w *walker) visit(node *sitter.Node) []*entry {
+	if node.Type() != w.shape.node {
+		return w.children(node, nil)
+	}
+
+	head := node
+	for {
+		inner := head.ChildByFieldName(w.shape.callee)
+		if inner == nil || inner.Type() != w.shape.node {
+			break
+		}
+		head = inner
+	}
+
+	callee := w.callee(head)
+	isSuite, isTest := w.parser.suites[callee], w.parser.tests[callee]
+	if !isSuite && !isTest {
+		return w.children(node, nil)
+	}
+
+	name, ok := firstArgument(head.ChildByFieldName(w.shape.arguments), w.source)
+	if !ok {
+		return w.children(node, nil)
+	}
+
+	e := &entry{node: node, suite: isSuite, name: name}
+	e.status, e.modifier = w.parser.status(callee)
+	if isSuite {
+		var skip *sitter.Node
+		if head != node {
+			skip = node.ChildByFieldName(w.shape.callee)
+		}
+		e.children = w.children(node, skip)
+	}
+	return []*entry{e}
+}
+
+func (w *walker) callee(call *sitter.Node) string {
+	fn := call.ChildByFieldName(w.shape.callee)
+	if fn == nil {
+		return ""
+	}
+	name := compact(fn.Content(w.source))
+	if w.shape.receiver != "" {
+		if receiver := call.ChildByFieldName(w.shape.receiver); receiver != nil {
+			name = compact(receiver.Content(w.source)) + "." + name
+		}
+	}
+	return name
+}
+
+func (p *Parser) status(callee string) (domain.TestStatus, string) {
+	switch {
+	case p.skip[callee]:
+		return domain.TestStatusSkipped, callee
+	case p.focus[callee]:
+		return domain.TestStatusFocused, callee
+	case p.todo[callee]:
+		return domain.TestStatusTodo, callee
+	default:
+		return domain.TestStatusActive, ""
+	}
+}
+
+// queryEntries runs the spec query and nests the captured suites and tests
+// by source range.
+func (p *Parser) queryEntries(root *sitter.Node, source []byte, lang domain.Language) ([]*entry, error) {
+	results, err := tspool.QueryWithCache(root, source, lang, p.query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Several patterns may match the same node (one naming it, another
+	// marking it skipped), so their captures are merged per node.
+	byNode := make(map[[2]uint32]*entry)
+	var found []*entry
+	for _, result := range results {
+		node, suite := result.Captures[captureSuite], true
+		if node == nil {
+			node, suite = result.Captures[captureTest], false
+		}
+		if node == nil {
+			continue
+		}
+
+		key := [2]uint32{node.StartByte(), node.EndByte()}
+		e, ok := byNode[key]
+		if !ok {
+			e = &entry{node: node, suite: suite, status: domain.TestStatusActive}
+			byNode[key] = e
+			found = append(found, e)
+		}
+
+		if name := result.Captures[captureName]; name != nil && e.name == "" {
+			e.name = stringValue(name, source)
+		}
+		if e.status == domain.TestStatusActive {
+			switch {
+			case result.Captures[captureSkip] != nil:
+				e.status, e.modifier = domain.TestStatusSkipped, result.Captures[captureSkip].Content(source)
+			case result.Captures[captureFocus] != nil:
+				e.status, e.modifier = domain.TestStatusFocused, result.Captures[captureFocus].Content(source)
+			case result.Captures[captureTodo] != nil:
+				e.status, e.modifier = domain.TestStatusTodo, result.Captures[captureTodo].Content(source)
+			}
+		}
+	}
+
+	// Nodes matched only by marker patterns have no description.
+	named := found[:0]
+	for _, e := range found {
+		if e.name != "" {
+			named = append(named, e)
+		}
+	}
+	found = named
+
+	sort.SliceStable(found, func(i, j int) bool {
+		a, b := found[i].node, found[j].node
+		if a.StartByte() != b.StartByte() {
+			return a.StartByte() < b.StartByte()
+		}
+		return a.EndByte() > b.EndByte()
+	})
+
+	var roots, stack []*entry
+	for _, e := range found {
+		for len(stack) > 0 && !contains(stack[len(stack)-1].node, e.node) {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, e)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, e)
+		}
+		if e.suite {
+			stack = append(stack, e)
+		}
+	}
+	return roots, nil
+}
+
+func contains(outer, inner *sitter.Node) bool {
+	return outer.StartByte() <= inner.StartByte() && inner.EndByte() <= outer.EndByte()
+}
+
+// firstArgument returns the description passed as the first argument.
+func firstArgument(args *sitter.Node, source []byte) (string, bool) {
+	if args == nil {
+		return "", false
+	}
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() == "comment" {
+			continue
+		}
+		// PHP and C# wrap each argument in an argument node.
+		if arg.Type() == "argument" && arg.NamedChildCount() > 0 {
+			arg = arg.NamedChild(0)
+		}
+		name := stringValue(arg, source)
+		return name, name != ""
+	}
+	return "", false
+}
+
+// stringValue strips the quotes and prefixes of a string literal. Other
+// expressions (identifiers, template strings) are returned as written.
+func stringValue(node *sitter.Node, source []byte) string {
+	text := node.Content(source)
+
+	// r"..." / f'...' (Python), @"..." / $"..." (C#)
+	trimmed := strings.TrimLeft(text, "rRbBuUfF@$")
+	if len(text)-len(trimmed) > 2 || trimmed == "" {
+		return text
+	}
+
+	for _, quote := range []string{`"""`, `'''`, `"`, `'`, "`"} {
+		if len(trimmed) >= 2*len(quote) && strings.HasPrefix(trimmed, quote) && strings.HasSuffix(trimmed, quote) {
+			return trimmed[len(quote) : len(trimmed)-len(quote)]
+		}
+	}
+	return text
+}
+
+func compact(text string) string {
+	return strings.Join(strings.Fields(text), "")
+}
+
+func location(node *sitter.Node, filename string) domain.Location {
+	start, end := node.StartPoint(), node.EndPoint()
+	return domain.Location{
+		File:      filename,
+		StartLine: int(start.Row) + 1,
+		EndLine:   int(end.Row) + 1,
+		StartCol:  int(start.Column),
+		EndCol:    int(end.Column),
+	}
+}
+
+// fileLanguage maps a file extension to the grammar it is parsed with.
+func fileLanguage(filename string) domain.Language {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ts":
+		return domain.LanguageTypeScript
+	case ".tsx":
+		return domain.LanguageTSX
+	case ".js", ".jsx", ".mjs", ".cjs":
+		return domain.LanguageJavaScript
+	case ".go":
+		return domain.LanguageGo
+	case ".java":
+		return domain.LanguageJava
+	case ".kt", ".kts":
+		return domain.LanguageKotlin
+	case ".py":
+		return domain.LanguagePython
+	case ".cs":
+		return domain.LanguageCSharp
+	case ".rb":
+		return domain.LanguageRuby
+	case ".rs":
+		return domain.LanguageRust
+	case ".cc", ".cpp", ".cxx":
+		return domain.LanguageCpp
+	case ".php":
+		return domain.LanguagePHP
+	case ".swift":
+		return domain.LanguageSwift
+	case ".ex", ".exs":
+		return domain.LanguageElixir
+	case ".scala":
+		return domain.LanguageScala
+	default:
+		return ""
+	}
+}
+
+func fingerprint(spec Spec) string {
+	data, _ := json.Marshal(spec)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
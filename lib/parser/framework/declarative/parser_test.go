@@ -0,0 +1,203 @@
+package declarative
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func loadParser(t *testing.T, data string) framework.Parser {
+	t.Helper()
+	defs, err := Load([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	return defs[0].Parser
+}
+
+func TestParser_CallNames(t *testing.T) {
+	parser := loadParser(t, acmeSpec)
+
+	source := `import { spec, xcase } from "@acme/spec";
+
+spec("Cart", () => {
+  case("adds items", () => {});
+  xcase("removes items", () => {});
+
+  spec.skip("checkout", () => {
+    case.only("pays", async () => {});
+  });
+});
+
+case(` + "`standalone`" + `, () => {});
+helper("not a test", () => {});
+`
+	file, err := parser.Parse(context.Background(), []byte(source), "cart.test.ts")
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme-spec", file.Framework)
+	assert.Equal(t, domain.LanguageTypeScript, file.Language)
+	require.Len(t, file.Tests, 1)
+	assert.Equal(t, "standalone", file.Tests[0].Name)
+
+	require.Len(t, file.Suites, 1)
+	suite := file.Suites[0]
+	assert.Equal(t, "Cart", suite.Name)
+	assert.Equal(t, 3, suite.Location.StartLine)
+	assert.Equal(t, 10, suite.Location.EndLine)
+	require.Len(t, suite.Tests, 2)
+	assert.Equal(t, "adds items", suite.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, suite.Tests[0].Status)
+	assert.Equal(t, "removes items", suite.Tests[1].Name)
+	assert.Equal(t, domain.TestStatusSkipped, suite.Tests[1].Status)
+	assert.Equal(t, "xcase", suite.Tests[1].Modifier)
+
+	require.Len(t, suite.Suites, 1)
+	nested := suite.Suites[0]
+	assert.Equal(t, "checkout", nested.Name)
+	assert.Equal(t, domain.TestStatusSkipped, nested.Status)
+	require.Len(t, nested.Tests, 1)
+	assert.Equal(t, domain.TestStatusFocused, nested.Tests[0].Status)
+	assert.Equal(t, "case.only", nested.Tests[0].Modifier)
+}
+
+func TestParser_CallNamesByLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		filename string
+		source   string
+	}{
+		{
+			name:     "python",
+			language: "python",
+			filename: "test_cart.py",
+			source: `spec("Cart", lambda: [
+    check(r"adds items", lambda: None),
+])
+`,
+		},
+		{
+			name:     "ruby blocks",
+			language: "ruby",
+			filename: "cart_spec.rb",
+			source: `spec "Cart" do
+  check("adds items") { }
+end
+`,
+		},
+		{
+			name:     "java",
+			language: "java",
+			filename: "CartTest.java",
+			source: `class CartTest {
+  void define() {
+    spec("Cart", () -> {
+      check("adds items", () -> {});
+    });
+  }
+}
+`,
+		},
+		{
+			name:     "php",
+			language: "php",
+			filename: "CartTest.php",
+			source: `<?php
+spec("Cart", function () {
+    check("adds items", fn () => null);
+});
+`,
+		},
+		{
+			name:     "scala curried",
+			language: "scala",
+			filename: "CartSpec.scala",
+			source: `object CartSpec {
+  spec("Cart") {
+    check("adds items") {}
+  }
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := loadParser(t, `frameworks:
+  - name: acme-spec
+    languages: [`+tt.language+`]
+    detection: {content: ['spec']}
+    parse: {suites: [spec], tests: [check]}
+`)
+			file, err := parser.Parse(context.Background(), []byte(tt.source), tt.filename)
+			require.NoError(t, err)
+
+			require.Len(t, file.Suites, 1)
+			assert.Equal(t, "Cart", file.Suites[0].Name)
+			require.Len(t, file.Suites[0].Tests, 1)
+			assert.Equal(t, "adds items", file.Suites[0].Tests[0].Name)
+			assert.Empty(t, file.Tests)
+		})
+	}
+}
+
+func TestParser_Query(t *testing.T) {
+	parser := loadParser(t, `frameworks:
+  - name: scenario-dsl
+    languages: [javascript]
+    detection: {imports: [scenario-dsl]}
+    parse:
+      query: |
+        (call_expression
+          function: (identifier) @fn (#eq? @fn "feature")
+          arguments: (arguments . (string) @name)) @suite
+        (call_expression
+          function: (identifier) @fn (#match? @fn "^(scenario|pending)$")
+          arguments: (arguments . (string) @name)) @test
+        (call_expression
+          function: (identifier) @todo (#eq? @todo "pending")) @test
+`)
+
+	source := `feature("Login", () => {
+  scenario("with password", () => {});
+  pending("with passkey", () => {});
+});
+scenario("logout", () => {});
+`
+	file, err := parser.Parse(context.Background(), []byte(source), "login.js")
+	require.NoError(t, err)
+
+	require.Len(t, file.Suites, 1)
+	suite := file.Suites[0]
+	assert.Equal(t, "Login", suite.Name)
+	require.Len(t, suite.Tests, 2)
+	assert.Equal(t, "with password", suite.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, suite.Tests[0].Status)
+	assert.Equal(t, "with passkey", suite.Tests[1].Name)
+	assert.Equal(t, domain.TestStatusTodo, suite.Tests[1].Status)
+	assert.Equal(t, "pending", suite.Tests[1].Modifier)
+	assert.Equal(t, 3, suite.Tests[1].Location.StartLine)
+
+	require.Len(t, file.Tests, 1)
+	assert.Equal(t, "logout", file.Tests[0].Name)
+}
+
+func TestParser_Fingerprint(t *testing.T) {
+	a := loadParser(t, acmeSpec).(*Parser)
+	b := loadParser(t, acmeSpec).(*Parser)
+	c := loadParser(t, `frameworks:
+  - name: acme-spec
+    languages: [javascript]
+    detection: {imports: ["@acme/spec"]}
+    parse: {tests: [case]}
+`).(*Parser)
+
+	assert.NotEmpty(t, a.Fingerprint())
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+	assert.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+}
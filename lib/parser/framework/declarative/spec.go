@@ -0,0 +1,333 @@
+// Package declarative builds framework definitions from a YAML or JSON file,
+// so in-house test DSLs can be detected and parsed without forking the library.
+//
+// A file declares one or more frameworks:
+//
+//	frameworks:
+//	  - name: acme-spec
+//	    languages: [javascript, typescript]
+//	    detection:
+//	      imports: ["@acme/spec"]
+//	      filenames: ["*.acme.ts"]
+//	    parse:
+//	      suites: [spec, spec.skip, spec.only]
+//	      tests: [case, case.skip, case.only, xcase]
+//	      skip: [spec.skip, case.skip, xcase]
+//	      focus: [spec.only, case.only]
+//	    assertions: [verify, "ensure.*"]
+//
+// Tests are found either by exact call names (parse.suites/tests) or by a
+// tree-sitter query (parse.query) capturing @suite or @test nodes with an
+// optional @name and @skip/@focus/@todo markers.
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// File is a standalone document declaring custom frameworks. Repositories
+// declare them in the frameworks section of their .specvital.yml instead
+// (see package repoconfig). JSON is accepted because JSON is valid YAML.
+type File struct {
+	Frameworks []Spec `yaml:"frameworks" json:"frameworks"`
+}
+
+// Spec declares a single custom framework.
+type Spec struct {
+	// Name is the framework identifier reported on parsed files.
+	Name string `yaml:"name" json:"name"`
+	// Languages are the languages whose files the framework may claim.
+	Languages []string `yaml:"languages" json:"languages"`
+	// Priority is generic, e2e or specialized (default). Specialized frameworks
+	// are checked before the built-in runners they usually wrap.
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
+	// Kind is the default kind of the framework's tests (unit, e2e, ...).
+	Kind string `yaml:"kind,omitempty" json:"kind,omitempty"`
+	// Assertions are callee patterns of assertion calls counted by test
+	// metrics and the no-assertion lint rule; "*" matches any characters.
+	Assertions []string `yaml:"assertions,omitempty" json:"assertions,omitempty"`
+	// Detection lists the signals that attribute a file to the framework.
+	Detection Detection `yaml:"detection" json:"detection"`
+	// Parse describes how suites and tests are written.
+	Parse Parse `yaml:"parse" json:"parse"`
+}
+
+// Detection lists the signals that attribute a file to a custom framework.
+type Detection struct {
+	// Imports are import paths; a trailing "/" also matches sub-paths.
+	Imports []string `yaml:"imports,omitempty" json:"imports,omitempty"`
+	// Filenames are glob patterns matched against the file's base name.
+	// Matching files are discovered even without a conventional test name.
+	Filenames []string `yaml:"filenames,omitempty" json:"filenames,omitempty"`
+	// Content are regular expressions matched against the file content.
+	Content []string `yaml:"content,omitempty" json:"content,omitempty"`
+}
+
+// Parse describes how suites and tests are written, either by call names or
+// by a tree-sitter query.
+type Parse struct {
+	// Suites and Tests are callee names as written, e.g. "spec" or "case.skip".
+	Suites []string `yaml:"suites,omitempty" json:"suites,omitempty"`
+	Tests  []string `yaml:"tests,omitempty" json:"tests,omitempty"`
+	// Skip, Focus and Todo mark which of those callees change the status.
+	Skip  []string `yaml:"skip,omitempty" json:"skip,omitempty"`
+	Focus []string `yaml:"focus,omitempty" json:"focus,omitempty"`
+	Todo  []string `yaml:"todo,omitempty" json:"todo,omitempty"`
+	// Query is a tree-sitter query used instead of call names.
+	Query string `yaml:"query,omitempty" json:"query,omitempty"`
+}
+
+var priorities = map[string]int{
+	"":            framework.PrioritySpecialized,
+	"generic":     framework.PriorityGeneric,
+	"e2e":         framework.PriorityE2E,
+	"specialized": framework.PrioritySpecialized,
+}
+
+// Load parses a custom framework file and builds one Definition per entry.
+// All validation problems are reported together.
+func Load(data []byte) ([]*framework.Definition, error) {
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	return Build(file.Frameworks)
+}
+
+// Build validates the specs and builds one Definition per entry. Problems
+// are labelled with the entry index and reported together.
+func Build(specs []Spec) ([]*framework.Definition, error) {
+	var (
+		defs []*framework.Definition
+		errs []error
+		seen = make(map[string]bool)
+	)
+	for i, spec := range specs {
+		label := fmt.Sprintf("frameworks[%d]", i)
+		if spec.Name != "" {
+			label = fmt.Sprintf("frameworks[%d] (%s)", i, spec.Name)
+			if seen[spec.Name] {
+				errs = append(errs, fmt.Errorf("%s: name: declared more than once", label))
+				continue
+			}
+			seen[spec.Name] = true
+		}
+
+		def, specErrs := build(spec)
+		for _, err := range specErrs {
+			errs = append(errs, fmt.Errorf("%s: %w", label, err))
+		}
+		if len(specErrs) == 0 {
+			defs = append(defs, def)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return defs, nil
+}
+
+// Register adds the definitions to the registry. Names already registered
+// are rejected so a custom framework cannot shadow a built-in one.
+func Register(registry *framework.Registry, defs []*framework.Definition) error {
+	for _, def := range defs {
+		if registry.Find(def.Name) != nil {
+			return fmt.Errorf("framework %q: name conflicts with a registered framework", def.Name)
+		}
+	}
+	for _, def := range defs {
+		registry.Register(def)
+	}
+	return nil
+}
+
+func build(spec Spec) (*framework.Definition, []error) {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if spec.Name == "" {
+		fail("name: required")
+	}
+
+	var languages []domain.Language
+	if len(spec.Languages) == 0 {
+		fail("languages: at least one language is required")
+	}
+	for _, name := range spec.Languages {
+		lang := domain.Language(name)
+		if !supportedLanguages[lang] {
+			fail("languages: unsupported language %q", name)
+			continue
+		}
+		languages = append(languages, lang)
+	}
+
+	priority, ok := priorities[spec.Priority]
+	if !ok {
+		fail("priority: must be generic, e2e or specialized, got %q", spec.Priority)
+	}
+
+	kind := domain.TestKind(spec.Kind)
+	if kind != "" && !isKnownKind(kind) {
+		fail("kind: unknown kind %q", spec.Kind)
+	}
+
+	for _, pattern := range spec.Assertions {
+		if _, err := path.Match(pattern, ""); err != nil {
+			fail("assertions: invalid pattern %q", pattern)
+		}
+	}
+
+	defMatchers, matcherErrs := buildMatchers(spec.Detection)
+	errs = append(errs, matcherErrs...)
+
+	parser, parserErrs := newParser(spec, languages)
+	errs = append(errs, parserErrs...)
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &framework.Definition{
+		Name:        spec.Name,
+		Languages:   languages,
+		Matchers:    defMatchers,
+		Parser:      parser,
+		Priority:    priority,
+		DefaultKind: kind,
+		Assertions:  spec.Assertions,
+	}, nil
+}
+
+func buildMatchers(detection Detection) ([]framework.Matcher, []error) {
+	var (
+		result []framework.Matcher
+		errs   []error
+	)
+
+	if len(detection.Imports) == 0 && len(detection.Filenames) == 0 && len(detection.Content) == 0 {
+		return nil, []error{errors.New("detection: declare at least one of imports, filenames or content")}
+	}
+
+	if len(detection.Imports) > 0 {
+		result = append(result, matchers.NewImportMatcher(detection.Imports...))
+	}
+
+	for _, pattern := range detection.Filenames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("detection.filenames: invalid glob %q", pattern))
+		}
+	}
+	if len(detection.Filenames) > 0 {
+		result = append(result, &FilenameMatcher{Patterns: detection.Filenames})
+	}
+
+	var patterns []*regexp.Regexp
+	for _, expr := range detection.Content {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("detection.content: invalid pattern %q: %w", expr, err))
+			continue
+		}
+		patterns = append(patterns, re)
+	}
+	if len(patterns) > 0 {
+		result = append(result, matchers.NewContentMatcher(patterns...))
+	}
+
+	return result, errs
+}
+
+func newParser(spec Spec, languages []domain.Language) (*Parser, []error) {
+	p := spec.Parse
+	hasCalls := len(p.Suites) > 0 || len(p.Tests) > 0
+
+	switch {
+	case hasCalls && p.Query != "":
+		return nil, []error{errors.New("parse: use either suites/tests or query, not both")}
+	case !hasCalls && p.Query == "":
+		return nil, []error{errors.New("parse: declare suites/tests call names or a query")}
+	}
+
+	var errs []error
+	if hasCalls {
+		declared := toSet(p.Suites, p.Tests)
+		markers := []struct {
+			field string
+			names []string
+		}{{"skip", p.Skip}, {"focus", p.Focus}, {"todo", p.Todo}}
+		for _, marker := range markers {
+			for _, name := range marker.names {
+				if !declared[name] {
+					errs = append(errs, fmt.Errorf("parse.%s: %q is not listed in suites or tests", marker.field, name))
+				}
+			}
+		}
+		for _, lang := range languages {
+			if _, ok := callShapes[lang]; !ok {
+				errs = append(errs, fmt.Errorf("parse: call names are not supported for %s; use parse.query", lang))
+			}
+		}
+	} else {
+		if !strings.Contains(p.Query, "@"+captureSuite) && !strings.Contains(p.Query, "@"+captureTest) {
+			errs = append(errs, errors.New("parse.query: capture @suite or @test nodes"))
+		}
+		if !strings.Contains(p.Query, "@"+captureName) {
+			errs = append(errs, errors.New("parse.query: capture the description as @name"))
+		}
+		for _, lang := range languages {
+			for _, grammar := range grammarsFor(lang) {
+				if err := tspool.ValidateQuery(grammar, p.Query); err != nil {
+					errs = append(errs, fmt.Errorf("parse.query (%s): %w", grammar, err))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &Parser{
+		name:        spec.Name,
+		suites:      toSet(p.Suites),
+		tests:       toSet(p.Tests),
+		skip:        toSet(p.Skip),
+		focus:       toSet(p.Focus),
+		todo:        toSet(p.Todo),
+		query:       p.Query,
+		fingerprint: fingerprint(spec),
+	}, nil
+}
+
+func isKnownKind(kind domain.TestKind) bool {
+	for _, known := range domain.TestKinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
+
+func toSet(lists ...[]string) map[string]bool {
+	set := make(map[string]bool)
+	for _, list := range lists {
+		for _, item := range list {
+			set[item] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,183 @@
+package declarative
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+const acmeSpec = `frameworks:
+  - name: acme-spec
+    languages: [javascript, typescript]
+    kind: integration
+    assertions: [verify, "ensure.*"]
+    detection:
+      imports: ["@acme/spec"]
+      filenames: ["*.acme.js"]
+      content: ['\bspec\(']
+    parse:
+      suites: [spec, spec.skip]
+      tests: [case, case.only, xcase]
+      skip: [spec.skip, xcase]
+      focus: [case.only]
+`
+
+func TestLoad(t *testing.T) {
+	defs, err := Load([]byte(acmeSpec))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+
+	def := defs[0]
+	assert.Equal(t, "acme-spec", def.Name)
+	assert.Equal(t, []domain.Language{domain.LanguageJavaScript, domain.LanguageTypeScript}, def.Languages)
+	assert.Equal(t, framework.PrioritySpecialized, def.Priority)
+	assert.Equal(t, domain.TestKindIntegration, def.DefaultKind)
+	assert.Equal(t, []string{"verify", "ensure.*"}, def.Assertions)
+	require.Len(t, def.Matchers, 3)
+
+	ctx := context.Background()
+	assert.Equal(t, 100, def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: "@acme/spec"}).Confidence)
+	assert.Equal(t, 100, def.Matchers[1].Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: "cart.acme.js"}).Confidence)
+	assert.Equal(t, 0, def.Matchers[1].Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: "cart.js"}).Confidence)
+	assert.Equal(t, 40, def.Matchers[2].Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(`spec("x")`)}).Confidence)
+
+	candidate, ok := def.Matchers[1].(framework.CandidateMatcher)
+	require.True(t, ok)
+	assert.True(t, candidate.IsCandidate("src/cart.acme.js"))
+}
+
+func TestLoad_JSON(t *testing.T) {
+	defs, err := Load([]byte(`{
+  "frameworks": [{
+    "name": "acme-spec",
+    "languages": ["python"],
+    "priority": "generic",
+    "detection": {"imports": ["acme.spec"]},
+    "parse": {"tests": ["case"]}
+  }]
+}`))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	assert.Equal(t, framework.PriorityGeneric, defs[0].Priority)
+}
+
+func TestLoad_Empty(t *testing.T) {
+	defs, err := Load([]byte("# nothing declared\n"))
+	require.NoError(t, err)
+	assert.Empty(t, defs)
+}
+
+func TestLoad_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{
+			name: "missing fields",
+			data: `frameworks:
+  - parse: {tests: [case]}
+`,
+			want: []string{
+				"frameworks[0]: name: required",
+				"frameworks[0]: languages: at least one language is required",
+				"frameworks[0]: detection: declare at least one of imports, filenames or content",
+			},
+		},
+		{
+			name: "invalid values",
+			data: `frameworks:
+  - name: acme
+    languages: [cobol]
+    priority: urgent
+    kind: smoke
+    assertions: ["[a-"]
+    detection: {filenames: ["[a-"], content: ["("]}
+    parse: {tests: [case], skip: [xcase]}
+`,
+			want: []string{
+				`frameworks[0] (acme): languages: unsupported language "cobol"`,
+				`frameworks[0] (acme): priority: must be generic, e2e or specialized, got "urgent"`,
+				`frameworks[0] (acme): kind: unknown kind "smoke"`,
+				`frameworks[0] (acme): assertions: invalid pattern "[a-"`,
+				`frameworks[0] (acme): detection.filenames: invalid glob "[a-"`,
+				`frameworks[0] (acme): detection.content: invalid pattern "("`,
+				`frameworks[0] (acme): parse.skip: "xcase" is not listed in suites or tests`,
+			},
+		},
+		{
+			name: "calls and query",
+			data: `frameworks:
+  - name: acme
+    languages: [javascript]
+    detection: {imports: [acme]}
+    parse: {tests: [case], query: "(call_expression) @test"}
+`,
+			want: []string{"frameworks[0] (acme): parse: use either suites/tests or query, not both"},
+		},
+		{
+			name: "call names unsupported",
+			data: `frameworks:
+  - name: acme
+    languages: [kotlin]
+    detection: {imports: [acme]}
+    parse: {tests: [case]}
+`,
+			want: []string{"frameworks[0] (acme): parse: call names are not supported for kotlin; use parse.query"},
+		},
+		{
+			name: "invalid query",
+			data: `frameworks:
+  - name: acme
+    languages: [javascript]
+    detection: {imports: [acme]}
+    parse: {query: "(no_such_node) @test"}
+`,
+			want: []string{
+				"frameworks[0] (acme): parse.query: capture the description as @name",
+				"frameworks[0] (acme): parse.query (javascript): invalid query",
+			},
+		},
+		{
+			name: "duplicate name",
+			data: `frameworks:
+  - {name: acme, languages: [javascript], detection: {imports: [a]}, parse: {tests: [t]}}
+  - {name: acme, languages: [javascript], detection: {imports: [b]}, parse: {tests: [t]}}
+`,
+			want: []string{"frameworks[1] (acme): name: declared more than once"},
+		},
+		{
+			name: "malformed",
+			data: "frameworks: {name: acme",
+			want: []string{"decode:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defs, err := Load([]byte(tt.data))
+			require.Error(t, err)
+			assert.Nil(t, defs)
+			for _, want := range tt.want {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	defs, err := Load([]byte(acmeSpec))
+	require.NoError(t, err)
+
+	registry := framework.NewRegistry()
+	require.NoError(t, Register(registry, defs))
+	assert.NotNil(t, registry.Find("acme-spec"))
+
+	err = Register(registry, defs)
+	assert.ErrorContains(t, err, `framework "acme-spec": name conflicts with a registered framework`)
+}
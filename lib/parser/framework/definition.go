@@ -4,6 +4,7 @@ package framework
 
 import (
 	"context"
+	"path"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
 )
@@ -42,6 +43,30 @@ type Definition struct {
 	// Higher priority frameworks are checked first.
 	// Use PriorityGeneric (100), PriorityE2E (150), or PrioritySpecialized (200).
 	Priority int
+
+	// DefaultKind is the kind of tests whose parser leaves Test.Kind empty
+	// (e.g., e2e for Playwright, benchmark for Criterion).
+	// Empty means the kind is decided by path rules, falling back to unit.
+	DefaultKind domain.TestKind
+
+	// Assertions are callee patterns of the framework's assertion calls
+	// (e.g., "expect", "assert*", "t.Error*"), matched by IsAssertion for
+	// test metrics and the no-assertion lint rule. Assert statements
+	// (Python, Java) count regardless.
+	Assertions []string
+}
+
+// IsAssertion reports whether callee matches one of the assertion patterns.
+// callee is the callee of a call without call arguments, whitespace and ?/!
+// markers: expect(x).not.toBe is "expect.not.toBe". "*" in a pattern matches
+// any characters.
+func IsAssertion(patterns []string, callee string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, callee); ok {
+			return true
+		}
+	}
+	return false
 }
 
 // Matcher defines the interface for framework detection rules.
@@ -53,6 +78,16 @@ type Matcher interface {
 	Match(ctx context.Context, signal Signal) MatchResult
 }
 
+// CandidateMatcher is implemented by matchers whose filename patterns also
+// make files test candidates during discovery, for frameworks whose test
+// files don't follow the built-in naming conventions.
+type CandidateMatcher interface {
+	Matcher
+
+	// IsCandidate reports whether the file at the given path may contain tests.
+	IsCandidate(path string) bool
+}
+
 // Signal represents a detection signal that matchers can evaluate.
 type Signal struct {
 	// Type indicates what kind of signal this is (import, config file, etc.).
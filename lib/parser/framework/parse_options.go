@@ -0,0 +1,26 @@
+package framework
+
+import "context"
+
+// ParseOptions carries scan-level settings that change how parsers build test entries.
+// Parsers read them from the context passed to Parser.Parse.
+type ParseOptions struct {
+	// ExpandParameterized expands statically resolvable parameterized tests
+	// (test.each, @pytest.mark.parametrize, @CsvSource, [InlineData], ...)
+	// into one domain.Test per case instead of a single template test.
+	ExpandParameterized bool
+}
+
+type parseOptionsKey struct{}
+
+// WithParseOptions returns a context carrying the given parse options.
+func WithParseOptions(ctx context.Context, opts ParseOptions) context.Context {
+	return context.WithValue(ctx, parseOptionsKey{}, opts)
+}
+
+// ParseOptionsFromContext returns the parse options stored in ctx.
+// Returns the zero value (all features disabled) when none are set.
+func ParseOptionsFromContext(ctx context.Context) ParseOptions {
+	opts, _ := ctx.Value(parseOptionsKey{}).(ParseOptions)
+	return opts
+}
@@ -105,6 +105,24 @@ func (r *Registry) All() []*Definition {
 	return result
 }
 
+// Clone returns an independent registry with the same definitions, so
+// callers can register additional frameworks for one scan without
+// affecting the registry they started from.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clone := NewRegistry()
+	for name, def := range r.frameworks {
+		clone.frameworks[name] = def
+	}
+	for lang, defs := range r.byLanguage {
+		clone.byLanguage[lang] = append([]*Definition(nil), defs...)
+	}
+	clone.byPriority = append(clone.byPriority, r.byPriority...)
+	return clone
+}
+
 // Clear removes all frameworks (useful for testing).
 func Clear() {
 	defaultRegistry.Clear()
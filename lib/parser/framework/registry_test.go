@@ -121,6 +121,37 @@ func TestRegistry_Clear(t *testing.T) {
 	}
 }
 
+func TestRegistry_Clone(t *testing.T) {
+	r := framework.NewRegistry()
+
+	r.Register(&framework.Definition{
+		Name:      "base",
+		Languages: []domain.Language{domain.LanguageTypeScript},
+		Priority:  framework.PriorityGeneric,
+	})
+
+	clone := r.Clone()
+	clone.Register(&framework.Definition{
+		Name:      "custom",
+		Languages: []domain.Language{domain.LanguageTypeScript},
+		Priority:  framework.PrioritySpecialized,
+	})
+
+	if clone.Find("base") == nil {
+		t.Error("clone should keep the original definitions")
+	}
+	if got := clone.FindByLanguage(domain.LanguageTypeScript); len(got) != 2 || got[0].Name != "custom" {
+		t.Errorf("clone FindByLanguage() = %v, want custom first", got)
+	}
+
+	if r.Find("custom") != nil {
+		t.Error("registering on the clone should not affect the original")
+	}
+	if len(r.FindByLanguage(domain.LanguageTypeScript)) != 1 {
+		t.Error("original byLanguage index should be unchanged")
+	}
+}
+
 func TestRegistry_Names(t *testing.T) {
 	r := framework.NewRegistry()
 
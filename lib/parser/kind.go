@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"path/filepath"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// KindRule assigns a kind to the tests of files whose path matches Pattern.
+type KindRule struct {
+	// Pattern is a doublestar glob matched against the slash-separated path
+	// relative to the scan root (e.g., "**/e2e/**").
+	Pattern string
+	// Kind is assigned to tests the parser and framework left unclassified.
+	Kind domain.TestKind
+}
+
+// DefaultKindRules are the path heuristics applied when no rules are configured.
+var DefaultKindRules = []KindRule{
+	{Pattern: "**/e2e/**", Kind: domain.TestKindE2E},
+	{Pattern: "**/*.e2e.*", Kind: domain.TestKindE2E},
+	{Pattern: "**/*.e2e-spec.*", Kind: domain.TestKindE2E},
+	{Pattern: "**/integration/**", Kind: domain.TestKindIntegration},
+	{Pattern: "**/integration-tests/**", Kind: domain.TestKindIntegration},
+	{Pattern: "**/*.integration.*", Kind: domain.TestKindIntegration},
+	{Pattern: "**/*_integration_test.*", Kind: domain.TestKindIntegration},
+}
+
+// classifyKinds fills Test.Kind for tests the parser left empty: the framework's
+// default kind wins, then the first matching path rule, then unit.
+func classifyKinds(file *domain.TestFile, defaultKind domain.TestKind, rules []KindRule) {
+	kind := defaultKind
+	if kind == "" {
+		kind = matchKindRule(file.Path, rules)
+	}
+	if kind == "" {
+		kind = domain.TestKindUnit
+	}
+
+	for i := range file.Tests {
+		if file.Tests[i].Kind == "" {
+			file.Tests[i].Kind = kind
+		}
+	}
+	for i := range file.Suites {
+		classifySuiteKinds(&file.Suites[i], kind)
+	}
+}
+
+func classifySuiteKinds(suite *domain.TestSuite, kind domain.TestKind) {
+	for i := range suite.Tests {
+		if suite.Tests[i].Kind == "" {
+			suite.Tests[i].Kind = kind
+		}
+	}
+	for i := range suite.Suites {
+		classifySuiteKinds(&suite.Suites[i], kind)
+	}
+}
+
+func matchKindRule(path string, rules []KindRule) domain.TestKind {
+	// Leading "**/" also matches at the root, so "e2e/login.spec.ts" matches "**/e2e/**".
+	slashPath := filepath.ToSlash(path)
+	for _, rule := range rules {
+		if matched, _ := doublestar.Match(rule.Pattern, slashPath); matched {
+			return rule.Kind
+		}
+	}
+	return ""
+}
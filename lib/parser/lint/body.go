@@ -0,0 +1,274 @@
+package lint
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// contextNames are the names conventionally given to the test context
+// object (Go's t, AVA's t).
+var contextNames = map[string]bool{"b": true, "f": true, "t": true, "tb": true}
+
+// sleepCalls are callees whose last segment waits for a fixed time.
+var sleepCalls = map[string]bool{
+	"Sleep": true, "setTimeout": true, "sleep": true, "usleep": true, "waitForTimeout": true,
+}
+
+// checkBodies runs the rules inspecting test bodies. Tests are matched to
+// their syntax node by location.
+func (r *report) checkBodies(root *sitter.Node, content []byte) {
+	r.walkTests(r.file.Tests, root, content)
+	for _, suite := range r.file.Suites {
+		r.walkSuite(suite, root, content)
+	}
+}
+
+// supportsBodies reports the languages whose test body shapes the rules
+// know; each has a tspool grammar.
+func supportsBodies(lang domain.Language) bool {
+	switch lang {
+	case domain.LanguageGo, domain.LanguageJava, domain.LanguageJavaScript,
+		domain.LanguagePython, domain.LanguageTSX, domain.LanguageTypeScript:
+		return true
+	}
+	return false
+}
+
+func (r *report) walkSuite(suite domain.TestSuite, root *sitter.Node, content []byte) {
+	r.walkTests(suite.Tests, root, content)
+	for _, nested := range suite.Suites {
+		r.walkSuite(nested, root, content)
+	}
+}
+
+func (r *report) walkTests(tests []domain.Test, root *sitter.Node, content []byte) {
+	for _, test := range tests {
+		// Skipped and todo tests are placeholders; their bodies never run.
+		if test.Status == domain.TestStatusSkipped || test.Status == domain.TestStatusTodo {
+			continue
+		}
+		node := tspool.FindNode(root, test.Location)
+		if node == nil {
+			continue
+		}
+		body := testBody(node)
+		if body == nil {
+			continue
+		}
+		r.checkBody(test, node, body, content)
+	}
+}
+
+func (r *report) checkBody(test domain.Test, node, body *sitter.Node, content []byte) {
+	if isEmptyBody(body) {
+		r.add(RuleEmptyTest, test.Location, "test %q has an empty body", test.Name)
+		return
+	}
+
+	// Without an assertion vocabulary, a missing assertion cannot be told
+	// apart from an unknown one.
+	asserts := len(r.assertions) == 0 ||
+		r.file.Language == domain.LanguageJava && hasExpectedException(node, body, content)
+	walk(body, 0, func(n *sitter.Node) {
+		if n.Type() == "assert_statement" {
+			asserts = true
+			return
+		}
+		callee, args := call(n, content)
+		if callee == "" {
+			return
+		}
+		if r.isAssertion(callee) || passesTestContext(callee, args, content) {
+			asserts = true
+		}
+		if isSleep(callee, args) {
+			r.add(RuleSleepWait, location(n), "test %q waits with a fixed sleep (%s)", test.Name, callee)
+		}
+	})
+
+	if !asserts {
+		r.add(RuleNoAssertion, test.Location, "test %q makes no assertion", test.Name)
+	}
+}
+
+// testBody returns the body of a test function or of the callback passed
+// to a test call (it("...", () => {}), t.Run("...", func(t *testing.T) {})).
+func testBody(node *sitter.Node) *sitter.Node {
+	switch node.Type() {
+	case "decorated_definition":
+		if def := node.ChildByFieldName("definition"); def != nil {
+			return def.ChildByFieldName("body")
+		}
+	case "expression_statement":
+		if node.NamedChildCount() == 1 {
+			return testBody(node.NamedChild(0))
+		}
+	case "function_declaration", "function_definition", "method_declaration":
+		return node.ChildByFieldName("body")
+	case "call_expression":
+		args := node.ChildByFieldName("arguments")
+		if args == nil {
+			return nil
+		}
+		for i := int(args.NamedChildCount()) - 1; i >= 0; i-- {
+			switch arg := args.NamedChild(i); arg.Type() {
+			case "arrow_function", "function", "function_expression", "func_literal":
+				return arg.ChildByFieldName("body")
+			}
+		}
+	}
+	return nil
+}
+
+// isEmptyBody reports whether a block holds nothing but comments, pass
+// statements and docstrings.
+func isEmptyBody(body *sitter.Node) bool {
+	switch body.Type() {
+	case "block", "statement_block", "compound_statement":
+	default:
+		// Expression bodies of arrow functions are never empty.
+		return false
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "comment", "line_comment", "block_comment", "pass_statement":
+			continue
+		case "expression_statement":
+			if child.NamedChildCount() == 1 && child.NamedChild(0).Type() == "string" {
+				continue
+			}
+		case "statement_list":
+			if !isEmptyStatementList(child) {
+				return false
+			}
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// isEmptyStatementList handles Go blocks, which wrap statements in a list.
+func isEmptyStatementList(list *sitter.Node) bool {
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		if list.NamedChild(i).Type() != "comment" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasExpectedException reports a JUnit 4 @Test(expected = ...) annotation,
+// which asserts that the test throws.
+func hasExpectedException(node, body *sitter.Node, content []byte) bool {
+	if body.StartByte() <= node.StartByte() {
+		return false
+	}
+	header := string(content[node.StartByte():body.StartByte()])
+	return strings.Contains(header, "expected")
+}
+
+// call returns the callee text and argument list of a call node, or an
+// empty callee for other nodes.
+func call(n *sitter.Node, content []byte) (string, *sitter.Node) {
+	switch n.Type() {
+	case "call_expression", "call":
+		fn := n.ChildByFieldName("function")
+		if fn == nil {
+			return "", nil
+		}
+		return fn.Content(content), n.ChildByFieldName("arguments")
+	case "method_invocation":
+		name := n.ChildByFieldName("name")
+		if name == nil {
+			return "", nil
+		}
+		callee := name.Content(content)
+		if object := n.ChildByFieldName("object"); object != nil {
+			callee = object.Content(content) + "." + callee
+		}
+		return callee, n.ChildByFieldName("arguments")
+	}
+	return "", nil
+}
+
+// isAssertion matches callee against the framework's assertion patterns,
+// the vocabulary test metrics count.
+func (r *report) isAssertion(callee string) bool {
+	return framework.IsAssertion(r.assertions, strings.Join(calleeSegments(callee), "."))
+}
+
+// passesTestContext reports calls handing the test context to a helper
+// (checkInvoice(t, got)), which then asserts on behalf of the test.
+func passesTestContext(callee string, args *sitter.Node, content []byte) bool {
+	if args == nil || contextNames[calleeSegments(callee)[0]] {
+		return false
+	}
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() == "identifier" && contextNames[arg.Content(content)] {
+			return true
+		}
+	}
+	return false
+}
+
+func isSleep(callee string, args *sitter.Node) bool {
+	segments := calleeSegments(callee)
+	if sleepCalls[segments[len(segments)-1]] {
+		return true
+	}
+	switch callee {
+	case "browser.pause":
+		return true
+	case "cy.wait":
+		// cy.wait("@alias") waits for a request; only numeric waits sleep.
+		return args != nil && args.NamedChildCount() > 0 && args.NamedChild(0).Type() == "number"
+	}
+	return false
+}
+
+// calleeSegments splits a callee on dots, dropping call arguments of chained
+// calls: expect(x).not.toBe yields expect, not and toBe.
+func calleeSegments(callee string) []string {
+	var b strings.Builder
+	depth := 0
+	for _, c := range callee {
+		switch {
+		case c == '(' || c == '[' || c == '<':
+			depth++
+		case (c == ')' || c == ']' || c == '>') && depth > 0:
+			depth--
+		case depth == 0 && !strings.ContainsRune(" \t\r\n?!", c):
+			b.WriteRune(c)
+		}
+	}
+	return strings.Split(b.String(), ".")
+}
+
+func walk(n *sitter.Node, depth int, visit func(*sitter.Node)) {
+	if depth > tspool.MaxTreeDepth {
+		return
+	}
+	visit(n)
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		walk(n.NamedChild(i), depth+1, visit)
+	}
+}
+
+func location(n *sitter.Node) domain.Location {
+	start, end := n.StartPoint(), n.EndPoint()
+	return domain.Location{
+		StartLine: int(start.Row) + 1,
+		EndLine:   int(end.Row) + 1,
+		StartCol:  int(start.Column),
+		EndCol:    int(end.Column),
+	}
+}
@@ -0,0 +1,58 @@
+package lint
+
+import (
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// checkInventory runs the rules decided by the parsed structure alone:
+// focused tests, skips without a reason and duplicate names.
+func (r *report) checkInventory() {
+	r.checkTests(r.file.Tests, "")
+	for _, suite := range r.file.Suites {
+		r.checkSuite(suite, "")
+	}
+}
+
+// checkSuite reports the suite itself, then its contents. Nested tests of a
+// skipped or focused suite often inherit its status, which is then reported
+// once on the suite.
+func (r *report) checkSuite(suite domain.TestSuite, inherited domain.TestStatus) {
+	if suite.Status != inherited {
+		r.checkStatus("suite", suite.Name, suite.Status, suite.SkipReason, suite.Location)
+	}
+
+	r.checkTests(suite.Tests, suite.Status)
+	for _, nested := range suite.Suites {
+		r.checkSuite(nested, suite.Status)
+	}
+}
+
+func (r *report) checkTests(tests []domain.Test, inherited domain.TestStatus) {
+	firstLine := make(map[string]int, len(tests))
+	for _, test := range tests {
+		if test.Status != inherited {
+			r.checkStatus("test", test.Name, test.Status, test.SkipReason, test.Location)
+		}
+
+		// Expanded parameterized cases are distinct even when their names
+		// repeat the template.
+		if test.Template != nil {
+			continue
+		}
+		if line, ok := firstLine[test.Name]; ok {
+			r.add(RuleDuplicateTestName, test.Location, "duplicate test name %q (first declared on line %d)", test.Name, line)
+			continue
+		}
+		firstLine[test.Name] = test.Location.StartLine
+	}
+}
+
+// checkStatus reports focused and reasonless skipped tests and suites.
+func (r *report) checkStatus(what, name string, status domain.TestStatus, skipReason string, loc domain.Location) {
+	switch {
+	case status == domain.TestStatusFocused:
+		r.add(RuleFocusedTest, loc, "focused %s %q makes the runner skip all other tests", what, name)
+	case status == domain.TestStatusSkipped && skipReason == "":
+		r.add(RuleSkipWithoutReason, loc, "%s %q is skipped without a reason", what, name)
+	}
+}
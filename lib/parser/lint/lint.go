@@ -0,0 +1,223 @@
+// Package lint reports test smells in parsed test files: focused tests left
+// in, empty tests, tests without assertions, duplicate test names, fixed
+// sleeps and skips without a reason.
+//
+// Rules run on the domain.TestFile produced by a framework parser and, for
+// the rules that inspect test bodies, on the tree-sitter tree of the file.
+// Body rules support JavaScript, TypeScript, Go, Python and Java.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// Rule IDs.
+const (
+	RuleDuplicateTestName = "duplicate-test-name"
+	RuleEmptyTest         = "empty-test"
+	RuleFocusedTest       = "focused-test"
+	RuleNoAssertion       = "no-assertion"
+	RuleSkipWithoutReason = "skip-without-reason"
+	RuleSleepWait         = "sleep-wait"
+)
+
+// SeverityOff disables a rule in Config.Rules.
+const SeverityOff = "off"
+
+// RuleInfo describes a lint rule.
+type RuleInfo struct {
+	// DefaultSeverity applies unless the configuration overrides it.
+	DefaultSeverity domain.Severity
+	// Description summarizes the smell the rule reports.
+	Description string
+	// ID identifies the rule in configuration and findings.
+	ID string
+}
+
+var rules = []RuleInfo{
+	{ID: RuleDuplicateTestName, DefaultSeverity: domain.SeverityWarning, Description: "Tests in the same suite share a name, making reports ambiguous."},
+	{ID: RuleEmptyTest, DefaultSeverity: domain.SeverityWarning, Description: "Test body is empty, so the test always passes."},
+	{ID: RuleFocusedTest, DefaultSeverity: domain.SeverityError, Description: "Focused test (.only, fit) makes the runner skip every other test."},
+	{ID: RuleNoAssertion, DefaultSeverity: domain.SeverityWarning, Description: "Test makes no assertion call."},
+	{ID: RuleSkipWithoutReason, DefaultSeverity: domain.SeverityNote, Description: "Skipped test does not say why it is skipped."},
+	{ID: RuleSleepWait, DefaultSeverity: domain.SeverityWarning, Description: "Test waits with a fixed sleep instead of waiting for a condition."},
+}
+
+// Rules returns every lint rule, sorted by ID.
+func Rules() []RuleInfo {
+	return append([]RuleInfo(nil), rules...)
+}
+
+// Config selects the rules to run and their severities.
+type Config struct {
+	// Rules maps rule IDs to a severity ("error", "warning", "note") or
+	// "off". Rules not listed run with their default severity.
+	Rules map[string]string `yaml:"rules,omitempty" json:"rules,omitempty"`
+}
+
+// Validate reports unknown rule IDs and severities.
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(c.Rules))
+	for id := range c.Rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var errs []error
+	for _, id := range ids {
+		if !isKnownRule(id) {
+			errs = append(errs, fmt.Errorf("rules.%s: unknown rule", id))
+			continue
+		}
+		switch severity := c.Rules[id]; severity {
+		case SeverityOff, string(domain.SeverityError), string(domain.SeverityWarning), string(domain.SeverityNote):
+		default:
+			errs = append(errs, fmt.Errorf("rules.%s: unknown severity %q (want error, warning, note or off)", id, severity))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Merge returns base with the rule settings of override applied on top.
+// Returns nil when both are nil.
+func Merge(base, override *Config) *Config {
+	if base == nil && override == nil {
+		return nil
+	}
+
+	merged := &Config{Rules: make(map[string]string)}
+	for _, cfg := range []*Config{base, override} {
+		if cfg == nil {
+			continue
+		}
+		for id, severity := range cfg.Rules {
+			merged.Rules[id] = severity
+		}
+	}
+	return merged
+}
+
+// Linter runs the enabled rules with their configured severities.
+type Linter struct {
+	severities map[string]domain.Severity
+}
+
+// New returns a Linter for cfg. A nil cfg enables every rule with its
+// default severity.
+func New(cfg *Config) (*Linter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	l := &Linter{severities: make(map[string]domain.Severity, len(rules))}
+	for _, rule := range rules {
+		severity := string(rule.DefaultSeverity)
+		if cfg != nil {
+			if configured, ok := cfg.Rules[rule.ID]; ok {
+				severity = configured
+			}
+		}
+		if severity != SeverityOff {
+			l.severities[rule.ID] = domain.Severity(severity)
+		}
+	}
+	return l, nil
+}
+
+// Enabled reports whether the rule runs.
+func (l *Linter) Enabled(id string) bool {
+	_, ok := l.severities[id]
+	return ok
+}
+
+// InspectsBodies reports whether rules inspecting test bodies run for files
+// in lang, so Lint needs the file's syntax tree.
+func (l *Linter) InspectsBodies(lang domain.Language) bool {
+	return supportsBodies(lang) && l.bodyRulesEnabled()
+}
+
+// SkipsBodies reports whether enabled rules inspecting test bodies skip files
+// in lang because they do not know its test body shapes.
+func (l *Linter) SkipsBodies(lang domain.Language) bool {
+	return !supportsBodies(lang) && l.bodyRulesEnabled()
+}
+
+func (l *Linter) bodyRulesEnabled() bool {
+	return l.Enabled(RuleEmptyTest) || l.Enabled(RuleNoAssertion) || l.Enabled(RuleSleepWait)
+}
+
+// Lint returns the findings for file, whose source is content, sorted by
+// location and rule. tree is the syntax tree of content; without it, rules
+// inspecting test bodies are skipped. assertions are the callee patterns of
+// the file's framework (framework.Definition.Assertions); without them,
+// no-assertion reports nothing.
+func (l *Linter) Lint(file *domain.TestFile, tree *sitter.Tree, content []byte, assertions []string) []domain.Finding {
+	r := &report{assertions: assertions, linter: l, file: file, seen: make(map[string]bool)}
+
+	r.checkInventory()
+	if tree != nil && l.InspectsBodies(file.Language) {
+		r.checkBodies(tree.RootNode(), content)
+	}
+
+	sort.Slice(r.findings, func(i, j int) bool {
+		a, b := r.findings[i].Location, r.findings[j].Location
+		if a.StartLine != b.StartLine {
+			return a.StartLine < b.StartLine
+		}
+		if a.StartCol != b.StartCol {
+			return a.StartCol < b.StartCol
+		}
+		return r.findings[i].Rule < r.findings[j].Rule
+	})
+	return r.findings
+}
+
+// report collects the findings of one file. Expanded parameterized cases
+// share the location of their template, so findings are deduplicated.
+type report struct {
+	assertions []string
+	file       *domain.TestFile
+	findings   []domain.Finding
+	linter     *Linter
+	seen       map[string]bool
+}
+
+func (r *report) add(rule string, loc domain.Location, format string, args ...any) {
+	severity, ok := r.linter.severities[rule]
+	if !ok {
+		return
+	}
+
+	key := fmt.Sprintf("%s:%d:%d", rule, loc.StartLine, loc.StartCol)
+	if r.seen[key] {
+		return
+	}
+	r.seen[key] = true
+
+	loc.File = r.file.Path
+	r.findings = append(r.findings, domain.Finding{
+		Location: loc,
+		Message:  fmt.Sprintf(format, args...),
+		Rule:     rule,
+		Severity: severity,
+	})
+}
+
+func isKnownRule(id string) bool {
+	for _, rule := range rules {
+		if rule.ID == id {
+			return true
+		}
+	}
+	return false
+}
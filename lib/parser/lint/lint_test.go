@@ -0,0 +1,313 @@
+package lint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/gotesting"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/jest"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/junit5"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/pytest"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// finding is the part of a domain.Finding the tests assert on.
+type finding struct {
+	Line int
+	Rule string
+}
+
+func lintSource(t *testing.T, def *framework.Definition, filename, source string, cfg *lint.Config) []domain.Finding {
+	t.Helper()
+
+	file, err := def.Parser.Parse(context.Background(), []byte(source), filename)
+	require.NoError(t, err)
+
+	tree, err := tspool.Parse(context.Background(), file.Language, []byte(source))
+	require.NoError(t, err)
+	defer tree.Close()
+
+	linter, err := lint.New(cfg)
+	require.NoError(t, err)
+	return linter.Lint(file, tree, []byte(source), def.Assertions)
+}
+
+func summarize(findings []domain.Finding) []finding {
+	out := make([]finding, 0, len(findings))
+	for _, f := range findings {
+		out = append(out, finding{Line: f.Location.StartLine, Rule: f.Rule})
+	}
+	return out
+}
+
+func TestLint_JavaScript(t *testing.T) {
+	source := `describe("invoice", () => {
+  it.only("totals lines", () => {
+    expect(total([1, 2])).toBe(3);
+  });
+
+  it("is empty", () => {
+    // TODO
+  });
+
+  it("renders", async () => {
+    render();
+    await new Promise((resolve) => setTimeout(resolve, 500));
+  });
+
+  it("renders", () => expect(render()).toBeTruthy());
+
+  it.skip("legacy", () => {});
+  it.skip("flaky", () => {}); // skipped: see #123
+
+  it.each([[1], [2]])("case %i", (n) => {
+    assert.ok(n);
+  });
+});
+
+describe.skip("disabled", () => {
+  it.skip("nested", () => {});
+});
+`
+	findings := lintSource(t, jest.NewDefinition(), "invoice.test.ts", source, nil)
+
+	assert.Equal(t, []finding{
+		{Line: 2, Rule: lint.RuleFocusedTest},
+		{Line: 6, Rule: lint.RuleEmptyTest},
+		{Line: 10, Rule: lint.RuleNoAssertion},
+		{Line: 12, Rule: lint.RuleSleepWait},
+		{Line: 15, Rule: lint.RuleDuplicateTestName},
+		{Line: 17, Rule: lint.RuleSkipWithoutReason},
+		{Line: 25, Rule: lint.RuleSkipWithoutReason},
+	}, summarize(findings))
+
+	first := findings[0]
+	assert.Equal(t, domain.SeverityError, first.Severity)
+	assert.Equal(t, "invoice.test.ts", first.Location.File)
+	assert.Equal(t, `focused test "totals lines" makes the runner skip all other tests`, first.Message)
+	assert.Equal(t, `test "renders" waits with a fixed sleep (setTimeout)`, findings[3].Message)
+	assert.Equal(t, `duplicate test name "renders" (first declared on line 10)`, findings[4].Message)
+}
+
+func TestLint_Go(t *testing.T) {
+	source := `package billing
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTotal(t *testing.T) {
+	if total() != 3 {
+		t.Errorf("bad total")
+	}
+}
+
+func TestHelper(t *testing.T) {
+	checkTotal(t, 3)
+}
+
+func TestEmpty(t *testing.T) {
+	// nothing yet
+}
+
+func TestWait(t *testing.T) {
+	time.Sleep(time.Second)
+	require.Equal(t, 3, total())
+}
+
+func TestPrints(t *testing.T) {
+	_ = total()
+}
+
+type BillingSuite struct {
+	suite.Suite
+}
+
+func (s *BillingSuite) TestTotal() {
+	s.Require().NoError(load())
+	s.Equal(3, total())
+}
+
+func (s *BillingSuite) TestLogs() {
+	s.T().Log(total())
+}
+
+func TestBillingSuite(t *testing.T) {
+	suite.Run(t, new(BillingSuite))
+}
+`
+	findings := lintSource(t, gotesting.NewDefinition(), "billing_test.go", source, nil)
+
+	assert.Equal(t, []finding{
+		{Line: 18, Rule: lint.RuleEmptyTest},
+		{Line: 23, Rule: lint.RuleSleepWait},
+		{Line: 27, Rule: lint.RuleNoAssertion},
+		{Line: 40, Rule: lint.RuleNoAssertion},
+	}, summarize(findings))
+}
+
+func TestLint_Python(t *testing.T) {
+	source := `import time
+import pytest
+
+
+def test_total():
+    assert total() == 3
+
+
+def test_raises():
+    with pytest.raises(ValueError):
+        total(-1)
+
+
+def test_placeholder():
+    """Documented but empty."""
+    pass
+
+
+@pytest.mark.skip
+def test_skipped():
+    time.sleep(1)
+
+
+@pytest.mark.skip(reason="needs a database")
+def test_skipped_with_reason():
+    pass
+
+
+def test_polls():
+    time.sleep(0.5)
+    self_check()
+`
+	findings := lintSource(t, pytest.NewDefinition(), "test_billing.py", source, nil)
+
+	assert.Equal(t, []finding{
+		{Line: 14, Rule: lint.RuleEmptyTest},
+		{Line: 20, Rule: lint.RuleSkipWithoutReason},
+		{Line: 29, Rule: lint.RuleNoAssertion},
+		{Line: 30, Rule: lint.RuleSleepWait},
+	}, summarize(findings))
+}
+
+func TestLint_Java(t *testing.T) {
+	source := `package com.acme;
+
+import org.junit.jupiter.api.Test;
+
+class InvoiceTest {
+    @Test
+    void totals() {
+        assertEquals(3, total());
+    }
+
+    @Test
+    void verifiesMock() throws Exception {
+        Thread.sleep(100);
+        verify(repository).save(any());
+    }
+
+    @Test
+    void empty() {
+    }
+}
+`
+	findings := lintSource(t, junit5.NewDefinition(), "InvoiceTest.java", source, nil)
+
+	assert.Equal(t, []finding{
+		{Line: 13, Rule: lint.RuleSleepWait},
+		{Line: 17, Rule: lint.RuleEmptyTest},
+	}, summarize(findings))
+}
+
+func TestLint_AssertionVocabulary(t *testing.T) {
+	source := `it("checks", () => {
+  check(total());
+});
+`
+	tests := []struct {
+		name       string
+		assertions []string
+		want       []finding
+	}{
+		{name: "framework vocabulary", assertions: jest.NewDefinition().Assertions, want: []finding{{Line: 1, Rule: lint.RuleNoAssertion}}},
+		{name: "custom vocabulary", assertions: []string{"check"}, want: []finding{}},
+		{name: "no vocabulary", assertions: nil, want: []finding{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := jest.NewDefinition()
+			def.Assertions = tt.assertions
+			assert.Equal(t, tt.want, summarize(lintSource(t, def, "a.test.js", source, nil)))
+		})
+	}
+}
+
+func TestLint_Config(t *testing.T) {
+	source := `it.only("a", () => {});
+it.skip("b", () => {});
+`
+	findings := lintSource(t, jest.NewDefinition(), "a.test.js", source, &lint.Config{
+		Rules: map[string]string{
+			lint.RuleEmptyTest:         lint.SeverityOff,
+			lint.RuleFocusedTest:       "warning",
+			lint.RuleSkipWithoutReason: lint.SeverityOff,
+		},
+	})
+
+	require.Len(t, findings, 1)
+	assert.Equal(t, lint.RuleFocusedTest, findings[0].Rule)
+	assert.Equal(t, domain.SeverityWarning, findings[0].Severity)
+}
+
+func TestLint_WithoutTree(t *testing.T) {
+	source := []byte(`it.only("a", () => {});
+`)
+	def := jest.NewDefinition()
+	file, err := def.Parser.Parse(context.Background(), source, "a.test.js")
+	require.NoError(t, err)
+
+	linter, err := lint.New(nil)
+	require.NoError(t, err)
+	assert.True(t, linter.InspectsBodies(domain.LanguageJavaScript))
+	assert.False(t, linter.InspectsBodies(domain.LanguageRuby))
+	assert.False(t, linter.SkipsBodies(domain.LanguageJavaScript))
+	assert.True(t, linter.SkipsBodies(domain.LanguageDart))
+
+	assert.Equal(t, []finding{
+		{Line: 1, Rule: lint.RuleFocusedTest},
+	}, summarize(linter.Lint(file, nil, source, def.Assertions)))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := &lint.Config{Rules: map[string]string{
+		"no-such-rule":       "error",
+		lint.RuleSleepWait:   "fatal",
+		lint.RuleFocusedTest: "error",
+	}}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rules.no-such-rule: unknown rule")
+	assert.Contains(t, err.Error(), `rules.sleep-wait: unknown severity "fatal"`)
+
+	_, err = lint.New(cfg)
+	assert.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	assert.Nil(t, lint.Merge(nil, nil))
+
+	merged := lint.Merge(
+		&lint.Config{Rules: map[string]string{lint.RuleSleepWait: "error", lint.RuleEmptyTest: "note"}},
+		&lint.Config{Rules: map[string]string{lint.RuleSleepWait: lint.SeverityOff}},
+	)
+	assert.Equal(t, map[string]string{lint.RuleSleepWait: lint.SeverityOff, lint.RuleEmptyTest: "note"}, merged.Rules)
+}
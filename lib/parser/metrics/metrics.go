@@ -0,0 +1,266 @@
+// Package metrics measures test definitions: assertion calls, lines of code
+// and control flow nesting depth.
+//
+// Measurements run on the tree-sitter tree of the file, so they are only
+// available for languages tspool can parse. Assertion calls are recognized
+// by the callee patterns of the file's framework (framework.Definition.Assertions).
+package metrics
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+// callTypes are the call nodes of the supported grammars.
+var callTypes = map[string]bool{
+	"call":                            true, // Python, Ruby, Elixir
+	"call_expression":                 true, // JavaScript, Go, Rust, C++, Kotlin, Swift, Scala
+	"infix_expression":                true, // Kotlin and Scala infix calls (x shouldBe 1)
+	"invocation_expression":           true, // C#
+	"macro_invocation":                true, // Rust
+	"member_call_expression":          true, // PHP
+	"method_invocation":               true, // Java
+	"nullsafe_member_call_expression": true, // PHP
+	"function_call_expression":        true, // PHP
+	"scoped_call_expression":          true, // PHP
+}
+
+// argumentTypes end the callee part of a call node.
+var argumentTypes = map[string]bool{
+	"argument_list":   true,
+	"arguments":       true,
+	"call_suffix":     true,
+	"token_tree":      true,
+	"value_arguments": true,
+}
+
+// controlTypes are the control flow statements counted for nesting depth.
+var controlTypes = map[string]bool{
+	"case": true, "do_statement": true, "do_while_statement": true,
+	"enhanced_for_statement": true, "expression_switch_statement": true,
+	"for": true, "for_expression": true, "for_in_statement": true, "for_range_loop": true,
+	"for_statement": true, "foreach_statement": true, "guard_statement": true,
+	"if": true, "if_expression": true, "if_statement": true, "loop_expression": true,
+	"match_expression": true, "match_statement": true, "repeat_while_statement": true,
+	"select_statement": true, "switch_expression": true, "switch_statement": true,
+	"try_statement": true, "type_switch_statement": true, "unless": true, "until": true,
+	"when_expression": true, "while": true, "while_expression": true, "while_statement": true,
+	"with_statement": true,
+}
+
+// Supports reports whether tests in lang can be measured, which needs a
+// tree-sitter grammar. Dart, Erlang and Gherkin have none.
+func Supports(lang domain.Language) bool {
+	return tspool.Supports(lang)
+}
+
+// Measure sets Metrics on the tests of file whose definition is found in
+// tree, the syntax tree of content. assertions are the callee patterns of
+// the framework's assertion calls. Files without a tree, or in languages
+// Supports rejects, are left unchanged.
+func Measure(file *domain.TestFile, tree *sitter.Tree, content []byte, assertions []string) {
+	if tree == nil || !Supports(file.Language) {
+		return
+	}
+
+	m := &measurer{
+		assertions: assertions,
+		byLocation: make(map[domain.Location]*domain.TestMetrics),
+		content:    content,
+		root:       tree.RootNode(),
+	}
+	m.measureTests(file.Tests)
+	for i := range file.Suites {
+		m.measureSuite(&file.Suites[i])
+	}
+}
+
+type measurer struct {
+	assertions []string
+	// byLocation shares results between expanded parameterized cases.
+	byLocation map[domain.Location]*domain.TestMetrics
+	content    []byte
+	root       *sitter.Node
+}
+
+func (m *measurer) measureSuite(suite *domain.TestSuite) {
+	m.measureTests(suite.Tests)
+	for i := range suite.Suites {
+		m.measureSuite(&suite.Suites[i])
+	}
+}
+
+func (m *measurer) measureTests(tests []domain.Test) {
+	for i := range tests {
+		loc := tests[i].Location
+		metrics, ok := m.byLocation[loc]
+		if !ok {
+			if node := tspool.FindNode(m.root, loc); node != nil {
+				metrics = m.measure(node)
+			}
+			m.byLocation[loc] = metrics
+		}
+		if metrics != nil {
+			copied := *metrics
+			tests[i].Metrics = &copied
+		}
+	}
+}
+
+func (m *measurer) measure(node *sitter.Node) *domain.TestMetrics {
+	return &domain.TestMetrics{
+		Assertions: m.countAssertions(node),
+		Depth:      nestingDepth(node, 0),
+		Lines:      codeLines(node),
+	}
+}
+
+// countAssertions counts assert statements and calls matching the assertion
+// patterns. A call chained onto a counted call (assertThat(x).isEqualTo(y))
+// is one assertion, so calls within the callee of a counted call are skipped.
+func (m *measurer) countAssertions(node *sitter.Node) int {
+	count := 0
+	var claimed [][2]uint32
+	walk(node, 0, func(n *sitter.Node) {
+		switch {
+		case n.Type() == "assert_statement":
+			count++
+			return
+		case n.Type() == "ERROR" && n.ChildCount() == 0:
+			// The Swift grammar predates macros and parses #expect(...) as
+			// an error token followed by a tuple.
+			if text := n.Content(m.content); strings.HasPrefix(text, "#") && m.isAssertion(text) {
+				count++
+			}
+			return
+		case !callTypes[n.Type()]:
+			return
+		}
+
+		calleeEnd, callee := m.callee(n)
+		if callee == "" || !m.isAssertion(callee) {
+			return
+		}
+		for _, r := range claimed {
+			if n.StartByte() >= r[0] && n.EndByte() <= r[1] {
+				return
+			}
+		}
+		count++
+		claimed = append(claimed, [2]uint32{n.StartByte(), calleeEnd})
+	})
+	return count
+}
+
+// callee returns the end of the callee part of a call node and the callee
+// with call arguments, whitespace and ?/! markers removed: expect(x).not.toBe
+// yields "expect.not.toBe", assert_eq! yields "assert_eq".
+func (m *measurer) callee(n *sitter.Node) (uint32, string) {
+	if n.Type() == "infix_expression" {
+		operator := n.ChildByFieldName("operator")
+		if operator == nil && n.NamedChildCount() == 3 && n.NamedChild(1).Type() == "simple_identifier" {
+			operator = n.NamedChild(1)
+		}
+		if operator == nil {
+			return 0, ""
+		}
+		return operator.EndByte(), operator.Content(m.content)
+	}
+
+	end := n.EndByte()
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		field := n.FieldNameForChild(i)
+		if i > 0 && (argumentTypes[child.Type()] || field == "arguments" || field == "block") {
+			end = child.StartByte()
+			break
+		}
+	}
+	return end, normalizeCallee(string(m.content[n.StartByte():end]))
+}
+
+func (m *measurer) isAssertion(callee string) bool {
+	return framework.IsAssertion(m.assertions, callee)
+}
+
+func normalizeCallee(callee string) string {
+	var b strings.Builder
+	depth := 0
+	for _, c := range callee {
+		switch {
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth > 0 {
+				depth--
+			}
+		case depth == 0 && !strings.ContainsRune(" \t\r\n?!", c):
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// nestingDepth returns the deepest nesting of control flow below n. An else
+// if continues its chain rather than nesting.
+func nestingDepth(n *sitter.Node, depth int) int {
+	if depth > tspool.MaxTreeDepth {
+		return 0
+	}
+	deepest := 0
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		d := nestingDepth(child, depth+1)
+		if controlTypes[child.Type()] && !isElseIf(n, child) {
+			d++
+		}
+		if d > deepest {
+			deepest = d
+		}
+	}
+	return deepest
+}
+
+func isElseIf(parent, child *sitter.Node) bool {
+	if !strings.HasPrefix(child.Type(), "if") {
+		return false
+	}
+	return parent.Type() == "else_clause" || parent.Type() == "else" || strings.HasPrefix(parent.Type(), "if")
+}
+
+// codeLines counts the lines holding tokens other than comments.
+func codeLines(node *sitter.Node) int {
+	rows := make(map[uint32]bool)
+	var visit func(n *sitter.Node, depth int)
+	visit = func(n *sitter.Node, depth int) {
+		if depth > tspool.MaxTreeDepth || strings.Contains(n.Type(), "comment") {
+			return
+		}
+		if n.ChildCount() == 0 {
+			for row := n.StartPoint().Row; row <= n.EndPoint().Row; row++ {
+				rows[row] = true
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			visit(n.Child(i), depth+1)
+		}
+	}
+	visit(node, 0)
+	return len(rows)
+}
+
+func walk(n *sitter.Node, depth int, visit func(*sitter.Node)) {
+	if depth > tspool.MaxTreeDepth {
+		return
+	}
+	visit(n)
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		walk(n.NamedChild(i), depth+1, visit)
+	}
+}
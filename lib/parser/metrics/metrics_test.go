@@ -0,0 +1,201 @@
+package metrics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/metrics"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/cargotest"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/gotesting"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/jest"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/junit5"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/pytest"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/rspec"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+func measure(t *testing.T, def *framework.Definition, filename, source string) map[string]domain.TestMetrics {
+	t.Helper()
+
+	file, err := def.Parser.Parse(context.Background(), []byte(source), filename)
+	require.NoError(t, err)
+	tree, err := tspool.Parse(context.Background(), file.Language, []byte(source))
+	require.NoError(t, err)
+	defer tree.Close()
+	metrics.Measure(file, tree, []byte(source), def.Assertions)
+
+	byName := make(map[string]domain.TestMetrics)
+	var collect func(tests []domain.Test, suites []domain.TestSuite)
+	collect = func(tests []domain.Test, suites []domain.TestSuite) {
+		for _, test := range tests {
+			require.NotNil(t, test.Metrics, test.Name)
+			byName[test.Name] = *test.Metrics
+		}
+		for _, suite := range suites {
+			collect(suite.Tests, suite.Suites)
+		}
+	}
+	collect(file.Tests, file.Suites)
+	return byName
+}
+
+func TestMeasure_JavaScript(t *testing.T) {
+	source := `describe("cart", () => {
+  it("adds", () => {
+    // arrange
+    const cart = new Cart();
+
+    cart.add(item);
+    expect(cart.total).toBe(3);
+    expect(cart.items).not.toContain(other);
+  });
+
+  it("checks every line", () => {
+    for (const line of lines) {
+      if (line.qty > 0) {
+        expect(line.total).toBeGreaterThan(0);
+      } else if (line.qty < 0) {
+        assert.fail("negative");
+      }
+    }
+  });
+
+  it("renders", () => render());
+});
+`
+	got := measure(t, jest.NewDefinition(), "cart.test.ts", source)
+
+	assert.Equal(t, domain.TestMetrics{Assertions: 2, Depth: 0, Lines: 6}, got["adds"])
+	assert.Equal(t, domain.TestMetrics{Assertions: 2, Depth: 2, Lines: 9}, got["checks every line"])
+	assert.Equal(t, domain.TestMetrics{Assertions: 0, Depth: 0, Lines: 1}, got["renders"])
+}
+
+func TestMeasure_Go(t *testing.T) {
+	source := `package cart
+
+import "testing"
+
+func TestTotal(t *testing.T) {
+	got := total()
+	if got != 3 {
+		t.Errorf("got %d", got)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+}
+`
+	got := measure(t, gotesting.NewDefinition(), "cart_test.go", source)
+
+	assert.Equal(t, domain.TestMetrics{Assertions: 3, Depth: 1, Lines: 8}, got["TestTotal"])
+}
+
+func TestMeasure_GoTestifySuite(t *testing.T) {
+	source := `package cart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CartSuite struct {
+	suite.Suite
+}
+
+func (s *CartSuite) TestTotal() {
+	got, err := total()
+	s.Require().NoError(err)
+	s.Equal(3, got)
+	s.Assert().NotZero(got)
+	s.T().Log(got)
+}
+
+func TestCartSuite(t *testing.T) {
+	suite.Run(t, new(CartSuite))
+}
+`
+	got := measure(t, gotesting.NewDefinition(), "cart_test.go", source)
+
+	assert.Equal(t, 3, got["TestTotal"].Assertions)
+}
+
+func TestMeasure_Python(t *testing.T) {
+	source := `import pytest
+
+
+@pytest.mark.slow
+def test_total():
+    """Totals the cart."""
+    assert total() == 3
+    with pytest.raises(ValueError):
+        total(-1)
+`
+	got := measure(t, pytest.NewDefinition(), "test_cart.py", source)
+
+	assert.Equal(t, domain.TestMetrics{Assertions: 2, Depth: 1, Lines: 5}, got["test_total"])
+}
+
+func TestMeasure_Java(t *testing.T) {
+	source := `class CartTest {
+    @Test
+    void totals() {
+        assertThat(cart.total()).isEqualTo(3);
+        assertEquals(3, cart.total());
+        verify(repository).save(cart);
+    }
+}
+`
+	got := measure(t, junit5.NewDefinition(), "CartTest.java", source)
+
+	assert.Equal(t, 3, got["totals"].Assertions)
+}
+
+func TestMeasure_Ruby(t *testing.T) {
+	source := `RSpec.describe Cart do
+  it "totals" do
+    expect(cart.total).to eq(3)
+    expect { cart.add(nil) }.to raise_error(ArgumentError)
+    cart.should be_valid
+  end
+end
+`
+	got := measure(t, rspec.NewDefinition(), "cart_spec.rb", source)
+
+	assert.Equal(t, 3, got["totals"].Assertions)
+}
+
+func TestMeasure_Rust(t *testing.T) {
+	source := `#[cfg(test)]
+mod tests {
+    #[test]
+    fn totals() {
+        assert_eq!(total(), 3);
+        assert!(valid());
+    }
+}
+`
+	got := measure(t, cargotest.NewDefinition(), "lib.rs", source)
+
+	assert.Equal(t, 2, got["totals"].Assertions)
+}
+
+func TestMeasure_UnsupportedLanguage(t *testing.T) {
+	file := &domain.TestFile{
+		Language: domain.LanguageDart,
+		Tests:    []domain.Test{{Name: "adds", Location: domain.Location{StartLine: 1, EndLine: 1}}},
+	}
+	source := []byte(`test("adds", () { expect(1, 1); });`)
+	// tspool parses languages without a grammar as TypeScript.
+	tree, err := tspool.Parse(context.Background(), file.Language, source)
+	require.NoError(t, err)
+	defer tree.Close()
+	metrics.Measure(file, tree, source, []string{"expect"})
+
+	assert.False(t, metrics.Supports(file.Language))
+	assert.Nil(t, file.Tests[0].Metrics)
+}
@@ -0,0 +1,199 @@
+package parser
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kubrickcode/specvital/lib/parser/detection"
+)
+
+// Observer receives progress events from a scan.
+//
+// Every file reported by FileDiscovered ends with exactly one FileParsed or
+// FileFailed, so done/discovered is the scan's progress once
+// DiscoveryCompleted has reported the total. Discovery errors are reported
+// by FileFailed alone, with an empty path, and are part of that total.
+// Discovery runs alongside parsing, so the total is unknown until then.
+//
+// File events are sent from parser workers concurrently; implementations
+// must be safe for concurrent use and should return quickly, since a slow
+// observer slows the scan. Embed NopObserver to handle only some events.
+type Observer interface {
+	// ConfigsDiscovered reports the framework config files found under the
+	// source root, relative to it. Not called when the scanner reuses a
+	// project scope from an earlier scan.
+	ConfigsDiscovered(paths []string)
+
+	// FileDiscovered reports a test file candidate queued for parsing.
+	FileDiscovered(path string)
+
+	// DiscoveryCompleted reports the number of candidates once discovery ends.
+	DiscoveryCompleted(files int)
+
+	// FileDetected reports the framework detection result of a file.
+	// Files served from the ResultCache are not detected again.
+	FileDetected(path string, result detection.Result)
+
+	// FileParsed reports a file that was parsed, served from the cache or
+	// skipped (result.File is nil when no framework was detected).
+	FileParsed(result *FileResult, elapsed time.Duration)
+
+	// FileFailed reports a file that could not be read, detected or parsed,
+	// or, with an empty path, an error encountered during discovery.
+	FileFailed(path string, err error, elapsed time.Duration)
+
+	// ScanCompleted reports the statistics of a finished, timed out or
+	// cancelled scan. Stats of a ScanStream carry no config or project counts.
+	ScanCompleted(stats ScanStats)
+}
+
+// NopObserver ignores every event. It is the default Observer.
+type NopObserver struct{}
+
+var _ Observer = NopObserver{}
+
+func (NopObserver) ConfigsDiscovered([]string)              {}
+func (NopObserver) FileDiscovered(string)                   {}
+func (NopObserver) DiscoveryCompleted(int)                  {}
+func (NopObserver) FileDetected(string, detection.Result)   {}
+func (NopObserver) FileParsed(*FileResult, time.Duration)   {}
+func (NopObserver) FileFailed(string, error, time.Duration) {}
+func (NopObserver) ScanCompleted(ScanStats)                 {}
+
+// DefaultProgressInterval is how often SlogObserver logs scan progress.
+const DefaultProgressInterval = 10 * time.Second
+
+// SlogObserver logs scan events to a slog.Logger. Per-file events are
+// logged at debug level, failures at warn level, and progress and
+// completion at info level at most once per interval.
+//
+// Counters reset when a scan completes, so an observer can be reused by
+// consecutive scans but not shared by concurrent ones.
+type SlogObserver struct {
+	logger   *slog.Logger
+	interval time.Duration
+
+	mu         sync.Mutex
+	lastLog    time.Time
+	discovered atomic.Int64
+	done       atomic.Int64
+	failed     atomic.Int64
+	// total is the number of candidates, or -1 while discovery runs.
+	total atomic.Int64
+}
+
+var _ Observer = (*SlogObserver)(nil)
+
+// NewSlogObserver creates an observer logging to logger, or to slog.Default()
+// when logger is nil, with progress logged every DefaultProgressInterval.
+func NewSlogObserver(logger *slog.Logger) *SlogObserver {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	o := &SlogObserver{
+		logger:   logger,
+		interval: DefaultProgressInterval,
+		lastLog:  time.Now(),
+	}
+	o.total.Store(-1)
+	return o
+}
+
+// WithInterval sets how often progress is logged. Non-positive values log
+// after every file.
+func (o *SlogObserver) WithInterval(d time.Duration) *SlogObserver {
+	o.interval = d
+	return o
+}
+
+func (o *SlogObserver) ConfigsDiscovered(paths []string) {
+	o.logger.Debug("scan configs discovered", "count", len(paths), "paths", paths)
+}
+
+func (o *SlogObserver) FileDiscovered(path string) {
+	o.discovered.Add(1)
+	o.logger.Debug("scan file discovered", "path", path)
+}
+
+func (o *SlogObserver) DiscoveryCompleted(files int) {
+	o.total.Store(int64(files))
+	o.logger.Info("scan discovery completed", "files", files)
+}
+
+func (o *SlogObserver) FileDetected(path string, result detection.Result) {
+	o.logger.Debug("scan file detected",
+		"path", path,
+		"framework", result.Framework,
+		"source", string(result.Source),
+		"confidence", result.Confidence,
+	)
+}
+
+func (o *SlogObserver) FileParsed(result *FileResult, elapsed time.Duration) {
+	tests := 0
+	if result.File != nil {
+		tests = result.File.CountTests()
+	}
+	o.logger.Debug("scan file parsed",
+		"path", result.Path,
+		"matched", result.File != nil,
+		"cached", result.Cached,
+		"tests", tests,
+		"duration_ms", elapsed.Milliseconds(),
+	)
+	o.fileDone()
+}
+
+func (o *SlogObserver) FileFailed(path string, err error, elapsed time.Duration) {
+	o.failed.Add(1)
+	o.logger.Warn("scan file failed",
+		"path", path,
+		"error", err,
+		"duration_ms", elapsed.Milliseconds(),
+	)
+	o.fileDone()
+}
+
+func (o *SlogObserver) ScanCompleted(stats ScanStats) {
+	o.logger.Info("scan completed",
+		"files_scanned", stats.FilesScanned,
+		"files_matched", stats.FilesMatched,
+		"files_failed", stats.FilesFailed,
+		"files_skipped", stats.FilesSkipped,
+		"files_cached", stats.FilesCached,
+		"configs_found", stats.ConfigsFound,
+		"duration_ms", stats.Duration.Milliseconds(),
+	)
+
+	o.mu.Lock()
+	o.lastLog = time.Now()
+	o.mu.Unlock()
+	o.discovered.Store(0)
+	o.done.Store(0)
+	o.failed.Store(0)
+	o.total.Store(-1)
+}
+
+func (o *SlogObserver) fileDone() {
+	done := o.done.Add(1)
+
+	o.mu.Lock()
+	if time.Since(o.lastLog) < o.interval {
+		o.mu.Unlock()
+		return
+	}
+	o.lastLog = time.Now()
+	o.mu.Unlock()
+
+	attrs := []any{
+		"files_done", done,
+		"files_discovered", o.discovered.Load(),
+		"files_failed", o.failed.Load(),
+	}
+	if total := o.total.Load(); total > 0 {
+		attrs = append(attrs, "progress_pct", int(done*100/total))
+	}
+	o.logger.Info("scan progress", attrs...)
+}
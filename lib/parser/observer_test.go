@@ -0,0 +1,55 @@
+package parser_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestSlogObserver(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	observer := parser.NewSlogObserver(logger).WithInterval(0)
+
+	observer.FileDiscovered("a.test.ts")
+	observer.FileDiscovered("b.test.ts")
+	observer.DiscoveryCompleted(2)
+	observer.FileParsed(&parser.FileResult{Path: "a.test.ts", File: &domain.TestFile{}}, time.Millisecond)
+	observer.FileFailed("b.test.ts", errors.New("boom"), time.Millisecond)
+	observer.ScanCompleted(parser.ScanStats{FilesScanned: 2, FilesMatched: 1, FilesFailed: 1})
+
+	out := buf.String()
+	for _, want := range []string{
+		"scan discovery completed",
+		"files_done=1 files_discovered=2 files_failed=0 progress_pct=50",
+		"scan file failed",
+		"files_done=2 files_discovered=2 files_failed=1 progress_pct=100",
+		"scan completed",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected log to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "scan file parsed") {
+		t.Errorf("expected per-file events at debug level, got:\n%s", out)
+	}
+}
+
+func TestSlogObserver_ThrottlesProgress(t *testing.T) {
+	var buf bytes.Buffer
+	observer := parser.NewSlogObserver(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	for i := 0; i < 10; i++ {
+		observer.FileParsed(&parser.FileResult{Path: "a.test.ts"}, time.Millisecond)
+	}
+
+	if strings.Contains(buf.String(), "scan progress") {
+		t.Errorf("expected no progress within the interval, got:\n%s", buf.String())
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
 )
 
 // ScanOptions configures scanner behavior.
@@ -12,15 +14,37 @@ type ScanOptions struct {
 	// These are combined with DefaultSkipPatterns.
 	ExcludePatterns []string
 
+	// ExpandParameterized expands statically resolvable parameterized tests
+	// (test.each, @pytest.mark.parametrize, @CsvSource, [InlineData], [TestCase],
+	// TestNG data providers) into one test per case, each linked to its template.
+	// Default: false (ADR-02: a parameterized test counts as one test).
+	ExpandParameterized bool
+
 	// ExtractDomainHints enables extraction of domain classification metadata.
 	// When true, imports, function calls, and variable names are extracted.
 	// Default: true (opt-out via WithDomainHints(false)).
 	ExtractDomainHints bool
 
+	// KindRules classify tests by file path when neither the parser nor the
+	// framework decides their kind. Rules are tried in order; the first match wins.
+	// Default: DefaultKindRules. An empty non-nil slice disables path rules.
+	KindRules []KindRule
+
+	// Lint enables the test smell linter with these rule settings; rules set
+	// in the repository configuration apply on top. Findings are stored in
+	// TestFile.Findings.
+	// Default: nil (disabled). Use WithLint(&lint.Config{}) for default severities.
+	Lint *lint.Config
+
 	// MaxFileSize is the maximum file size in bytes to process.
 	// Files larger than this are skipped.
 	MaxFileSize int64
 
+	// Observer receives progress events (files discovered, detected, parsed
+	// or failed, scan completed). It does not affect scan results.
+	// Default: NopObserver. Use NewSlogObserver to log progress.
+	Observer Observer
+
 	// Patterns specifies glob patterns to filter test files.
 	// Empty means all test file candidates are processed.
 	Patterns []string
@@ -29,6 +53,33 @@ type ScanOptions struct {
 	// If nil, uses framework.DefaultRegistry().
 	Registry *framework.Registry
 
+	// RepoConfig replaces the repository configuration file read from the
+	// source root. Default: nil (read .specvital.yml unless IgnoreRepoConfig).
+	RepoConfig *repoconfig.Config
+
+	// IgnoreRepoConfig skips reading the repository configuration file.
+	IgnoreRepoConfig bool
+
+	// ResultCache stores per-file results across scans. Files whose content,
+	// detection inputs and parser version are unchanged skip parsing.
+	// Default: nil (no caching).
+	ResultCache ResultCache
+
+	// SourceGraph maps test files to the production source files they import,
+	// exposed as Inventory.SourceGraph. Only Scan builds the graph.
+	// Default: false (opt-in via WithSourceGraph(true)).
+	SourceGraph bool
+
+	// TestMetrics measures every test (assertion calls, lines of code,
+	// nesting depth) into Test.Metrics.
+	// Default: false (opt-in via WithTestMetrics(true)).
+	TestMetrics bool
+
+	// Fingerprints hashes every test definition into Test.Fingerprint so
+	// renamed or moved tests can be recognized.
+	// Default: false (opt-in via WithFingerprints(true)).
+	Fingerprints bool
+
 	// Timeout is the maximum duration for the entire scan operation.
 	// Zero or negative values use DefaultTimeout.
 	Timeout time.Duration
@@ -71,6 +122,69 @@ func WithDomainHints(enabled bool) ScanOption {
 	}
 }
 
+// WithParameterizedExpansion enables or disables expansion of parameterized tests
+// into one test per statically resolvable case.
+// Default: false (disabled).
+func WithParameterizedExpansion(enabled bool) ScanOption {
+	return func(o *ScanOptions) {
+		o.ExpandParameterized = enabled
+	}
+}
+
+// WithSourceGraph enables or disables mapping test files to the production
+// source files they import. Mapping walks and resolves the whole source tree.
+// Default: false (disabled).
+func WithSourceGraph(enabled bool) ScanOption {
+	return func(o *ScanOptions) {
+		o.SourceGraph = enabled
+	}
+}
+
+// WithTestMetrics enables or disables per-test metrics. Measuring parses
+// each test file a second time.
+// Default: false (disabled).
+func WithTestMetrics(enabled bool) ScanOption {
+	return func(o *ScanOptions) {
+		o.TestMetrics = enabled
+	}
+}
+
+// WithFingerprints enables or disables per-test fingerprints. Fingerprinting
+// parses each test file a second time.
+// Default: false (disabled).
+func WithFingerprints(enabled bool) ScanOption {
+	return func(o *ScanOptions) {
+		o.Fingerprints = enabled
+	}
+}
+
+// WithKindRules replaces the path rules used to classify test kinds.
+// Pass an empty slice to classify by framework only.
+func WithKindRules(rules []KindRule) ScanOption {
+	return func(o *ScanOptions) {
+		if rules == nil {
+			rules = []KindRule{}
+		}
+		o.KindRules = rules
+	}
+}
+
+// WithLint enables the test smell linter. A nil cfg disables it; an empty
+// one runs every rule with its default severity.
+func WithLint(cfg *lint.Config) ScanOption {
+	return func(o *ScanOptions) {
+		o.Lint = cfg
+	}
+}
+
+// WithObserver sets the observer notified of scan progress.
+// A nil observer restores the default NopObserver.
+func WithObserver(observer Observer) ScanOption {
+	return func(o *ScanOptions) {
+		o.Observer = observer
+	}
+}
+
 // WithExcludePatterns adds directory patterns to skip during file discovery.
 func WithExcludePatterns(patterns []string) ScanOption {
 	return func(o *ScanOptions) {
@@ -99,14 +213,41 @@ func WithRegistry(registry *framework.Registry) ScanOption {
 	}
 }
 
+// WithRepoConfig scans with cfg instead of the configuration file at the source root.
+func WithRepoConfig(cfg *repoconfig.Config) ScanOption {
+	return func(o *ScanOptions) {
+		o.RepoConfig = cfg
+	}
+}
+
+// WithoutRepoConfig ignores the configuration file at the source root.
+func WithoutRepoConfig() ScanOption {
+	return func(o *ScanOptions) {
+		o.IgnoreRepoConfig = true
+	}
+}
+
+// WithResultCache sets the cache used to reuse per-file results across scans.
+func WithResultCache(cache ResultCache) ScanOption {
+	return func(o *ScanOptions) {
+		o.ResultCache = cache
+	}
+}
+
 func applyDefaults(opts *ScanOptions) {
 	// ExtractDomainHints defaults to true when opts is zero-initialized.
 	// Since bool zero value is false, we need special handling.
 	// Users must explicitly call WithDomainHints(false) to disable.
 	// This is handled by initializing to true in newDefaultOptions().
+	if opts.KindRules == nil {
+		opts.KindRules = DefaultKindRules
+	}
 	if opts.MaxFileSize <= 0 {
 		opts.MaxFileSize = DefaultMaxFileSize
 	}
+	if opts.Observer == nil {
+		opts.Observer = NopObserver{}
+	}
 	if opts.Registry == nil {
 		opts.Registry = framework.DefaultRegistry()
 	}
@@ -0,0 +1,205 @@
+// Package project attributes test files to the package or module that owns
+// them in a monorepo.
+//
+// A project is declared by a build manifest: go.mod, Cargo.toml,
+// package.json (npm, pnpm and yarn workspaces), build.gradle(.kts),
+// *.csproj / *.fsproj and pyproject.toml. A file belongs to the project of
+// the nearest directory above it that holds a manifest.
+package project
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// Kind identifies the build system that declares a project.
+type Kind string
+
+const (
+	KindCargo    Kind = "cargo"
+	KindDotNet   Kind = "dotnet"
+	KindGoModule Kind = "go"
+	KindGradle   Kind = "gradle"
+	KindNPM      Kind = "npm"
+	KindPython   Kind = "python"
+)
+
+// priority orders kinds for directories holding several manifests
+// (e.g., a Gradle project with a package.json for tooling): lower wins.
+var priority = map[Kind]int{
+	KindGoModule: 0,
+	KindCargo:    1,
+	KindGradle:   2,
+	KindDotNet:   3,
+	KindPython:   4,
+	KindNPM:      5,
+}
+
+// ManifestKind returns the kind of project a manifest file name declares.
+func ManifestKind(filename string) (Kind, bool) {
+	switch filename {
+	case "go.mod":
+		return KindGoModule, true
+	case "Cargo.toml":
+		return KindCargo, true
+	case "package.json":
+		return KindNPM, true
+	case "build.gradle", "build.gradle.kts":
+		return KindGradle, true
+	case "pyproject.toml":
+		return KindPython, true
+	}
+	switch filepath.Ext(filename) {
+	case ".csproj", ".fsproj":
+		return KindDotNet, true
+	}
+	return "", false
+}
+
+// Parse reads the manifest at relPath (relative to the source root) and
+// returns the project it declares. It returns false for manifests that do
+// not declare a package, such as a Cargo workspace root.
+//
+// Projects without a declared name are named after their directory; the
+// root project may then have an empty name.
+func Parse(relPath string, content []byte) (domain.Project, bool) {
+	relPath = filepath.ToSlash(relPath)
+	filename := path.Base(relPath)
+	kind, ok := ManifestKind(filename)
+	if !ok {
+		return domain.Project{}, false
+	}
+
+	dir := path.Dir(relPath)
+	name := ""
+	switch kind {
+	case KindGoModule:
+		name = goModulePath(content)
+	case KindCargo:
+		name, ok = tomlSectionName(content, "package")
+		if !ok {
+			return domain.Project{}, false
+		}
+	case KindPython:
+		name, ok = tomlSectionName(content, "project", "tool.poetry")
+		if !ok {
+			return domain.Project{}, false
+		}
+	case KindNPM:
+		var pkg struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(content, &pkg); err != nil {
+			return domain.Project{}, false
+		}
+		name = pkg.Name
+	case KindDotNet:
+		name = strings.TrimSuffix(filename, path.Ext(filename))
+	}
+
+	if name == "" && dir != "." {
+		name = path.Base(dir)
+	}
+
+	return domain.Project{Kind: string(kind), Name: name, Path: dir}, true
+}
+
+// goModulePath returns the module path declared in a go.mod file.
+func goModulePath(content []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "module"); ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(rest), `"`)
+		}
+	}
+	return ""
+}
+
+// tomlSectionName returns the name key of the first of sections present in a
+// TOML document. Only the subset of TOML used by manifests is understood.
+func tomlSectionName(content []byte, sections ...string) (string, bool) {
+	wanted := make(map[string]bool, len(sections))
+	for _, s := range sections {
+		wanted[s] = true
+	}
+
+	found := false
+	inSection := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			header := strings.TrimSpace(strings.Trim(line, "[]"))
+			inSection = wanted[header]
+			found = found || inSection
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(key) != "name" {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if i := strings.Index(value, "#"); i > 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+		return strings.Trim(value, `"'`), true
+	}
+	return "", found
+}
+
+// Index resolves files to the project of their nearest enclosing manifest.
+// A nil Index resolves nothing.
+type Index struct {
+	byDir map[string]domain.Project
+}
+
+// NewIndex returns an Index over projects. When several projects share a
+// directory, the build system with the higher priority wins.
+func NewIndex(projects []domain.Project) *Index {
+	idx := &Index{byDir: make(map[string]domain.Project, len(projects))}
+	for _, p := range projects {
+		existing, ok := idx.byDir[p.Path]
+		if ok && priority[Kind(existing.Kind)] <= priority[Kind(p.Kind)] {
+			continue
+		}
+		idx.byDir[p.Path] = p
+	}
+	return idx
+}
+
+// Len returns the number of indexed projects.
+func (idx *Index) Len() int {
+	if idx == nil {
+		return 0
+	}
+	return len(idx.byDir)
+}
+
+// Lookup returns the project owning the file at relPath, or nil when no
+// manifest encloses it.
+func (idx *Index) Lookup(relPath string) *domain.Project {
+	if idx.Len() == 0 {
+		return nil
+	}
+
+	dir := path.Dir(filepath.ToSlash(relPath))
+	for {
+		if p, ok := idx.byDir[dir]; ok {
+			return &p
+		}
+		if dir == "." || dir == "/" {
+			return nil
+		}
+		dir = path.Dir(dir)
+	}
+}
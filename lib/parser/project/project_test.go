@@ -0,0 +1,129 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+		want    domain.Project
+		wantOK  bool
+	}{
+		{
+			name:    "go module",
+			path:    "services/api/go.mod",
+			content: "// comment\nmodule github.com/acme/api\n\ngo 1.24\n",
+			want:    domain.Project{Kind: "go", Name: "github.com/acme/api", Path: "services/api"},
+			wantOK:  true,
+		},
+		{
+			name:    "cargo package",
+			path:    "crates/core/Cargo.toml",
+			content: "[package]\nname = \"acme-core\" # the core\nversion = \"0.1.0\"\n\n[dependencies]\nname = \"ignored\"\n",
+			want:    domain.Project{Kind: "cargo", Name: "acme-core", Path: "crates/core"},
+			wantOK:  true,
+		},
+		{
+			name:    "cargo workspace root is not a package",
+			path:    "Cargo.toml",
+			content: "[workspace]\nmembers = [\"crates/*\"]\n",
+			wantOK:  false,
+		},
+		{
+			name:    "npm package",
+			path:    "packages/ui/package.json",
+			content: `{"name": "@acme/ui", "version": "1.0.0"}`,
+			want:    domain.Project{Kind: "npm", Name: "@acme/ui", Path: "packages/ui"},
+			wantOK:  true,
+		},
+		{
+			name:    "unnamed root package",
+			path:    "package.json",
+			content: `{"private": true, "workspaces": ["packages/*"]}`,
+			want:    domain.Project{Kind: "npm", Name: "", Path: "."},
+			wantOK:  true,
+		},
+		{
+			name:    "invalid package.json",
+			path:    "web/package.json",
+			content: `{`,
+			wantOK:  false,
+		},
+		{
+			name:    "gradle subproject",
+			path:    "modules/billing/build.gradle.kts",
+			content: "plugins { kotlin(\"jvm\") }\n",
+			want:    domain.Project{Kind: "gradle", Name: "billing", Path: "modules/billing"},
+			wantOK:  true,
+		},
+		{
+			name:    "csproj",
+			path:    "tests/Acme.Tests/Acme.Tests.csproj",
+			content: "<Project Sdk=\"Microsoft.NET.Sdk\"></Project>",
+			want:    domain.Project{Kind: "dotnet", Name: "Acme.Tests", Path: "tests/Acme.Tests"},
+			wantOK:  true,
+		},
+		{
+			name:    "poetry project",
+			path:    "tools/cli/pyproject.toml",
+			content: "[build-system]\nrequires = []\n\n[tool.poetry]\nname = 'acme-cli'\n",
+			want:    domain.Project{Kind: "python", Name: "acme-cli", Path: "tools/cli"},
+			wantOK:  true,
+		},
+		{
+			name:    "pyproject without a project table",
+			path:    "pyproject.toml",
+			content: "[tool.pytest.ini_options]\naddopts = \"-q\"\n",
+			wantOK:  false,
+		},
+		{
+			name:    "not a manifest",
+			path:    "src/index.ts",
+			content: "",
+			wantOK:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Parse(tt.path, []byte(tt.content))
+
+			require.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIndex_Lookup(t *testing.T) {
+	idx := NewIndex([]domain.Project{
+		{Kind: "npm", Name: "monorepo", Path: "."},
+		{Kind: "npm", Name: "@acme/ui", Path: "packages/ui"},
+		{Kind: "npm", Name: "gradle-tooling", Path: "modules/billing"},
+		{Kind: "gradle", Name: "billing", Path: "modules/billing"},
+	})
+
+	assert.Equal(t, 3, idx.Len())
+	assert.Equal(t, "@acme/ui", idx.Lookup("packages/ui/src/button.test.tsx").Name)
+	assert.Equal(t, "billing", idx.Lookup("modules/billing/src/test/BillingTest.kt").Name)
+	assert.Equal(t, "monorepo", idx.Lookup("scripts/release.test.js").Name)
+	assert.Equal(t, "monorepo", idx.Lookup("root.test.js").Name)
+}
+
+func TestIndex_NilAndEmpty(t *testing.T) {
+	var idx *Index
+	assert.Nil(t, idx.Lookup("a/b.test.ts"))
+	assert.Equal(t, 0, idx.Len())
+
+	idx = NewIndex([]domain.Project{{Kind: "go", Name: "example.com/a", Path: "a"}})
+	assert.Nil(t, idx.Lookup("b/c_test.go"))
+}
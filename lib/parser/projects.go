@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/project"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// ensureProjects discovers the package manifests of the source once per
+// Scanner so that test files can be attributed to their project.
+func (s *Scanner) ensureProjects(ctx context.Context, src source.Source) {
+	if s.projects != nil {
+		return
+	}
+
+	var projects []domain.Project
+	for _, manifest := range s.discoverManifests(ctx, src) {
+		content, err := readFileFromSource(ctx, src, manifest)
+		if err != nil {
+			continue
+		}
+		if p, ok := project.Parse(manifest, content); ok {
+			projects = append(projects, p)
+		}
+	}
+	s.projects = project.NewIndex(projects)
+}
+
+// discoverManifests walks the source root to find package manifests,
+// skipping the same directories as test file discovery.
+func (s *Scanner) discoverManifests(ctx context.Context, src source.Source) []string {
+	rootPath := src.Root()
+	skipSet := buildSkipSet(append(DefaultSkipPatterns, s.options.ExcludePatterns...))
+	var manifests []string
+
+	_ = filepath.WalkDir(rootPath, func(path string, d fs.DirEntry, walkErr error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if walkErr != nil {
+			return nil
+		}
+
+		if d.IsDir() {
+			if shouldSkipDir(path, rootPath, skipSet) || s.excludesDir(path, rootPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type()&os.ModeSymlink != 0 {
+			return nil
+		}
+
+		if _, ok := project.ManifestKind(d.Name()); !ok {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(rootPath, path)
+		if err != nil {
+			return nil
+		}
+		manifests = append(manifests, relPath)
+		return nil
+	})
+
+	sort.Strings(manifests)
+	return manifests
+}
+
+// attributeProject sets the project owning the file. It runs after the
+// result cache, since manifests are not part of a file's cache key.
+func (s *Scanner) attributeProject(file *domain.TestFile) {
+	if file == nil {
+		return
+	}
+	file.Project = s.projects.Lookup(file.Path)
+}
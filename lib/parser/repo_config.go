@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/kubrickcode/specvital/lib/parser/detection"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// ensureRepoConfig resolves the repository configuration once per Scanner:
+// the one passed with WithRepoConfig, or the file at the source root.
+// Custom frameworks it declares are registered on a copy of the registry.
+func (s *Scanner) ensureRepoConfig(ctx context.Context, src source.Source) error {
+	if s.repoConfigResolved {
+		return nil
+	}
+
+	cfg := s.options.RepoConfig
+	if cfg == nil && !s.options.IgnoreRepoConfig {
+		loaded, err := repoconfig.Load(ctx, src)
+		if err != nil {
+			return err
+		}
+		cfg = loaded
+	}
+
+	registry, err := cfg.Resolve(s.registry)
+	if err != nil {
+		return err
+	}
+	if registry != s.registry {
+		s.registry = registry
+		s.detector = detection.NewDetector(registry)
+		if s.projectScope != nil {
+			s.detector.SetProjectScope(s.projectScope)
+		}
+		s.candidateMatchers = collectCandidateMatchers(registry)
+	}
+
+	if s.options.Lint != nil {
+		var repoLint *lint.Config
+		if cfg != nil {
+			repoLint = cfg.Lint
+		}
+		linter, err := lint.New(lint.Merge(s.options.Lint, repoLint))
+		if err != nil {
+			return fmt.Errorf("lint: %w", err)
+		}
+		s.linter = linter
+	}
+
+	s.repoConfig = cfg
+	s.repoConfigResolved = true
+	return nil
+}
+
+// excludesDir reports whether the repository configuration excludes every
+// file below the directory, so discovery can skip it.
+func (s *Scanner) excludesDir(path, rootPath string) bool {
+	if s.repoConfig == nil || path == rootPath {
+		return false
+	}
+	relPath, err := filepath.Rel(rootPath, path)
+	if err != nil {
+		return false
+	}
+	return s.repoConfig.ExcludesDir(relPath)
+}
+
+// applyRepoConfigTags adds the tags of matching configuration rules to the
+// file's top-level suites and tests; nested ones inherit them.
+func applyRepoConfigTags(file *domain.TestFile, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+	for i := range file.Suites {
+		file.Suites[i].Tags = domain.MergeTags(tags, file.Suites[i].Tags)
+	}
+	for i := range file.Tests {
+		file.Tests[i].Tags = domain.MergeTags(tags, file.Tests[i].Tags)
+	}
+}
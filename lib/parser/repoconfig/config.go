@@ -0,0 +1,442 @@
+// Package repoconfig reads the repository configuration file (.specvital.yml)
+// that tells the scanner which files to analyze and how to classify them.
+//
+//	version: 1
+//	include: ["packages/**"]
+//	exclude: ["**/fixtures/**", "third_party/**"]
+//	overrides:
+//	  - files: ["qa/**/*.js"]
+//	    framework: playwright
+//	rules:
+//	  - files: ["qa/**"]
+//	    kind: e2e
+//	    tags: [qa]
+//	specView:
+//	  exclude: ["**/legacy/**"]
+//	  domains:
+//	    - name: Billing
+//	      files: ["src/billing/**"]
+//	lint:
+//	  rules:
+//	    sleep-wait: error
+//	    skip-without-reason: off
+//
+// The same file may declare custom frameworks under frameworks (see package
+// declarative). All globs are doublestar patterns matched against
+// slash-separated paths relative to the source root.
+package repoconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/declarative"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// CurrentVersion is the only configuration format version understood so far.
+const CurrentVersion = 1
+
+// unversionedVersion is the format of files without a version field: those
+// written before the format was versioned, which only declared frameworks.
+const unversionedVersion = 1
+
+// FileNames are the configuration files looked up at the source root, in
+// order. The first one present is used. JSON is accepted because JSON is valid YAML.
+var FileNames = []string{".specvital.yml", ".specvital.yaml", ".specvital.json"}
+
+// Config is a validated repository configuration.
+type Config struct {
+	// Version is the format version; it must be CurrentVersion. Files
+	// without one are read as version 1.
+	Version int `yaml:"version" json:"version"`
+	// Include limits the scan to files matching any of these globs.
+	// Empty means every test file candidate.
+	Include []string `yaml:"include,omitempty" json:"include,omitempty"`
+	// Exclude drops files matching any of these globs, e.g. fixtures or
+	// vendored suites. Exclusion wins over Include and Overrides.
+	Exclude []string `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+	// Frameworks declares custom frameworks.
+	Frameworks []declarative.Spec `yaml:"frameworks,omitempty" json:"frameworks,omitempty"`
+	// Overrides force the framework of matching files, bypassing detection.
+	// The first matching override wins.
+	Overrides []Override `yaml:"overrides,omitempty" json:"overrides,omitempty"`
+	// Rules assign kinds and tags to the tests of matching files.
+	Rules []Rule `yaml:"rules,omitempty" json:"rules,omitempty"`
+	// SpecView holds hints for spec document generation.
+	SpecView *SpecView `yaml:"specView,omitempty" json:"specView,omitempty"`
+	// Lint adjusts the test smell rules when the scan enables linting.
+	Lint *lint.Config `yaml:"lint,omitempty" json:"lint,omitempty"`
+
+	// Path is the file the configuration was read from, relative to the source root.
+	Path string `yaml:"-" json:"path,omitempty"`
+
+	definitions []*framework.Definition
+}
+
+// Override treats files matching Files as tests of Framework.
+type Override struct {
+	Files     []string `yaml:"files" json:"files"`
+	Framework string   `yaml:"framework" json:"framework"`
+}
+
+// Rule assigns a kind and tags to the tests of files matching Files.
+// Kind takes precedence over the framework default and the path heuristics;
+// the first matching rule with a kind wins. Tags of all matching rules are
+// added to the file's top-level suites and tests.
+type Rule struct {
+	Files []string `yaml:"files" json:"files"`
+	Kind  string   `yaml:"kind,omitempty" json:"kind,omitempty"`
+	Tags  []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// SpecView holds hints for spec document generation.
+type SpecView struct {
+	// Exclude drops matching files from spec documents while keeping them
+	// in the inventory.
+	Exclude []string `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+	// Domains suggest the business domain of matching files.
+	Domains []DomainHint `yaml:"domains,omitempty" json:"domains,omitempty"`
+}
+
+// DomainHint suggests Name as the domain of files matching Files.
+type DomainHint struct {
+	Name  string   `yaml:"name" json:"name"`
+	Files []string `yaml:"files" json:"files"`
+}
+
+// Parse decodes and validates a configuration file. Unknown fields are
+// rejected, and all validation problems are reported together.
+func Parse(data []byte) (*Config, error) {
+	// A file written for another version is expected to fail the strict
+	// decode, so its version is checked first to report the real cause.
+	if err := checkVersion(data); err != nil {
+		return nil, err
+	}
+
+	cfg := Config{Version: unversionedVersion}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// checkVersion rejects files declaring a version other than CurrentVersion.
+// Decode errors are left to the strict decode in Parse.
+func checkVersion(data []byte) error {
+	var header struct {
+		Version *int `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil || header.Version == nil {
+		return nil
+	}
+	if *header.Version != CurrentVersion {
+		return fmt.Errorf("version: unsupported version %d (current version is %d)", *header.Version, CurrentVersion)
+	}
+	return nil
+}
+
+// Load reads the first of FileNames present at the source root.
+// Returns nil without error when the repository has no configuration.
+func Load(ctx context.Context, src source.Source) (*Config, error) {
+	for _, name := range FileNames {
+		if _, err := src.Stat(ctx, name); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		data, err := readAll(ctx, src, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		cfg, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		cfg.Path = name
+		return cfg, nil
+	}
+	return nil, nil
+}
+
+func readAll(ctx context.Context, src source.Source, name string) ([]byte, error) {
+	reader, err := src.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	checkGlobs := func(field string, patterns []string) {
+		for _, pattern := range patterns {
+			if !doublestar.ValidatePattern(pattern) {
+				fail("%s: invalid glob %q", field, pattern)
+			}
+		}
+	}
+
+	checkGlobs("include", c.Include)
+	checkGlobs("exclude", c.Exclude)
+
+	for i, override := range c.Overrides {
+		field := fmt.Sprintf("overrides[%d]", i)
+		if len(override.Files) == 0 {
+			fail("%s.files: at least one glob is required", field)
+		}
+		checkGlobs(field+".files", override.Files)
+		if override.Framework == "" {
+			fail("%s.framework: required", field)
+		}
+	}
+
+	for i, rule := range c.Rules {
+		field := fmt.Sprintf("rules[%d]", i)
+		if len(rule.Files) == 0 {
+			fail("%s.files: at least one glob is required", field)
+		}
+		checkGlobs(field+".files", rule.Files)
+		if rule.Kind == "" && len(rule.Tags) == 0 {
+			fail("%s: declare a kind or tags", field)
+		}
+		if rule.Kind != "" && !isKnownKind(domain.TestKind(rule.Kind)) {
+			fail("%s.kind: unknown kind %q", field, rule.Kind)
+		}
+		for _, tag := range rule.Tags {
+			if strings.TrimSpace(tag) == "" {
+				fail("%s.tags: empty tag", field)
+			}
+		}
+	}
+
+	if c.SpecView != nil {
+		checkGlobs("specView.exclude", c.SpecView.Exclude)
+		for i, hint := range c.SpecView.Domains {
+			field := fmt.Sprintf("specView.domains[%d]", i)
+			if hint.Name == "" {
+				fail("%s.name: required", field)
+			}
+			if len(hint.Files) == 0 {
+				fail("%s.files: at least one glob is required", field)
+			}
+			checkGlobs(field+".files", hint.Files)
+		}
+	}
+
+	if err := c.Lint.Validate(); err != nil {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				fail("lint.%v", e)
+			}
+		} else {
+			fail("lint.%v", err)
+		}
+	}
+
+	defs, err := declarative.Build(c.Frameworks)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	c.definitions = defs
+
+	return errors.Join(errs...)
+}
+
+// Resolve returns the registry the configuration is scanned with: base
+// itself when no custom frameworks are declared, otherwise a clone of base
+// with them registered. Overrides must name a framework of that registry.
+func (c *Config) Resolve(base *framework.Registry) (*framework.Registry, error) {
+	if c == nil {
+		return base, nil
+	}
+
+	// Configs built in code rather than parsed have no definitions yet.
+	if c.definitions == nil && len(c.Frameworks) > 0 {
+		defs, err := declarative.Build(c.Frameworks)
+		if err != nil {
+			return nil, c.wrap(err)
+		}
+		c.definitions = defs
+	}
+
+	registry := base
+	if len(c.definitions) > 0 {
+		registry = base.Clone()
+		if err := declarative.Register(registry, c.definitions); err != nil {
+			return nil, c.wrap(fmt.Errorf("frameworks: %w", err))
+		}
+	}
+
+	var errs []error
+	for i, override := range c.Overrides {
+		def := registry.Find(override.Framework)
+		if def == nil || def.Parser == nil {
+			errs = append(errs, fmt.Errorf("overrides[%d].framework: unknown framework %q", i, override.Framework))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, c.wrap(errors.Join(errs...))
+	}
+	return registry, nil
+}
+
+func (c *Config) wrap(err error) error {
+	if c.Path == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", c.Path, err)
+}
+
+// Selects reports whether a file passes the include and exclude globs.
+func (c *Config) Selects(path string) bool {
+	if c == nil {
+		return true
+	}
+	slashPath := filepath.ToSlash(path)
+	if matchAny(c.Exclude, slashPath) {
+		return false
+	}
+	return len(c.Include) == 0 || matchAny(c.Include, slashPath)
+}
+
+// ExcludesDir reports whether every file below dir is excluded, so
+// discovery can skip the directory. Only exclude globs ending in "/**"
+// prune directories; other globs are checked per file.
+func (c *Config) ExcludesDir(dir string) bool {
+	if c == nil {
+		return false
+	}
+	slashDir := filepath.ToSlash(dir)
+	for _, pattern := range c.Exclude {
+		prefix, ok := strings.CutSuffix(pattern, "/**")
+		if !ok {
+			continue
+		}
+		if matched, _ := doublestar.Match(prefix, slashDir); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// FrameworkFor returns the framework forced on the file by an override,
+// or an empty string when detection decides.
+func (c *Config) FrameworkFor(path string) string {
+	if c == nil {
+		return ""
+	}
+	slashPath := filepath.ToSlash(path)
+	for _, override := range c.Overrides {
+		if matchAny(override.Files, slashPath) {
+			return override.Framework
+		}
+	}
+	return ""
+}
+
+// KindFor returns the kind of the first matching rule that declares one.
+func (c *Config) KindFor(path string) domain.TestKind {
+	if c == nil {
+		return ""
+	}
+	slashPath := filepath.ToSlash(path)
+	for _, rule := range c.Rules {
+		if rule.Kind != "" && matchAny(rule.Files, slashPath) {
+			return domain.TestKind(rule.Kind)
+		}
+	}
+	return ""
+}
+
+// TagsFor returns the tags of all matching rules, in declaration order.
+func (c *Config) TagsFor(path string) []string {
+	if c == nil {
+		return nil
+	}
+	slashPath := filepath.ToSlash(path)
+	var tags []string
+	for _, rule := range c.Rules {
+		if matchAny(rule.Files, slashPath) {
+			tags = domain.MergeTags(tags, rule.Tags)
+		}
+	}
+	return tags
+}
+
+// Fingerprint identifies the effective configuration, so cached results
+// are invalidated when it changes. The file it was read from is ignored.
+func (c *Config) Fingerprint() string {
+	if c == nil {
+		return "none"
+	}
+	effective := *c
+	effective.Path = ""
+	data, _ := json.Marshal(effective)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Excludes reports whether the file is left out of spec documents.
+func (v *SpecView) Excludes(path string) bool {
+	return v != nil && matchAny(v.Exclude, filepath.ToSlash(path))
+}
+
+// DomainFor returns the name of the first domain hint matching the file.
+func (v *SpecView) DomainFor(path string) string {
+	if v == nil {
+		return ""
+	}
+	slashPath := filepath.ToSlash(path)
+	for _, hint := range v.Domains {
+		if matchAny(hint.Files, slashPath) {
+			return hint.Name
+		}
+	}
+	return ""
+}
+
+func matchAny(patterns []string, slashPath string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := doublestar.Match(pattern, slashPath); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownKind(kind domain.TestKind) bool {
+	for _, known := range domain.TestKinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,273 @@
+package repoconfig
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/declarative"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+const sampleConfig = `version: 1
+include: ["src/**", "qa/**"]
+exclude: ["**/fixtures/**", "src/legacy/*.test.js"]
+overrides:
+  - files: ["qa/**/*.js"]
+    framework: acme-spec
+rules:
+  - files: ["qa/**"]
+    kind: e2e
+    tags: [qa]
+  - files: ["**/*.js"]
+    tags: [js, qa]
+specView:
+  exclude: ["src/internal/**"]
+  domains:
+    - name: Billing
+      files: ["src/billing/**"]
+frameworks:
+  - name: acme-spec
+    languages: [javascript]
+    detection: {imports: ["@acme/spec"]}
+    parse: {tests: [check]}
+lint:
+  rules:
+    sleep-wait: error
+    skip-without-reason: off
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(sampleConfig))
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Selects("src/cart.test.js"))
+	assert.False(t, cfg.Selects("tools/cart.test.js"))
+	assert.False(t, cfg.Selects("src/fixtures/cart.test.js"))
+	assert.False(t, cfg.Selects("src/legacy/cart.test.js"))
+	assert.True(t, cfg.Selects("src/legacy/nested/cart.test.js"))
+
+	assert.True(t, cfg.ExcludesDir("src/fixtures"))
+	assert.True(t, cfg.ExcludesDir("fixtures"))
+	assert.False(t, cfg.ExcludesDir("src/legacy"))
+
+	assert.Equal(t, "acme-spec", cfg.FrameworkFor("qa/checkout/pay.js"))
+	assert.Empty(t, cfg.FrameworkFor("src/cart.test.js"))
+
+	assert.Equal(t, domain.TestKindE2E, cfg.KindFor("qa/checkout/pay.js"))
+	assert.Empty(t, cfg.KindFor("src/cart.test.js"))
+	assert.Equal(t, []string{"qa", "js"}, cfg.TagsFor("qa/checkout/pay.js"))
+	assert.Equal(t, []string{"js", "qa"}, cfg.TagsFor("src/cart.test.js"))
+
+	assert.True(t, cfg.SpecView.Excludes("src/internal/a.test.js"))
+	assert.Equal(t, "Billing", cfg.SpecView.DomainFor("src/billing/invoice.test.js"))
+	assert.Empty(t, cfg.SpecView.DomainFor("src/cart.test.js"))
+
+	assert.Equal(t, map[string]string{"sleep-wait": "error", "skip-without-reason": "off"}, cfg.Lint.Rules)
+}
+
+func TestParse_Unversioned(t *testing.T) {
+	cfg, err := Parse([]byte(`frameworks:
+  - name: acme-spec
+    languages: [javascript]
+    detection: {imports: ["@acme/spec"]}
+    parse: {tests: [check]}
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, cfg.Version)
+	require.Len(t, cfg.Frameworks, 1)
+	assert.Equal(t, "acme-spec", cfg.Frameworks[0].Name)
+
+	cfg, err = Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cfg.Version)
+}
+
+func TestConfig_NilIsNoop(t *testing.T) {
+	var cfg *Config
+
+	assert.True(t, cfg.Selects("any/file.test.js"))
+	assert.False(t, cfg.ExcludesDir("any"))
+	assert.Empty(t, cfg.FrameworkFor("any/file.test.js"))
+	assert.Empty(t, cfg.KindFor("any/file.test.js"))
+	assert.Nil(t, cfg.TagsFor("any/file.test.js"))
+
+	registry := framework.NewRegistry()
+	resolved, err := cfg.Resolve(registry)
+	require.NoError(t, err)
+	assert.Same(t, registry, resolved)
+}
+
+func TestParse_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{
+			name: "zero version",
+			data: "version: 0\n",
+			want: []string{"version: unsupported version 0 (current version is 1)"},
+		},
+		{
+			name: "unsupported version",
+			data: "version: 2\n",
+			want: []string{"version: unsupported version 2 (current version is 1)"},
+		},
+		{
+			name: "unknown field",
+			data: "version: 1\nignore: [fixtures]\n",
+			want: []string{"decode:", "field ignore not found"},
+		},
+		{
+			name: "invalid entries",
+			data: `version: 1
+exclude: ["[a-"]
+overrides:
+  - files: []
+rules:
+  - files: ["qa/**"]
+  - files: ["e2e/**"]
+    kind: smoke
+specView:
+  domains:
+    - files: ["src/**"]
+frameworks:
+  - name: acme
+lint:
+  rules:
+    no-such-rule: error
+    sleep-wait: fatal
+`,
+			want: []string{
+				`exclude: invalid glob "[a-"`,
+				"overrides[0].files: at least one glob is required",
+				"overrides[0].framework: required",
+				"rules[0]: declare a kind or tags",
+				`rules[1].kind: unknown kind "smoke"`,
+				"specView.domains[0].name: required",
+				"frameworks[0] (acme): languages: at least one language is required",
+				"lint.rules.no-such-rule: unknown rule",
+				`lint.rules.sleep-wait: unknown severity "fatal"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := Parse([]byte(tt.data))
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+			for _, want := range tt.want {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestParse_VersionCheckedFirst(t *testing.T) {
+	_, err := Parse([]byte("version: 2\nbogus: true\n"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "version: unsupported version 2 (current version is 1)")
+	assert.NotContains(t, err.Error(), "bogus")
+}
+
+func TestConfig_Resolve(t *testing.T) {
+	base := framework.NewRegistry()
+	base.Register(&framework.Definition{Name: "playwright", Parser: stubParser{}})
+
+	t.Run("registers custom frameworks on a copy", func(t *testing.T) {
+		cfg, err := Parse([]byte(sampleConfig))
+		require.NoError(t, err)
+
+		registry, err := cfg.Resolve(base)
+		require.NoError(t, err)
+		assert.NotNil(t, registry.Find("acme-spec"))
+		assert.Nil(t, base.Find("acme-spec"))
+	})
+
+	t.Run("rejects unknown override frameworks", func(t *testing.T) {
+		cfg, err := Parse([]byte("version: 1\noverrides: [{files: [\"qa/**\"], framework: cypress}]\n"))
+		require.NoError(t, err)
+		cfg.Path = ".specvital.yml"
+
+		_, err = cfg.Resolve(base)
+		assert.EqualError(t, err, `.specvital.yml: overrides[0].framework: unknown framework "cypress"`)
+	})
+
+	t.Run("rejects custom frameworks shadowing registered ones", func(t *testing.T) {
+		cfg := &Config{Version: CurrentVersion, Frameworks: []declarative.Spec{{
+			Name:      "playwright",
+			Languages: []string{"javascript"},
+		}}}
+		cfg.Frameworks[0].Detection.Imports = []string{"pw"}
+		cfg.Frameworks[0].Parse.Tests = []string{"check"}
+
+		_, err := cfg.Resolve(base)
+		assert.ErrorContains(t, err, `framework "playwright": name conflicts with a registered framework`)
+	})
+}
+
+func TestConfig_Fingerprint(t *testing.T) {
+	a, err := Parse([]byte(sampleConfig))
+	require.NoError(t, err)
+	b, err := Parse([]byte(sampleConfig))
+	require.NoError(t, err)
+	b.Path = ".specvital.yaml"
+	c, err := Parse([]byte("version: 1\nexclude: [\"**/fixtures/**\"]\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+	assert.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+	assert.Equal(t, "none", (*Config)(nil).Fingerprint())
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("reads the config file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".specvital.yaml"), []byte(sampleConfig), 0644))
+
+		src, err := source.NewLocalSource(dir)
+		require.NoError(t, err)
+
+		cfg, err := Load(context.Background(), src)
+		require.NoError(t, err)
+		require.NotNil(t, cfg)
+		assert.Equal(t, ".specvital.yaml", cfg.Path)
+		assert.Len(t, cfg.Frameworks, 1)
+	})
+
+	t.Run("no config file", func(t *testing.T) {
+		src, err := source.NewLocalSource(t.TempDir())
+		require.NoError(t, err)
+
+		cfg, err := Load(context.Background(), src)
+		require.NoError(t, err)
+		assert.Nil(t, cfg)
+	})
+
+	t.Run("invalid config file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".specvital.json"), []byte(`{"version": 1, "frameworks": [{"name": "acme"}]}`), 0644))
+
+		src, err := source.NewLocalSource(dir)
+		require.NoError(t, err)
+
+		_, err = Load(context.Background(), src)
+		assert.ErrorContains(t, err, ".specvital.json: frameworks[0] (acme): languages")
+	})
+}
+
+type stubParser struct{}
+
+func (stubParser) Parse(context.Context, []byte, string) (*domain.TestFile, error) {
+	return &domain.TestFile{}, nil
+}
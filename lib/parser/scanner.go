@@ -18,11 +18,19 @@ import (
 	"github.com/kubrickcode/specvital/lib/parser/detection"
 	"github.com/kubrickcode/specvital/lib/parser/domain"
 	domain_hints "github.com/kubrickcode/specvital/lib/parser/domain_hints"
+	"github.com/kubrickcode/specvital/lib/parser/fingerprint"
 	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/parser/metrics"
+	"github.com/kubrickcode/specvital/lib/parser/project"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
 	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/dotnetast"
 	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/kotlinast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalaast"
 	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/swiftast"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
 	"github.com/kubrickcode/specvital/lib/source"
+	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/sync/semaphore"
 )
 
@@ -54,6 +62,10 @@ var (
 	ErrScanCancelled = errors.New("scanner: scan cancelled")
 	// ErrScanTimeout is returned when scanning exceeds the timeout duration.
 	ErrScanTimeout = errors.New("scanner: scan timeout")
+	// ErrUnsupportedLanguage is reported in ScanResult.Errors, once per language
+	// and pass, when test metrics, fingerprints or lint body rules cannot inspect
+	// the files of a language. The files are still parsed.
+	ErrUnsupportedLanguage = errors.New("scanner: unsupported language")
 )
 
 // Scanner performs framework detection and test file parsing.
@@ -63,6 +75,27 @@ type Scanner struct {
 	detector     *detection.Detector
 	projectScope *framework.AggregatedProjectScope
 	options      *ScanOptions
+
+	// repoConfig is the repository configuration the scan honours (nil when
+	// the repository has none). Resolved once per Scanner by ensureRepoConfig.
+	repoConfig         *repoconfig.Config
+	repoConfigResolved bool
+
+	// linter reports test smells when linting is enabled. Created with the
+	// repository configuration, whose lint rules apply over ScanOptions.Lint.
+	linter *lint.Linter
+
+	// projects attributes test files to the package or module owning them.
+	// Discovered once per Scanner by ensureProjects.
+	projects *project.Index
+
+	// candidateMatchers extend test file discovery beyond the built-in
+	// naming conventions (e.g., custom frameworks with their own file names).
+	candidateMatchers []framework.CandidateMatcher
+
+	// cacheSalt digests the scan-wide inputs of result cache keys.
+	// Computed before parsing starts when a ResultCache is configured.
+	cacheSalt string
 }
 
 // ScanResult contains the outcome of a scan operation.
@@ -75,6 +108,10 @@ type ScanResult struct {
 
 	// Stats provides scan statistics including confidence distribution.
 	Stats ScanStats
+
+	// Config is the effective repository configuration, or nil when the
+	// repository has none.
+	Config *repoconfig.Config
 }
 
 // ScanError represents an error that occurred during a specific phase of scanning.
@@ -86,7 +123,8 @@ type ScanError struct {
 	Path string
 
 	// Phase indicates which phase the error occurred in.
-	// Values: "discovery", "config-parse", "detection", "parsing"
+	// Values: "discovery", "config-parse", "detection", "parsing", "mapping",
+	// "metrics", "fingerprints", "lint"
 	Phase string
 }
 
@@ -112,6 +150,10 @@ type ScanStats struct {
 	// FilesSkipped is the number of files skipped due to low confidence or other reasons.
 	FilesSkipped int
 
+	// FilesCached is the number of files whose result was served from the ResultCache.
+	// Cached files are also counted in FilesMatched or FilesSkipped.
+	FilesCached int
+
 	// ConfidenceDist tracks detection confidence distribution.
 	// Keys: "definite", "moderate", "weak", "unknown"
 	ConfidenceDist map[string]int
@@ -119,10 +161,32 @@ type ScanStats struct {
 	// ConfigsFound is the number of config files discovered and parsed.
 	ConfigsFound int
 
+	// ProjectsFound is the number of packages or modules declared by manifests.
+	ProjectsFound int
+
 	// Duration is the total scan duration.
 	Duration time.Duration
 }
 
+// add counts a streamed file result the way Scan does.
+func (st *ScanStats) add(result *FileResult) {
+	st.FilesScanned++
+	if result.Confidence != "" {
+		st.ConfidenceDist[result.Confidence]++
+	}
+	if result.Cached {
+		st.FilesCached++
+	}
+	switch {
+	case result.Err != nil:
+		st.FilesFailed++
+	case result.File != nil:
+		st.FilesMatched++
+	default:
+		st.FilesSkipped++
+	}
+}
+
 // NewScanner creates a new scanner with the given options.
 func NewScanner(opts ...ScanOption) *Scanner {
 	options := newDefaultOptions()
@@ -134,13 +198,26 @@ func NewScanner(opts ...ScanOption) *Scanner {
 	detector := detection.NewDetector(options.Registry)
 
 	return &Scanner{
-		registry:     options.Registry,
-		detector:     detector,
-		projectScope: nil,
-		options:      &options,
+		registry:          options.Registry,
+		detector:          detector,
+		projectScope:      nil,
+		options:           &options,
+		candidateMatchers: collectCandidateMatchers(options.Registry),
 	}
 }
 
+func collectCandidateMatchers(registry *framework.Registry) []framework.CandidateMatcher {
+	var result []framework.CandidateMatcher
+	for _, def := range registry.All() {
+		for _, matcher := range def.Matchers {
+			if candidate, ok := matcher.(framework.CandidateMatcher); ok {
+				result = append(result, candidate)
+			}
+		}
+	}
+	return result
+}
+
 // SetProjectScope sets pre-parsed config information for remote sources.
 // This is useful when scanning from sources without filesystem access (e.g., GitHub API).
 func (s *Scanner) SetProjectScope(scope *framework.AggregatedProjectScope) {
@@ -174,8 +251,8 @@ func (s *Scanner) Scan(ctx context.Context, src source.Source) (*ScanResult, err
 		},
 	}
 
-	// ScanStream handles timeout and config parsing internally
-	resultCh, err := s.ScanStream(ctx, src)
+	// scanStream handles timeout and config parsing internally
+	resultCh, err := s.scanStream(ctx, src, false)
 	if err != nil {
 		result.Stats.Duration = time.Since(startTime)
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -186,11 +263,14 @@ func (s *Scanner) Scan(ctx context.Context, src source.Source) (*ScanResult, err
 		}
 		return result, err
 	}
+	defer func() { s.options.Observer.ScanCompleted(result.Stats) }()
 
 	// Retrieve config stats after ScanStream initialization
+	result.Config = s.repoConfig
 	if s.projectScope != nil {
 		result.Stats.ConfigsFound = len(s.projectScope.Configs)
 	}
+	result.Stats.ProjectsFound = s.projects.Len()
 
 	// Collect all streaming results
 	var files []domain.TestFile
@@ -200,6 +280,9 @@ func (s *Scanner) Scan(ctx context.Context, src source.Source) (*ScanResult, err
 		if fileResult.Confidence != "" {
 			result.Stats.ConfidenceDist[fileResult.Confidence]++
 		}
+		if fileResult.Cached {
+			result.Stats.FilesCached++
+		}
 
 		if fileResult.Err != nil {
 			phase := "parsing"
@@ -229,6 +312,15 @@ func (s *Scanner) Scan(ctx context.Context, src source.Source) (*ScanResult, err
 	result.Stats.FilesMatched = len(files)
 	result.Stats.FilesFailed = len(result.Errors)
 	result.Stats.FilesSkipped = result.Stats.FilesScanned - result.Stats.FilesMatched - result.Stats.FilesFailed
+	result.Errors = append(result.Errors, s.unsupportedLanguages(files)...)
+
+	if s.options.SourceGraph && ctx.Err() == nil {
+		graph, err := s.buildSourceGraph(ctx, src, files)
+		if err != nil && ctx.Err() == nil {
+			result.Errors = append(result.Errors, ScanError{Err: err, Phase: "mapping"})
+		}
+		result.Inventory.SourceGraph = graph
+	}
 	result.Stats.Duration = time.Since(startTime)
 
 	// Check for timeout or cancellation after processing
@@ -247,6 +339,8 @@ func (s *Scanner) Scan(ctx context.Context, src source.Source) (*ScanResult, err
 // ScanFiles scans specific files (for incremental/watch mode).
 // This bypasses file discovery and directly scans the provided file paths.
 // Internally uses streaming scan for unified implementation.
+// Config scopes and project attribution come from an earlier Scan on the
+// same Scanner; files scanned on a fresh Scanner have no Project.
 //
 // The caller is responsible for calling src.Close() when done.
 func (s *Scanner) ScanFiles(ctx context.Context, src source.Source, files []string) (*ScanResult, error) {
@@ -267,6 +361,19 @@ func (s *Scanner) ScanFiles(ctx context.Context, src source.Source, files []stri
 		},
 	}
 
+	if err := s.ensureRepoConfig(ctx, src); err != nil {
+		result.Stats.Duration = time.Since(startTime)
+		return result, err
+	}
+	result.Config = s.repoConfig
+
+	defer func() { s.options.Observer.ScanCompleted(result.Stats) }()
+
+	for _, file := range files {
+		s.options.Observer.FileDiscovered(file)
+	}
+	s.options.Observer.DiscoveryCompleted(len(files))
+
 	if len(files) == 0 {
 		result.Stats.Duration = time.Since(startTime)
 		return result, nil
@@ -278,6 +385,9 @@ func (s *Scanner) ScanFiles(ctx context.Context, src source.Source, files []stri
 		if fileResult.Confidence != "" {
 			result.Stats.ConfidenceDist[fileResult.Confidence]++
 		}
+		if fileResult.Cached {
+			result.Stats.FilesCached++
+		}
 
 		if fileResult.Err != nil {
 			result.Errors = append(result.Errors, ScanError{
@@ -302,6 +412,7 @@ func (s *Scanner) ScanFiles(ctx context.Context, src source.Source, files []stri
 	result.Stats.FilesMatched = len(parsedFiles)
 	result.Stats.FilesFailed = len(result.Errors)
 	result.Stats.FilesSkipped = result.Stats.FilesScanned - result.Stats.FilesMatched - result.Stats.FilesFailed
+	result.Errors = append(result.Errors, s.unsupportedLanguages(parsedFiles)...)
 	result.Stats.Duration = time.Since(startTime)
 
 	if err := ctx.Err(); err != nil {
@@ -353,6 +464,13 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 		".mocharc.yaml",
 		".mocharc.yml",
 		"mocha.opts",
+		"bunfig.toml",
+		"deno.json",
+		"deno.jsonc",
+		"jasmine.json",
+		"build.sbt",
+		"build.sc",
+		"build.mill",
 	}
 
 	rootPath := src.Root()
@@ -369,7 +487,7 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 		}
 
 		if d.IsDir() {
-			if shouldSkipDir(path, rootPath, skipSet) {
+			if shouldSkipDir(path, rootPath, skipSet) || s.excludesDir(path, rootPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -503,7 +621,7 @@ func (s *Scanner) discoverTestFilesStream(ctx context.Context, src source.Source
 			}
 
 			if d.IsDir() {
-				if shouldSkipDir(path, rootPath, skipSet) {
+				if shouldSkipDir(path, rootPath, skipSet) || s.excludesDir(path, rootPath) {
 					return filepath.SkipDir
 				}
 				return nil
@@ -523,7 +641,7 @@ func (s *Scanner) discoverTestFilesStream(ctx context.Context, src source.Source
 				return nil
 			}
 
-			if !isTestFileCandidate(relPath) {
+			if !s.isTestFileCandidate(relPath) || !s.repoConfig.Selects(relPath) {
 				return nil
 			}
 
@@ -574,6 +692,8 @@ func (s *Scanner) discoverTestFilesStream(ctx context.Context, src source.Source
 func (s *Scanner) scanFilesStream(ctx context.Context, src source.Source, files []string) <-chan *FileResult {
 	out := make(chan *FileResult)
 
+	s.prepareCacheKeys(src.Root())
+
 	go func() {
 		defer close(out)
 
@@ -617,13 +737,16 @@ func (s *Scanner) scanFilesStream(ctx context.Context, src source.Source, files
 	return out
 }
 
-func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string) (*domain.TestFile, *ScanError, string) {
+// parseFile reads, detects and parses a single file.
+// When a ResultCache is configured, results are looked up by content before
+// detection and stored after a successful parse. The last return value reports a cache hit.
+func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string) (*domain.TestFile, *ScanError, string, bool) {
 	if err := ctx.Err(); err != nil {
 		return nil, &ScanError{
 			Err:   err,
 			Path:  path,
 			Phase: "parsing",
-		}, ""
+		}, "", false
 	}
 
 	content, err := readFileFromSource(ctx, src, path)
@@ -632,13 +755,38 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 			Err:   err,
 			Path:  path,
 			Phase: "parsing",
-		}, ""
+		}, "", false
+	}
+
+	cache := s.options.ResultCache
+	if cache == nil {
+		testFile, scanErr, confidence := s.parseContent(ctx, src, path, content)
+		return testFile, scanErr, confidence, false
 	}
 
+	key := s.cacheKey(path, content)
+	if cached, ok := cache.Get(ctx, key); ok {
+		return cached.File, nil, cached.Confidence, true
+	}
+
+	testFile, scanErr, confidence := s.parseContent(ctx, src, path, content)
+	if scanErr == nil {
+		// Cache write failures only cost a re-parse next time.
+		_ = cache.Put(ctx, key, &CachedResult{Confidence: confidence, File: testFile})
+	}
+	return testFile, scanErr, confidence, false
+}
+
+func (s *Scanner) parseContent(ctx context.Context, src source.Source, path string, content []byte) (*domain.TestFile, *ScanError, string) {
 	// Use absolute path for detection to match config scope paths
 	absPath := filepath.Join(src.Root(), path)
 	detectionResult := s.detector.Detect(ctx, absPath, content)
+	if forced := s.repoConfig.FrameworkFor(path); forced != "" {
+		detectionResult = detection.Confirmed(forced, detection.SourceRepoConfig).
+			WithEvidence(100, "config: "+s.repoConfig.Path)
+	}
 
+	s.options.Observer.FileDetected(path, detectionResult)
 	if !detectionResult.IsDetected() {
 		return nil, nil, "unknown"
 	}
@@ -652,7 +800,10 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 		}, string(detectionResult.Source)
 	}
 
-	testFile, err := def.Parser.Parse(ctx, content, path)
+	parseCtx := framework.WithParseOptions(ctx, framework.ParseOptions{
+		ExpandParameterized: s.options.ExpandParameterized,
+	})
+	testFile, err := def.Parser.Parse(parseCtx, content, path)
 	if err != nil {
 		return nil, &ScanError{
 			Err:   fmt.Errorf("parse: %w", err),
@@ -661,15 +812,102 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 		}, string(detectionResult.Source)
 	}
 
+	defaultKind := def.DefaultKind
+	if kind := s.repoConfig.KindFor(path); kind != "" {
+		defaultKind = kind
+	}
+	testFile.Detection = buildDetection(detectionResult, src.Root())
+	classifyKinds(testFile, defaultKind, s.options.KindRules)
+	applyRepoConfigTags(testFile, s.repoConfig.TagsFor(path))
+
 	if s.options.ExtractDomainHints {
 		if extractor := domain_hints.GetExtractor(testFile.Language); extractor != nil {
 			testFile.DomainHints = extractor.Extract(ctx, content)
 		}
 	}
 
+	tree := s.syntaxTree(ctx, testFile.Language, content)
+	if tree != nil {
+		defer tree.Close()
+	}
+
+	if s.options.TestMetrics {
+		metrics.Measure(testFile, tree, content, def.Assertions)
+	}
+
+	if s.options.Fingerprints {
+		fingerprint.Apply(testFile, tree, content)
+	}
+
+	if s.linter != nil {
+		testFile.Findings = s.linter.Lint(testFile, tree, content, def.Assertions)
+	}
+
 	return testFile, nil, string(detectionResult.Source)
 }
 
+// unsupportedLanguages reports each requested pass that cannot inspect the
+// files of a language, once per language, so tests left without metrics,
+// fingerprints or body findings are not mistaken for clean ones.
+func (s *Scanner) unsupportedLanguages(files []domain.TestFile) []ScanError {
+	var errs []ScanError
+	seen := make(map[domain.Language]bool)
+	for _, file := range files {
+		lang := file.Language
+		if seen[lang] {
+			continue
+		}
+		seen[lang] = true
+
+		if s.options.TestMetrics && !metrics.Supports(lang) {
+			errs = append(errs, ScanError{Err: fmt.Errorf("%w: no test metrics for %s", ErrUnsupportedLanguage, lang), Phase: "metrics"})
+		}
+		if s.options.Fingerprints && !fingerprint.Supports(lang) {
+			errs = append(errs, ScanError{Err: fmt.Errorf("%w: no fingerprints for %s", ErrUnsupportedLanguage, lang), Phase: "fingerprints"})
+		}
+		if s.linter != nil && s.linter.SkipsBodies(lang) {
+			errs = append(errs, ScanError{Err: fmt.Errorf("%w: no body rules for %s", ErrUnsupportedLanguage, lang), Phase: "lint"})
+		}
+	}
+	return errs
+}
+
+// syntaxTree parses content for the passes inspecting test bodies, so each
+// file is parsed at most once. Returns nil when none of them runs, the
+// language has no grammar or parsing fails. Caller must close the returned
+// tree.
+func (s *Scanner) syntaxTree(ctx context.Context, lang domain.Language, content []byte) *sitter.Tree {
+	needed := s.options.TestMetrics || s.options.Fingerprints ||
+		(s.linter != nil && s.linter.InspectsBodies(lang))
+	if !needed || !tspool.Supports(lang) {
+		return nil
+	}
+
+	tree, err := tspool.Parse(ctx, lang, content)
+	if err != nil {
+		return nil
+	}
+	return tree
+}
+
+// buildDetection converts a detection result into its domain form. Evidence
+// naming files under the source root is made relative to it.
+func buildDetection(result detection.Result, rootPath string) *domain.Detection {
+	var evidence []string
+	if len(result.Evidence) > 0 {
+		prefix := rootPath + string(filepath.Separator)
+		evidence = make([]string, len(result.Evidence))
+		for i, e := range result.Evidence {
+			evidence[i] = strings.ReplaceAll(e, prefix, "")
+		}
+	}
+	return &domain.Detection{
+		Confidence: result.Confidence,
+		Evidence:   evidence,
+		Source:     string(result.Source),
+	}
+}
+
 // readFileFromSource reads a file from source using relative path.
 // The relPath must be relative to src.Root().
 func readFileFromSource(ctx context.Context, src source.Source, relPath string) ([]byte, error) {
@@ -714,6 +952,21 @@ func shouldSkipDir(path, rootPath string, skipSet map[string]bool) bool {
 	return skipSet[base]
 }
 
+// isTestFileCandidate reports whether the path follows a built-in test file
+// convention, is claimed by a registered framework's filename patterns or
+// has its framework forced by the repository configuration.
+func (s *Scanner) isTestFileCandidate(path string) bool {
+	if isTestFileCandidate(path) || s.repoConfig.FrameworkFor(path) != "" {
+		return true
+	}
+	for _, matcher := range s.candidateMatchers {
+		if matcher.IsCandidate(path) {
+			return true
+		}
+	}
+	return false
+}
+
 func isTestFileCandidate(path string) bool {
 	ext := strings.ToLower(filepath.Ext(path))
 
@@ -740,6 +993,17 @@ func isTestFileCandidate(path string) bool {
 		return isPHPTestFile(path)
 	case ".swift":
 		return isSwiftTestFile(path)
+	case ".dart":
+		return isDartTestFile(path)
+	case ".exs":
+		return isElixirTestFile(path)
+	case ".erl":
+		return isErlangTestFile(path)
+	case ".scala":
+		return isScalaTestFile(path)
+	case ".feature":
+		// Gherkin feature files are specifications wherever they live.
+		return true
 	default:
 		return false
 	}
@@ -784,6 +1048,10 @@ func isKotlinTestFile(path string) bool {
 	return kotlinast.IsKotlinTestFile(path)
 }
 
+func isScalaTestFile(path string) bool {
+	return scalaast.IsScalaTestFile(path)
+}
+
 func isJSTestFile(path string) bool {
 	base := filepath.Base(path)
 	lowerBase := strings.ToLower(base)
@@ -806,6 +1074,17 @@ func isJSTestFile(path string) bool {
 		return true
 	}
 
+	// Deno and node:test conventions: *_test.ts, test-*.js, test.js
+	name := strings.TrimSuffix(lowerBase, ext)
+	if strings.HasSuffix(name, "_test") || strings.HasPrefix(name, "test-") || name == "test" {
+		return true
+	}
+
+	// Jasmine conventions: *Spec.js, *_spec.js
+	if strings.HasSuffix(strings.TrimSuffix(base, filepath.Ext(base)), "Spec") || strings.HasSuffix(name, "_spec") {
+		return true
+	}
+
 	normalizedPath := filepath.ToSlash(path)
 
 	// Exclude fixture and mock directories (not actual test files)
@@ -877,6 +1156,11 @@ func isCSharpTestFile(path string) bool {
 	if strings.Contains(normalizedPath, ".Specs/") || strings.Contains(normalizedPath, ".Spec/") {
 		return true
 	}
+	// BenchmarkDotNet projects (Foo.Benchmarks/, *Benchmarks.cs)
+	if strings.Contains(normalizedPath, ".Benchmarks/") || strings.Contains(normalizedPath, "/benchmarks/") ||
+		strings.HasSuffix(normalizedPath, "Benchmark.cs") || strings.HasSuffix(normalizedPath, "Benchmarks.cs") {
+		return true
+	}
 	// "test/", "tests/", "Tests/" as project folder patterns
 	if strings.HasPrefix(normalizedPath, "test/") || strings.HasPrefix(normalizedPath, "tests/") ||
 		strings.HasPrefix(normalizedPath, "Tests/") || strings.Contains(normalizedPath, "/Tests/") {
@@ -943,6 +1227,11 @@ func isRustTestFile(path string) bool {
 		return strings.HasSuffix(base, ".rs")
 	}
 
+	// benches/ directory: Cargo bench targets (e.g., Criterion benchmarks)
+	if strings.Contains(normalizedPath, "/benches/") || strings.HasPrefix(normalizedPath, "benches/") {
+		return strings.HasSuffix(base, ".rs")
+	}
+
 	// crates/ directory: Cargo workspaces often use crates/ for sub-crates
 	// Each sub-crate may have inline tests with #[cfg(test)] modules
 	if strings.Contains(normalizedPath, "/crates/") || strings.HasPrefix(normalizedPath, "crates/") {
@@ -965,6 +1254,11 @@ func isCppTestFile(path string) bool {
 		return true
 	}
 
+	// Catch2/doctest/Boost.Test projects also use test_*, *_tests
+	if strings.HasPrefix(name, "test_") || strings.HasSuffix(name, "_tests") {
+		return true
+	}
+
 	// *Test pattern (e.g., DatabaseTest.cc) - uppercase T avoids false positives like "contest.cc"
 	baseOriginal := filepath.Base(path)
 	nameOriginal := strings.TrimSuffix(baseOriginal, filepath.Ext(baseOriginal))
@@ -1012,6 +1306,21 @@ func isSwiftTestFile(path string) bool {
 	return swiftast.IsSwiftTestFile(path)
 }
 
+// isDartTestFile follows the package:test and flutter_test convention: *_test.dart.
+func isDartTestFile(path string) bool {
+	return strings.HasSuffix(filepath.Base(path), "_test.dart")
+}
+
+// isElixirTestFile follows the ExUnit convention: *_test.exs.
+func isElixirTestFile(path string) bool {
+	return strings.HasSuffix(filepath.Base(path), "_test.exs")
+}
+
+// isErlangTestFile follows the Common Test convention: *_SUITE.erl.
+func isErlangTestFile(path string) bool {
+	return strings.HasSuffix(filepath.Base(path), "_SUITE.erl")
+}
+
 func matchesAnyPattern(path, rootPath string, patterns []string) bool {
 	relPath, err := filepath.Rel(rootPath, path)
 	if err != nil {
@@ -1059,17 +1368,27 @@ func matchesAnyPattern(path, rootPath string, patterns []string) bool {
 // Parse errors are included in FileResult.Err rather than aborting the scan.
 // The caller is responsible for calling src.Close() when done.
 func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *FileResult, error) {
+	return s.scanStream(ctx, src, true)
+}
+
+// scanStream implements ScanStream. Unless reportCompletion is set, the
+// caller reports ScanCompleted with the statistics it collects itself.
+func (s *Scanner) scanStream(ctx context.Context, src source.Source, reportCompletion bool) (<-chan *FileResult, error) {
+	startTime := time.Now()
 	ctx, cancel := context.WithTimeout(ctx, s.options.Timeout)
 
+	// An invalid repository configuration aborts the scan rather than
+	// silently analyzing files the repository asked to skip.
+	if err := s.ensureRepoConfig(ctx, src); err != nil {
+		cancel()
+		return nil, err
+	}
+
 	// Config parsing first (projectScope required for detection)
 	// Config errors are not propagated in streaming mode.
 	// Use Scan() if config error reporting is required.
-	if s.projectScope == nil {
-		configFiles := s.discoverConfigFiles(ctx, src)
-		var configErrors []ScanError
-		s.projectScope = s.parseConfigFiles(ctx, src, configFiles, &configErrors)
-		s.detector.SetProjectScope(s.projectScope)
-	}
+	s.ensureProjectScope(ctx, src)
+	s.ensureProjects(ctx, src)
 
 	// Check for early cancellation
 	if err := ctx.Err(); err != nil {
@@ -1077,6 +1396,8 @@ func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *Fi
 		return nil, err
 	}
 
+	s.prepareCacheKeys(src.Root())
+
 	// Unbuffered channel for natural backpressure
 	out := make(chan *FileResult)
 
@@ -1084,6 +1405,30 @@ func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *Fi
 		defer close(out)
 		defer cancel()
 
+		var (
+			statsMu sync.Mutex
+			stats   = ScanStats{ConfidenceDist: make(map[string]int)}
+		)
+		if reportCompletion {
+			defer func() {
+				stats.Duration = time.Since(startTime)
+				s.options.Observer.ScanCompleted(stats)
+			}()
+		}
+		send := func(result *FileResult) bool {
+			select {
+			case out <- result:
+			case <-ctx.Done():
+				return false
+			}
+			if reportCompletion {
+				statsMu.Lock()
+				stats.add(result)
+				statsMu.Unlock()
+			}
+			return true
+		}
+
 		workers := s.options.Workers
 		if workers <= 0 {
 			workers = runtime.GOMAXPROCS(0)
@@ -1095,24 +1440,25 @@ func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *Fi
 		sem := semaphore.NewWeighted(int64(workers))
 		var wg sync.WaitGroup
 
+		discovered := 0
 		for discoveryResult := range s.discoverTestFilesStream(ctx, src) {
 			if ctx.Err() != nil {
 				break
 			}
 
 			if discoveryResult.Err != nil {
-				select {
-				case out <- &FileResult{
-					Err:  discoveryResult.Err,
-					Path: "",
-				}:
-				case <-ctx.Done():
+				// Scan counts discovery errors as scanned, failed files.
+				discovered++
+				s.options.Observer.FileFailed("", discoveryResult.Err, 0)
+				if !send(&FileResult{Err: discoveryResult.Err, Path: ""}) {
 					return
 				}
 				continue
 			}
 
 			path := discoveryResult.Path
+			discovered++
+			s.options.Observer.FileDiscovered(path)
 
 			if err := sem.Acquire(ctx, 1); err != nil {
 				break
@@ -1123,14 +1469,12 @@ func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *Fi
 				defer wg.Done()
 				defer sem.Release(1)
 
-				result := s.parseFileToResult(ctx, src, filePath)
-
-				select {
-				case out <- result:
-				case <-ctx.Done():
-				}
+				send(s.parseFileToResult(ctx, src, filePath))
 			}(path)
 		}
+		if ctx.Err() == nil {
+			s.options.Observer.DiscoveryCompleted(discovered)
+		}
 
 		wg.Wait()
 	}()
@@ -1138,10 +1482,34 @@ func (s *Scanner) ScanStream(ctx context.Context, src source.Source) (<-chan *Fi
 	return out, nil
 }
 
+// ensureProjectScope discovers and parses config files unless a project scope is already set.
+func (s *Scanner) ensureProjectScope(ctx context.Context, src source.Source) {
+	if s.projectScope != nil {
+		return
+	}
+	configFiles := s.discoverConfigFiles(ctx, src)
+	s.options.Observer.ConfigsDiscovered(configFiles)
+	var configErrors []ScanError
+	s.projectScope = s.parseConfigFiles(ctx, src, configFiles, &configErrors)
+	s.detector.SetProjectScope(s.projectScope)
+}
+
 // parseFileToResult parses a single file and returns FileResult.
 // This is the streaming-oriented version that wraps parseFile.
 func (s *Scanner) parseFileToResult(ctx context.Context, src source.Source, path string) *FileResult {
-	testFile, scanErr, confidence := s.parseFile(ctx, src, path)
+	start := time.Now()
+	result := s.fileResult(ctx, src, path)
+	if result.Err != nil {
+		s.options.Observer.FileFailed(path, result.Err, time.Since(start))
+	} else {
+		s.options.Observer.FileParsed(result, time.Since(start))
+	}
+	return result
+}
+
+func (s *Scanner) fileResult(ctx context.Context, src source.Source, path string) *FileResult {
+	testFile, scanErr, confidence, cached := s.parseFile(ctx, src, path)
+	s.attributeProject(testFile)
 
 	if scanErr != nil {
 		return &FileResult{
@@ -1156,6 +1524,7 @@ func (s *Scanner) parseFileToResult(ctx context.Context, src source.Source, path
 		return &FileResult{
 			Path:       path,
 			Confidence: confidence,
+			Cached:     cached,
 		}
 	}
 
@@ -1163,6 +1532,7 @@ func (s *Scanner) parseFileToResult(ctx context.Context, src source.Source, path
 		File:       testFile,
 		Path:       path,
 		Confidence: confidence,
+		Cached:     cached,
 	}
 }
 
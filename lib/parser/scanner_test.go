@@ -6,22 +6,49 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/detection"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/lint"
+	"github.com/kubrickcode/specvital/lib/parser/repoconfig"
 	"github.com/kubrickcode/specvital/lib/source"
 
 	// Import frameworks to register them via init()
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/ava"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/benchmarkdotnet"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/boosttest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/buntest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/cargotest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/catch2"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/commontest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/criterion"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/darttest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/denotest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/doctest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/exunit"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/fluttertest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gherkin"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/ginkgo"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gotesting"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gtest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/jasmine"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/jest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/mocha"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/mstest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/munit"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/nodetest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/phpunit"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/scalatest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/specs2"
 )
 
 func TestScan(t *testing.T) {
@@ -342,6 +369,14 @@ func TestScanOptions(t *testing.T) {
 		}
 	})
 
+	t.Run("WithParameterizedExpansion enables expansion", func(t *testing.T) {
+		opts := &parser.ScanOptions{}
+		parser.WithParameterizedExpansion(true)(opts)
+		if !opts.ExpandParameterized {
+			t.Error("expected ExpandParameterized to be true")
+		}
+	})
+
 	t.Run("WithWorkers ignores negative values", func(t *testing.T) {
 		opts := &parser.ScanOptions{Workers: 4}
 		parser.WithWorkers(-1)(opts)
@@ -578,6 +613,69 @@ TEST(CxxTest, Works) {
 	})
 }
 
+func TestScan_CppHeaderDetection(t *testing.T) {
+	t.Run("should detect C++ frameworks from included headers", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		files := map[string]string{
+			"tests/vector.cpp": `
+#include "third_party/catch.hpp"
+
+TEST_CASE("vectors can be sized") {
+    SECTION("resizing") {}
+}
+`,
+			"test_math.cpp": `
+#include <doctest/doctest.h>
+
+TEST_CASE("adds") {}
+`,
+			"calc_test.cpp": `
+#include <boost/test/unit_test.hpp>
+
+BOOST_AUTO_TEST_CASE(divides)
+{
+}
+`,
+			"legacy_test.cc": `
+#include <gtest/gtest.h>
+
+TEST(Legacy, Works) {}
+`,
+		}
+		writeFiles(t, tmpDir, files)
+
+		src, err := source.NewLocalSource(tmpDir)
+		if err != nil {
+			t.Fatalf("failed to create source: %v", err)
+		}
+		defer src.Close()
+
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := map[string]string{
+			"tests/vector.cpp": "catch2",
+			"test_math.cpp":    "doctest",
+			"calc_test.cpp":    "boost-test",
+			"legacy_test.cc":   "gtest",
+		}
+		if len(result.Inventory.Files) != len(want) {
+			t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+		}
+		for _, file := range result.Inventory.Files {
+			if file.Framework != want[file.Path] {
+				t.Errorf("%s: expected framework %q, got %q", file.Path, want[file.Path], file.Framework)
+			}
+			if file.CountTests() != 1 {
+				t.Errorf("%s: expected 1 test, got %d", file.Path, file.CountTests())
+			}
+		}
+	})
+}
+
 func TestScan_PHPUnit(t *testing.T) {
 	t.Run("should scan PHP PHPUnit files", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -1098,6 +1196,53 @@ fn integration_test() {
 			t.Errorf("expected framework 'cargo-test', got %q", file.Framework)
 		}
 	})
+
+	t.Run("should scan Criterion benchmarks in benches/ directory", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		benchesDir := filepath.Join(tmpDir, "benches")
+		if err := os.MkdirAll(benchesDir, 0755); err != nil {
+			t.Fatalf("failed to create benches dir: %v", err)
+		}
+
+		benchContent := []byte(`
+use criterion::{criterion_group, criterion_main, Criterion};
+
+fn bench(c: &mut Criterion) {
+    c.bench_function("parse", |b| b.iter(|| parse()));
+}
+
+criterion_group!(benches, bench);
+criterion_main!(benches);
+`)
+		benchFile := filepath.Join(benchesDir, "parse.rs")
+		if err := os.WriteFile(benchFile, benchContent, 0644); err != nil {
+			t.Fatalf("failed to write bench file: %v", err)
+		}
+
+		src, err := source.NewLocalSource(tmpDir)
+		if err != nil {
+			t.Fatalf("failed to create source: %v", err)
+		}
+		defer src.Close()
+
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(result.Inventory.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+		}
+
+		file := result.Inventory.Files[0]
+		if file.Framework != "criterion" {
+			t.Errorf("expected framework 'criterion', got %q", file.Framework)
+		}
+		if len(file.Tests) != 1 || file.Tests[0].Name != "parse" {
+			t.Errorf("expected benchmark 'parse', got %+v", file.Tests)
+		}
+	})
 }
 
 func TestScan_CSharpTestDirectory(t *testing.T) {
@@ -1315,6 +1460,57 @@ func TestSomething(t *testing.T) {
 	})
 }
 
+func TestScan_ParameterizedExpansion(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	testContent := []byte(`import { it } from '@jest/globals';
+
+it.each([[1, 1, 2], [2, 2, 4]])('add(%i, %i) = %i', (a, b, expected) => {});
+`)
+	if err := os.WriteFile(filepath.Join(tmpDir, "math.test.ts"), testContent, 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("should keep a single dynamic test by default", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := result.Inventory.CountTests(); got != 1 {
+			t.Errorf("expected 1 test, got %d", got)
+		}
+	})
+
+	t.Run("should expand cases with WithParameterizedExpansion(true)", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src, parser.WithParameterizedExpansion(true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(result.Inventory.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+		}
+
+		tests := result.Inventory.Files[0].Tests
+		if len(tests) != 2 {
+			t.Fatalf("expected 2 tests, got %d", len(tests))
+		}
+		if tests[1].Name != "add(2, 2) = 4" {
+			t.Errorf("expected rendered name %q, got %q", "add(2, 2) = 4", tests[1].Name)
+		}
+		if tests[1].Template == nil || tests[1].Template.Name != "add(%i, %i) = %i" {
+			t.Errorf("expected template link to %q, got %+v", "add(%i, %i) = %i", tests[1].Template)
+		}
+	})
+}
+
 func TestScan_JSTestDirectory(t *testing.T) {
 	t.Run("should detect JS test files in test/ directory", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -1392,3 +1588,1350 @@ describe('API Tests', () => {
 		}
 	})
 }
+
+func TestScan_JSRunners(t *testing.T) {
+	files := map[string]string{
+		// node:test is always imported; *_test.js is a node:test default pattern
+		"node/math_test.js": `import { test } from 'node:test';
+test('adds', () => {});
+`,
+		// AVA files import ava; test-*.js is an AVA default pattern
+		"ava/test-math.js": `import test from 'ava';
+test.serial('adds', t => {});
+`,
+		// bun test injects globals; bunfig.toml scopes the package
+		"bun/bunfig.toml": "[test]\npreload = [\"./setup.ts\"]\n",
+		"bun/math.test.ts": `test('adds', () => {});
+`,
+		// Deno.test is a global; deno.json scopes the package
+		"deno/deno.json": `{ "test": { "include": ["src/"] } }`,
+		"deno/src/math_test.ts": `Deno.test('adds', () => {});
+`,
+		// jasmine init layout: spec/support/jasmine.json, *Spec.js specs
+		"jasmine/spec/support/jasmine.json": `{ "spec_dir": "spec", "spec_files": ["**/*[sS]pec.js"] }`,
+		"jasmine/spec/mathSpec.js": `describe('math', () => { it('adds', () => {}); });
+`,
+	}
+
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		filepath.Join("ava", "test-math.js"):            "ava",
+		filepath.Join("bun", "math.test.ts"):            "bun-test",
+		filepath.Join("deno", "src", "math_test.ts"):    "deno-test",
+		filepath.Join("jasmine", "spec", "mathSpec.js"): "jasmine",
+		filepath.Join("node", "math_test.js"):           "node-test",
+	}
+
+	if len(result.Inventory.Files) != len(expected) {
+		t.Fatalf("expected %d files, got %d", len(expected), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		want, ok := expected[file.Path]
+		if !ok {
+			t.Errorf("unexpected file %s", file.Path)
+			continue
+		}
+		if file.Framework != want {
+			t.Errorf("%s: expected framework %q, got %q", file.Path, want, file.Framework)
+		}
+		if len(file.Tests)+len(file.Suites) == 0 {
+			t.Errorf("%s: expected tests to be parsed", file.Path)
+		}
+	}
+}
+
+func TestScan_GoFrameworks(t *testing.T) {
+	files := map[string]string{
+		"books/books_suite_test.go": `package books_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+)
+
+func TestBooks(t *testing.T) {
+	RunSpecs(t, "Books Suite")
+}
+
+var _ = Describe("Book", func() {
+	It("has a title", func() {})
+})
+`,
+		"calc/calc_test.go": `package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {}
+`,
+	}
+
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		filepath.Join("books", "books_suite_test.go"): "ginkgo",
+		filepath.Join("calc", "calc_test.go"):         "go-testing",
+	}
+
+	if len(result.Inventory.Files) != len(expected) {
+		t.Fatalf("expected %d files, got %d", len(expected), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		if want := expected[file.Path]; file.Framework != want {
+			t.Errorf("%s: expected framework %q, got %q", file.Path, want, file.Framework)
+		}
+	}
+}
+
+func TestScan_TestKinds(t *testing.T) {
+	files := map[string]string{
+		"calc/calc_test.go": `package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {}
+func BenchmarkAdd(b *testing.B) {}
+func FuzzAdd(f *testing.F) {}
+`,
+		"api/integration/users_test.go": `package integration
+
+import "testing"
+
+func TestCreateUser(t *testing.T) {}
+`,
+		"web/e2e/login.spec.ts": `import { test } from '@playwright/test';
+test('logs in', async ({ page }) => {});
+`,
+		"web/src/app.e2e.test.ts": `import { test } from 'vitest';
+test('renders', () => {});
+`,
+		"web/src/math.test.ts": `import { test } from 'vitest';
+test('adds', () => {});
+`,
+	}
+
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	collect := func(result *parser.ScanResult) map[string]domain.TestKind {
+		kinds := make(map[string]domain.TestKind)
+		for _, file := range result.Inventory.Files {
+			for _, test := range file.Tests {
+				kinds[test.Name] = test.Kind
+			}
+		}
+		return kinds
+	}
+
+	t.Run("should classify by framework semantics and default path rules", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := map[string]domain.TestKind{
+			"TestAdd":        domain.TestKindUnit,
+			"BenchmarkAdd":   domain.TestKindBenchmark,
+			"FuzzAdd":        domain.TestKindFuzz,
+			"TestCreateUser": domain.TestKindIntegration,
+			"logs in":        domain.TestKindE2E,
+			"renders":        domain.TestKindE2E,
+			"adds":           domain.TestKindUnit,
+		}
+		kinds := collect(result)
+		for name, want := range expected {
+			if kinds[name] != want {
+				t.Errorf("%s: expected kind %q, got %q", name, want, kinds[name])
+			}
+		}
+	})
+
+	t.Run("should apply custom rules instead of defaults", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src, parser.WithKindRules([]parser.KindRule{
+			{Pattern: "api/**", Kind: domain.TestKindE2E},
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		kinds := collect(result)
+		if kinds["TestCreateUser"] != domain.TestKindE2E {
+			t.Errorf("expected custom rule to apply, got %q", kinds["TestCreateUser"])
+		}
+		if kinds["renders"] != domain.TestKindUnit {
+			t.Errorf("expected default rules to be replaced, got %q", kinds["renders"])
+		}
+		if kinds["logs in"] != domain.TestKindE2E {
+			t.Errorf("expected framework default to win, got %q", kinds["logs in"])
+		}
+	})
+}
+
+// writeFiles writes files, keyed by slash-separated path, under dir and
+// creates their parent directories.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
+
+// memoryCache is an in-memory parser.ResultCache for tests.
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*parser.CachedResult
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string]*parser.CachedResult)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (*parser.CachedResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result, ok := c.entries[key]
+	return result, ok
+}
+
+func (c *memoryCache) Put(_ context.Context, key string, result *parser.CachedResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = result
+	return nil
+}
+
+func TestScan_ResultCache(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"a.test.ts": `import { it } from '@jest/globals';
+it('a', () => {});
+`,
+		"b.test.ts": `import { it } from '@jest/globals';
+it.each([[1], [2]])('b %i', (n) => {});
+`,
+		"c.test.ts": `// no framework usage here`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	cache := newMemoryCache()
+	scan := func(opts ...parser.ScanOption) *parser.ScanResult {
+		t.Helper()
+		result, err := parser.Scan(context.Background(), src, append(opts, parser.WithResultCache(cache))...)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	first := scan()
+	if first.Stats.FilesCached != 0 {
+		t.Errorf("expected no cached files on first scan, got %d", first.Stats.FilesCached)
+	}
+
+	t.Run("should serve unchanged files from cache with the same stats", func(t *testing.T) {
+		second := scan()
+
+		if second.Stats.FilesCached != second.Stats.FilesScanned {
+			t.Errorf("expected all %d files cached, got %d", second.Stats.FilesScanned, second.Stats.FilesCached)
+		}
+		if second.Stats.FilesMatched != first.Stats.FilesMatched || second.Stats.FilesSkipped != first.Stats.FilesSkipped {
+			t.Errorf("expected stats %+v, got %+v", first.Stats, second.Stats)
+		}
+		if fmt.Sprint(second.Stats.ConfidenceDist) != fmt.Sprint(first.Stats.ConfidenceDist) {
+			t.Errorf("expected confidence %v, got %v", first.Stats.ConfidenceDist, second.Stats.ConfidenceDist)
+		}
+		if second.Inventory.CountTests() != first.Inventory.CountTests() {
+			t.Errorf("expected %d tests, got %d", first.Inventory.CountTests(), second.Inventory.CountTests())
+		}
+	})
+
+	t.Run("should re-parse when options affecting output change", func(t *testing.T) {
+		expanded := scan(parser.WithParameterizedExpansion(true))
+
+		if expanded.Stats.FilesCached != 0 {
+			t.Errorf("expected no cached files, got %d", expanded.Stats.FilesCached)
+		}
+		if got := expanded.Inventory.CountTests(); got != 3 {
+			t.Errorf("expected 3 tests with expansion, got %d", got)
+		}
+	})
+
+	t.Run("should re-parse only changed files", func(t *testing.T) {
+		changed := []byte(`import { it } from '@jest/globals';
+it('a', () => {});
+it('a2', () => {});
+`)
+		if err := os.WriteFile(filepath.Join(tmpDir, "a.test.ts"), changed, 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+
+		result := scan()
+
+		if result.Stats.FilesCached != result.Stats.FilesScanned-1 {
+			t.Errorf("expected %d cached files, got %d", result.Stats.FilesScanned-1, result.Stats.FilesCached)
+		}
+		if got := result.Inventory.CountTests(); got != 3 {
+			t.Errorf("expected 3 tests, got %d", got)
+		}
+	})
+}
+
+func TestScan_ResultCache_SharedAcrossCheckouts(t *testing.T) {
+	writeCheckout := func() string {
+		dir := t.TempDir()
+		files := map[string]string{
+			"jest.config.js": `module.exports = { testEnvironment: 'node' };`,
+			"a.test.ts":      "it('a', () => {});\n",
+		}
+		writeFiles(t, dir, files)
+		return dir
+	}
+
+	cache := newMemoryCache()
+	scan := func(dir string) *parser.ScanResult {
+		t.Helper()
+		src, err := source.NewLocalSource(dir)
+		if err != nil {
+			t.Fatalf("failed to create source: %v", err)
+		}
+		defer src.Close()
+
+		result, err := parser.Scan(context.Background(), src, parser.WithResultCache(cache))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	first := scan(writeCheckout())
+	if first.Stats.FilesMatched != 1 {
+		t.Fatalf("expected 1 matched file, got %d", first.Stats.FilesMatched)
+	}
+
+	second := scan(writeCheckout())
+	if second.Stats.FilesCached != 1 {
+		t.Errorf("expected the clone in another directory to hit the cache, got %d cached", second.Stats.FilesCached)
+	}
+}
+
+func TestScan_GherkinFeatures(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"features/login.feature": `@auth
+Feature: Login
+
+  Scenario: Successful login
+    Given a registered user
+    When they sign in
+    Then they see the dashboard
+
+  @wip
+  Scenario: Locked account
+    Given a locked user
+`,
+		"docs/readme.md": "# not a test",
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "gherkin" || file.Language != domain.LanguageGherkin {
+		t.Errorf("expected gherkin framework and language, got %q/%q", file.Framework, file.Language)
+	}
+	if file.CountTests() != 2 {
+		t.Errorf("expected 2 scenarios, got %d", file.CountTests())
+	}
+	for _, suite := range file.Suites {
+		for _, test := range suite.Tests {
+			if test.Kind != domain.TestKindE2E {
+				t.Errorf("expected scenario %q to be e2e, got %q", test.Name, test.Kind)
+			}
+		}
+	}
+}
+
+func TestScan_DartTests(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test/calculator_test.dart": `import 'package:test/test.dart';
+
+void main() {
+  group('Calculator', () {
+    test('adds', () => expect(1 + 1, 2));
+    test('divides', () {}, skip: 'not implemented');
+  });
+}
+`,
+		"test/widget_test.dart": `import 'package:flutter_test/flutter_test.dart';
+
+void main() {
+  testWidgets('renders counter', (tester) async {});
+}
+`,
+		"lib/calculator.dart": "int add(int a, int b) => a + b;",
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Inventory.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(result.Inventory.Files))
+	}
+
+	frameworks := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		if file.Language != domain.LanguageDart {
+			t.Errorf("expected dart language for %s, got %q", file.Path, file.Language)
+		}
+		frameworks[filepath.Base(file.Path)] = file.Framework
+	}
+	if frameworks["calculator_test.dart"] != "dart-test" {
+		t.Errorf("expected dart-test for calculator_test.dart, got %q", frameworks["calculator_test.dart"])
+	}
+	if frameworks["widget_test.dart"] != "flutter-test" {
+		t.Errorf("expected flutter-test for widget_test.dart, got %q", frameworks["widget_test.dart"])
+	}
+	if result.Inventory.CountTests() != 3 {
+		t.Errorf("expected 3 tests, got %d", result.Inventory.CountTests())
+	}
+
+	t.Run("reports passes without Dart support", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src,
+			parser.WithTestMetrics(true),
+			parser.WithFingerprints(true),
+			parser.WithLint(&lint.Config{}),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.Stats.FilesFailed != 0 || len(result.Inventory.Files) != 2 {
+			t.Errorf("expected 2 parsed files and no failures, got %d and %d", len(result.Inventory.Files), result.Stats.FilesFailed)
+		}
+		var phases []string
+		for _, scanErr := range result.Errors {
+			if !errors.Is(scanErr.Err, parser.ErrUnsupportedLanguage) {
+				t.Errorf("unexpected error: %v", scanErr)
+				continue
+			}
+			phases = append(phases, scanErr.Phase)
+		}
+		if want := []string{"metrics", "fingerprints", "lint"}; !reflect.DeepEqual(phases, want) {
+			t.Errorf("expected one error per pass %v, got %v", want, phases)
+		}
+	})
+}
+
+func TestScan_ElixirAndErlangTests(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test/my_app_web/controllers/page_controller_test.exs": `defmodule MyAppWeb.PageControllerTest do
+  use MyAppWeb.ConnCase
+
+  describe "GET /" do
+    test "renders the home page", %{conn: conn} do
+      assert html_response(get(conn, ~p"/"), 200)
+    end
+
+    @tag :skip
+    test "redirects guests"
+  end
+end
+`,
+		"test/my_app/cart_test.exs": `defmodule MyApp.CartTest do
+  use ExUnit.Case, async: true
+  doctest MyApp.Cart
+
+  test "adds items" do
+    assert MyApp.Cart.add([], :apple) == [:apple]
+  end
+end
+`,
+		"test/test_helper.exs": "ExUnit.start()\n",
+		"test/cart_SUITE.erl": `-module(cart_SUITE).
+-export([all/0, add_item/1]).
+
+all() -> [add_item].
+
+add_item(_Config) -> ok.
+`,
+		"src/cart.erl": "-module(cart).\n",
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Inventory.Files) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(result.Inventory.Files))
+	}
+
+	frameworks := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		frameworks[filepath.Base(file.Path)] = file.Framework
+	}
+	if frameworks["page_controller_test.exs"] != "exunit" {
+		t.Errorf("expected exunit for page_controller_test.exs, got %q", frameworks["page_controller_test.exs"])
+	}
+	if frameworks["cart_test.exs"] != "exunit" {
+		t.Errorf("expected exunit for cart_test.exs, got %q", frameworks["cart_test.exs"])
+	}
+	if frameworks["cart_SUITE.erl"] != "common-test" {
+		t.Errorf("expected common-test for cart_SUITE.erl, got %q", frameworks["cart_SUITE.erl"])
+	}
+	if result.Inventory.CountTests() != 4 {
+		t.Errorf("expected 4 tests, got %d", result.Inventory.CountTests())
+	}
+}
+
+func TestScan_ScalaTests(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"build.sbt": `lazy val root = (project in file("."))
+  .settings(
+    libraryDependencies += "org.scalameta" %% "munit" % "1.0.0" % Test
+  )
+`,
+		"src/test/scala/shop/CartSuite.scala": `package shop
+
+class CartSuite extends BaseSuite {
+  test("adds items") {}
+  test("removes items".ignore) {}
+}
+`,
+		"src/test/scala/shop/StackSpec.scala": `package shop
+
+import org.scalatest.flatspec.AnyFlatSpec
+
+class StackSpec extends AnyFlatSpec {
+  "A Stack" should "pop values" in {}
+}
+`,
+		"src/test/scala/shop/HelloSpec.scala": `package shop
+
+import org.specs2.mutable.Specification
+
+class HelloSpec extends Specification {
+  "Hello" should {
+    "have 5 characters" in { "Hello" must haveSize(5) }
+  }
+}
+`,
+		"src/main/scala/shop/Cart.scala": "package shop\n\nclass Cart\n",
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Inventory.Files) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(result.Inventory.Files))
+	}
+
+	frameworks := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		if file.Language != domain.LanguageScala {
+			t.Errorf("expected scala language for %s, got %q", file.Path, file.Language)
+		}
+		frameworks[filepath.Base(file.Path)] = file.Framework
+	}
+	// CartSuite has no import; the munit dependency in build.sbt decides.
+	if frameworks["CartSuite.scala"] != "munit" {
+		t.Errorf("expected munit for CartSuite.scala, got %q", frameworks["CartSuite.scala"])
+	}
+	if frameworks["StackSpec.scala"] != "scalatest" {
+		t.Errorf("expected scalatest for StackSpec.scala, got %q", frameworks["StackSpec.scala"])
+	}
+	if frameworks["HelloSpec.scala"] != "specs2" {
+		t.Errorf("expected specs2 for HelloSpec.scala, got %q", frameworks["HelloSpec.scala"])
+	}
+	if result.Inventory.CountTests() != 4 {
+		t.Errorf("expected 4 tests, got %d", result.Inventory.CountTests())
+	}
+}
+
+func TestScan_DeclarativeFramework(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".specvital.yml": `version: 1
+frameworks:
+  - name: acme-spec
+    languages: [javascript]
+    detection:
+      imports: ["@acme/spec"]
+      filenames: ["*.acme.js"]
+    parse:
+      suites: [spec]
+      tests: [check, xcheck]
+      skip: [xcheck]
+`,
+		// Conventional name, attributed by import over mocha's content patterns.
+		"test/cart.test.js": `const { spec, check } = require("@acme/spec");
+
+spec("Cart", function () {
+  this.timeout(5000);
+  check("adds items", () => {});
+});
+`,
+		// Non-conventional name, discovered through the filename pattern.
+		"qa/checkout.acme.js": `spec("Checkout", () => {
+  check("pays", () => {});
+  xcheck("refunds", () => {});
+});
+`,
+		"src/cart.js": "module.exports = {};\n",
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Inventory.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		if file.Framework != "acme-spec" {
+			t.Errorf("expected acme-spec for %s, got %q", file.Path, file.Framework)
+		}
+	}
+	if result.Inventory.CountTests() != 3 {
+		t.Errorf("expected 3 tests, got %d", result.Inventory.CountTests())
+	}
+
+	if framework.DefaultRegistry().Find("acme-spec") != nil {
+		t.Error("custom framework should not leak into the default registry")
+	}
+}
+
+func TestScan_RepoConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".specvital.yml": `version: 1
+exclude: ["**/fixtures/**", "legacy/*.test.js"]
+overrides:
+  - files: ["qa/**/*.js"]
+    framework: playwright
+rules:
+  - files: ["src/payments/**"]
+    kind: integration
+    tags: [payments]
+  - files: ["src/**"]
+    tags: [core]
+`,
+		"src/payments/charge.test.js": `import { describe, it } from "vitest";
+
+describe("Charge", () => {
+  it("captures", () => {});
+});
+it("refunds", () => {});
+`,
+		"src/fixtures/sample.test.js": `import { it } from "vitest"; it("is not a real test", () => {});`,
+		"legacy/old.test.js":          `import { it } from "vitest"; it("is vendored", () => {});`,
+		"legacy/nested/kept.test.js":  `import { it } from "vitest"; it("is kept", () => {});`,
+		"qa/checkout/pay.js": `test("pays", async ({ page }) => {});
+`,
+	}
+
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Config == nil || result.Config.Path != ".specvital.yml" {
+		t.Fatalf("expected effective config from .specvital.yml, got %+v", result.Config)
+	}
+
+	byPath := make(map[string]domain.TestFile)
+	for _, file := range result.Inventory.Files {
+		byPath[filepath.ToSlash(file.Path)] = file
+	}
+	if len(byPath) != 3 {
+		t.Fatalf("expected 3 files, got %v", byPath)
+	}
+
+	qa, ok := byPath["qa/checkout/pay.js"]
+	if !ok || qa.Framework != "playwright" {
+		t.Errorf("expected qa/checkout/pay.js forced to playwright, got %+v", qa)
+	}
+	if qa.Detection == nil || qa.Detection.Source != "repo-config" || !reflect.DeepEqual(qa.Detection.Evidence, []string{"config: .specvital.yml"}) {
+		t.Errorf("expected repo-config detection, got %+v", qa.Detection)
+	}
+	if _, ok := byPath["legacy/nested/kept.test.js"]; !ok {
+		t.Error("expected legacy/nested/kept.test.js to be kept")
+	}
+
+	charge := byPath["src/payments/charge.test.js"]
+	if len(charge.Suites) != 1 || len(charge.Tests) != 1 {
+		t.Fatalf("unexpected structure: %+v", charge)
+	}
+	wantTags := []string{"payments", "core"}
+	if !reflect.DeepEqual(charge.Suites[0].Tags, wantTags) || !reflect.DeepEqual(charge.Tests[0].Tags, wantTags) {
+		t.Errorf("expected tags %v, got suite %v and test %v", wantTags, charge.Suites[0].Tags, charge.Tests[0].Tags)
+	}
+	if charge.Suites[0].Tests[0].Kind != domain.TestKindIntegration || charge.Tests[0].Kind != domain.TestKindIntegration {
+		t.Errorf("expected integration kind from config rule, got %q and %q", charge.Suites[0].Tests[0].Kind, charge.Tests[0].Kind)
+	}
+}
+
+func TestScan_Detection(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"vitest.config.ts": `export default { test: { globals: true } };`,
+		"src/scoped.test.ts": `describe("Scoped", () => {
+  it("works", () => {});
+});
+`,
+		"src/imported.test.ts": `import { it } from "vitest"; it("works", () => {});`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]domain.Detection{
+		"src/imported.test.ts": {Confidence: 100, Evidence: []string{"import: vitest"}, Source: "import"},
+		"src/scoped.test.ts":   {Confidence: 80, Evidence: []string{"config: vitest.config.ts", "globals: true"}, Source: "config-scope"},
+	}
+	if len(result.Inventory.Files) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		path := filepath.ToSlash(file.Path)
+		if file.Detection == nil {
+			t.Errorf("%s: expected detection to be set", path)
+			continue
+		}
+		if !reflect.DeepEqual(*file.Detection, want[path]) {
+			t.Errorf("%s: expected detection %+v, got %+v", path, want[path], *file.Detection)
+		}
+	}
+}
+
+func TestScan_ProjectAttribution(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"package.json":                            `{"name": "monorepo", "private": true}`,
+		"packages/ui/package.json":                `{"name": "@acme/ui"}`,
+		"packages/ui/src/button.test.ts":          `import { it } from "vitest"; it("renders", () => {});`,
+		"packages/ui/node_modules/x/package.json": `{"name": "x"}`,
+		"services/api/go.mod":                     "module github.com/acme/api\n",
+		"services/api/handler/handler_test.go":    "package handler\n\nimport \"testing\"\n\nfunc TestHandle(t *testing.T) {}\n",
+		"scripts/release.test.js":                 `import { it } from "vitest"; it("releases", () => {});`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Stats.ProjectsFound != 3 {
+		t.Errorf("expected 3 projects, got %d", result.Stats.ProjectsFound)
+	}
+
+	want := map[string]domain.Project{
+		"packages/ui/src/button.test.ts":       {Kind: "npm", Name: "@acme/ui", Path: "packages/ui"},
+		"services/api/handler/handler_test.go": {Kind: "go", Name: "github.com/acme/api", Path: "services/api"},
+		"scripts/release.test.js":              {Kind: "npm", Name: "monorepo", Path: "."},
+	}
+	if len(result.Inventory.Files) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		path := filepath.ToSlash(file.Path)
+		if file.Project == nil {
+			t.Errorf("%s: expected a project", path)
+			continue
+		}
+		if *file.Project != want[path] {
+			t.Errorf("%s: expected project %+v, got %+v", path, want[path], *file.Project)
+		}
+	}
+}
+
+func TestScan_RepoConfigInclude(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"packages/app/a.test.js": `import { it } from "vitest"; it("a", () => {});`,
+		"tools/b.test.js":        `import { it } from "vitest"; it("b", () => {});`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	cfg, err := repoconfig.Parse([]byte("version: 1\ninclude: [\"packages/**\"]\n"))
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+
+	result, err := parser.Scan(context.Background(), src, parser.WithRepoConfig(cfg))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Inventory.Files) != 1 || filepath.ToSlash(result.Inventory.Files[0].Path) != "packages/app/a.test.js" {
+		t.Errorf("expected only packages/app/a.test.js, got %+v", result.Inventory.Files)
+	}
+}
+
+func TestScan_InvalidRepoConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "unsupported version",
+			config:  "version: 2\nexclude: [fixtures/**]\n",
+			wantErr: ".specvital.yml: version: unsupported version 2",
+		},
+		{
+			name:    "unknown field",
+			config:  "version: 1\nexcludes: [fixtures/**]\n",
+			wantErr: "field excludes not found",
+		},
+		{
+			name:    "unknown override framework",
+			config:  "version: 1\noverrides: [{files: [\"qa/**\"], framework: nope}]\n",
+			wantErr: `.specvital.yml: overrides[0].framework: unknown framework "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, ".specvital.yml"), []byte(tt.config), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			src, err := source.NewLocalSource(tmpDir)
+			if err != nil {
+				t.Fatalf("failed to create source: %v", err)
+			}
+			defer src.Close()
+
+			_, err = parser.Scan(context.Background(), src)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+
+			// The configuration file can be ignored explicitly.
+			if _, err := parser.Scan(context.Background(), src, parser.WithoutRepoConfig()); err != nil {
+				t.Errorf("expected no error when ignoring config, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScan_SourceGraph(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":                  "module example.com/shop\n",
+		"billing/invoice.go":      "package billing\n",
+		"billing/invoice_test.go": "package billing\n\nimport \"testing\"\n\nfunc TestTotal(t *testing.T) {}\n",
+		"web/cart.ts":             "export const cart = 1;\n",
+		"web/cart.test.ts":        "import { it } from \"vitest\";\nimport { cart } from \"./cart\";\n\nit(\"adds\", () => {});\n",
+		"web/vendor/lib.ts":       "export {};\n",
+		"reports/monthly.go":      "package reports\n",
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Inventory.SourceGraph != nil {
+			t.Errorf("expected no source graph, got %+v", result.Inventory.SourceGraph)
+		}
+	})
+
+	t.Run("maps tests to imported sources", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src,
+			parser.WithSourceGraph(true),
+			parser.WithExcludePatterns([]string{"vendor"}),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		graph := result.Inventory.SourceGraph
+		if graph == nil {
+			t.Fatal("expected a source graph")
+		}
+
+		wantEdges := map[string][]string{
+			"billing/invoice_test.go": {"billing/invoice.go"},
+			"web/cart.test.ts":        {"web/cart.ts"},
+		}
+		if !reflect.DeepEqual(graph.Edges, wantEdges) {
+			t.Errorf("expected edges %v, got %v", wantEdges, graph.Edges)
+		}
+		wantSources := []string{"billing/invoice.go", "reports/monthly.go", "web/cart.ts"}
+		if !reflect.DeepEqual(graph.Sources, wantSources) {
+			t.Errorf("expected sources %v, got %v", wantSources, graph.Sources)
+		}
+		if got := graph.UntestedDirs(); !reflect.DeepEqual(got, []string{"reports"}) {
+			t.Errorf("expected untested dirs [reports], got %v", got)
+		}
+	})
+}
+
+func TestScan_Lint(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".specvital.yml": "version: 1\nlint:\n  rules:\n    empty-test: off\n",
+		"cart.test.ts":   "import { it, expect } from \"vitest\";\n\nit.only(\"adds\", () => {\n  expect(1).toBe(1);\n});\n\nit(\"removes\", () => {});\n",
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if findings := result.Inventory.Files[0].Findings; len(findings) != 0 {
+			t.Errorf("expected no findings, got %+v", findings)
+		}
+	})
+
+	t.Run("applies repository rule settings", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src, parser.WithLint(&lint.Config{}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		findings := result.Inventory.Files[0].Findings
+		if len(findings) != 1 {
+			t.Fatalf("expected 1 finding, got %+v", findings)
+		}
+		got := findings[0]
+		if got.Rule != lint.RuleFocusedTest || got.Severity != domain.SeverityError {
+			t.Errorf("expected focused-test error, got %s %s", got.Rule, got.Severity)
+		}
+		if got.Location.File != "cart.test.ts" || got.Location.StartLine != 3 {
+			t.Errorf("expected cart.test.ts:3, got %s:%d", got.Location.File, got.Location.StartLine)
+		}
+	})
+
+	t.Run("rejects unknown rules", func(t *testing.T) {
+		_, err := parser.Scan(context.Background(), src, parser.WithLint(&lint.Config{
+			Rules: map[string]string{"no-such-rule": "error"},
+		}))
+		if err == nil || !strings.Contains(err.Error(), "rules.no-such-rule: unknown rule") {
+			t.Errorf("expected unknown rule error, got %v", err)
+		}
+	})
+}
+
+func TestScan_TestMetrics(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `import { it, expect } from "vitest";
+
+it("adds", () => {
+  const cart = add([], 1);
+  // one item
+  if (cart.length) {
+    expect(cart).toHaveLength(1);
+  }
+  expect.soft(cart[0]).toBe(1);
+});
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "cart.test.ts"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if metrics := result.Inventory.Files[0].Tests[0].Metrics; metrics != nil {
+			t.Errorf("expected no metrics, got %+v", metrics)
+		}
+	})
+
+	t.Run("measures test bodies", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src, parser.WithTestMetrics(true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		metrics := result.Inventory.Files[0].Tests[0].Metrics
+		if metrics == nil {
+			t.Fatal("expected metrics")
+		}
+		want := domain.TestMetrics{Assertions: 2, Depth: 1, Lines: 7}
+		if *metrics != want {
+			t.Errorf("expected %+v, got %+v", want, *metrics)
+		}
+	})
+}
+
+func TestScan_Fingerprints(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"cart.test.ts":   "import { it, expect } from \"vitest\";\n\nit(\"adds\", () => {\n  expect(add(1)).toBe(1);\n});\n",
+		"basket.test.ts": "import { it, expect } from \"vitest\";\n\n// moved from cart.test.ts\nit(\"adds one\", () => {\n  expect(add(1)).toBe(1);\n});\n",
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, file := range result.Inventory.Files {
+			if got := file.Tests[0].Fingerprint; got != "" {
+				t.Errorf("%s: expected no fingerprint, got %q", file.Path, got)
+			}
+		}
+	})
+
+	t.Run("matches moved and renamed tests", func(t *testing.T) {
+		result, err := parser.Scan(context.Background(), src, parser.WithFingerprints(true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Inventory.Files) != 2 {
+			t.Fatalf("expected 2 files, got %d", len(result.Inventory.Files))
+		}
+
+		first := result.Inventory.Files[0].Tests[0].Fingerprint
+		second := result.Inventory.Files[1].Tests[0].Fingerprint
+		if first == "" || first != second {
+			t.Errorf("expected equal fingerprints, got %q and %q", first, second)
+		}
+	})
+}
+
+// recordingObserver records scan events for assertions.
+type recordingObserver struct {
+	parser.NopObserver
+
+	mu         sync.Mutex
+	configs    []string
+	discovered []string
+	total      int
+	detected   map[string]string
+	parsed     []string
+	failed     []string
+	completed  []parser.ScanStats
+}
+
+func (o *recordingObserver) ConfigsDiscovered(paths []string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.configs = append(o.configs, paths...)
+}
+
+func (o *recordingObserver) FileDiscovered(path string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.discovered = append(o.discovered, path)
+}
+
+func (o *recordingObserver) DiscoveryCompleted(files int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.total = files
+}
+
+func (o *recordingObserver) FileDetected(path string, result detection.Result) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.detected == nil {
+		o.detected = make(map[string]string)
+	}
+	o.detected[path] = result.Framework
+}
+
+func (o *recordingObserver) FileParsed(result *parser.FileResult, elapsed time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.parsed = append(o.parsed, result.Path)
+}
+
+func (o *recordingObserver) FileFailed(path string, err error, elapsed time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.failed = append(o.failed, path)
+}
+
+func (o *recordingObserver) ScanCompleted(stats parser.ScanStats) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.completed = append(o.completed, stats)
+}
+
+func TestScan_ObserverDiscoveryError(t *testing.T) {
+	tmpDir := t.TempDir()
+	root := filepath.Join(tmpDir, "repo")
+	if err := os.Mkdir(root, 0o755); err != nil {
+		t.Fatalf("failed to create root: %v", err)
+	}
+
+	src, err := source.NewLocalSource(root)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	if err := os.Remove(root); err != nil {
+		t.Fatalf("failed to remove root: %v", err)
+	}
+
+	observer := &recordingObserver{}
+	result, err := parser.Scan(context.Background(), src, parser.WithObserver(observer))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Errors) != 1 || result.Errors[0].Phase != "discovery" {
+		t.Fatalf("expected one discovery error, got %v", result.Errors)
+	}
+	if !reflect.DeepEqual(observer.failed, []string{""}) {
+		t.Errorf("expected the discovery error reported as failed, got %q", observer.failed)
+	}
+	if observer.total != result.Stats.FilesScanned {
+		t.Errorf("expected discovery total %d to match files scanned %d", observer.total, result.Stats.FilesScanned)
+	}
+}
+
+func TestScan_Observer(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"vitest.config.ts": "export default {};\n",
+		"cart.test.ts":     "import { it, expect } from \"vitest\";\n\nit(\"adds\", () => {\n  expect(add(1)).toBe(1);\n});\n",
+		"cart_test.go":     "package cart\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {}\n",
+	}
+	writeFiles(t, tmpDir, files)
+
+	src, err := source.NewLocalSource(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	t.Run("Scan reports every phase", func(t *testing.T) {
+		observer := &recordingObserver{}
+		result, err := parser.Scan(context.Background(), src, parser.WithObserver(observer))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(observer.configs, []string{"vitest.config.ts"}) {
+			t.Errorf("expected vitest.config.ts discovered, got %v", observer.configs)
+		}
+		sort.Strings(observer.discovered)
+		if !reflect.DeepEqual(observer.discovered, []string{"cart.test.ts", "cart_test.go"}) {
+			t.Errorf("unexpected discovered files: %v", observer.discovered)
+		}
+		if observer.total != 2 {
+			t.Errorf("expected discovery of 2 files, got %d", observer.total)
+		}
+		if observer.detected["cart.test.ts"] != "vitest" || observer.detected["cart_test.go"] != "go-testing" {
+			t.Errorf("unexpected detection results: %v", observer.detected)
+		}
+		if len(observer.parsed) != 2 {
+			t.Errorf("expected 2 parsed files, got %v", observer.parsed)
+		}
+		if len(observer.completed) != 1 {
+			t.Fatalf("expected one completion, got %d", len(observer.completed))
+		}
+		if got := observer.completed[0]; got.FilesMatched != 2 || got.ConfigsFound != result.Stats.ConfigsFound || got.Duration != result.Stats.Duration {
+			t.Errorf("completion stats %+v do not match result stats %+v", got, result.Stats)
+		}
+	})
+
+	t.Run("ScanStream reports completion after the last result", func(t *testing.T) {
+		observer := &recordingObserver{}
+		scanner := parser.NewScanner(parser.WithObserver(observer))
+		results, err := scanner.ScanStream(context.Background(), src)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for range results {
+		}
+
+		observer.mu.Lock()
+		defer observer.mu.Unlock()
+		if len(observer.completed) != 1 {
+			t.Fatalf("expected one completion, got %d", len(observer.completed))
+		}
+		if got := observer.completed[0]; got.FilesScanned != 2 || got.FilesMatched != 2 {
+			t.Errorf("unexpected completion stats: %+v", got)
+		}
+	})
+
+	t.Run("ScanFiles reports the given files as discovered", func(t *testing.T) {
+		observer := &recordingObserver{}
+		scanner := parser.NewScanner(parser.WithObserver(observer))
+		if _, err := scanner.ScanFiles(context.Background(), src, []string{"cart_test.go"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if observer.total != 1 || len(observer.parsed) != 1 || len(observer.completed) != 1 {
+			t.Errorf("unexpected events: total=%d parsed=%v completed=%d", observer.total, observer.parsed, len(observer.completed))
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/testmap"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// buildSourceGraph maps the scanned test files to the production files they
+// import. Directories are skipped as in test file discovery; candidates not
+// detected as tests are not counted as production source either.
+func (s *Scanner) buildSourceGraph(ctx context.Context, src source.Source, files []domain.TestFile) (*domain.SourceGraph, error) {
+	rootPath := src.Root()
+	skipSet := buildSkipSet(append(DefaultSkipPatterns, s.options.ExcludePatterns...))
+
+	tests := make(map[string]bool, len(files))
+	for _, file := range files {
+		tests[filepath.ToSlash(file.Path)] = true
+	}
+
+	return testmap.Build(ctx, src, files, testmap.Options{
+		SkipDir: func(relDir string) bool {
+			path := filepath.Join(rootPath, filepath.FromSlash(relDir))
+			return shouldSkipDir(path, rootPath, skipSet) || s.excludesDir(path, rootPath)
+		},
+		IsTestFile: func(relPath string) bool {
+			return tests[relPath] || s.isTestFileCandidate(relPath)
+		},
+	})
+}
@@ -3,10 +3,25 @@
 package all
 
 import (
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/ava"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/benchmarkdotnet"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/boosttest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/buntest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/cargotest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/catch2"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/commontest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/criterion"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/cypress"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/darttest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/denotest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/doctest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/exunit"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/fluttertest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gherkin"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/ginkgo"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gotesting"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/gtest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/jasmine"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/jest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/junit4"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/junit5"
@@ -14,11 +29,15 @@ import (
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/minitest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/mocha"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/mstest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/munit"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/nodetest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/nunit"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/phpunit"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/playwright"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/pytest"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/rspec"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/scalatest"
+	_ "github.com/kubrickcode/specvital/lib/parser/strategies/specs2"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/swift-testing"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/testng"
 	_ "github.com/kubrickcode/specvital/lib/parser/strategies/unittest"
@@ -0,0 +1,76 @@
+package ava
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+const frameworkName = framework.FrameworkAVA
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the AVA definition. AVA has no globals or suites;
+// test.serial is a plain test and test.failing an expected failure,
+// both handled by the shared jstest parser.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageTypeScript, domain.LanguageJavaScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("ava"),
+			&AVAContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &AVAParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions: []string{
+			"t.assert", "t.deepEqual", "t.fail", "t.false", "t.falsy", "t.is", "t.like", "t.not",
+			"t.notDeepEqual", "t.notRegex", "t.notThrows", "t.notThrowsAsync", "t.pass", "t.regex",
+			"t.snapshot", "t.throws", "t.throwsAsync", "t.true", "t.truthy",
+		},
+	}
+}
+
+// AVAContentMatcher detects AVA-specific API patterns in file content.
+type AVAContentMatcher struct{}
+
+var avaPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\btest\.serial\b`), "test.serial()"},
+	{regexp.MustCompile(`\btest\.macro\s*\(`), "test.macro()"},
+	{regexp.MustCompile(`\bt\.(?:deepEqual|notDeepEqual|throwsAsync|notThrowsAsync|like|snapshot)\s*\(`), "t.* assertion"},
+}
+
+func (m *AVAContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range avaPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found AVA-specific pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type AVAParser struct{}
+
+func (p *AVAParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.Parse(ctx, source, filename, frameworkName)
+}
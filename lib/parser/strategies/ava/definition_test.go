@@ -0,0 +1,126 @@
+package ava
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "ava" {
+		t.Errorf("expected Name to be 'ava', got %q", def.Name)
+	}
+
+	if def.Priority != framework.PriorityGeneric {
+		t.Errorf("expected Priority to be %d, got %d", framework.PriorityGeneric, def.Priority)
+	}
+
+	if def.ConfigParser != nil {
+		t.Error("expected ConfigParser to be nil")
+	}
+
+	// ImportMatcher + ContentMatcher
+	if len(def.Matchers) != 2 {
+		t.Errorf("expected 2 matchers, got %d", len(def.Matchers))
+	}
+}
+
+func TestAVAContentMatcher_Match(t *testing.T) {
+	matcher := &AVAContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		content            string
+		expectedConfidence int
+	}{
+		{"test.serial", `test.serial('writes file', async t => {});`, 40},
+		{"test.macro", `const macro = test.macro((t, input) => {});`, 40},
+		{"t.deepEqual", `test('x', t => { t.deepEqual(a, b); });`, 40},
+		{"jest style", `test('x', () => { expect(a).toEqual(b); });`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+
+			result := matcher.Match(ctx, signal)
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("expected confidence %d, got %d", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestAVAParser_Parse(t *testing.T) {
+	source := `import test from 'ava';
+
+const macro = test.macro((t, input, expected) => {
+  t.is(eval(input), expected);
+});
+
+test.before(t => {});
+
+test('adds', t => {
+  t.is(1 + 1, 2);
+});
+
+test.serial('writes shared file', async t => {});
+
+test.serial.skip('slow serial', async t => {});
+
+test.failing('known bug', t => {
+  t.fail();
+});
+
+test.skip('skipped', t => {});
+
+test.only('focused', t => {});
+
+test.todo('later');
+
+test('evaluates 2 + 2', macro, '2 + 2', 4);
+`
+
+	file, err := (&AVAParser{}).Parse(context.Background(), []byte(source), "test/math.js")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if file.Framework != "ava" {
+		t.Errorf("expected framework 'ava', got %q", file.Framework)
+	}
+
+	expected := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+	}{
+		{"adds", domain.TestStatusActive, ""},
+		{"writes shared file", domain.TestStatusActive, ""},
+		{"slow serial", domain.TestStatusSkipped, "skip"},
+		{"known bug", domain.TestStatusXfail, "failing"},
+		{"skipped", domain.TestStatusSkipped, "skip"},
+		{"focused", domain.TestStatusFocused, "only"},
+		{"later", domain.TestStatusTodo, "todo"},
+		{"evaluates 2 + 2", domain.TestStatusActive, ""},
+	}
+	if len(file.Tests) != len(expected) {
+		t.Fatalf("expected %d tests, got %d: %+v", len(expected), len(file.Tests), file.Tests)
+	}
+	for i, want := range expected {
+		got := file.Tests[i]
+		if got.Name != want.name || got.Status != want.status || got.Modifier != want.modifier {
+			t.Errorf("test %d: expected (%q, %s, %q), got (%q, %s, %q)",
+				i, want.name, want.status, want.modifier, got.Name, got.Status, got.Modifier)
+		}
+	}
+}
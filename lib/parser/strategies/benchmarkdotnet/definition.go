@@ -0,0 +1,181 @@
+// Package benchmarkdotnet implements BenchmarkDotNet support for C# benchmark files.
+package benchmarkdotnet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/dotnetast"
+)
+
+const frameworkName = framework.FrameworkBenchmarkDotNet
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the BenchmarkDotNet definition. Every [Benchmark]
+// method is reported as a benchmark-kind test under its class.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCSharp},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"BenchmarkDotNet.Attributes",
+				"BenchmarkDotNet.Configs",
+				"BenchmarkDotNet.Jobs",
+				"BenchmarkDotNet.Running",
+			),
+			&BenchmarkDotNetContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &BenchmarkDotNetParser{},
+		Priority:     framework.PrioritySpecialized,
+		DefaultKind:  domain.TestKindBenchmark,
+	}
+}
+
+// BenchmarkDotNetContentMatcher matches BenchmarkDotNet attributes.
+type BenchmarkDotNetContentMatcher struct{}
+
+var benchmarkPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\[Benchmark(?:\s*\(|\])`), "[Benchmark] attribute"},
+	{regexp.MustCompile(`\[(?:SimpleJob|MemoryDiagnoser|ShortRunJob)\b`), "job attribute"},
+	{regexp.MustCompile(`\bBenchmarkRunner\.Run\b`), "BenchmarkRunner.Run"},
+}
+
+func (m *BenchmarkDotNetContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range benchmarkPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found BenchmarkDotNet pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// BenchmarkDotNetParser extracts [Benchmark] methods from C# files.
+type BenchmarkDotNetParser struct{}
+
+func (p *BenchmarkDotNetParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCSharp, source)
+	if err != nil {
+		return nil, fmt.Errorf("benchmarkdotnet parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	var suites []domain.TestSuite
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if node.Type() == dotnetast.NodeClassDeclaration {
+			if suite := parseBenchmarkClass(node, source, filename); suite != nil {
+				suites = append(suites, *suite)
+			}
+			return false
+		}
+		return true
+	})
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCSharp,
+		Framework: frameworkName,
+		Suites:    suites,
+	}, nil
+}
+
+func parseBenchmarkClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	className := dotnetast.GetClassName(node, source)
+	body := dotnetast.GetDeclarationList(node)
+	if className == "" || body == nil {
+		return nil
+	}
+
+	suite := domain.TestSuite{
+		Name:     className,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+
+	for _, child := range dotnetast.GetDeclarationChildren(body) {
+		switch child.Type() {
+		case dotnetast.NodeMethodDeclaration:
+			if test := parseBenchmarkMethod(child, source, filename); test != nil {
+				suite.Tests = append(suite.Tests, *test)
+			}
+		case dotnetast.NodeClassDeclaration:
+			if nested := parseBenchmarkClass(child, source, filename); nested != nil {
+				suite.Suites = append(suite.Suites, *nested)
+			}
+		}
+	}
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return nil
+	}
+	return &suite
+}
+
+func parseBenchmarkMethod(node *sitter.Node, source []byte, filename string) *domain.Test {
+	var benchmark *sitter.Node
+	for _, attr := range dotnetast.GetAttributes(dotnetast.GetAttributeLists(node)) {
+		switch dotnetast.GetAttributeName(attr, source) {
+		case "Benchmark", "BenchmarkAttribute":
+			benchmark = attr
+		}
+	}
+	if benchmark == nil {
+		return nil
+	}
+
+	name := dotnetast.GetMethodName(node, source)
+	if description := attributeDescription(benchmark, source); description != "" {
+		name = description
+	}
+	if name == "" {
+		return nil
+	}
+
+	return &domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+}
+
+// attributeDescription returns Description from [Benchmark(Description = "...")].
+func attributeDescription(attr *sitter.Node, source []byte) string {
+	argList := dotnetast.FindAttributeArgumentList(attr)
+	if argList == nil {
+		return ""
+	}
+	for i := 0; i < int(argList.ChildCount()); i++ {
+		arg := argList.Child(i)
+		if arg.Type() != dotnetast.NodeAttributeArgument {
+			continue
+		}
+		if name, value := dotnetast.ParseAssignmentExpression(arg, source); name == "Description" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+package benchmarkdotnet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "benchmarkdotnet" {
+		t.Errorf("expected name 'benchmarkdotnet', got %q", def.Name)
+	}
+	if def.Priority != framework.PrioritySpecialized {
+		t.Errorf("expected PrioritySpecialized, got %d", def.Priority)
+	}
+	if def.DefaultKind != domain.TestKindBenchmark {
+		t.Errorf("expected default kind benchmark, got %q", def.DefaultKind)
+	}
+}
+
+func TestBenchmarkDotNetContentMatcher_Match(t *testing.T) {
+	matcher := &BenchmarkDotNetContentMatcher{}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"should match Benchmark attribute", "[Benchmark]\npublic void Sha256() {}", true},
+		{"should match Benchmark attribute with arguments", "[Benchmark(Baseline = true)]", true},
+		{"should match MemoryDiagnoser", "[MemoryDiagnoser]\npublic class Bench {}", true},
+		{"should not match BenchmarkCategory alone", "[BenchmarkCategory(\"x\")]", false},
+		{"should not match xUnit test", "[Fact]\npublic void Adds() {}", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(context.Background(), framework.Signal{
+				Type:    framework.SignalFileContent,
+				Context: []byte(tt.content),
+			})
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("expected match=%v, got confidence %d", tt.expected, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestBenchmarkDotNetParser_Parse(t *testing.T) {
+	source := `
+using BenchmarkDotNet.Attributes;
+
+[MemoryDiagnoser]
+public class HashBenchmarks
+{
+    [Params(1000, 10000)]
+    public int N;
+
+    [GlobalSetup]
+    public void Setup() {}
+
+    [Benchmark(Baseline = true)]
+    public byte[] Sha256() => null;
+
+    [Benchmark(Description = "MD5 hash")]
+    public byte[] Md5() => null;
+
+    public void Helper() {}
+}
+
+public class Plain
+{
+    public void NotABenchmark() {}
+}
+`
+	file, err := (&BenchmarkDotNetParser{}).Parse(context.Background(), []byte(source), "HashBenchmarks.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if file.Framework != "benchmarkdotnet" {
+		t.Errorf("expected framework benchmarkdotnet, got %q", file.Framework)
+	}
+	if len(file.Suites) != 1 {
+		t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+	}
+
+	suite := file.Suites[0]
+	if suite.Name != "HashBenchmarks" {
+		t.Errorf("expected suite HashBenchmarks, got %q", suite.Name)
+	}
+	if len(suite.Tests) != 2 {
+		t.Fatalf("expected 2 benchmarks, got %d", len(suite.Tests))
+	}
+	if suite.Tests[0].Name != "Sha256" {
+		t.Errorf("expected Sha256, got %q", suite.Tests[0].Name)
+	}
+	if suite.Tests[1].Name != "MD5 hash" {
+		t.Errorf("expected description as name, got %q", suite.Tests[1].Name)
+	}
+}
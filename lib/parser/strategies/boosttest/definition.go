@@ -0,0 +1,201 @@
+// Package boosttest implements Boost.Test framework support for C++ test files.
+package boosttest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkBoostTest
+
+const confidenceContent = 40
+
+// suiteMacros and testCaseMacros map Boost.Test macros to the argument index of their name.
+var (
+	suiteMacros = map[string]int{
+		"BOOST_AUTO_TEST_SUITE":    0,
+		"BOOST_FIXTURE_TEST_SUITE": 0,
+	}
+	testCaseMacros = map[string]int{
+		"BOOST_AUTO_TEST_CASE":             0,
+		"BOOST_FIXTURE_TEST_CASE":          0,
+		"BOOST_AUTO_TEST_CASE_TEMPLATE":    0,
+		"BOOST_FIXTURE_TEST_CASE_TEMPLATE": 0,
+		"BOOST_DATA_TEST_CASE":             0,
+		"BOOST_DATA_TEST_CASE_F":           1,
+	}
+)
+
+const suiteEndMacro = "BOOST_AUTO_TEST_SUITE_END"
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			&cppast.HeaderMatcher{
+				Dirs: []string{"boost/test/"},
+			},
+			&BoostTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &BoostTestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"BOOST_CHECK*", "BOOST_REQUIRE*", "BOOST_TEST*", "BOOST_WARN*", "BOOST_ERROR", "BOOST_FAIL"},
+	}
+}
+
+// BoostTestContentMatcher matches Boost.Test registration macros.
+type BoostTestContentMatcher struct{}
+
+var boostTestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\bBOOST_(?:AUTO|FIXTURE|DATA)_TEST_CASE\w*\s*\(`), "BOOST_*_TEST_CASE() macro"},
+	{regexp.MustCompile(`\bBOOST_(?:AUTO|FIXTURE)_TEST_SUITE\s*\(`), "BOOST_*_TEST_SUITE() macro"},
+	{regexp.MustCompile(`#define\s+BOOST_TEST_MODULE\b`), "BOOST_TEST_MODULE definition"},
+}
+
+func (m *BoostTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range boostTestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(confidenceContent, "Found Boost.Test pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// BoostTestParser extracts test cases from Boost.Test files.
+// Suites are delimited by BOOST_AUTO_TEST_SUITE(name) ... BOOST_AUTO_TEST_SUITE_END().
+type BoostTestParser struct{}
+
+func (p *BoostTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("boost-test parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	root := &domain.TestSuite{}
+	stack := []*domain.TestSuite{root}
+
+	closeSuite := func() {
+		closed := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		parent := stack[len(stack)-1]
+		parent.Suites = append(parent.Suites, *closed)
+	}
+
+	for _, call := range cppast.MacroCalls(tree.RootNode(), source) {
+		current := stack[len(stack)-1]
+
+		if call.Name == suiteEndMacro {
+			if len(stack) > 1 {
+				closeSuite()
+			}
+			continue
+		}
+
+		if nameIdx, ok := suiteMacros[call.Name]; ok {
+			name, _ := call.IdentifierArg(nameIdx, source)
+			dec := decoratorsOf(call, nameIdx, source)
+			stack = append(stack, &domain.TestSuite{
+				Location: call.Location(filename),
+				Modifier: dec.modifier,
+				Name:     name,
+				Status:   dec.status,
+				Tags:     dec.tags,
+			})
+			continue
+		}
+
+		nameIdx, ok := testCaseMacros[call.Name]
+		if !ok || call.Body == nil {
+			continue
+		}
+
+		name, _ := call.IdentifierArg(nameIdx, source)
+		dec := decoratorsOf(call, nameIdx, source)
+		current.Tests = append(current.Tests, domain.Test{
+			Location: call.Location(filename),
+			Modifier: dec.modifier,
+			Name:     name,
+			Status:   dec.status,
+			Tags:     dec.tags,
+		})
+	}
+
+	// Suites left open (missing BOOST_AUTO_TEST_SUITE_END) end with the file.
+	for len(stack) > 1 {
+		closeSuite()
+	}
+
+	file.Tests = root.Tests
+	file.Suites = root.Suites
+	return file, nil
+}
+
+var enableIfFalsePattern = regexp.MustCompile(`enable_if\s*<\s*false\s*>`)
+
+// decorators is what the `* boost::unit_test::...` decorators of a unit declare.
+type decorators struct {
+	modifier string
+	status   domain.TestStatus
+	tags     []string
+}
+
+// decoratorsOf reads the decorators passed after the name argument:
+// disabled() and enable_if<false>() mark the unit skipped, label("x") adds a tag.
+func decoratorsOf(call cppast.MacroCall, nameIdx int, source []byte) decorators {
+	result := decorators{status: domain.TestStatusActive}
+
+	for _, arg := range call.Args[min(nameIdx+1, len(call.Args)):] {
+		for _, d := range cppast.DecoratorCalls(arg, source) {
+			switch d.Name {
+			case "disabled":
+				result.status = domain.TestStatusSkipped
+				result.modifier = "disabled"
+			case "enable_if":
+				if enableIfFalsePattern.MatchString(parser.GetNodeText(arg, source)) {
+					result.status = domain.TestStatusSkipped
+					result.modifier = "enable_if<false>"
+				}
+			case "label":
+				if d.Args != nil && d.Args.NamedChildCount() > 0 {
+					if label, ok := cppast.StringValue(d.Args.NamedChild(0), source); ok && label != "" {
+						result.tags = domain.MergeTags(result.tags, []string{label})
+					}
+				}
+			}
+		}
+	}
+
+	return result
+}
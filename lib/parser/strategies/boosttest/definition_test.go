@@ -0,0 +1,128 @@
+package boosttest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestBoostTestParser_Parse(t *testing.T) {
+	source := `
+#define BOOST_TEST_MODULE calculator
+#include <boost/test/unit_test.hpp>
+
+namespace utf = boost::unit_test;
+
+BOOST_AUTO_TEST_CASE(free_test)
+{
+    BOOST_TEST(1 == 1);
+}
+
+BOOST_AUTO_TEST_SUITE(arithmetic)
+
+BOOST_AUTO_TEST_CASE(addition)
+{
+    BOOST_TEST(add(1, 2) == 3);
+}
+
+BOOST_AUTO_TEST_CASE(division, *utf::disabled())
+{
+}
+
+BOOST_AUTO_TEST_SUITE(nested, *utf::label("slow"))
+
+BOOST_FIXTURE_TEST_CASE(with_fixture, Fixture)
+{
+}
+
+BOOST_AUTO_TEST_CASE(conditional, *utf::enable_if<false>())
+{
+}
+
+BOOST_AUTO_TEST_SUITE_END()
+BOOST_AUTO_TEST_SUITE_END()
+
+BOOST_DATA_TEST_CASE(data_driven, boost::unit_test::data::make({1, 2, 3}), value)
+{
+}
+`
+
+	file, err := (&BoostTestParser{}).Parse(context.Background(), []byte(source), "calculator_test.cpp")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if file.Framework != frameworkName {
+		t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+	}
+
+	var topLevel []string
+	for _, test := range file.Tests {
+		topLevel = append(topLevel, test.Name)
+	}
+	if !reflect.DeepEqual(topLevel, []string{"free_test", "data_driven"}) {
+		t.Errorf("top-level tests = %v", topLevel)
+	}
+	if file.Tests[0].Location.StartLine != 7 || file.Tests[0].Location.EndLine != 10 {
+		t.Errorf("expected free_test at lines 7-10, got %d-%d", file.Tests[0].Location.StartLine, file.Tests[0].Location.EndLine)
+	}
+
+	if len(file.Suites) != 1 {
+		t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+	}
+	arithmetic := file.Suites[0]
+	if arithmetic.Name != "arithmetic" || len(arithmetic.Tests) != 2 {
+		t.Fatalf("unexpected arithmetic suite: %+v", arithmetic)
+	}
+	if arithmetic.Tests[1].Status != domain.TestStatusSkipped || arithmetic.Tests[1].Modifier != "disabled" {
+		t.Errorf("expected division disabled, got %s %q", arithmetic.Tests[1].Status, arithmetic.Tests[1].Modifier)
+	}
+
+	if len(arithmetic.Suites) != 1 {
+		t.Fatalf("expected nested suite, got %d", len(arithmetic.Suites))
+	}
+	nested := arithmetic.Suites[0]
+	if nested.Name != "nested" || !reflect.DeepEqual(nested.Tags, []string{"slow"}) {
+		t.Errorf("unexpected nested suite: %q %v", nested.Name, nested.Tags)
+	}
+	if len(nested.Tests) != 2 || nested.Tests[0].Name != "with_fixture" {
+		t.Fatalf("unexpected nested tests: %+v", nested.Tests)
+	}
+	if nested.Tests[1].Status != domain.TestStatusSkipped {
+		t.Errorf("expected enable_if<false> test skipped, got %s", nested.Tests[1].Status)
+	}
+}
+
+func TestBoostTestMatchers(t *testing.T) {
+	ctx := context.Background()
+	def := NewDefinition()
+
+	headers := map[string]bool{
+		"boost/test/unit_test.hpp":          true,
+		"boost/test/included/unit_test.hpp": true,
+		"boost/asio.hpp":                    false,
+	}
+	for header, want := range headers {
+		result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: header})
+		if (result.Confidence > 0) != want {
+			t.Errorf("Match(%q) = %v, want %v", header, result.Confidence > 0, want)
+		}
+	}
+
+	contents := map[string]bool{
+		"BOOST_AUTO_TEST_CASE(a) {}":     true,
+		"BOOST_FIXTURE_TEST_SUITE(s, F)": true,
+		"#define BOOST_TEST_MODULE mod":  true,
+		"BOOST_CHECK(true);":             false,
+	}
+	matcher := &BoostTestContentMatcher{}
+	for content, want := range contents {
+		result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(content)})
+		if (result.Confidence > 0) != want {
+			t.Errorf("Match(%q) = %v, want %v", content, result.Confidence > 0, want)
+		}
+	}
+}
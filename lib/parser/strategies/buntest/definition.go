@@ -0,0 +1,113 @@
+package buntest
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+const frameworkName = framework.FrameworkBunTest
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageTypeScript, domain.LanguageJavaScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("bun:test"),
+			matchers.NewConfigMatcher("bunfig.toml"),
+			&BunContentMatcher{},
+		},
+		ConfigParser: &BunConfigParser{},
+		Parser:       &BunParser{},
+		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"expect", "assert", "assert.*"},
+	}
+}
+
+// BunContentMatcher detects Bun-only test APIs in file content.
+type BunContentMatcher struct{}
+
+var bunPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\b(?:describe|it|test)\.(?:if|todoIf)\s*\(`), "test.if()"},
+	{regexp.MustCompile(`\bmock\.module\s*\(`), "mock.module()"},
+	{regexp.MustCompile(`\bBun\.\w+`), "Bun global"},
+}
+
+func (m *BunContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range bunPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Bun-specific pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type BunConfigParser struct{}
+
+func (p *BunConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	// bun test injects describe/it/test/expect as globals, like Jest.
+	scope := framework.NewConfigScope(configPath, parseTestRoot(content))
+	scope.Framework = frameworkName
+	scope.GlobalsMode = true
+	return scope, nil
+}
+
+// bunfig.toml is TOML; only the root key of the [test] table affects scope.
+// Limitation: Inline tables (test = { root = "..." }) are not supported.
+var (
+	tomlTablePattern = regexp.MustCompile(`(?m)^\s*\[([^\]]+)\]\s*$`)
+	testRootPattern  = regexp.MustCompile(`(?m)^\s*root\s*=\s*["']([^"']+)["']`)
+)
+
+func parseTestRoot(content []byte) string {
+	section := testTable(content)
+	if section == nil {
+		return ""
+	}
+	if match := testRootPattern.FindSubmatch(section); match != nil {
+		return string(match[1])
+	}
+	return ""
+}
+
+func testTable(content []byte) []byte {
+	tables := tomlTablePattern.FindAllSubmatchIndex(content, -1)
+	for i, table := range tables {
+		if string(content[table[2]:table[3]]) != "test" {
+			continue
+		}
+		end := len(content)
+		if i+1 < len(tables) {
+			end = tables[i+1][0]
+		}
+		return content[table[1]:end]
+	}
+	return nil
+}
+
+type BunParser struct{}
+
+func (p *BunParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.Parse(ctx, source, filename, frameworkName)
+}
@@ -0,0 +1,167 @@
+package buntest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "bun-test" {
+		t.Errorf("expected Name to be 'bun-test', got %q", def.Name)
+	}
+
+	if def.Priority != framework.PrioritySpecialized {
+		t.Errorf("expected Priority to be %d, got %d", framework.PrioritySpecialized, def.Priority)
+	}
+
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
+	}
+
+	// ImportMatcher + ConfigMatcher + ContentMatcher
+	if len(def.Matchers) != 3 {
+		t.Errorf("expected 3 matchers, got %d", len(def.Matchers))
+	}
+}
+
+func TestBunContentMatcher_Match(t *testing.T) {
+	matcher := &BunContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		content            string
+		expectedConfidence int
+	}{
+		{"test.if", `test.if(process.platform === "linux")("uses epoll", () => {});`, 40},
+		{"test.todoIf", `test.todoIf(isMacOS)("works on mac", () => {});`, 40},
+		{"mock.module", `mock.module("./db", () => ({ query: mock() }));`, 40},
+		{"Bun global", `const file = Bun.file("fixture.json");`, 40},
+		{"vitest skipIf is not bun-specific", `test.skipIf(isCI)("local only", () => {});`, 0},
+		{"plain test", `test("adds", () => { expect(1).toBe(1); });`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+
+			result := matcher.Match(ctx, signal)
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("expected confidence %d, got %d", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestBunConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		expectedBaseDir string
+	}{
+		{
+			name: "no test table",
+			content: `[install]
+registry = "https://registry.npmjs.org"
+`,
+			expectedBaseDir: "/project",
+		},
+		{
+			name: "test root",
+			content: `[install]
+root = "ignored"
+
+[test]
+preload = ["./setup.ts"]
+root = "./src"
+`,
+			expectedBaseDir: "/project/src",
+		},
+		{
+			name: "root in a later table is ignored",
+			content: `[test]
+coverage = true
+
+[run]
+root = "scripts"
+`,
+			expectedBaseDir: "/project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := (&BunConfigParser{}).Parse(context.Background(), "/project/bunfig.toml", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if scope.Framework != "bun-test" {
+				t.Errorf("expected framework 'bun-test', got %q", scope.Framework)
+			}
+			if !scope.GlobalsMode {
+				t.Error("expected globals mode")
+			}
+			if scope.BaseDir != tt.expectedBaseDir {
+				t.Errorf("expected BaseDir %q, got %q", tt.expectedBaseDir, scope.BaseDir)
+			}
+		})
+	}
+}
+
+func TestBunParser_Parse(t *testing.T) {
+	source := `import { describe, expect, test } from "bun:test";
+
+describe("server", () => {
+  test("starts", () => {});
+  test.if(process.platform === "linux")("uses epoll", () => {});
+  test.skip("slow", () => {});
+  test.failing("known bug", () => {});
+  test.todo("graceful shutdown");
+});
+`
+
+	file, err := (&BunParser{}).Parse(context.Background(), []byte(source), "server.test.ts")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if file.Framework != "bun-test" {
+		t.Errorf("expected framework 'bun-test', got %q", file.Framework)
+	}
+	if len(file.Suites) != 1 {
+		t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+	}
+
+	expected := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+	}{
+		{"starts", domain.TestStatusActive, ""},
+		{"uses epoll", domain.TestStatusActive, "if"},
+		{"slow", domain.TestStatusSkipped, "skip"},
+		{"known bug", domain.TestStatusXfail, "failing"},
+		{"graceful shutdown", domain.TestStatusTodo, "todo"},
+	}
+
+	got := file.Suites[0].Tests
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d tests, got %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i].Name != want.name || got[i].Status != want.status || got[i].Modifier != want.modifier {
+			t.Errorf("test %d: expected (%q, %s, %q), got (%q, %s, %q)",
+				i, want.name, want.status, want.modifier, got[i].Name, got[i].Status, got[i].Modifier)
+		}
+	}
+}
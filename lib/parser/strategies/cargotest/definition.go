@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -41,6 +42,7 @@ func NewDefinition() *framework.Definition {
 		Name:      frameworkName,
 		Languages: []domain.Language{domain.LanguageRust},
 		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("proptest", "rstest", "test_case"),
 			&CargoTestFileMatcher{},
 			matchers.NewConfigMatcher("Cargo.toml"),
 			&CargoTestContentMatcher{},
@@ -48,6 +50,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &CargoTestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "debug_assert*"},
 	}
 }
 
@@ -85,6 +88,10 @@ var cargoTestPatterns = []struct {
 	{regexp.MustCompile(`#\[cfg\(test\)\]`), "#[cfg(test)] attribute"},
 	{regexp.MustCompile(`#\[ignore\]`), "#[ignore] attribute"},
 	{regexp.MustCompile(`#\[should_panic`), "#[should_panic] attribute"},
+	{regexp.MustCompile(`#\[(?:rstest::)?rstest\b`), "#[rstest] attribute"},
+	{regexp.MustCompile(`#\[(?:test_case::)?test_case\s*\(`), "#[test_case] attribute"},
+	{regexp.MustCompile(`#\[(?:tokio|async_std)::test\b`), "async runtime test attribute"},
+	{regexp.MustCompile(`proptest!\s*\{`), "proptest! block"},
 	{regexp.MustCompile(`\w*test\w*!\s*\(`), "macro-based test pattern"},
 }
 
@@ -125,14 +132,15 @@ func (p *CargoTestParser) Parse(ctx context.Context, source []byte, filename str
 	}
 
 	// Use WalkTree for depth-protected traversal (prevents stack overflow)
-	parseRustAST(root, source, filename, file)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	parseRustAST(root, source, filename, file, expand)
 	return file, nil
 }
 
 // parseRustAST traverses the AST using depth-protected WalkTree.
 // It handles test modules and test functions at the top level and within #[cfg(test)] modules.
 // Uses 2-pass approach: first collects test-generating macro definitions, then processes tests.
-func parseRustAST(root *sitter.Node, source []byte, filename string, file *domain.TestFile) {
+func parseRustAST(root *sitter.Node, source []byte, filename string, file *domain.TestFile, expand bool) {
 	// Track test modules by node start byte position to associate tests with their parent suite
 	testModules := make(map[uint32]*domain.TestSuite)
 
@@ -168,14 +176,17 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 				return false
 			}
 
-			test := buildTest(name, attrs, node, filename)
+			tests := []domain.Test{buildTest(name, attrs, node, filename)}
+			if expand {
+				tests = expandCases(tests[0], attrs, source)
+			}
 
 			// Find parent test module, if any
 			parentSuite := findParentTestSuite(node, testModules)
 			if parentSuite != nil {
-				parentSuite.Tests = append(parentSuite.Tests, test)
+				parentSuite.Tests = append(parentSuite.Tests, tests...)
 			} else {
-				file.Tests = append(file.Tests, test)
+				file.Tests = append(file.Tests, tests...)
 			}
 			return false // No need to traverse into function body
 
@@ -189,19 +200,24 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 				return true // Not a test macro, continue
 			}
 
-			test := domain.Test{
-				Name:     testName,
-				Status:   domain.TestStatusActive,
-				Modifier: macroName + "!",
-				Location: parser.GetLocation(node, filename),
+			var tests []domain.Test
+			if macroName == proptestMacro {
+				tests = extractProptestTests(node, source, filename)
+			} else {
+				tests = []domain.Test{{
+					Name:     testName,
+					Status:   domain.TestStatusActive,
+					Modifier: macroName + "!",
+					Location: parser.GetLocation(node, filename),
+				}}
 			}
 
 			// Find parent test module, if any
 			parentSuite := findParentTestSuite(node, testModules)
 			if parentSuite != nil {
-				parentSuite.Tests = append(parentSuite.Tests, test)
+				parentSuite.Tests = append(parentSuite.Tests, tests...)
 			} else {
-				file.Tests = append(file.Tests, test)
+				file.Tests = append(file.Tests, tests...)
 			}
 			return false // No need to traverse into macro body
 		}
@@ -356,17 +372,23 @@ func buildTest(name string, attrs testAttributes, node *sitter.Node, filename st
 	}
 
 	return domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: attrs.ignoreReason,
+		Location:   parser.GetLocation(node, filename),
 	}
 }
 
 type testAttributes struct {
-	isTest      bool
-	isIgnore    bool
-	shouldPanic string // Full attribute text (e.g., "#[should_panic(expected = \"...\")]")
+	isTest       bool
+	isIgnore     bool
+	ignoreReason string // From #[ignore = "reason"]
+	shouldPanic  string // Full attribute text (e.g., "#[should_panic(expected = \"...\")]")
+	// rstestCases holds the #[case] attributes of an #[rstest] function, in source order.
+	rstestCases []*sitter.Node
+	// testCases holds the #[test_case(...)] attributes, in source order.
+	testCases []*sitter.Node
 }
 
 // getPrecedingAttributes returns attribute_item nodes immediately preceding the given node.
@@ -403,13 +425,27 @@ func getPrecedingAttributes(node *sitter.Node) []*sitter.Node {
 func collectAttributes(funcNode *sitter.Node, source []byte) testAttributes {
 	attrs := testAttributes{}
 
-	for _, attrNode := range getPrecedingAttributes(funcNode) {
-		attrName := extractAttributeName(attrNode, source)
-		switch attrName {
-		case "test":
+	// getPrecedingAttributes walks backwards; collect cases in source order.
+	preceding := getPrecedingAttributes(funcNode)
+	for i := len(preceding) - 1; i >= 0; i-- {
+		attrNode := preceding[i]
+		path := extractAttributePath(attrNode, source)
+		switch {
+		case isTestAttributePath(path):
+			attrs.isTest = true
+		case path == "rstest" || path == "rstest::rstest":
 			attrs.isTest = true
+		case path == "case" || strings.HasPrefix(path, "case::"):
+			attrs.rstestCases = append(attrs.rstestCases, attrNode)
+		case path == "test_case" || path == "test_case::test_case":
+			attrs.isTest = true
+			attrs.testCases = append(attrs.testCases, attrNode)
+		}
+
+		switch path {
 		case "ignore":
 			attrs.isIgnore = true
+			attrs.ignoreReason = extractAttributeValue(attrNode, source)
 		case "should_panic":
 			attrs.shouldPanic = parser.GetNodeText(attrNode, source)
 		}
@@ -418,6 +454,29 @@ func collectAttributes(funcNode *sitter.Node, source []byte) testAttributes {
 	return attrs
 }
 
+// isTestAttributePath reports whether an attribute path marks a test function:
+// the built-in #[test] and runtime wrappers such as #[tokio::test] and #[async_std::test].
+func isTestAttributePath(path string) bool {
+	return path == "test" || strings.HasSuffix(path, "::test")
+}
+
+// extractAttributePath returns the attribute path including its scope
+// (e.g., "test", "tokio::test", "case::positive").
+func extractAttributePath(attrItem *sitter.Node, source []byte) string {
+	attr := parser.FindChildByType(attrItem, nodeAttribute)
+	if attr == nil {
+		return ""
+	}
+
+	if ident := parser.FindChildByType(attr, nodeIdentifier); ident != nil {
+		return parser.GetNodeText(ident, source)
+	}
+	if scoped := parser.FindChildByType(attr, nodeScopedIdentifier); scoped != nil {
+		return parser.GetNodeText(scoped, source)
+	}
+	return ""
+}
+
 func extractAttributeName(attrItem *sitter.Node, source []byte) string {
 	attr := parser.FindChildByType(attrItem, nodeAttribute)
 	if attr == nil {
@@ -441,6 +500,25 @@ func extractAttributeName(attrItem *sitter.Node, source []byte) string {
 	return ""
 }
 
+// extractAttributeValue returns the string value of a name-value attribute like #[ignore = "reason"].
+func extractAttributeValue(attrItem *sitter.Node, source []byte) string {
+	attr := parser.FindChildByType(attrItem, nodeAttribute)
+	if attr == nil {
+		return ""
+	}
+
+	value := attr.ChildByFieldName("value")
+	if value == nil || value.Type() != "string_literal" {
+		return ""
+	}
+
+	text := parser.GetNodeText(value, source)
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		return unquoted
+	}
+	return strings.Trim(text, `"`)
+}
+
 func extractFunctionName(funcNode *sitter.Node, source []byte) string {
 	name := funcNode.ChildByFieldName("name")
 	if name == nil {
@@ -112,6 +112,29 @@ fn test_panic_message() {
 				}
 			},
 		},
+		{
+			name: "test with ignore reason",
+			source: `
+#[test]
+#[ignore = "requires network"]
+fn test_fetch() {
+    assert!(true);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Errorf("expected 1 test, got %d", len(file.Tests))
+					return
+				}
+				test := file.Tests[0]
+				if test.Status != domain.TestStatusSkipped {
+					t.Errorf("expected status skipped, got %q", test.Status)
+				}
+				if test.SkipReason != "requires network" {
+					t.Errorf("expected skip reason 'requires network', got %q", test.SkipReason)
+				}
+			},
+		},
 		{
 			name: "test with ignore and should_panic combined",
 			source: `
@@ -578,6 +601,132 @@ rgtest!(test_rg, |dir, cmd| { assert!(true); });
 				}
 			},
 		},
+		{
+			name: "async runtime test attributes",
+			source: `
+#[tokio::test]
+async fn test_tokio() {}
+
+#[tokio::test(flavor = "multi_thread", worker_threads = 2)]
+async fn test_tokio_multi() {}
+
+#[async_std::test]
+async fn test_async_std() {}
+
+#[tokio::main]
+async fn main() {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				want := []string{"test_tokio", "test_tokio_multi", "test_async_std"}
+				for i, name := range want {
+					if file.Tests[i].Name != name {
+						t.Errorf("test[%d] = %q, want %q", i, file.Tests[i].Name, name)
+					}
+				}
+			},
+		},
+		{
+			name: "rstest function with cases kept as template",
+			source: `
+use rstest::rstest;
+
+#[rstest]
+#[case(0, 0)]
+#[case(1, 1)]
+fn fibonacci_test(#[case] input: u32, #[case] expected: u32) {}
+
+#[rstest]
+#[ignore]
+fn fixture_test(repository: Repository) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "fibonacci_test" || file.Tests[0].Template != nil {
+					t.Errorf("unexpected template test: %+v", file.Tests[0])
+				}
+				if file.Tests[1].Status != domain.TestStatusSkipped {
+					t.Errorf("expected fixture_test skipped, got %q", file.Tests[1].Status)
+				}
+			},
+		},
+		{
+			name: "test_case function kept as template",
+			source: `
+use test_case::test_case;
+
+#[test_case(-2, -4 ; "when both operands are negative")]
+#[test_case(2,  4  ; "when both operands are positive")]
+fn multiplication_tests(x: i8, y: i8) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "multiplication_tests" {
+					t.Errorf("expected single multiplication_tests, got %+v", file.Tests)
+				}
+			},
+		},
+		{
+			name: "proptest block",
+			source: `
+use proptest::prelude::*;
+
+proptest! {
+    #![proptest_config(ProptestConfig::with_cases(10))]
+
+    #[test]
+    fn doesnt_crash(s in "\\PC*") {
+        parse_date(&s);
+    }
+
+    #[test]
+    #[ignore = "slow"]
+    fn parses_all_valid_dates(y in 0u32..10000, m in 1u32..13) {
+        parse_date(&format!("{:04}-{:02}", y, m)).unwrap();
+    }
+
+    fn helper(x in 0..10) {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d: %+v", len(file.Tests), file.Tests)
+				}
+				first, second := file.Tests[0], file.Tests[1]
+				if first.Name != "doesnt_crash" || first.Modifier != "proptest!" || first.Status != domain.TestStatusActive {
+					t.Errorf("unexpected first test: %+v", first)
+				}
+				if first.Location.StartLine != 8 {
+					t.Errorf("expected doesnt_crash at line 8, got %d", first.Location.StartLine)
+				}
+				if second.Name != "parses_all_valid_dates" || second.Status != domain.TestStatusSkipped || second.SkipReason != "slow" {
+					t.Errorf("unexpected second test: %+v", second)
+				}
+			},
+		},
+		{
+			name: "proptest block inside test module",
+			source: `
+#[cfg(test)]
+mod tests {
+    proptest! {
+        #[test]
+        fn roundtrip(x in any::<u64>()) {}
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Tests) != 1 {
+					t.Fatalf("expected 1 suite with 1 test, got %+v", file.Suites)
+				}
+				if file.Suites[0].Tests[0].Name != "roundtrip" {
+					t.Errorf("expected roundtrip, got %q", file.Suites[0].Tests[0].Name)
+				}
+			},
+		},
 	}
 
 	parser := &CargoTestParser{}
@@ -651,6 +800,12 @@ func TestCargoTestContentMatcher_Match(t *testing.T) {
 		{"macro-based test rgtest", "rgtest!(my_test, |dir| {});", true},
 		{"macro-based test quicktest", "quicktest!(test_case, || {});", true},
 		{"non-test macro", "println!(\"test\");", false},
+		{"rstest attribute", "#[rstest]\nfn fixture_test() {}", true},
+		{"test_case attribute", "#[test_case(1 ; \"one\")]\nfn cases(x: i32) {}", true},
+		{"tokio test attribute", "#[tokio::test]\nasync fn run() {}", true},
+		{"async_std test attribute", "#[async_std::test]\nasync fn run() {}", true},
+		{"proptest block", "proptest! {\n    fn helper() {}\n}", true},
+		{"tokio main attribute", "#[tokio::main]\nasync fn main() {}", false},
 	}
 
 	matcher := &CargoTestContentMatcher{}
@@ -670,3 +825,68 @@ func TestCargoTestContentMatcher_Match(t *testing.T) {
 		})
 	}
 }
+
+func TestCargoTestParser_ExpandParameterized(t *testing.T) {
+	source := `
+#[rstest]
+#[case(0, 0)]
+#[case::one(1, 1)]
+#[case(2, 1)]
+fn fibonacci_test(#[case] input: u32, #[case] expected: u32) {}
+
+#[rstest]
+#[case(1)] #[case(2)] #[case(3)] #[case(4)] #[case(5)]
+#[case(6)] #[case(7)] #[case(8)] #[case(9)] #[case(10)]
+fn padded(#[case] n: u32) {}
+
+#[test_case(-2, -4 ; "when both operands are negative")]
+#[test_case(2, 4 => 8)]
+#[ignore]
+fn multiplication_tests(x: i8, y: i8) -> i8 { x * y }
+
+#[rstest]
+fn no_cases() {}
+`
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	file, err := (&CargoTestParser{}).Parse(ctx, []byte(source), "test.rs")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var names []string
+	for _, test := range file.Tests {
+		names = append(names, test.Name)
+	}
+	want := []string{
+		"fibonacci_test::case_1",
+		"fibonacci_test::case_2_one",
+		"fibonacci_test::case_3",
+		"padded::case_01", "padded::case_02", "padded::case_03", "padded::case_04", "padded::case_05",
+		"padded::case_06", "padded::case_07", "padded::case_08", "padded::case_09", "padded::case_10",
+		"multiplication_tests::when_both_operands_are_negative",
+		"multiplication_tests::_2_4_expects_8",
+		"no_cases",
+	}
+	if len(names) != len(want) {
+		t.Fatalf("tests = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("test[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	second := file.Tests[1]
+	if second.Template == nil || second.Template.Name != "fibonacci_test" || second.Template.Index != 1 {
+		t.Errorf("expected template link to fibonacci_test#1, got %+v", second.Template)
+	}
+	for _, test := range file.Tests[13:15] {
+		if test.Status != domain.TestStatusSkipped {
+			t.Errorf("expected %s to inherit #[ignore], got %q", test.Name, test.Status)
+		}
+	}
+	if file.Tests[15].Template != nil {
+		t.Errorf("expected no_cases to stay unexpanded, got %+v", file.Tests[15].Template)
+	}
+}
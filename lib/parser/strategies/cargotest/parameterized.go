@@ -0,0 +1,119 @@
+package cargotest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+// expandCases returns one test per #[case] of an #[rstest] function or per
+// #[test_case(...)] attribute, named the way cargo test reports them
+// (fib::case_1, add::when_both_negative). Returns the template test unchanged
+// when the function declares no cases.
+func expandCases(test domain.Test, attrs testAttributes, source []byte) []domain.Test {
+	var names []string
+	switch {
+	case len(attrs.rstestCases) > 0:
+		names = rstestCaseNames(test.Name, attrs.rstestCases, source)
+	case len(attrs.testCases) > 0:
+		names = testCaseNames(test.Name, attrs.testCases, source)
+	}
+
+	if tests := parameterized.Expand(test, names); tests != nil {
+		return tests
+	}
+	return []domain.Test{test}
+}
+
+// rstestCaseNames names cases case_1, case_2, ... with the index zero-padded to the
+// width of the case count, followed by the description of #[case::description(...)].
+func rstestCaseNames(fnName string, cases []*sitter.Node, source []byte) []string {
+	width := len(strconv.Itoa(len(cases)))
+	names := make([]string, len(cases))
+	for i, attr := range cases {
+		name := fmt.Sprintf("case_%0*d", width, i+1)
+		if _, desc, ok := strings.Cut(extractAttributePath(attr, source), "::"); ok {
+			name += "_" + desc
+		}
+		names[i] = fnName + "::" + name
+	}
+	return names
+}
+
+// testCaseNames names cases after their description (the string after ';'),
+// or after their arguments when no description is given.
+func testCaseNames(fnName string, cases []*sitter.Node, source []byte) []string {
+	names := make([]string, len(cases))
+	for i, attr := range cases {
+		names[i] = fnName + "::" + escapeTestCaseName(testCaseLabel(attr, source))
+	}
+	return names
+}
+
+// testCaseLabel returns the description of a #[test_case(args ; "description")]
+// attribute, falling back to the argument text with "=>" spelled as "expects".
+func testCaseLabel(attrItem *sitter.Node, source []byte) string {
+	attr := parser.FindChildByType(attrItem, nodeAttribute)
+	if attr == nil {
+		return ""
+	}
+	args := attr.ChildByFieldName("arguments")
+	if args == nil {
+		return ""
+	}
+
+	var parts []string
+	for i := 0; i < int(args.ChildCount()); i++ {
+		child := args.Child(i)
+		text := parser.GetNodeText(child, source)
+		switch {
+		case i == 0 || i == int(args.ChildCount())-1:
+			continue // parentheses
+		case text == ";":
+			if i+1 < int(args.ChildCount()) && args.Child(i+1).Type() == "string_literal" {
+				desc := parser.GetNodeText(args.Child(i+1), source)
+				if unquoted, err := strconv.Unquote(desc); err == nil {
+					return unquoted
+				}
+				return strings.Trim(desc, `"`)
+			}
+		case text == "=>":
+			parts = append(parts, "expects")
+		default:
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// escapeTestCaseName mirrors test_case's identifier generation: lowercase
+// alphanumerics, runs of other characters collapsed to '_', and a leading '_'
+// when the name would not start with a letter.
+func escapeTestCaseName(label string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range label {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+			lastUnderscore = false
+			continue
+		}
+		if !lastUnderscore {
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+
+	name := b.String()
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z')) {
+		name = "_" + name
+	}
+	return name
+}
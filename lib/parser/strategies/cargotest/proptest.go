@@ -0,0 +1,97 @@
+package cargotest
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+const proptestMacro = "proptest"
+
+// extractProptestTests returns one test per #[test] function declared inside a
+// proptest! { ... } block. Functions without #[test] are helpers and are ignored.
+func extractProptestTests(node *sitter.Node, source []byte, filename string) []domain.Test {
+	body := parser.FindChildByType(node, nodeTokenTree)
+	if body == nil {
+		return nil
+	}
+
+	var tests []domain.Test
+	var attrs []string
+	var ignoreReason string
+
+	for i := 0; i < int(body.ChildCount()); i++ {
+		child := body.Child(i)
+		text := parser.GetNodeText(child, source)
+
+		switch {
+		case text == "#":
+			// Outer attribute: # followed by [name ...]. Inner #![...] attributes are skipped.
+			if i+1 < int(body.ChildCount()) && body.Child(i+1).Type() == nodeTokenTree {
+				attr := body.Child(i + 1)
+				if name := parser.FindChildByType(attr, nodeIdentifier); name != nil {
+					attrs = append(attrs, parser.GetNodeText(name, source))
+					if parser.GetNodeText(name, source) == "ignore" {
+						ignoreReason = proptestAttributeValue(attr, source)
+					}
+				}
+				i++
+			}
+
+		case text == "fn":
+			if i+1 >= int(body.ChildCount()) || body.Child(i+1).Type() != nodeIdentifier {
+				attrs, ignoreReason = nil, ""
+				continue
+			}
+			nameNode := body.Child(i + 1)
+			if test, ok := buildProptestTest(nameNode, attrs, ignoreReason, source, filename); ok {
+				tests = append(tests, test)
+			}
+			attrs, ignoreReason = nil, ""
+			i++
+		}
+	}
+
+	return tests
+}
+
+func buildProptestTest(nameNode *sitter.Node, attrs []string, ignoreReason string, source []byte, filename string) (domain.Test, bool) {
+	isTest := false
+	isIgnore := false
+	for _, attr := range attrs {
+		switch attr {
+		case "test":
+			isTest = true
+		case "ignore":
+			isIgnore = true
+		}
+	}
+	if !isTest {
+		return domain.Test{}, false
+	}
+
+	test := domain.Test{
+		Name:     parser.GetNodeText(nameNode, source),
+		Status:   domain.TestStatusActive,
+		Modifier: proptestMacro + "!",
+		Location: parser.GetLocation(nameNode, filename),
+	}
+	if isIgnore {
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = "#[ignore]"
+		test.SkipReason = ignoreReason
+	}
+	return test, true
+}
+
+// proptestAttributeValue returns the reason of an [ignore = "reason"] token tree.
+func proptestAttributeValue(attr *sitter.Node, source []byte) string {
+	if lit := parser.FindChildByType(attr, "string_literal"); lit != nil {
+		content := parser.FindChildByType(lit, "string_content")
+		if content != nil {
+			return parser.GetNodeText(content, source)
+		}
+	}
+	return ""
+}
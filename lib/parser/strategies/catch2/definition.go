@@ -0,0 +1,221 @@
+// Package catch2 implements Catch2 framework support for C++ test files.
+package catch2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkCatch2
+
+const (
+	confidenceContent = 40
+
+	// macroPrefix is the prefix of Catch2's CATCH_CONFIG_PREFIX_ALL macro names.
+	macroPrefix            = "CATCH_"
+	dynamicNamePlaceholder = "(dynamic)"
+)
+
+// testCaseMacros maps Catch2 test case macros to the argument index of their name.
+// The tag string, if any, follows the name.
+var testCaseMacros = map[string]int{
+	"TEST_CASE":                             0,
+	"TEST_CASE_METHOD":                      1,
+	"SCENARIO":                              0,
+	"SCENARIO_METHOD":                       1,
+	"TEMPLATE_TEST_CASE":                    0,
+	"TEMPLATE_TEST_CASE_SIG":                0,
+	"TEMPLATE_TEST_CASE_METHOD":             1,
+	"TEMPLATE_PRODUCT_TEST_CASE":            0,
+	"TEMPLATE_LIST_TEST_CASE":               0,
+	"TEMPLATE_LIST_TEST_CASE_METHOD":        1,
+	"TEMPLATE_PRODUCT_TEST_CASE_METHOD":     1,
+	"TEMPLATE_TEST_CASE_METHOD_SIG":         1,
+	"TEMPLATE_PRODUCT_TEST_CASE_SIG":        0,
+	"TEMPLATE_PRODUCT_TEST_CASE_METHOD_SIG": 1,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			&cppast.HeaderMatcher{
+				Dirs:  []string{"catch2/"},
+				Files: []string{"catch.hpp", "catch_amalgamated.hpp"},
+			},
+			&Catch2ContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &Catch2Parser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"CHECK*", "REQUIRE*", "FAIL", "FAIL_CHECK"},
+	}
+}
+
+// Catch2ContentMatcher matches Catch2-specific macros.
+// A bare TEST_CASE is shared with doctest, so only Catch2-only forms are matched.
+type Catch2ContentMatcher struct{}
+
+var catch2Patterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\bSECTION\s*\(`), "SECTION() macro"},
+	{regexp.MustCompile(`\bTEST_CASE_METHOD\s*\(`), "TEST_CASE_METHOD() macro"},
+	{regexp.MustCompile(`\bCATCH_TEST_CASE\s*\(`), "CATCH_TEST_CASE() macro"},
+	{regexp.MustCompile(`\bTEST_CASE\s*\(\s*"[^"]*"\s*,\s*"\[`), "TEST_CASE() with tags"},
+	{regexp.MustCompile(`\bSCENARIO\s*\(\s*"[^"]*"\s*,\s*"\[`), "SCENARIO() with tags"},
+}
+
+func (m *Catch2ContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range catch2Patterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(confidenceContent, "Found Catch2 pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// Catch2Parser extracts test cases from Catch2 files.
+// A test case with SECTIONs (or GIVEN/WHEN/THEN) becomes a suite whose leaf sections are tests.
+type Catch2Parser struct{}
+
+func (p *Catch2Parser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("catch2 parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	anonymous := 0
+	for _, call := range cppast.MacroCalls(tree.RootNode(), source) {
+		macro := strings.TrimPrefix(call.Name, macroPrefix)
+		nameIdx, ok := testCaseMacros[macro]
+		if !ok || call.Body == nil {
+			continue
+		}
+
+		name, _ := call.StringArg(nameIdx, source)
+		if name == "" {
+			anonymous++
+			name = "Anonymous test case " + strconv.Itoa(anonymous)
+		}
+		if strings.HasPrefix(macro, "SCENARIO") {
+			name = "Scenario: " + name
+		}
+
+		tagString, _ := call.StringArg(nameIdx+1, source)
+		tags := parseTags(tagString)
+
+		tests, suites := cppast.BuildSections(call.Body, source, filename, sectionName, tags.status, tags.modifier)
+		if len(tests) == 0 && len(suites) == 0 {
+			file.Tests = append(file.Tests, domain.Test{
+				Location: call.Location(filename),
+				Modifier: tags.modifier,
+				Name:     name,
+				Status:   tags.status,
+				Tags:     tags.tags,
+			})
+			continue
+		}
+
+		file.Suites = append(file.Suites, domain.TestSuite{
+			Location: call.Location(filename),
+			Modifier: tags.modifier,
+			Name:     name,
+			Status:   tags.status,
+			Suites:   suites,
+			Tags:     tags.tags,
+			Tests:    tests,
+		})
+	}
+
+	return file, nil
+}
+
+// sectionName resolves SECTION, DYNAMIC_SECTION and the BDD section macros.
+func sectionName(call cppast.MacroCall, source []byte) (string, bool) {
+	macro := strings.TrimPrefix(call.Name, macroPrefix)
+	switch macro {
+	case "SECTION":
+		name, _ := call.StringArg(0, source)
+		return name, true
+	case "DYNAMIC_SECTION":
+		return dynamicNamePlaceholder, true
+	}
+
+	if prefix, ok := cppast.BDDSectionPrefixes[macro]; ok {
+		name, _ := call.StringArg(0, source)
+		return prefix + name, true
+	}
+	return "", false
+}
+
+var tagPattern = regexp.MustCompile(`\[([^\]]*)\]`)
+
+// caseTags is the outcome of parsing a Catch2 tag string.
+type caseTags struct {
+	modifier string
+	status   domain.TestStatus
+	tags     []string
+}
+
+// parseTags splits a tag string like "[vector][.][!mayfail]" into plain tags and status.
+// Hidden tests ("[.]", "[.slow]", "[!hide]") are not run by default and are reported as skipped;
+// "[!shouldfail]" and "[!mayfail]" as xfail. Other special tags ("[!throws]", "[@alias]") are dropped.
+func parseTags(tagString string) caseTags {
+	result := caseTags{status: domain.TestStatusActive}
+
+	for _, match := range tagPattern.FindAllStringSubmatch(tagString, -1) {
+		tag := match[1]
+		switch {
+		case tag == "." || tag == "!hide":
+			result.status = domain.TestStatusSkipped
+			result.modifier = match[0]
+		case strings.HasPrefix(tag, "."):
+			result.status = domain.TestStatusSkipped
+			result.modifier = "[.]"
+			result.tags = domain.MergeTags(result.tags, []string{tag[1:]})
+		case tag == "!shouldfail" || tag == "!mayfail":
+			if result.status != domain.TestStatusSkipped {
+				result.status = domain.TestStatusXfail
+				result.modifier = match[0]
+			}
+		case tag == "" || strings.HasPrefix(tag, "!") || strings.HasPrefix(tag, "@"):
+			continue
+		default:
+			result.tags = domain.MergeTags(result.tags, []string{tag})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,245 @@
+package catch2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestCatch2Parser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "test case without sections",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+TEST_CASE("Factorials are computed", "[factorial][math]") {
+    REQUIRE(Factorial(1) == 1);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 test, got %d", len(file.Tests))
+				}
+				test := file.Tests[0]
+				if test.Name != "Factorials are computed" {
+					t.Errorf("expected name 'Factorials are computed', got %q", test.Name)
+				}
+				if !reflect.DeepEqual(test.Tags, []string{"factorial", "math"}) {
+					t.Errorf("expected tags [factorial math], got %v", test.Tags)
+				}
+				if test.Location.StartLine != 4 || test.Location.EndLine != 6 {
+					t.Errorf("expected lines 4-6, got %d-%d", test.Location.StartLine, test.Location.EndLine)
+				}
+			},
+		},
+		{
+			name: "test case with nested sections",
+			source: `
+TEST_CASE("vectors can be sized and resized", "[vector]") {
+    std::vector<int> v(5);
+
+    SECTION("resizing bigger changes size and capacity") {
+        v.resize(10);
+        SECTION("and shrinking back") {
+            v.resize(5);
+        }
+        SECTION("and clearing") {
+            v.clear();
+        }
+    }
+    SECTION("reserving bigger changes capacity but not size") {
+        v.reserve(10);
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 0 || len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite and no tests, got %d suites, %d tests", len(file.Suites), len(file.Tests))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "vectors can be sized and resized" || !reflect.DeepEqual(suite.Tags, []string{"vector"}) {
+					t.Errorf("unexpected suite: %q %v", suite.Name, suite.Tags)
+				}
+				if len(suite.Tests) != 1 || suite.Tests[0].Name != "reserving bigger changes capacity but not size" {
+					t.Errorf("expected leaf section test, got %+v", suite.Tests)
+				}
+				if len(suite.Suites) != 1 || len(suite.Suites[0].Tests) != 2 {
+					t.Fatalf("expected nested section with 2 leaves, got %+v", suite.Suites)
+				}
+				if suite.CountTests() != 3 {
+					t.Errorf("expected 3 leaf sections, got %d", suite.CountTests())
+				}
+			},
+		},
+		{
+			name: "BDD scenario",
+			source: `
+SCENARIO("vectors can be sized and resized", "[vector]") {
+    GIVEN("A vector with some items") {
+        std::vector<int> v(5);
+        WHEN("the size is increased") {
+            v.resize(10);
+            THEN("the size changes") {
+                REQUIRE(v.size() == 10);
+            }
+            AND_THEN("the capacity grows") {
+                REQUIRE(v.capacity() >= 10);
+            }
+        }
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				scenario := file.Suites[0]
+				if scenario.Name != "Scenario: vectors can be sized and resized" {
+					t.Errorf("unexpected scenario name %q", scenario.Name)
+				}
+				given := scenario.Suites[0]
+				when := given.Suites[0]
+				if given.Name != "Given: A vector with some items" || when.Name != "When: the size is increased" {
+					t.Errorf("unexpected section names %q / %q", given.Name, when.Name)
+				}
+				if len(when.Tests) != 2 || when.Tests[0].Name != "Then: the size changes" || when.Tests[1].Name != "And: the capacity grows" {
+					t.Errorf("unexpected THEN sections: %+v", when.Tests)
+				}
+			},
+		},
+		{
+			name: "hidden and expected-to-fail tests",
+			source: `
+TEST_CASE("slow integration", "[.][integration]") {}
+TEST_CASE("hidden with tag", "[.slow]") {}
+TEST_CASE("known bug", "[!shouldfail]") {}
+TEST_CASE("flaky", "[!mayfail][!throws]") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 4 {
+					t.Fatalf("expected 4 tests, got %d", len(file.Tests))
+				}
+				want := []struct {
+					status   domain.TestStatus
+					modifier string
+					tags     []string
+				}{
+					{domain.TestStatusSkipped, "[.]", []string{"integration"}},
+					{domain.TestStatusSkipped, "[.]", []string{"slow"}},
+					{domain.TestStatusXfail, "[!shouldfail]", nil},
+					{domain.TestStatusXfail, "[!mayfail]", nil},
+				}
+				for i, w := range want {
+					got := file.Tests[i]
+					if got.Status != w.status || got.Modifier != w.modifier || !reflect.DeepEqual(got.Tags, w.tags) {
+						t.Errorf("test[%d] %q = %s %q %v, want %s %q %v", i, got.Name, got.Status, got.Modifier, got.Tags, w.status, w.modifier, w.tags)
+					}
+				}
+			},
+		},
+		{
+			name: "hidden test case passes status to sections",
+			source: `
+TEST_CASE("hidden", "[.]") {
+    SECTION("leaf") {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Tests) != 1 {
+					t.Fatalf("expected 1 suite with 1 section, got %+v", file.Suites)
+				}
+				if file.Suites[0].Tests[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected section skipped, got %q", file.Suites[0].Tests[0].Status)
+				}
+			},
+		},
+		{
+			name: "fixture, template, prefixed and anonymous test cases",
+			source: `
+namespace tests {
+TEST_CASE_METHOD(DatabaseFixture, "inserts rows", "[db]") {}
+TEMPLATE_TEST_CASE("containers grow", "[template]", std::vector<int>, std::deque<int>) {}
+CATCH_TEST_CASE("prefixed") {}
+TEST_CASE() {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				var names []string
+				for _, test := range file.Tests {
+					names = append(names, test.Name)
+				}
+				want := []string{"inserts rows", "containers grow", "prefixed", "Anonymous test case 1"}
+				if !reflect.DeepEqual(names, want) {
+					t.Errorf("tests = %v, want %v", names, want)
+				}
+			},
+		},
+	}
+
+	parser := &Catch2Parser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test.cpp")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestCatch2Matchers(t *testing.T) {
+	ctx := context.Background()
+	def := NewDefinition()
+
+	t.Run("should match Catch2 headers", func(t *testing.T) {
+		headers := map[string]bool{
+			"catch2/catch_test_macros.hpp": true,
+			"catch2/catch_all.hpp":         true,
+			"catch.hpp":                    true,
+			"third_party/catch.hpp":        true,
+			"gtest/gtest.h":                false,
+			"doctest.h":                    false,
+		}
+		for header, want := range headers {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: header})
+			if (result.Confidence > 0) != want {
+				t.Errorf("Match(%q) = %v, want %v", header, result.Confidence > 0, want)
+			}
+		}
+	})
+
+	t.Run("should match Catch2-only content", func(t *testing.T) {
+		contents := map[string]bool{
+			`TEST_CASE("a", "[tag]") {}`:         true,
+			`SECTION("a") {}`:                    true,
+			`TEST_CASE_METHOD(F, "a") {}`:        true,
+			`TEST_CASE("a") { SUBCASE("b") {} }`: false,
+			`TEST(Suite, Name) {}`:               false,
+		}
+		matcher := &Catch2ContentMatcher{}
+		for content, want := range contents {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(content)})
+			if (result.Confidence > 0) != want {
+				t.Errorf("Match(%q) = %v, want %v", content, result.Confidence > 0, want)
+			}
+		}
+	})
+}
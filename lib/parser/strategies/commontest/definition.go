@@ -0,0 +1,277 @@
+// Package commontest implements Erlang Common Test support for *_SUITE.erl modules.
+//
+// There is no tree-sitter grammar for Erlang in the bindings, so the parser
+// reads the literal terms returned by all/0 and groups/0 with a small term
+// reader. Suites that compute all/0 at runtime are reported without tests.
+package commontest
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+const frameworkName = framework.FrameworkCommonTest
+
+const suiteSuffix = "_SUITE.erl"
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Common Test definition. Common Test only runs
+// modules named *_SUITE, so the filename alone confirms the framework.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageErlang},
+		Matchers: []framework.Matcher{
+			&CommonTestFilenameMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &CommonTestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"?assert*"},
+	}
+}
+
+// CommonTestFilenameMatcher matches *_SUITE.erl files.
+type CommonTestFilenameMatcher struct{}
+
+func (m *CommonTestFilenameMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.HasSuffix(filepath.Base(signal.Value), suiteSuffix) {
+		return framework.DefiniteMatch("filename: *_SUITE.erl")
+	}
+
+	return framework.NoMatch()
+}
+
+var moduleAttrPattern = regexp.MustCompile(`(?m)^-module\(\s*'?([^')\s]+)'?\s*\)`)
+
+// CommonTestParser maps the suite module to a suite, the test cases listed by
+// all/0 to tests and {group, Name} entries to nested suites built from groups/0.
+type CommonTestParser struct{}
+
+func (p *CommonTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	code := stripComments(source)
+	functions := functionClauses(code)
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageErlang,
+		Framework: frameworkName,
+	}
+
+	all, ok := functions["all"]
+	if !ok {
+		return file, nil
+	}
+
+	name := strings.TrimSuffix(filepath.Base(filename), ".erl")
+	if match := moduleAttrPattern.FindSubmatch(code); match != nil {
+		name = string(match[1])
+	}
+
+	b := &builder{
+		filename:  filename,
+		functions: functions,
+		groups:    make(map[string]term),
+	}
+	if groups, ok := functions["groups"]; ok {
+		if list := readBody(code, groups); list.kind == termList {
+			for _, def := range list.items {
+				if def.kind == termTuple && len(def.items) >= 2 && def.items[0].kind == termAtom {
+					b.groups[def.items[0].text] = def
+				}
+			}
+		}
+	}
+
+	suite := domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: b.location(all, all),
+	}
+	if list := readBody(code, all); list.kind == termList {
+		b.addEntries(&suite, list.items, map[string]bool{})
+	}
+
+	if len(suite.Tests) > 0 || len(suite.Suites) > 0 {
+		file.Suites = []domain.TestSuite{suite}
+	}
+	return file, nil
+}
+
+type builder struct {
+	filename  string
+	functions map[string]clause
+	groups    map[string]term
+}
+
+// addEntries adds the test cases and groups of an all/0 or group case list.
+// visiting guards against groups that include themselves.
+func (b *builder) addEntries(suite *domain.TestSuite, entries []term, visiting map[string]bool) {
+	for _, entry := range entries {
+		switch {
+		case entry.kind == termAtom:
+			suite.Tests = append(suite.Tests, b.testCase(entry))
+		case entry.isTagged("testcase") && len(entry.items) >= 2 && entry.items[1].kind == termAtom:
+			suite.Tests = append(suite.Tests, b.testCase(entry.items[1]))
+		case entry.isTagged("group") && len(entry.items) >= 2 && entry.items[1].kind == termAtom:
+			if group := b.group(entry.items[1], visiting); group != nil {
+				suite.Suites = append(suite.Suites, *group)
+			}
+		}
+	}
+}
+
+func (b *builder) group(ref term, visiting map[string]bool) *domain.TestSuite {
+	name := ref.text
+	def, ok := b.groups[name]
+	if !ok || visiting[name] {
+		return nil
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	suite := domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: domain.Location{File: b.filename, StartLine: def.line, EndLine: def.line},
+	}
+	// {Name, Cases} or {Name, Properties, Cases}
+	if cases := def.items[len(def.items)-1]; cases.kind == termList {
+		b.addEntries(&suite, cases.items, visiting)
+	}
+	return &suite
+}
+
+// testCase locates a test case at its function definition, or at its entry in
+// all/0 or groups/0 when the function is not defined in the module.
+func (b *builder) testCase(name term) domain.Test {
+	location := domain.Location{File: b.filename, StartLine: name.line, EndLine: name.line}
+	if fn, ok := b.functions[name.text]; ok {
+		location = b.location(fn, fn)
+	}
+	return domain.Test{
+		Name:     name.text,
+		Status:   domain.TestStatusActive,
+		Location: location,
+	}
+}
+
+func (b *builder) location(start, end clause) domain.Location {
+	return domain.Location{
+		File:      b.filename,
+		StartLine: start.line,
+		EndLine:   end.endLine,
+	}
+}
+
+// clause is the first clause of a top-level function.
+type clause struct {
+	// body is the byte offset just after "->".
+	body    int
+	line    int
+	endLine int
+}
+
+var functionHeadPattern = regexp.MustCompile(`(?m)^('(?:[^'\\]|\\.)*'|[a-z][A-Za-z0-9_@]*)\s*\(`)
+
+// functionClauses indexes top-level functions by name. A function starts at
+// column 0 with its name and ends at the "." that closes its last clause.
+func functionClauses(code []byte) map[string]clause {
+	functions := make(map[string]clause)
+	for _, match := range functionHeadPattern.FindAllSubmatchIndex(code, -1) {
+		name := strings.Trim(string(code[match[2]:match[3]]), "'")
+		if _, exists := functions[name]; exists {
+			continue
+		}
+		arrow := bytes.Index(code[match[1]:], []byte("->"))
+		if arrow < 0 {
+			continue
+		}
+		body := match[1] + arrow + 2
+		end := formEnd(code, body)
+		functions[name] = clause{
+			body:    body,
+			line:    lineAt(code, match[0]),
+			endLine: lineAt(code, end),
+		}
+	}
+	return functions
+}
+
+// readBody reads the term a function clause evaluates to.
+func readBody(code []byte, fn clause) term {
+	r := &termReader{code: code, pos: fn.body}
+	return r.read(0)
+}
+
+// formEnd returns the offset of the "." that ends the form containing from.
+func formEnd(code []byte, from int) int {
+	for i := from; i < len(code); i++ {
+		switch code[i] {
+		case '"', '\'':
+			i = skipQuoted(code, i)
+		case '$':
+			i++
+		case '.':
+			if i+1 >= len(code) || code[i+1] == '\n' || code[i+1] == ' ' || code[i+1] == '\t' || code[i+1] == '\r' {
+				return i
+			}
+		}
+	}
+	return len(code) - 1
+}
+
+func lineAt(code []byte, offset int) int {
+	if offset > len(code) {
+		offset = len(code)
+	}
+	return bytes.Count(code[:offset], []byte("\n")) + 1
+}
+
+// stripComments blanks % comments outside strings and quoted atoms, keeping
+// offsets and line breaks.
+func stripComments(source []byte) []byte {
+	code := make([]byte, len(source))
+	copy(code, source)
+	for i := 0; i < len(code); i++ {
+		switch code[i] {
+		case '"', '\'':
+			i = skipQuoted(code, i)
+		case '$':
+			i++
+		case '%':
+			for i < len(code) && code[i] != '\n' {
+				code[i] = ' '
+				i++
+			}
+		}
+	}
+	return code
+}
+
+// skipQuoted returns the offset of the quote closing the one at start.
+func skipQuoted(code []byte, start int) int {
+	quote := code[start]
+	for i := start + 1; i < len(code); i++ {
+		switch code[i] {
+		case '\\':
+			i++
+		case quote:
+			return i
+		}
+	}
+	return len(code) - 1
+}
@@ -0,0 +1,142 @@
+package commontest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "common-test", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageErlang}, def.Languages)
+	assert.Nil(t, def.ConfigParser)
+}
+
+func TestCommonTestFilenameMatcher_Match(t *testing.T) {
+	matcher := &CommonTestFilenameMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		filename string
+		expected bool
+	}{
+		{"test/cart_SUITE.erl", true},
+		{"apps/shop/test/checkout_SUITE.erl", true},
+		{"src/cart.erl", false},
+		{"test/cart_tests.erl", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func parse(t *testing.T, source string) *domain.TestFile {
+	t.Helper()
+	file, err := (&CommonTestParser{}).Parse(context.Background(), []byte(source), "test/cart_SUITE.erl")
+	require.NoError(t, err)
+	return file
+}
+
+func TestCommonTestParser_Parse(t *testing.T) {
+	file := parse(t, `-module(cart_SUITE).
+-include_lib("common_test/include/ct.hrl").
+-export([all/0, groups/0, add_item/1, pay/1, refund/1]).
+
+%% add_item is listed first; remove_item is disabled.
+all() ->
+    [add_item,
+     %% remove_item,
+     {group, checkout}].
+
+groups() ->
+    [{checkout, [parallel], [pay, refund, {group, nested}]},
+     {nested, [], [{testcase, 'retry.payment', [{repeat, 3}]}]}].
+
+add_item(_Config) ->
+    Cart = cart:add(cart:new(), "item. one"),
+    1 = cart:size(Cart).
+
+pay(_Config) ->
+    ok.
+
+refund(_Config) -> ok.
+`)
+
+	assert.Equal(t, domain.LanguageErlang, file.Language)
+	assert.Equal(t, "common-test", file.Framework)
+	require.Len(t, file.Suites, 1)
+
+	suite := file.Suites[0]
+	assert.Equal(t, "cart_SUITE", suite.Name)
+	assert.Equal(t, 6, suite.Location.StartLine)
+	assert.Equal(t, 9, suite.Location.EndLine)
+
+	require.Len(t, suite.Tests, 1)
+	assert.Equal(t, "add_item", suite.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, suite.Tests[0].Status)
+	assert.Equal(t, 15, suite.Tests[0].Location.StartLine)
+	assert.Equal(t, 17, suite.Tests[0].Location.EndLine)
+
+	require.Len(t, suite.Suites, 1)
+	checkout := suite.Suites[0]
+	assert.Equal(t, "checkout", checkout.Name)
+	assert.Equal(t, 12, checkout.Location.StartLine)
+	require.Len(t, checkout.Tests, 2)
+	assert.Equal(t, "pay", checkout.Tests[0].Name)
+	assert.Equal(t, 19, checkout.Tests[0].Location.StartLine)
+	assert.Equal(t, 20, checkout.Tests[0].Location.EndLine)
+	assert.Equal(t, "refund", checkout.Tests[1].Name)
+	assert.Equal(t, 22, checkout.Tests[1].Location.StartLine)
+
+	require.Len(t, checkout.Suites, 1)
+	nested := checkout.Suites[0]
+	assert.Equal(t, "nested", nested.Name)
+	require.Len(t, nested.Tests, 1)
+	assert.Equal(t, "retry.payment", nested.Tests[0].Name)
+	assert.Equal(t, 13, nested.Tests[0].Location.StartLine)
+}
+
+func TestCommonTestParser_Parse_RecursiveGroups(t *testing.T) {
+	file := parse(t, `-module(loop_SUITE).
+all() -> [{group, a}].
+groups() -> [{a, [], [one, {group, b}]}, {b, [], [two, {group, a}]}].
+`)
+
+	require.Len(t, file.Suites, 1)
+	require.Len(t, file.Suites[0].Suites, 1)
+	a := file.Suites[0].Suites[0]
+	require.Len(t, a.Suites, 1)
+	assert.Equal(t, "b", a.Suites[0].Name)
+	assert.Empty(t, a.Suites[0].Suites)
+}
+
+func TestCommonTestParser_Parse_ComputedAll(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{"no all/0", "-module(empty_SUITE).\ninit_per_suite(Config) -> Config.\n"},
+		{"comprehension", "-module(gen_SUITE).\nall() -> [F || {F, 1} <- ?MODULE:module_info(exports)].\n"},
+		{"call", "-module(gen_SUITE).\nall() -> ct_helper:all(?MODULE).\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := parse(t, tt.source)
+			assert.Empty(t, file.Suites)
+			assert.Empty(t, file.Tests)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package commontest
+
+import "strings"
+
+type termKind int
+
+const (
+	termOther termKind = iota
+	termAtom
+	termList
+	termTuple
+)
+
+// term is an Erlang term as far as all/0 and groups/0 need it: atoms, lists
+// and tuples. Anything else (strings, numbers, variables, calls) is termOther.
+type term struct {
+	kind  termKind
+	text  string
+	items []term
+	line  int
+}
+
+// isTagged reports whether the term is a tuple whose first element is the atom tag.
+func (t term) isTagged(tag string) bool {
+	return t.kind == termTuple && len(t.items) > 0 && t.items[0].kind == termAtom && t.items[0].text == tag
+}
+
+// maxTermDepth bounds nesting so malformed input cannot recurse without limit.
+const maxTermDepth = 64
+
+type termReader struct {
+	code []byte
+	pos  int
+}
+
+// read reads one term. Expressions that are not plain terms (list
+// comprehensions, calls, operators) read as termOther.
+func (r *termReader) read(depth int) term {
+	r.skipSpace()
+	if r.pos >= len(r.code) || depth > maxTermDepth {
+		return term{}
+	}
+
+	line := lineAt(r.code, r.pos)
+	c := r.code[r.pos]
+	switch {
+	case c == '[':
+		r.pos++
+		return term{kind: termList, items: r.readItems(']', depth), line: line}
+	case c == '{':
+		r.pos++
+		return term{kind: termTuple, items: r.readItems('}', depth), line: line}
+	case c == '\'':
+		end := skipQuoted(r.code, r.pos)
+		text := string(r.code[r.pos+1 : end])
+		r.pos = end + 1
+		return term{kind: termAtom, text: strings.ReplaceAll(text, `\'`, "'"), line: line}
+	case c >= 'a' && c <= 'z':
+		start := r.pos
+		for r.pos < len(r.code) && isAtomChar(r.code[r.pos]) {
+			r.pos++
+		}
+		t := term{kind: termAtom, text: string(r.code[start:r.pos]), line: line}
+		r.skipSpace()
+		if r.pos < len(r.code) && (r.code[r.pos] == '(' || r.code[r.pos] == ':') {
+			// A function call, not an atom.
+			t.kind = termOther
+		}
+		return t
+	default:
+		r.skipOther()
+		return term{line: line}
+	}
+}
+
+// readItems reads comma-separated terms up to the closing bracket. A list
+// comprehension (||) or a tail (|) makes the list opaque.
+func (r *termReader) readItems(closing byte, depth int) []term {
+	var items []term
+	for {
+		r.skipSpace()
+		if r.pos >= len(r.code) {
+			return items
+		}
+		if r.code[r.pos] == closing {
+			r.pos++
+			return items
+		}
+		items = append(items, r.read(depth+1))
+		r.skipSpace()
+		if r.pos >= len(r.code) {
+			return items
+		}
+		switch r.code[r.pos] {
+		case ',':
+			r.pos++
+		case closing:
+			r.pos++
+			return items
+		default:
+			// Operators or comprehensions: give up on this container.
+			r.skipTo(closing)
+			return nil
+		}
+	}
+}
+
+// skipOther skips a non-term token such as a string, number, variable or macro.
+func (r *termReader) skipOther() {
+	if r.code[r.pos] == '"' {
+		r.pos = skipQuoted(r.code, r.pos) + 1
+		return
+	}
+	start := r.pos
+	for r.pos < len(r.code) && (isAtomChar(r.code[r.pos]) || r.code[r.pos] == '?' || r.code[r.pos] == '#') {
+		r.pos++
+	}
+	if r.pos == start {
+		r.pos++
+	}
+}
+
+// skipTo moves past the bracket closing the current container.
+func (r *termReader) skipTo(closing byte) {
+	depth := 0
+	for ; r.pos < len(r.code); r.pos++ {
+		switch c := r.code[r.pos]; {
+		case c == '"' || c == '\'':
+			r.pos = skipQuoted(r.code, r.pos)
+		case c == '[' || c == '{' || c == '(':
+			depth++
+		case c == ']' || c == '}' || c == ')':
+			if depth == 0 && c == closing {
+				r.pos++
+				return
+			}
+			depth--
+		}
+	}
+}
+
+func (r *termReader) skipSpace() {
+	for r.pos < len(r.code) {
+		switch r.code[r.pos] {
+		case ' ', '\t', '\n', '\r':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+func isAtomChar(c byte) bool {
+	return c == '_' || c == '@' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,239 @@
+// Package criterion implements Criterion.rs benchmark support for Rust bench files.
+// Benchmarks are reported as tests of their own framework so they stay separate
+// from cargo test functions.
+package criterion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkCriterion
+
+const dynamicNamePlaceholder = "(dynamic)"
+
+const (
+	confidenceFileName = 20
+	confidenceContent  = 40
+)
+
+// Tree-sitter node types for Rust
+const (
+	nodeCallExpression   = "call_expression"
+	nodeFieldExpression  = "field_expression"
+	nodeFunctionItem     = "function_item"
+	nodeIdentifier       = "identifier"
+	nodeLetDeclaration   = "let_declaration"
+	nodeScopedIdentifier = "scoped_identifier"
+	nodeStringContent    = "string_content"
+	nodeStringLiteral    = "string_literal"
+)
+
+// benchMethods are the Criterion/BenchmarkGroup methods that register one benchmark.
+var benchMethods = map[string]bool{
+	"bench_function":   true,
+	"bench_with_input": true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageRust},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("criterion"),
+			&CriterionFileMatcher{},
+			&CriterionContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &CriterionParser{},
+		Priority:     framework.PrioritySpecialized,
+		DefaultKind:  domain.TestKindBenchmark,
+	}
+}
+
+// CriterionFileMatcher matches Cargo bench targets (benches/*.rs).
+type CriterionFileMatcher struct{}
+
+func (m *CriterionFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	path := signal.Value
+	if strings.HasSuffix(path, ".rs") && (strings.Contains(path, "/benches/") || strings.HasPrefix(path, "benches/")) {
+		return framework.PartialMatch(confidenceFileName, "Cargo bench target: benches/*.rs")
+	}
+
+	return framework.NoMatch()
+}
+
+// CriterionContentMatcher matches criterion_group!/criterion_main! registrations.
+type CriterionContentMatcher struct{}
+
+var criterionPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`criterion_group!\s*[({]`), "criterion_group! macro"},
+	{regexp.MustCompile(`criterion_main!\s*[({]`), "criterion_main! macro"},
+}
+
+func (m *CriterionContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range criterionPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(confidenceContent, "Found Criterion pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// CriterionParser extracts benchmarks registered with bench_function/bench_with_input.
+// Benchmarks registered on a BenchmarkGroup are nested in a suite named after the group.
+type CriterionParser struct{}
+
+func (p *CriterionParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageRust, source)
+	if err != nil {
+		return nil, fmt.Errorf("criterion parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageRust,
+		Framework: frameworkName,
+	}
+
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if node.Type() != nodeFunctionItem {
+			return true
+		}
+		parseBenchFunction(node, source, filename, file)
+		return false
+	})
+
+	return file, nil
+}
+
+// parseBenchFunction collects the benchmarks registered inside one bench function.
+// Groups are tracked by the variable bound to c.benchmark_group("name").
+func parseBenchFunction(fn *sitter.Node, source []byte, filename string, file *domain.TestFile) {
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return
+	}
+
+	groups := make(map[string]int) // variable name -> index into file.Suites
+
+	parser.WalkTree(body, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case nodeLetDeclaration:
+			pattern := node.ChildByFieldName("pattern")
+			value := node.ChildByFieldName("value")
+			if pattern == nil || value == nil || pattern.Type() != nodeIdentifier {
+				return true
+			}
+			if _, method, args := methodCall(value, source); method == "benchmark_group" {
+				file.Suites = append(file.Suites, domain.TestSuite{
+					Name:     benchmarkName(args, source),
+					Status:   domain.TestStatusActive,
+					Location: parser.GetLocation(value, filename),
+				})
+				groups[parser.GetNodeText(pattern, source)] = len(file.Suites) - 1
+			}
+			return true
+
+		case nodeCallExpression:
+			receiver, method, args := methodCall(node, source)
+			if !benchMethods[method] {
+				return true
+			}
+			test := domain.Test{
+				Name:     benchmarkName(args, source),
+				Status:   domain.TestStatusActive,
+				Location: parser.GetLocation(node, filename),
+			}
+			if idx, ok := groups[receiver]; ok {
+				file.Suites[idx].Tests = append(file.Suites[idx].Tests, test)
+			} else {
+				file.Tests = append(file.Tests, test)
+			}
+			return false
+		}
+		return true
+	})
+}
+
+// methodCall returns the receiver text, method name and arguments of a
+// receiver.method(args) call expression.
+func methodCall(node *sitter.Node, source []byte) (receiver, method string, args *sitter.Node) {
+	if node.Type() != nodeCallExpression {
+		return "", "", nil
+	}
+	fn := node.ChildByFieldName("function")
+	if fn == nil || fn.Type() != nodeFieldExpression {
+		return "", "", nil
+	}
+	value := fn.ChildByFieldName("value")
+	field := fn.ChildByFieldName("field")
+	if value == nil || field == nil {
+		return "", "", nil
+	}
+	return parser.GetNodeText(value, source), parser.GetNodeText(field, source), node.ChildByFieldName("arguments")
+}
+
+// benchmarkName resolves the ID passed as the first argument: a string literal,
+// or the function name of BenchmarkId::new("name", parameter).
+// Computed IDs yield the dynamic placeholder.
+func benchmarkName(args *sitter.Node, source []byte) string {
+	if args == nil || args.NamedChildCount() == 0 {
+		return dynamicNamePlaceholder
+	}
+
+	first := args.NamedChild(0)
+	switch first.Type() {
+	case nodeStringLiteral:
+		return stringValue(first, source)
+	case nodeCallExpression:
+		fn := first.ChildByFieldName("function")
+		if fn == nil || fn.Type() != nodeScopedIdentifier || parser.GetNodeText(fn, source) != "BenchmarkId::new" {
+			return dynamicNamePlaceholder
+		}
+		inner := first.ChildByFieldName("arguments")
+		if inner != nil && inner.NamedChildCount() > 0 && inner.NamedChild(0).Type() == nodeStringLiteral {
+			return stringValue(inner.NamedChild(0), source)
+		}
+	}
+	return dynamicNamePlaceholder
+}
+
+func stringValue(lit *sitter.Node, source []byte) string {
+	content := parser.FindChildByType(lit, nodeStringContent)
+	if content == nil {
+		return ""
+	}
+	return parser.GetNodeText(content, source)
+}
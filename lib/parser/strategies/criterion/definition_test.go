@@ -0,0 +1,121 @@
+package criterion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestCriterionParser_Parse(t *testing.T) {
+	source := `
+use criterion::{black_box, criterion_group, criterion_main, BenchmarkId, Criterion};
+
+fn fibonacci_benchmark(c: &mut Criterion) {
+    c.bench_function("fib 20", |b| b.iter(|| fibonacci(black_box(20))));
+}
+
+fn sorting_benchmark(c: &mut Criterion) {
+    let mut group = c.benchmark_group("sorting");
+    for size in [10, 100, 1000] {
+        group.bench_with_input(BenchmarkId::new("merge", size), &size, |b, &s| b.iter(|| merge(s)));
+        group.bench_with_input(BenchmarkId::from_parameter(size), &size, |b, &s| b.iter(|| quick(s)));
+    }
+    group.finish();
+}
+
+fn helper() -> u64 { 1 }
+
+criterion_group!(benches, fibonacci_benchmark, sorting_benchmark);
+criterion_main!(benches);
+`
+
+	file, err := (&CriterionParser{}).Parse(context.Background(), []byte(source), "benches/bench.rs")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if file.Framework != frameworkName || file.Language != domain.LanguageRust {
+		t.Errorf("unexpected file metadata: %s %s", file.Framework, file.Language)
+	}
+
+	if len(file.Tests) != 1 || file.Tests[0].Name != "fib 20" {
+		t.Fatalf("expected top-level benchmark 'fib 20', got %+v", file.Tests)
+	}
+	if file.Tests[0].Location.StartLine != 5 {
+		t.Errorf("expected 'fib 20' at line 5, got %d", file.Tests[0].Location.StartLine)
+	}
+
+	if len(file.Suites) != 1 {
+		t.Fatalf("expected 1 benchmark group, got %d", len(file.Suites))
+	}
+	group := file.Suites[0]
+	if group.Name != "sorting" {
+		t.Errorf("expected group 'sorting', got %q", group.Name)
+	}
+	if len(group.Tests) != 2 {
+		t.Fatalf("expected 2 benchmarks in group, got %d", len(group.Tests))
+	}
+	if group.Tests[0].Name != "merge" {
+		t.Errorf("expected BenchmarkId::new name 'merge', got %q", group.Tests[0].Name)
+	}
+	if group.Tests[1].Name != dynamicNamePlaceholder {
+		t.Errorf("expected dynamic name for computed ID, got %q", group.Tests[1].Name)
+	}
+}
+
+func TestCriterionFileMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		filename  string
+		wantMatch bool
+	}{
+		{"benches directory", "benches/parse.rs", true},
+		{"nested benches directory", "crates/core/benches/parse.rs", true},
+		{"tests directory", "tests/parse.rs", false},
+		{"non-rust file in benches", "benches/data.json", false},
+	}
+
+	matcher := &CriterionFileMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() = %v, want match = %v", result.Confidence > 0, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestCriterionContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"criterion_group macro", "criterion_group!(benches, bench_a);", true},
+		{"criterion_group braced config", "criterion_group! {\n    name = benches;\n}", true},
+		{"criterion_main macro", "criterion_main!(benches);", true},
+		{"plain test", "#[test]\nfn test_foo() {}", false},
+	}
+
+	matcher := &CriterionContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() = %v, want match = %v", result.Confidence > 0, tt.wantMatch)
+			}
+		})
+	}
+}
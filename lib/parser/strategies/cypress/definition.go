@@ -36,6 +36,8 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &CypressConfigParser{},
 		Parser:       &CypressParser{},
 		Priority:     framework.PriorityE2E,
+		DefaultKind:  domain.TestKindE2E,
+		Assertions:   []string{"expect", "*.should", "assert", "assert.*"},
 	}
 }
 
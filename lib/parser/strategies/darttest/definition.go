@@ -0,0 +1,70 @@
+// Package darttest implements package:test support for Dart test files.
+package darttest
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/darttest"
+)
+
+const frameworkName = framework.FrameworkDartTest
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageDart},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("package:test/", "package:test_api/"),
+			&DartTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &DartTestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"expect", "expectLater", "expectAsync*", "fail"},
+	}
+}
+
+// DartTestContentMatcher matches package:test calls in files that import the
+// test API through a helper library.
+type DartTestContentMatcher struct{}
+
+var dartTestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*group\s*\(\s*r?['"]`), "group()"},
+	{regexp.MustCompile(`(?m)^\s*test\s*\(\s*r?['"]`), "test()"},
+}
+
+func (m *DartTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range dartTestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found package:test pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type DartTestParser struct{}
+
+func (p *DartTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return darttest.Parse(ctx, source, filename, frameworkName)
+}
@@ -0,0 +1,98 @@
+package darttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "dart-test" {
+		t.Errorf("expected Name to be 'dart-test', got %q", def.Name)
+	}
+	if def.Priority != framework.PriorityGeneric {
+		t.Errorf("expected Priority to be %d, got %d", framework.PriorityGeneric, def.Priority)
+	}
+	if len(def.Languages) != 1 || def.Languages[0] != domain.LanguageDart {
+		t.Errorf("expected Languages [dart], got %v", def.Languages)
+	}
+}
+
+func TestDartTestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"package:test/test.dart", true},
+		{"package:test_api/test_api.dart", true},
+		{"package:flutter_test/flutter_test.dart", false},
+		{"package:test_helpers/helpers.dart", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			signal := framework.Signal{Type: framework.SignalImport, Value: tt.importPath}
+			result := def.Matchers[0].Match(ctx, signal)
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("expected match=%v, got confidence %d", tt.expected, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestDartTestContentMatcher_Match(t *testing.T) {
+	matcher := &DartTestContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"group call", "void main() {\n  group('Calculator', () {});\n}", true},
+		{"test call", "void main() {\n  test(\"adds\", () {});\n}", true},
+		{"member call", "void main() {\n  runner.test('adds');\n}", false},
+		{"plain dart", "int add(int a, int b) => a + b;", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{
+				Type:    framework.SignalFileContent,
+				Context: []byte(tt.content),
+			})
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("expected match=%v, got confidence %d", tt.expected, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestDartTestParser_Parse(t *testing.T) {
+	source := `import 'package:test/test.dart';
+
+void main() {
+  group('Calculator', () {
+    test('adds', () {});
+  });
+}
+`
+	file, err := (&DartTestParser{}).Parse(context.Background(), []byte(source), "calculator_test.dart")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if file.Framework != "dart-test" {
+		t.Errorf("expected framework dart-test, got %q", file.Framework)
+	}
+	if file.CountTests() != 1 {
+		t.Errorf("expected 1 test, got %d", file.CountTests())
+	}
+}
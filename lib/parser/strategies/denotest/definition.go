@@ -0,0 +1,184 @@
+package denotest
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/configutil"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+const frameworkName = framework.FrameworkDenoTest
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Deno test runner definition. Tests are registered with
+// the Deno.test global; describe/it from @std/testing/bdd go through the shared jstest walker.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageTypeScript, domain.LanguageJavaScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"jsr:@std/",
+				"@std/assert",
+				"@std/expect",
+				"@std/testing/",
+				"https://deno.land/",
+			),
+			matchers.NewConfigMatcher("deno.json", "deno.jsonc"),
+			&DenoContentMatcher{},
+		},
+		ConfigParser: &DenoConfigParser{},
+		Parser:       &DenoParser{},
+		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"assert*", "expect", "fail"},
+	}
+}
+
+// DenoContentMatcher detects the Deno.test global.
+type DenoContentMatcher struct{}
+
+var denoTestPattern = regexp.MustCompile(`\bDeno\.test(?:\.(?:ignore|only))?\s*\(`)
+
+func (m *DenoContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	if denoTestPattern.Match(content) {
+		return framework.PartialMatch(40, "Found Deno-specific pattern: Deno.test()")
+	}
+
+	return framework.NoMatch()
+}
+
+type DenoConfigParser struct{}
+
+func (p *DenoConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	scope.GlobalsMode = true
+	if test := testBlockPattern.FindSubmatch(content); test != nil {
+		scope.Include = toGlobs(parseArray(test[1], includePattern))
+		scope.Exclude = toGlobs(parseArray(test[1], excludePattern))
+	}
+	return scope, nil
+}
+
+// Config parsing regex patterns.
+// Limitation: Only the "test" object's include/exclude are read; nested objects inside it are not supported.
+var (
+	testBlockPattern = regexp.MustCompile(`"test"\s*:\s*\{([^{}]*)\}`)
+	includePattern   = regexp.MustCompile(`"include"\s*:\s*\[([^\]]*)\]`)
+	excludePattern   = regexp.MustCompile(`"exclude"\s*:\s*\[([^\]]*)\]`)
+)
+
+func parseArray(content []byte, pattern *regexp.Regexp) []string {
+	if match := pattern.FindSubmatch(content); match != nil {
+		return configutil.ExtractQuotedStrings(match[1])
+	}
+	return nil
+}
+
+// toGlobs converts deno.json paths to globs: Deno accepts both globs and plain
+// paths, where a directory path covers everything beneath it.
+func toGlobs(paths []string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+	globs := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = strings.TrimPrefix(p, "./")
+		switch {
+		case strings.ContainsAny(p, "*?[{"):
+			globs = append(globs, p)
+		case path.Ext(p) != "":
+			globs = append(globs, p)
+		default:
+			globs = append(globs, strings.TrimSuffix(p, "/")+"/**")
+		}
+	}
+	return globs
+}
+
+type DenoParser struct{}
+
+func (p *DenoParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.ParseWith(ctx, source, filename, frameworkName, func(root *sitter.Node, file *domain.TestFile) {
+		parseDenoTests(root, source, filename, file)
+	})
+}
+
+func parseDenoTests(node *sitter.Node, source []byte, filename string, file *domain.TestFile) {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == "call_expression" {
+			if status, modifier, ok := denoTestCall(child, source); ok {
+				processDenoTest(child, source, filename, file, status, modifier)
+				continue
+			}
+		}
+		parseDenoTests(child, source, filename, file)
+	}
+}
+
+// denoTestCall reports whether call is Deno.test(), Deno.test.ignore() or Deno.test.only().
+func denoTestCall(call *sitter.Node, source []byte) (domain.TestStatus, string, bool) {
+	funcNode := call.ChildByFieldName("function")
+	if funcNode == nil || funcNode.Type() != "member_expression" {
+		return domain.TestStatusActive, "", false
+	}
+
+	switch parser.GetNodeText(funcNode, source) {
+	case "Deno.test":
+		return domain.TestStatusActive, "", true
+	case "Deno.test.ignore":
+		return domain.TestStatusSkipped, jstest.ModifierIgnore, true
+	case "Deno.test.only":
+		return domain.TestStatusFocused, jstest.ModifierOnly, true
+	default:
+		return domain.TestStatusActive, "", false
+	}
+}
+
+func processDenoTest(call *sitter.Node, source []byte, filename string, file *domain.TestFile, status domain.TestStatus, modifier string) {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return
+	}
+
+	resolved := jstest.ResolveTestCall(args, source)
+	if resolved.Name == "" {
+		return
+	}
+
+	reason := jstest.ExtractSkipComment(call, source, status)
+	if status == domain.TestStatusActive {
+		status, modifier, reason = jstest.ExtractOptionsStatus(resolved.Options, source)
+	}
+
+	test := domain.Test{
+		Name:       resolved.Name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(call, filename),
+	}
+	jstest.AddTestWithSubtests(test, resolved.Callback, source, filename, nil, file)
+}
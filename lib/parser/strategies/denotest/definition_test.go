@@ -0,0 +1,242 @@
+package denotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "deno-test" {
+		t.Errorf("expected Name to be 'deno-test', got %q", def.Name)
+	}
+
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
+	}
+
+	// ImportMatcher + ConfigMatcher + ContentMatcher
+	if len(def.Matchers) != 3 {
+		t.Errorf("expected 3 matchers, got %d", len(def.Matchers))
+	}
+}
+
+func TestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		imp      string
+		expected bool
+	}{
+		{"jsr:@std/assert", true},
+		{"jsr:@std/testing/bdd", true},
+		{"@std/assert", true},
+		{"@std/testing/mock", true},
+		{"https://deno.land/std@0.224.0/assert/mod.ts", true},
+		{"@std/path", false},
+		{"vitest", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.imp, func(t *testing.T) {
+			matched := false
+			for _, m := range def.Matchers {
+				if m.Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.imp}).Confidence > 0 {
+					matched = true
+				}
+			}
+			if matched != tt.expected {
+				t.Errorf("import %q: expected matched=%v, got %v", tt.imp, tt.expected, matched)
+			}
+		})
+	}
+}
+
+func TestDenoContentMatcher_Match(t *testing.T) {
+	matcher := &DenoContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		content            string
+		expectedConfidence int
+	}{
+		{"Deno.test", `Deno.test("adds", () => {});`, 40},
+		{"Deno.test.ignore", `Deno.test.ignore("later", () => {});`, 40},
+		{"other Deno API", `const text = await Deno.readTextFile("a.txt");`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+
+			result := matcher.Match(ctx, signal)
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("expected confidence %d, got %d", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestDenoConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		expectedInclude []string
+		expectedExclude []string
+	}{
+		{
+			name:    "no test config",
+			content: `{ "imports": { "@std/assert": "jsr:@std/assert@^1.0.0" } }`,
+		},
+		{
+			name: "test include and exclude",
+			content: `{
+  // deno.jsonc allows comments
+  "test": {
+    "include": ["src/", "./lib/**/*_test.ts"],
+    "exclude": ["src/fixtures", "src/testdata.ts"]
+  }
+}`,
+			expectedInclude: []string{"src/**", "lib/**/*_test.ts"},
+			expectedExclude: []string{"src/fixtures/**", "src/testdata.ts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := (&DenoConfigParser{}).Parse(context.Background(), "/project/deno.jsonc", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if scope.Framework != "deno-test" {
+				t.Errorf("expected framework 'deno-test', got %q", scope.Framework)
+			}
+			if !scope.GlobalsMode {
+				t.Error("expected globals mode")
+			}
+			assertStrings(t, "include", tt.expectedInclude, scope.Include)
+			assertStrings(t, "exclude", tt.expectedExclude, scope.Exclude)
+		})
+	}
+}
+
+func assertStrings(t *testing.T, label string, expected, actual []string) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Errorf("expected %s %v, got %v", label, expected, actual)
+		return
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("expected %s[%d] %q, got %q", label, i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestDenoParser_Parse(t *testing.T) {
+	source := `import { assertEquals } from "jsr:@std/assert";
+import { describe, it } from "jsr:@std/testing/bdd";
+
+Deno.test("adds numbers", () => {
+  assertEquals(1 + 1, 2);
+});
+
+Deno.test(function subtractsNumbers() {});
+
+Deno.test({
+  name: "reads env",
+  permissions: { env: true },
+  ignore: Deno.build.os === "windows",
+  fn() {},
+});
+
+Deno.test({ name: "not ready", ignore: true, fn: () => {} });
+
+// needs a database
+Deno.test.ignore("integration", () => {});
+
+Deno.test.only("focused", () => {});
+
+Deno.test("database", async (t) => {
+  await t.step("insert", () => {});
+  await t.step({
+    name: "query",
+    fn: async (t) => {
+      await t.step("by id", () => {});
+    },
+  });
+});
+
+describe("bdd", () => {
+  it("works", () => {});
+});
+`
+
+	file, err := (&DenoParser{}).Parse(context.Background(), []byte(source), "math_test.ts")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if file.Framework != "deno-test" {
+		t.Errorf("expected framework 'deno-test', got %q", file.Framework)
+	}
+
+	expected := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+		reason   string
+	}{
+		{"adds numbers", domain.TestStatusActive, "", ""},
+		{"subtractsNumbers", domain.TestStatusActive, "", ""},
+		{"reads env", domain.TestStatusActive, "", ""},
+		{"not ready", domain.TestStatusSkipped, "ignore", ""},
+		{"integration", domain.TestStatusSkipped, "ignore", "needs a database"},
+		{"focused", domain.TestStatusFocused, "only", ""},
+	}
+	if len(file.Tests) != len(expected) {
+		t.Fatalf("expected %d top-level tests, got %d", len(expected), len(file.Tests))
+	}
+	for i, want := range expected {
+		got := file.Tests[i]
+		if got.Name != want.name || got.Status != want.status || got.Modifier != want.modifier || got.SkipReason != want.reason {
+			t.Errorf("test %d: expected (%q, %s, %q, %q), got (%q, %s, %q, %q)",
+				i, want.name, want.status, want.modifier, want.reason,
+				got.Name, got.Status, got.Modifier, got.SkipReason)
+		}
+	}
+
+	if len(file.Suites) != 2 {
+		t.Fatalf("expected 2 suites (bdd, database), got %d", len(file.Suites))
+	}
+
+	bdd := file.Suites[0]
+	if bdd.Name != "bdd" || len(bdd.Tests) != 1 {
+		t.Errorf("expected bdd suite with 1 test, got %q with %d", bdd.Name, len(bdd.Tests))
+	}
+
+	database := file.Suites[1]
+	if database.Name != "database" {
+		t.Errorf("expected steps to make 'database' a suite, got %q", database.Name)
+	}
+	if len(database.Tests) != 1 || database.Tests[0].Name != "insert" {
+		t.Errorf("expected step 'insert', got %+v", database.Tests)
+	}
+	if len(database.Suites) != 1 || database.Suites[0].Name != "query" {
+		t.Fatalf("expected nested step suite 'query', got %+v", database.Suites)
+	}
+	if len(database.Suites[0].Tests) != 1 || database.Suites[0].Tests[0].Name != "by id" {
+		t.Errorf("expected step 'by id' under 'query', got %+v", database.Suites[0].Tests)
+	}
+}
@@ -0,0 +1,251 @@
+// Package doctest implements doctest framework support for C++ test files.
+package doctest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkDoctest
+
+const (
+	confidenceContent = 40
+
+	// macroPrefix is the prefix of doctest's DOCTEST_CONFIG_NO_SHORT_MACRO_NAMES macro names.
+	macroPrefix = "DOCTEST_"
+)
+
+// testCaseMacros maps doctest test case macros to the argument index of their name.
+var testCaseMacros = map[string]int{
+	"TEST_CASE":                 0,
+	"TEST_CASE_FIXTURE":         1,
+	"TEST_CASE_TEMPLATE":        0,
+	"TEST_CASE_TEMPLATE_DEFINE": 0,
+	"SCENARIO":                  0,
+	"SCENARIO_TEMPLATE":         0,
+	"SCENARIO_TEMPLATE_DEFINE":  0,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			&cppast.HeaderMatcher{
+				Dirs:  []string{"doctest/"},
+				Files: []string{"doctest.h"},
+			},
+			&DoctestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &DoctestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"CHECK*", "REQUIRE*", "WARN*", "FAIL*"},
+	}
+}
+
+// DoctestContentMatcher matches doctest-specific macros.
+// A bare TEST_CASE is shared with Catch2, so only doctest-only forms are matched.
+type DoctestContentMatcher struct{}
+
+var doctestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\bSUBCASE\s*\(`), "SUBCASE() macro"},
+	{regexp.MustCompile(`\bTEST_SUITE(?:_BEGIN)?\s*\(`), "TEST_SUITE() macro"},
+	{regexp.MustCompile(`\bTEST_CASE_FIXTURE\s*\(`), "TEST_CASE_FIXTURE() macro"},
+	{regexp.MustCompile(`\bDOCTEST_[A-Z_]+\s*\(`), "DOCTEST_ prefixed macro"},
+	{regexp.MustCompile(`\bdoctest::`), "doctest namespace"},
+}
+
+func (m *DoctestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range doctestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(confidenceContent, "Found doctest pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// DoctestParser extracts test cases from doctest files.
+// TEST_SUITE blocks and TEST_SUITE_BEGIN/END pairs become suites; a test case with
+// SUBCASEs (or GIVEN/WHEN/THEN) becomes a suite whose leaf subcases are tests.
+type DoctestParser struct{}
+
+func (p *DoctestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("doctest parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	root := &domain.TestSuite{}
+	parseBlock(tree.RootNode(), source, filename, root, decorators{status: domain.TestStatusActive})
+	file.Tests = root.Tests
+	file.Suites = root.Suites
+
+	return file, nil
+}
+
+// parseBlock adds the test cases and suites found in container to parent.
+// TEST_SUITE_BEGIN opens a suite that collects the following siblings until TEST_SUITE_END.
+func parseBlock(container *sitter.Node, source []byte, filename string, parent *domain.TestSuite, inherited decorators) {
+	stack := []*domain.TestSuite{parent}
+	statuses := []decorators{inherited}
+
+	for _, call := range cppast.MacroCalls(container, source) {
+		current := stack[len(stack)-1]
+		currentDec := statuses[len(statuses)-1]
+		macro := strings.TrimPrefix(call.Name, macroPrefix)
+
+		switch macro {
+		case "TEST_SUITE":
+			suite := newSuite(call, source, filename, currentDec)
+			parseBlock(call.Body, source, filename, &suite, decoratorsOf(call, 0, source, currentDec))
+			current.Suites = append(current.Suites, suite)
+			continue
+
+		case "TEST_SUITE_BEGIN":
+			suite := newSuite(call, source, filename, currentDec)
+			stack = append(stack, &suite)
+			statuses = append(statuses, decoratorsOf(call, 0, source, currentDec))
+			continue
+
+		case "TEST_SUITE_END":
+			if len(stack) > 1 {
+				closed := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				statuses = statuses[:len(statuses)-1]
+				owner := stack[len(stack)-1]
+				owner.Suites = append(owner.Suites, *closed)
+			}
+			continue
+		}
+
+		nameIdx, ok := testCaseMacros[macro]
+		if !ok || call.Body == nil {
+			continue
+		}
+
+		name, _ := call.StringArg(nameIdx, source)
+		if strings.HasPrefix(macro, "SCENARIO") {
+			name = "Scenario: " + name
+		}
+		dec := decoratorsOf(call, nameIdx, source, currentDec)
+
+		tests, suites := cppast.BuildSections(call.Body, source, filename, sectionName, dec.status, dec.modifier)
+		if len(tests) == 0 && len(suites) == 0 {
+			current.Tests = append(current.Tests, domain.Test{
+				Location: call.Location(filename),
+				Modifier: dec.modifier,
+				Name:     name,
+				Status:   dec.status,
+			})
+			continue
+		}
+
+		current.Suites = append(current.Suites, domain.TestSuite{
+			Location: call.Location(filename),
+			Modifier: dec.modifier,
+			Name:     name,
+			Status:   dec.status,
+			Suites:   suites,
+			Tests:    tests,
+		})
+	}
+
+	// Unterminated TEST_SUITE_BEGIN blocks end with the file.
+	for len(stack) > 1 {
+		closed := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		owner := stack[len(stack)-1]
+		owner.Suites = append(owner.Suites, *closed)
+	}
+}
+
+func newSuite(call cppast.MacroCall, source []byte, filename string, inherited decorators) domain.TestSuite {
+	name, _ := call.StringArg(0, source)
+	dec := decoratorsOf(call, 0, source, inherited)
+	return domain.TestSuite{
+		Location: call.Location(filename),
+		Modifier: dec.modifier,
+		Name:     name,
+		Status:   dec.status,
+	}
+}
+
+// sectionName resolves SUBCASE and the BDD subcase macros.
+func sectionName(call cppast.MacroCall, source []byte) (string, bool) {
+	macro := strings.TrimPrefix(call.Name, macroPrefix)
+	if macro == "SUBCASE" {
+		name, _ := call.StringArg(0, source)
+		return name, true
+	}
+	if prefix, ok := cppast.BDDSectionPrefixes[macro]; ok {
+		name, _ := call.StringArg(0, source)
+		return prefix + name, true
+	}
+	return "", false
+}
+
+// decorators is the status derived from doctest decorators such as `* doctest::skip()`.
+type decorators struct {
+	modifier string
+	status   domain.TestStatus
+}
+
+// decoratorsOf applies the decorators combined with the name argument at nameIdx
+// on top of the inherited suite status.
+func decoratorsOf(call cppast.MacroCall, nameIdx int, source []byte, inherited decorators) decorators {
+	result := inherited
+	if nameIdx >= len(call.Args) {
+		return result
+	}
+
+	for _, d := range cppast.DecoratorCalls(call.Args[nameIdx], source) {
+		switch d.Name {
+		case "skip":
+			arg := d.FirstArgText(source)
+			if arg == "" || arg == "true" {
+				result = decorators{modifier: "doctest::skip", status: domain.TestStatusSkipped}
+			} else if arg == "false" && result.modifier == "doctest::skip" {
+				result = decorators{status: domain.TestStatusActive}
+			}
+		case "should_fail", "may_fail", "expected_failures":
+			if result.status != domain.TestStatusSkipped {
+				result = decorators{modifier: "doctest::" + d.Name, status: domain.TestStatusXfail}
+			}
+		}
+	}
+	return result
+}
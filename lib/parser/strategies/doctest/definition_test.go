@@ -0,0 +1,183 @@
+package doctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestDoctestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "test cases with subcases",
+			source: `
+#include "doctest.h"
+
+TEST_CASE("lots of nested subcases") {
+    SUBCASE("") {}
+    SUBCASE("outer") {
+        SUBCASE("inner 1") {}
+        SUBCASE("inner 2") {}
+    }
+}
+
+TEST_CASE("factorial") {
+    CHECK(factorial(1) == 1);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "factorial" {
+					t.Fatalf("expected test 'factorial', got %+v", file.Tests)
+				}
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "lots of nested subcases" || suite.CountTests() != 3 {
+					t.Errorf("unexpected suite %q with %d tests", suite.Name, suite.CountTests())
+				}
+				if suite.Suites[0].Name != "outer" || suite.Suites[0].Tests[1].Name != "inner 2" {
+					t.Errorf("unexpected nested subcases: %+v", suite.Suites)
+				}
+			},
+		},
+		{
+			name: "test suite block and begin/end pair",
+			source: `
+TEST_SUITE("math") {
+    TEST_CASE("adds") {}
+    TEST_CASE_FIXTURE(Calculator, "divides") {}
+}
+
+TEST_SUITE_BEGIN("io");
+TEST_CASE("reads") {}
+TEST_SUITE_END();
+
+TEST_CASE("top level") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				math, io := file.Suites[0], file.Suites[1]
+				if math.Name != "math" || len(math.Tests) != 2 || math.Tests[1].Name != "divides" {
+					t.Errorf("unexpected math suite: %+v", math)
+				}
+				if io.Name != "io" || len(io.Tests) != 1 || io.Tests[0].Name != "reads" {
+					t.Errorf("unexpected io suite: %+v", io)
+				}
+				if len(file.Tests) != 1 || file.Tests[0].Name != "top level" {
+					t.Errorf("expected top-level test, got %+v", file.Tests)
+				}
+			},
+		},
+		{
+			name: "decorators",
+			source: `
+TEST_CASE("skipped" * doctest::skip()) {}
+TEST_CASE("not skipped" * doctest::skip(false)) {}
+TEST_CASE("expected to fail" * doctest::should_fail() * doctest::timeout(0.5)) {}
+
+TEST_SUITE("disabled suite" * doctest::skip()) {
+    TEST_CASE("inherits skip") {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				want := []domain.TestStatus{domain.TestStatusSkipped, domain.TestStatusActive, domain.TestStatusXfail}
+				for i, status := range want {
+					if file.Tests[i].Status != status {
+						t.Errorf("test[%d] %q status = %s, want %s", i, file.Tests[i].Name, file.Tests[i].Status, status)
+					}
+				}
+				if file.Tests[0].Name != "skipped" || file.Tests[0].Modifier != "doctest::skip" {
+					t.Errorf("unexpected skipped test: %+v", file.Tests[0])
+				}
+				suite := file.Suites[0]
+				if suite.Status != domain.TestStatusSkipped || suite.Tests[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected suite skip to be inherited, got %s / %s", suite.Status, suite.Tests[0].Status)
+				}
+			},
+		},
+		{
+			name: "BDD scenario and prefixed macros",
+			source: `
+SCENARIO("vectors grow") {
+    GIVEN("an empty vector") {
+        WHEN("an item is pushed") {
+            THEN("the size is one") {}
+        }
+    }
+}
+
+DOCTEST_TEST_CASE("prefixed") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "Scenario: vectors grow" {
+					t.Fatalf("expected scenario suite, got %+v", file.Suites)
+				}
+				then := file.Suites[0].Suites[0].Suites[0].Tests[0]
+				if then.Name != "Then: the size is one" {
+					t.Errorf("expected THEN leaf, got %q", then.Name)
+				}
+				if len(file.Tests) != 1 || file.Tests[0].Name != "prefixed" {
+					t.Errorf("expected prefixed test, got %+v", file.Tests)
+				}
+			},
+		},
+	}
+
+	parser := &DoctestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test.cpp")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestDoctestContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"subcase", `TEST_CASE("a") { SUBCASE("b") {} }`, true},
+		{"test suite", `TEST_SUITE("a") {}`, true},
+		{"decorator", `TEST_CASE("a" * doctest::skip()) {}`, true},
+		{"bare test case", `TEST_CASE("a") {}`, false},
+		{"catch2 section", `TEST_CASE("a", "[tag]") { SECTION("b") {} }`, false},
+	}
+
+	matcher := &DoctestContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(tt.content)})
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() = %v, want match = %v", result.Confidence > 0, tt.wantMatch)
+			}
+		})
+	}
+}
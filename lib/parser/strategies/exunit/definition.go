@@ -0,0 +1,470 @@
+// Package exunit implements ExUnit support for Elixir test files.
+package exunit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkExUnit
+
+// ExUnit macros and module attributes.
+const (
+	macroDefmodule = "defmodule"
+	macroDescribe  = "describe"
+	macroTest      = "test"
+	macroDoctest   = "doctest"
+
+	attrTag         = "tag"
+	attrDescribeTag = "describetag"
+	attrModuleTag   = "moduletag"
+
+	tagSkip    = "skip"
+	tagPending = "pending"
+
+	// modifierNotImplemented marks a test declared without a body (test "name"),
+	// which ExUnit registers as not implemented.
+	modifierNotImplemented = "not_implemented"
+)
+
+// configTags are ExUnit tags that configure a test rather than label it.
+var configTags = map[string]bool{
+	tagSkip:       true,
+	tagPending:    true,
+	"async":       true,
+	"capture_log": true,
+	"report":      true,
+	"timeout":     true,
+	"tmp_dir":     true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the ExUnit definition. Test modules are detected from
+// `use ExUnit.Case` or from case templates such as Phoenix's ConnCase and DataCase.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageElixir},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("ExUnit.Case", "ExUnit.CaseTemplate"),
+			&ExUnitContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &ExUnitParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "refute*", "flunk"},
+	}
+}
+
+// ExUnitContentMatcher matches ExUnit case templates and test macros.
+type ExUnitContentMatcher struct{}
+
+var exunitPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*use\s+[A-Z][\w.]*Case\b`), "use *Case template"},
+	{regexp.MustCompile(`(?m)^\s*test\s+"[^"]*"(?:\s*,[^\n]*)?\s+do\b`), "test macro"},
+}
+
+func (m *ExUnitContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range exunitPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found ExUnit pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// ExUnitParser maps test modules to suites, describe blocks to nested suites
+// and test macros to tests. `doctest Module` becomes an empty suite standing in
+// for the doctests, which live in the module's documentation.
+type ExUnitParser struct{}
+
+func (p *ExUnitParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageElixir, source)
+	if err != nil {
+		return nil, fmt.Errorf("exunit parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageElixir,
+		Framework: frameworkName,
+	}
+
+	w := &walker{source: source, filename: filename, file: file}
+	w.walk(tree.RootNode(), &scope{})
+
+	return file, nil
+}
+
+// tagSet is the result of reading @tag, @describetag or @moduletag attributes.
+type tagSet struct {
+	status   domain.TestStatus
+	modifier string
+	reason   string
+	tags     []string
+}
+
+// scope carries the enclosing suite and the attributes that apply to it.
+type scope struct {
+	suite     *domain.TestSuite
+	inherited tagSet
+	// pending collects @tag attributes until the next test.
+	pending []*sitter.Node
+	// own collects @moduletag or @describetag attributes of the enclosing suite.
+	own []*sitter.Node
+	// ownAttr is the attribute name collected into own.
+	ownAttr string
+}
+
+type walker struct {
+	source   []byte
+	filename string
+	file     *domain.TestFile
+}
+
+func (w *walker) walk(node *sitter.Node, s *scope) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		w.visit(node.NamedChild(i), s)
+	}
+}
+
+func (w *walker) visit(node *sitter.Node, s *scope) {
+	switch node.Type() {
+	case "unary_operator":
+		if name, args := w.attribute(node); name != "" {
+			w.recordAttribute(name, args, s)
+			return
+		}
+	case "call":
+		switch w.callName(node) {
+		case macroDefmodule:
+			w.visitModule(node)
+			return
+		case macroDescribe:
+			if s.suite != nil {
+				w.visitDescribe(node, s)
+				return
+			}
+		case macroTest:
+			if s.suite != nil {
+				w.visitTest(node, s)
+				return
+			}
+		case macroDoctest:
+			if s.suite != nil {
+				w.visitDoctest(node, s)
+				return
+			}
+		}
+	}
+	w.walk(node, s)
+}
+
+func (w *walker) visitModule(node *sitter.Node) {
+	args := argumentNodes(node)
+	body := doBlock(node)
+	if len(args) == 0 || body == nil {
+		return
+	}
+
+	suite := domain.TestSuite{
+		Name:     parser.GetNodeText(args[0], w.source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	s := &scope{suite: &suite, inherited: tagSet{status: domain.TestStatusActive}, ownAttr: attrModuleTag}
+	w.walk(body, s)
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return
+	}
+	w.file.Suites = append(w.file.Suites, suite)
+}
+
+func (w *walker) visitDescribe(node *sitter.Node, parent *scope) {
+	args := argumentNodes(node)
+	body := doBlock(node)
+	if len(args) == 0 {
+		return
+	}
+
+	suite := domain.TestSuite{
+		Name:     w.stringValue(args[0]),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	parent.pending = nil
+
+	s := &scope{suite: &suite, inherited: w.inherited(parent), ownAttr: attrDescribeTag}
+	if body != nil {
+		w.walk(body, s)
+	}
+	parent.suite.Suites = append(parent.suite.Suites, suite)
+}
+
+func (w *walker) visitTest(node *sitter.Node, s *scope) {
+	args := argumentNodes(node)
+	if len(args) == 0 {
+		return
+	}
+
+	own := w.readTags(attrTag, s.pending)
+	s.pending = nil
+
+	test := domain.Test{
+		Name:       w.stringValue(args[0]),
+		Status:     own.status,
+		Modifier:   own.modifier,
+		SkipReason: own.reason,
+		Tags:       own.tags,
+		Location:   parser.GetLocation(node, w.filename),
+	}
+
+	if test.Status == domain.TestStatusActive {
+		if inherited := w.inherited(s); inherited.status != domain.TestStatusActive {
+			test.Status, test.Modifier, test.SkipReason = inherited.status, inherited.modifier, inherited.reason
+		} else if doBlock(node) == nil && !w.hasDoKeyword(args) {
+			test.Status, test.Modifier = domain.TestStatusTodo, modifierNotImplemented
+		}
+	}
+
+	s.suite.Tests = append(s.suite.Tests, test)
+}
+
+func (w *walker) visitDoctest(node *sitter.Node, s *scope) {
+	args := argumentNodes(node)
+	if len(args) == 0 {
+		return
+	}
+
+	s.suite.Suites = append(s.suite.Suites, domain.TestSuite{
+		Name:     macroDoctest + " " + parser.GetNodeText(args[0], w.source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	})
+}
+
+// recordAttribute collects tag attributes. @moduletag and @describetag are
+// recorded on the enclosing suite and inherited by the tests that follow.
+func (w *walker) recordAttribute(name string, args *sitter.Node, s *scope) {
+	switch name {
+	case attrTag:
+		if args != nil {
+			s.pending = append(s.pending, args)
+		}
+	case attrModuleTag, attrDescribeTag:
+		if s.suite == nil || name != s.ownAttr || args == nil {
+			return
+		}
+		s.own = append(s.own, args)
+		own := w.readTags(name, s.own)
+		s.suite.Status, s.suite.Modifier, s.suite.SkipReason = own.status, own.modifier, own.reason
+		s.suite.Tags = own.tags
+	}
+}
+
+// inherited returns the status tests in the scope inherit: the scope's own
+// attributes first, then those of enclosing suites.
+func (w *walker) inherited(s *scope) tagSet {
+	own := w.readTags(s.ownAttr, s.own)
+	if own.status != domain.TestStatusActive {
+		return own
+	}
+	return s.inherited
+}
+
+// readTags interprets tag attribute arguments: `:skip` and `skip: "reason"`
+// skip, `:pending` marks the test todo, other atoms and keyword pairs are tags.
+func (w *walker) readTags(attr string, argsList []*sitter.Node) tagSet {
+	result := tagSet{status: domain.TestStatusActive}
+	var skip, pending bool
+	for _, args := range argsList {
+		for i := 0; i < int(args.NamedChildCount()); i++ {
+			arg := args.NamedChild(i)
+			switch arg.Type() {
+			case "atom", "quoted_atom":
+				name := atomName(parser.GetNodeText(arg, w.source))
+				switch name {
+				case tagSkip:
+					skip = true
+				case tagPending:
+					pending = true
+				default:
+					result.tags = appendTag(result.tags, name)
+				}
+			case "keywords":
+				for _, pair := range namedChildrenOfType(arg, "pair") {
+					key, value := w.keywordPair(pair)
+					switch {
+					case key == tagSkip && value != nil && value.Type() == "string":
+						skip, result.reason = true, w.stringValue(value)
+					case key == tagSkip:
+						skip = isTrue(value, w.source)
+					case key == tagPending:
+						pending = isTrue(value, w.source)
+					case configTags[key]:
+					default:
+						if tag := w.keywordTag(key, value); tag != "" {
+							result.tags = appendTag(result.tags, tag)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	switch {
+	case skip:
+		result.status, result.modifier = domain.TestStatusSkipped, "@"+attr+" :"+tagSkip
+	case pending:
+		result.status, result.modifier = domain.TestStatusTodo, "@"+attr+" :"+tagPending
+	default:
+		result.reason = ""
+	}
+	return result
+}
+
+// keywordTag renders `slow: true` as "slow" and `type: :db` as "type:db".
+// Other values configure the test and are not tags.
+func (w *walker) keywordTag(key string, value *sitter.Node) string {
+	if value == nil {
+		return ""
+	}
+	switch value.Type() {
+	case "boolean":
+		if isTrue(value, w.source) {
+			return key
+		}
+	case "atom":
+		return key + ":" + atomName(parser.GetNodeText(value, w.source))
+	case "string":
+		return key + ":" + w.stringValue(value)
+	}
+	return ""
+}
+
+func (w *walker) keywordPair(pair *sitter.Node) (string, *sitter.Node) {
+	keyNode := pair.ChildByFieldName("key")
+	if keyNode == nil {
+		return "", nil
+	}
+	key := strings.TrimSpace(parser.GetNodeText(keyNode, w.source))
+	key = strings.Trim(strings.TrimSuffix(key, ":"), `"`)
+	return key, pair.ChildByFieldName("value")
+}
+
+// attribute returns the name and arguments of a module attribute (@name args).
+func (w *walker) attribute(node *sitter.Node) (string, *sitter.Node) {
+	operator := node.ChildByFieldName("operator")
+	operand := node.ChildByFieldName("operand")
+	if operator == nil || operand == nil || parser.GetNodeText(operator, w.source) != "@" || operand.Type() != "call" {
+		return "", nil
+	}
+	return w.callName(operand), parser.FindChildByType(operand, "arguments")
+}
+
+func (w *walker) callName(node *sitter.Node) string {
+	target := node.ChildByFieldName("target")
+	if target == nil || target.Type() != "identifier" {
+		return ""
+	}
+	return parser.GetNodeText(target, w.source)
+}
+
+// stringValue returns the content of a string literal with interpolations kept
+// as written, or the source text of any other expression.
+func (w *walker) stringValue(node *sitter.Node) string {
+	text := parser.GetNodeText(node, w.source)
+	if node.Type() != "string" {
+		return text
+	}
+	start, end := node.ChildByFieldName("quoted_start"), node.ChildByFieldName("quoted_end")
+	if start == nil || end == nil {
+		return text
+	}
+	return string(w.source[start.EndByte():end.StartByte()])
+}
+
+func argumentNodes(call *sitter.Node) []*sitter.Node {
+	args := parser.FindChildByType(call, "arguments")
+	if args == nil {
+		return nil
+	}
+	var nodes []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		nodes = append(nodes, args.NamedChild(i))
+	}
+	return nodes
+}
+
+func doBlock(call *sitter.Node) *sitter.Node {
+	return parser.FindChildByType(call, "do_block")
+}
+
+// hasDoKeyword reports whether the arguments end with the `do:` keyword form.
+func (w *walker) hasDoKeyword(args []*sitter.Node) bool {
+	last := args[len(args)-1]
+	if last.Type() != "keywords" {
+		return false
+	}
+	for _, pair := range namedChildrenOfType(last, "pair") {
+		if key, _ := w.keywordPair(pair); key == "do" {
+			return true
+		}
+	}
+	return false
+}
+
+func namedChildrenOfType(node *sitter.Node, nodeType string) []*sitter.Node {
+	var children []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == nodeType {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func isTrue(node *sitter.Node, source []byte) bool {
+	return node != nil && node.Type() == "boolean" && parser.GetNodeText(node, source) == "true"
+}
+
+// atomName strips the colon and quotes from :name and :"name".
+func atomName(text string) string {
+	return strings.Trim(strings.TrimPrefix(text, ":"), `"`)
+}
+
+func appendTag(tags []string, tag string) []string {
+	for _, existing := range tags {
+		if existing == tag {
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
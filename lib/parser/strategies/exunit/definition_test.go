@@ -0,0 +1,251 @@
+package exunit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "exunit", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageElixir}, def.Languages)
+	assert.Nil(t, def.ConfigParser)
+}
+
+func TestExUnitImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"ExUnit.Case", true},
+		{"ExUnit.CaseTemplate", true},
+		{"MyApp.DataCase", false},
+		{"Plug.Conn", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.importPath})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func TestExUnitContentMatcher_Match(t *testing.T) {
+	matcher := &ExUnitContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"Phoenix ConnCase", "defmodule MyAppWeb.PageControllerTest do\n  use MyAppWeb.ConnCase\nend", true},
+		{"DataCase with options", "  use MyApp.DataCase, async: true", true},
+		{"test macro", "  test \"renders\", %{conn: conn} do", true},
+		{"plain module", "defmodule MyApp.Cart do\n  use GenServer\nend", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{
+				Type:    framework.SignalFileContent,
+				Context: []byte(tt.content),
+			})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func parse(t *testing.T, source string) *domain.TestFile {
+	t.Helper()
+	file, err := (&ExUnitParser{}).Parse(context.Background(), []byte(source), "test/my_app/cart_test.exs")
+	require.NoError(t, err)
+	return file
+}
+
+func TestExUnitParser_Parse(t *testing.T) {
+	file := parse(t, `defmodule MyApp.CartTest do
+  use ExUnit.Case, async: true
+
+  doctest MyApp.Cart
+
+  setup do
+    {:ok, cart: MyApp.Cart.new()}
+  end
+
+  describe "add/2" do
+    test "adds an item", %{cart: cart} do
+      assert MyApp.Cart.add(cart, :apple)
+    end
+
+    test "rejects unknown items" do
+      refute MyApp.Cart.add(%{}, :unknown)
+    end
+  end
+
+  test "starts empty", do: assert(MyApp.Cart.new() == %{})
+end
+`)
+
+	assert.Equal(t, "exunit", file.Framework)
+	assert.Equal(t, domain.LanguageElixir, file.Language)
+	require.Len(t, file.Suites, 1)
+
+	module := file.Suites[0]
+	assert.Equal(t, "MyApp.CartTest", module.Name)
+	assert.Equal(t, 1, module.Location.StartLine)
+	require.Len(t, module.Suites, 2)
+
+	doctest := module.Suites[0]
+	assert.Equal(t, "doctest MyApp.Cart", doctest.Name)
+	assert.Empty(t, doctest.Tests)
+	assert.Equal(t, 4, doctest.Location.StartLine)
+
+	describe := module.Suites[1]
+	assert.Equal(t, "add/2", describe.Name)
+	require.Len(t, describe.Tests, 2)
+	assert.Equal(t, "adds an item", describe.Tests[0].Name)
+	assert.Equal(t, 11, describe.Tests[0].Location.StartLine)
+	assert.Equal(t, 13, describe.Tests[0].Location.EndLine)
+	assert.Equal(t, "rejects unknown items", describe.Tests[1].Name)
+
+	require.Len(t, module.Tests, 1)
+	assert.Equal(t, "starts empty", module.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, module.Tests[0].Status)
+}
+
+func TestExUnitParser_Tags(t *testing.T) {
+	file := parse(t, `defmodule MyApp.TagsTest do
+  use ExUnit.Case
+
+  @moduletag :integration
+
+  describe "api" do
+    @describetag :slow
+
+    @tag :skip
+    test "skipped" do
+    end
+
+    @tag skip: "needs network"
+    test "skipped with reason" do
+    end
+
+    @tag :pending
+    test "pending" do
+    end
+
+    @tag db: true, type: :repo, timeout: 60_000
+    @tag :external
+    test "tagged" do
+    end
+
+    test "not implemented"
+
+    test "plain" do
+    end
+  end
+end
+`)
+
+	require.Len(t, file.Suites, 1)
+	module := file.Suites[0]
+	assert.Equal(t, []string{"integration"}, module.Tags)
+	require.Len(t, module.Suites, 1)
+
+	describe := module.Suites[0]
+	assert.Equal(t, []string{"slow"}, describe.Tags)
+	require.Len(t, describe.Tests, 6)
+
+	tests := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+		reason   string
+		tags     []string
+	}{
+		{"skipped", domain.TestStatusSkipped, "@tag :skip", "", nil},
+		{"skipped with reason", domain.TestStatusSkipped, "@tag :skip", "needs network", nil},
+		{"pending", domain.TestStatusTodo, "@tag :pending", "", nil},
+		{"tagged", domain.TestStatusActive, "", "", []string{"db", "type:repo", "external"}},
+		{"not implemented", domain.TestStatusTodo, "not_implemented", "", nil},
+		{"plain", domain.TestStatusActive, "", "", nil},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := describe.Tests[i]
+			assert.Equal(t, tt.name, test.Name)
+			assert.Equal(t, tt.status, test.Status)
+			assert.Equal(t, tt.modifier, test.Modifier)
+			assert.Equal(t, tt.reason, test.SkipReason)
+			assert.Equal(t, tt.tags, test.Tags)
+		})
+	}
+}
+
+func TestExUnitParser_InheritedSkip(t *testing.T) {
+	file := parse(t, `defmodule MyApp.LegacyTest do
+  use ExUnit.Case
+  @moduletag skip: "rewrite pending"
+
+  test "old behaviour" do
+  end
+
+  describe "nested" do
+    @describetag :pending
+
+    test "inner" do
+    end
+  end
+end
+`)
+
+	require.Len(t, file.Suites, 1)
+	module := file.Suites[0]
+	assert.Equal(t, domain.TestStatusSkipped, module.Status)
+	assert.Equal(t, "rewrite pending", module.SkipReason)
+
+	require.Len(t, module.Tests, 1)
+	assert.Equal(t, domain.TestStatusSkipped, module.Tests[0].Status)
+	assert.Equal(t, "@moduletag :skip", module.Tests[0].Modifier)
+	assert.Equal(t, "rewrite pending", module.Tests[0].SkipReason)
+
+	require.Len(t, module.Suites, 1)
+	require.Len(t, module.Suites[0].Tests, 1)
+	assert.Equal(t, domain.TestStatusTodo, module.Suites[0].Status)
+	assert.Equal(t, domain.TestStatusTodo, module.Suites[0].Tests[0].Status)
+	assert.Equal(t, "@describetag :pending", module.Suites[0].Tests[0].Modifier)
+}
+
+func TestExUnitParser_GeneratedTests(t *testing.T) {
+	file := parse(t, `defmodule MyApp.ParserTest do
+  use ExUnit.Case
+
+  for input <- ["1", "2"] do
+    test "parses #{input}" do
+      assert MyApp.Parser.parse(unquote(input))
+    end
+  end
+end
+
+defmodule MyApp.Helpers do
+  def fixture, do: :ok
+end
+`)
+
+	require.Len(t, file.Suites, 1)
+	require.Len(t, file.Suites[0].Tests, 1)
+	assert.Equal(t, "parses #{input}", file.Suites[0].Tests[0].Name)
+}
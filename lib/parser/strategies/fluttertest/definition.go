@@ -0,0 +1,78 @@
+// Package fluttertest implements flutter_test support for Flutter widget tests.
+// flutter_test re-exports the package:test API and adds testWidgets; golden
+// tests from golden_toolkit (testGoldens) are reported here as well.
+package fluttertest
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/darttest"
+)
+
+const frameworkName = framework.FrameworkFlutterTest
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the flutter_test definition. It is checked before
+// package:test because Flutter test files use the same group/test API.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageDart},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"package:flutter_test/",
+				"package:golden_toolkit/",
+				"package:integration_test/",
+			),
+			&FlutterTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &FlutterTestParser{},
+		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"expect", "expectLater", "expectAsync*", "fail"},
+	}
+}
+
+// FlutterTestContentMatcher matches Flutter-only test APIs.
+type FlutterTestContentMatcher struct{}
+
+var flutterTestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\btestWidgets\s*\(`), "testWidgets()"},
+	{regexp.MustCompile(`\btestGoldens\s*\(`), "testGoldens()"},
+	{regexp.MustCompile(`\bWidgetTester\b`), "WidgetTester"},
+}
+
+func (m *FlutterTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range flutterTestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found flutter_test pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type FlutterTestParser struct{}
+
+func (p *FlutterTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return darttest.Parse(ctx, source, filename, frameworkName)
+}
@@ -0,0 +1,103 @@
+package fluttertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "flutter-test" {
+		t.Errorf("expected Name to be 'flutter-test', got %q", def.Name)
+	}
+	if def.Priority != framework.PrioritySpecialized {
+		t.Errorf("expected Priority to be %d, got %d", framework.PrioritySpecialized, def.Priority)
+	}
+	if len(def.Languages) != 1 || def.Languages[0] != domain.LanguageDart {
+		t.Errorf("expected Languages [dart], got %v", def.Languages)
+	}
+}
+
+func TestFlutterTestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"package:flutter_test/flutter_test.dart", true},
+		{"package:golden_toolkit/golden_toolkit.dart", true},
+		{"package:integration_test/integration_test.dart", true},
+		{"package:test/test.dart", false},
+		{"package:flutter/material.dart", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			signal := framework.Signal{Type: framework.SignalImport, Value: tt.importPath}
+			result := def.Matchers[0].Match(ctx, signal)
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("expected match=%v, got confidence %d", tt.expected, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestFlutterTestContentMatcher_Match(t *testing.T) {
+	matcher := &FlutterTestContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"testWidgets", "testWidgets('renders', (tester) async {});", true},
+		{"testGoldens", "testGoldens('golden', (tester) async {});", true},
+		{"WidgetTester", "Future<void> pump(WidgetTester tester) async {}", true},
+		{"plain package:test", "test('adds', () {});", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{
+				Type:    framework.SignalFileContent,
+				Context: []byte(tt.content),
+			})
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("expected match=%v, got confidence %d", tt.expected, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestFlutterTestParser_Parse(t *testing.T) {
+	source := `import 'package:flutter_test/flutter_test.dart';
+
+void main() {
+  group('Counter', () {
+    testWidgets('increments', (WidgetTester tester) async {});
+    testWidgets('decrements', (tester) async {}, skip: true);
+  });
+}
+`
+	file, err := (&FlutterTestParser{}).Parse(context.Background(), []byte(source), "counter_test.dart")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if file.Framework != "flutter-test" {
+		t.Errorf("expected framework flutter-test, got %q", file.Framework)
+	}
+	if len(file.Suites) != 1 || len(file.Suites[0].Tests) != 2 {
+		t.Fatalf("expected 1 suite with 2 tests, got %+v", file.Suites)
+	}
+	if file.Suites[0].Tests[1].Status != domain.TestStatusSkipped {
+		t.Errorf("expected second test skipped, got %q", file.Suites[0].Tests[1].Status)
+	}
+}
@@ -0,0 +1,434 @@
+// Package gherkin implements support for Gherkin .feature files run by Cucumber,
+// behave, SpecFlow and other BDD runners.
+//
+// There is no tree-sitter grammar for Gherkin; the format is line oriented, so
+// the parser reads it line by line like the reference Gherkin parser does.
+package gherkin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+const frameworkName = framework.FrameworkGherkin
+
+// Status tags. They are reported through Status instead of Tags.
+const (
+	tagWIP      = "wip"
+	tagIgnore   = "ignore"
+	tagSkip     = "skip"
+	tagDisabled = "disabled"
+)
+
+type keyword int
+
+const (
+	keywordNone keyword = iota
+	keywordFeature
+	keywordRule
+	keywordBackground
+	keywordScenario
+	keywordOutline
+	keywordExamples
+)
+
+// keywords maps the English Gherkin keywords (including synonyms) to their role.
+var keywords = map[string]keyword{
+	"Feature":           keywordFeature,
+	"Business Need":     keywordFeature,
+	"Ability":           keywordFeature,
+	"Rule":              keywordRule,
+	"Background":        keywordBackground,
+	"Scenario":          keywordScenario,
+	"Example":           keywordScenario,
+	"Scenario Outline":  keywordOutline,
+	"Scenario Template": keywordOutline,
+	"Examples":          keywordExamples,
+	"Scenarios":         keywordExamples,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Gherkin definition. Every .feature file is Gherkin,
+// so the extension alone confirms the framework. Scenarios describe behavior
+// through the whole application, so they are e2e tests.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageGherkin},
+		Matchers: []framework.Matcher{
+			&GherkinFilenameMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &GherkinParser{},
+		Priority:     framework.PriorityGeneric,
+		DefaultKind:  domain.TestKindE2E,
+	}
+}
+
+// GherkinFilenameMatcher matches *.feature files.
+type GherkinFilenameMatcher struct{}
+
+func (m *GherkinFilenameMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.HasSuffix(strings.ToLower(signal.Value), ".feature") {
+		return framework.DefiniteMatch("filename: *.feature")
+	}
+
+	return framework.NoMatch()
+}
+
+// GherkinParser maps Feature to a suite, Rule to a nested suite and each
+// Scenario or Scenario Outline to a test.
+type GherkinParser struct{}
+
+func (p *GherkinParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	state := &parseState{
+		expand:   framework.ParseOptionsFromContext(ctx).ExpandParameterized,
+		filename: filename,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(source)+1)
+	for scanner.Scan() {
+		state.line++
+		state.processLine(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("gherkin parser: failed to read %s: %w", filename, err)
+	}
+	state.closeScenario()
+	state.closeRule()
+	state.closeFeature()
+
+	testFile := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageGherkin,
+		Framework: frameworkName,
+	}
+	if state.feature != nil {
+		testFile.Suites = []domain.TestSuite{*state.feature}
+	}
+	return testFile, nil
+}
+
+// examplesBlock is one Examples table of a Scenario Outline.
+type examplesBlock struct {
+	header []string
+	rows   [][]string
+	status domain.TestStatus
+	mod    string
+}
+
+// scenario is the test being read, with the Examples tables of an outline.
+type scenario struct {
+	test     domain.Test
+	outline  bool
+	examples []*examplesBlock
+}
+
+type parseState struct {
+	expand   bool
+	filename string
+	line     int
+
+	feature  *domain.TestSuite
+	rule     *domain.TestSuite
+	current  *scenario
+	examples *examplesBlock
+
+	pendingTags []string
+	docString   string
+	lastLine    int
+}
+
+func (s *parseState) processLine(raw string) {
+	text := strings.TrimSpace(raw)
+
+	if s.docString != "" {
+		if strings.HasPrefix(text, s.docString) {
+			s.docString = ""
+		}
+		s.lastLine = s.line
+		return
+	}
+
+	switch {
+	case text == "" || strings.HasPrefix(text, "#"):
+		return
+	case strings.HasPrefix(text, `"""`), strings.HasPrefix(text, "```"):
+		s.docString = text[:3]
+		s.lastLine = s.line
+		return
+	case strings.HasPrefix(text, "@"):
+		s.pendingTags = append(s.pendingTags, parseTags(text)...)
+		return
+	case strings.HasPrefix(text, "|"):
+		s.addTableRow(text)
+		s.lastLine = s.line
+		return
+	}
+
+	kw, name := parseKeyword(text)
+	if kw == keywordNone {
+		// Steps and free-form descriptions extend the current element.
+		s.lastLine = s.line
+		return
+	}
+
+	tags := s.pendingTags
+	s.pendingTags = nil
+
+	switch kw {
+	case keywordFeature:
+		s.closeScenario()
+		s.closeRule()
+		s.closeFeature()
+		suite := s.newSuite(name, tags)
+		s.feature = &suite
+	case keywordRule:
+		s.closeScenario()
+		s.closeRule()
+		suite := s.newSuite(name, tags)
+		s.rule = &suite
+	case keywordBackground:
+		s.closeScenario()
+	case keywordScenario, keywordOutline:
+		s.closeScenario()
+		s.openScenario(name, tags, kw == keywordOutline)
+	case keywordExamples:
+		if s.current == nil || !s.current.outline {
+			return
+		}
+		status, mod, _ := splitStatusTags(tags)
+		s.examples = &examplesBlock{status: status, mod: mod}
+		s.current.examples = append(s.current.examples, s.examples)
+	}
+	s.lastLine = s.line
+}
+
+func (s *parseState) newSuite(name string, tags []string) domain.TestSuite {
+	status, mod, own := splitStatusTags(tags)
+	return domain.TestSuite{
+		Name:     name,
+		Status:   status,
+		Modifier: mod,
+		Location: s.location(s.line),
+		Tags:     own,
+	}
+}
+
+func (s *parseState) openScenario(name string, tags []string, outline bool) {
+	status, mod, own := splitStatusTags(tags)
+	if status == domain.TestStatusActive {
+		status, mod = s.inheritedStatus()
+	}
+	s.current = &scenario{
+		test: domain.Test{
+			Name:     name,
+			Status:   status,
+			Modifier: mod,
+			Location: s.location(s.line),
+			Tags:     own,
+		},
+		outline: outline,
+	}
+}
+
+// inheritedStatus returns the status of the innermost enclosing Rule or Feature.
+func (s *parseState) inheritedStatus() (domain.TestStatus, string) {
+	for _, suite := range []*domain.TestSuite{s.rule, s.feature} {
+		if suite != nil && suite.Status != domain.TestStatusActive {
+			return suite.Status, suite.Modifier
+		}
+	}
+	return domain.TestStatusActive, ""
+}
+
+func (s *parseState) addTableRow(text string) {
+	if s.examples == nil {
+		// Step data table.
+		return
+	}
+	cells := parseTableRow(text)
+	if s.examples.header == nil {
+		s.examples.header = cells
+		return
+	}
+	s.examples.rows = append(s.examples.rows, cells)
+}
+
+func (s *parseState) closeScenario() {
+	if s.current == nil {
+		return
+	}
+	sc := s.current
+	s.current = nil
+	s.examples = nil
+	sc.test.Location.EndLine = s.lastLine
+
+	parent := s.container()
+	if parent == nil {
+		return
+	}
+	parent.Tests = append(parent.Tests, sc.expand(s.expand)...)
+}
+
+func (s *parseState) closeRule() {
+	if s.rule == nil {
+		return
+	}
+	s.rule.Location.EndLine = s.lastLine
+	if s.feature != nil {
+		s.feature.Suites = append(s.feature.Suites, *s.rule)
+	}
+	s.rule = nil
+}
+
+func (s *parseState) closeFeature() {
+	if s.feature != nil {
+		s.feature.Location.EndLine = s.lastLine
+	}
+}
+
+// container returns the suite new scenarios belong to.
+func (s *parseState) container() *domain.TestSuite {
+	if s.rule != nil {
+		return s.rule
+	}
+	return s.feature
+}
+
+func (s *parseState) location(line int) domain.Location {
+	return domain.Location{
+		File:      s.filename,
+		StartLine: line,
+		EndLine:   line,
+	}
+}
+
+// expand returns the scenario as a single test, or one test per Examples row
+// when expansion is enabled. Row names substitute <placeholders> the way
+// Cucumber does, with the table and row number appended when the name has none.
+func (sc *scenario) expand(enabled bool) []domain.Test {
+	if !enabled || !sc.outline {
+		return []domain.Test{sc.test}
+	}
+
+	var names []string
+	var blocks []*examplesBlock
+	for i, block := range sc.examples {
+		for j, row := range block.rows {
+			name := substitute(sc.test.Name, block.header, row)
+			if name == sc.test.Name {
+				name += " #" + strconv.Itoa(i+1) + "." + strconv.Itoa(j+1)
+			}
+			names = append(names, name)
+			blocks = append(blocks, block)
+		}
+	}
+
+	tests := parameterized.Expand(sc.test, names)
+	if tests == nil {
+		return []domain.Test{sc.test}
+	}
+	for i := range tests {
+		if block := blocks[i]; block.status != domain.TestStatusActive && tests[i].Status == domain.TestStatusActive {
+			tests[i].Status = block.status
+			tests[i].Modifier = block.mod
+		}
+	}
+	return tests
+}
+
+func substitute(name string, header, row []string) string {
+	for i, column := range header {
+		if i < len(row) {
+			name = strings.ReplaceAll(name, "<"+column+">", row[i])
+		}
+	}
+	return name
+}
+
+// parseKeyword returns the keyword that starts a line and the name after its colon.
+func parseKeyword(text string) (keyword, string) {
+	colon := strings.Index(text, ":")
+	if colon <= 0 {
+		return keywordNone, ""
+	}
+	kw, ok := keywords[strings.TrimSpace(text[:colon])]
+	if !ok {
+		return keywordNone, ""
+	}
+	return kw, strings.TrimSpace(text[colon+1:])
+}
+
+// parseTags returns the tag names of a tag line, without the @ prefix.
+func parseTags(text string) []string {
+	var tags []string
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "#") {
+			break
+		}
+		if tag := strings.TrimPrefix(field, "@"); tag != "" && tag != field {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// splitStatusTags separates status tags (@wip, @ignore, ...) from the other tags.
+// Skipping tags win over @wip.
+func splitStatusTags(tags []string) (domain.TestStatus, string, []string) {
+	status, mod := domain.TestStatusActive, ""
+	var own []string
+	for _, tag := range tags {
+		switch strings.ToLower(tag) {
+		case tagIgnore, tagSkip, tagDisabled:
+			status, mod = domain.TestStatusSkipped, "@"+tag
+		case tagWIP:
+			if status == domain.TestStatusActive {
+				status, mod = domain.TestStatusTodo, "@"+tag
+			}
+		default:
+			own = append(own, tag)
+		}
+	}
+	return status, mod, own
+}
+
+// parseTableRow splits a | a | b | row into trimmed cells, honouring \| escapes.
+func parseTableRow(text string) []string {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "|")
+	text = strings.TrimSuffix(text, "|")
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text) && text[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case text[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(text[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
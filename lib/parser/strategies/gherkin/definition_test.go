@@ -0,0 +1,194 @@
+package gherkin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+const loginFeature = `# language: en
+@auth @smoke
+Feature: Login
+  As a user
+  I want to sign in
+
+  Background:
+    Given the app is running
+
+  Scenario: Successful login
+    Given a registered user
+    When they sign in with:
+      | user | pass |
+      | bob  | x    |
+    Then they see the dashboard
+
+  @wip
+  Scenario: Locked account
+    Given a locked user
+
+  Rule: Passwords expire
+
+    @slow
+    Scenario Outline: Expired password for <role>
+      Given a <role> whose password expired
+      """
+      Scenario: not real
+      """
+      Then they must reset it
+
+      Examples: staff
+        | role  |
+        | admin |
+        | clerk |
+
+      @ignore
+      Examples:
+        | role  |
+        | guest |
+`
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "gherkin", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageGherkin}, def.Languages)
+	assert.Nil(t, def.ConfigParser)
+	assert.NotNil(t, def.Parser)
+}
+
+func TestGherkinFilenameMatcher_Match(t *testing.T) {
+	matcher := &GherkinFilenameMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		filename string
+		expected bool
+	}{
+		{"login.feature", true},
+		{"Checkout.FEATURE", true},
+		{"login.feature.md", false},
+		{"login_test.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			assert.Equal(t, tt.expected, result.Confidence == 100)
+		})
+	}
+}
+
+func TestGherkinParser_Parse(t *testing.T) {
+	file, err := (&GherkinParser{}).Parse(context.Background(), []byte(loginFeature), "features/login.feature")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.LanguageGherkin, file.Language)
+	assert.Equal(t, "gherkin", file.Framework)
+	require.Len(t, file.Suites, 1)
+
+	feature := file.Suites[0]
+	assert.Equal(t, "Login", feature.Name)
+	assert.Equal(t, []string{"auth", "smoke"}, feature.Tags)
+	assert.Equal(t, 3, feature.Location.StartLine)
+	assert.Equal(t, 39, feature.Location.EndLine)
+
+	require.Len(t, feature.Tests, 2)
+	assert.Equal(t, "Successful login", feature.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, feature.Tests[0].Status)
+	assert.Equal(t, 10, feature.Tests[0].Location.StartLine)
+	assert.Equal(t, 15, feature.Tests[0].Location.EndLine)
+
+	assert.Equal(t, "Locked account", feature.Tests[1].Name)
+	assert.Equal(t, domain.TestStatusTodo, feature.Tests[1].Status)
+	assert.Equal(t, "@wip", feature.Tests[1].Modifier)
+	assert.Empty(t, feature.Tests[1].Tags)
+
+	require.Len(t, feature.Suites, 1)
+	rule := feature.Suites[0]
+	assert.Equal(t, "Passwords expire", rule.Name)
+	require.Len(t, rule.Tests, 1, "outline is a single test without expansion")
+	assert.Equal(t, "Expired password for <role>", rule.Tests[0].Name)
+	assert.Equal(t, []string{"slow"}, rule.Tests[0].Tags)
+	assert.Nil(t, rule.Tests[0].Template)
+}
+
+func TestGherkinParser_ExpandExamples(t *testing.T) {
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	file, err := (&GherkinParser{}).Parse(ctx, []byte(loginFeature), "features/login.feature")
+	require.NoError(t, err)
+
+	tests := file.Suites[0].Suites[0].Tests
+	require.Len(t, tests, 3)
+
+	names := []string{tests[0].Name, tests[1].Name, tests[2].Name}
+	assert.Equal(t, []string{
+		"Expired password for admin",
+		"Expired password for clerk",
+		"Expired password for guest",
+	}, names)
+	assert.Equal(t, "Expired password for <role>", tests[0].Template.Name)
+	assert.Equal(t, 2, tests[2].Template.Index)
+	assert.Equal(t, domain.TestStatusActive, tests[1].Status)
+	assert.Equal(t, domain.TestStatusSkipped, tests[2].Status, "rows of an @ignore Examples table are skipped")
+	assert.Equal(t, "@ignore", tests[2].Modifier)
+}
+
+func TestGherkinParser_ExpandWithoutPlaceholders(t *testing.T) {
+	source := `Feature: Cart
+  Scenario Outline: Adding items
+    Given <count> items
+    Examples:
+      | count |
+      | 1     |
+      | 2     |
+`
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	file, err := (&GherkinParser{}).Parse(ctx, []byte(source), "cart.feature")
+	require.NoError(t, err)
+
+	tests := file.Suites[0].Tests
+	require.Len(t, tests, 2)
+	assert.Equal(t, "Adding items #1.1", tests[0].Name)
+	assert.Equal(t, "Adding items #1.2", tests[1].Name)
+}
+
+func TestGherkinParser_FeatureStatusInherited(t *testing.T) {
+	source := `@ignore
+Feature: Legacy export
+
+  Scenario: Export CSV
+    Given data
+
+  Rule: PDF
+    Scenario: Export PDF
+      Given data
+`
+	file, err := (&GherkinParser{}).Parse(context.Background(), []byte(source), "export.feature")
+	require.NoError(t, err)
+
+	feature := file.Suites[0]
+	assert.Equal(t, domain.TestStatusSkipped, feature.Status)
+	assert.Empty(t, feature.Tags)
+	assert.Equal(t, domain.TestStatusSkipped, feature.Tests[0].Status)
+	assert.Equal(t, domain.TestStatusSkipped, feature.Suites[0].Tests[0].Status)
+}
+
+func TestGherkinParser_EmptyFile(t *testing.T) {
+	file, err := (&GherkinParser{}).Parse(context.Background(), []byte("# just a comment\n"), "empty.feature")
+	require.NoError(t, err)
+
+	assert.Empty(t, file.Suites)
+	assert.Equal(t, 0, file.CountTests())
+}
+
+func TestParseTableRow(t *testing.T) {
+	assert.Equal(t, []string{"a", "b|c", ""}, parseTableRow(`| a | b\|c |  |`))
+}
@@ -0,0 +1,338 @@
+// Package ginkgo implements Ginkgo BDD test framework support for Go test files.
+package ginkgo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkGinkgo
+
+const (
+	nodeCallExpression           = "call_expression"
+	nodeFuncLiteral              = "func_literal"
+	nodeIdentifier               = "identifier"
+	nodeInterpretedStringLiteral = "interpreted_string_literal"
+	nodeRawStringLiteral         = "raw_string_literal"
+	nodeSelectorExpression       = "selector_expression"
+	nodeExpressionStatement      = "expression_statement"
+
+	decoratorFocus   = "Focus"
+	decoratorLabel   = "Label"
+	decoratorPending = "Pending"
+	funcSkip         = "Skip"
+
+	// dynamicNamePlaceholder is used for entries whose description is generated at runtime.
+	dynamicNamePlaceholder = "(dynamic)"
+)
+
+type nodeKind int
+
+const (
+	kindNone nodeKind = iota
+	kindContainer
+	kindSpec
+	kindTable
+	kindEntry
+)
+
+var dslKinds = map[string]nodeKind{
+	"Describe":      kindContainer,
+	"Context":       kindContainer,
+	"When":          kindContainer,
+	"It":            kindSpec,
+	"Specify":       kindSpec,
+	"DescribeTable": kindTable,
+	"Entry":         kindEntry,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Ginkgo definition. Ginkgo suites are ordinary
+// *_test.go files, so detection relies on the ginkgo import taking precedence
+// over go-testing.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageGo},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"github.com/onsi/ginkgo",
+				"github.com/onsi/ginkgo/",
+			),
+		},
+		ConfigParser: nil,
+		Parser:       &GinkgoParser{},
+		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"Expect*", "Ω", "Eventually*", "Consistently*", "Fail"},
+	}
+}
+
+type GinkgoParser struct{}
+
+func (p *GinkgoParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageGo, source)
+	if err != nil {
+		return nil, fmt.Errorf("ginkgo parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	testFile := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageGo,
+		Framework: frameworkName,
+	}
+
+	walkNodes(tree.RootNode(), source, filename, nil, testFile)
+
+	return testFile, nil
+}
+
+// walkNodes finds DSL calls below node. Container bodies are walked for nested
+// nodes; spec bodies are not, since Ginkgo forbids registering nodes inside them.
+func walkNodes(node *sitter.Node, source []byte, filename string, parentSuite *domain.TestSuite, file *domain.TestFile) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == nodeCallExpression {
+			if name, kind := dslCall(child, source); kind != kindNone {
+				processNode(child, name, kind, source, filename, parentSuite, file)
+				continue
+			}
+		}
+		walkNodes(child, source, filename, parentSuite, file)
+	}
+}
+
+// dslCall resolves a call to a Ginkgo DSL function, accepting dot-imported
+// (Describe) and qualified (ginkgo.Describe) forms and the F/P/X prefixes.
+func dslCall(call *sitter.Node, source []byte) (string, nodeKind) {
+	name := calleeName(call, source)
+	if kind, ok := dslKinds[name]; ok {
+		return name, kind
+	}
+	if len(name) > 1 && strings.ContainsRune("FPX", rune(name[0])) {
+		if kind, ok := dslKinds[name[1:]]; ok {
+			return name, kind
+		}
+	}
+	return "", kindNone
+}
+
+func calleeName(call *sitter.Node, source []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil {
+		return ""
+	}
+	switch fn.Type() {
+	case nodeIdentifier:
+		return parser.GetNodeText(fn, source)
+	case nodeSelectorExpression:
+		if field := fn.ChildByFieldName("field"); field != nil {
+			return parser.GetNodeText(field, source)
+		}
+	}
+	return ""
+}
+
+// nodeArgs is the decoded argument list of a DSL call.
+type nodeArgs struct {
+	name     string
+	body     *sitter.Node
+	entries  []*sitter.Node
+	focus    bool
+	pending  bool
+	labels   []string
+	hasName  bool
+	location domain.Location
+}
+
+func parseArgs(call *sitter.Node, source []byte, filename string) nodeArgs {
+	result := nodeArgs{location: parser.GetLocation(call, filename)}
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return result
+	}
+
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		switch arg.Type() {
+		case nodeInterpretedStringLiteral, nodeRawStringLiteral:
+			if i == 0 {
+				result.name = unquote(parser.GetNodeText(arg, source))
+				result.hasName = true
+			}
+		case nodeFuncLiteral:
+			if result.body == nil {
+				result.body = arg
+			}
+		case nodeIdentifier:
+			switch parser.GetNodeText(arg, source) {
+			case decoratorFocus:
+				result.focus = true
+			case decoratorPending:
+				result.pending = true
+			}
+		case nodeCallExpression:
+			if _, kind := dslCall(arg, source); kind == kindEntry {
+				result.entries = append(result.entries, arg)
+				continue
+			}
+			if calleeName(arg, source) == decoratorLabel {
+				result.labels = append(result.labels, stringArgs(arg, source)...)
+			}
+		}
+	}
+	return result
+}
+
+// status derives the node status from its prefix (FIt, PIt, XIt) or decorators.
+func (a nodeArgs) status(name string) (domain.TestStatus, string) {
+	switch {
+	case name[0] == 'P' || name[0] == 'X':
+		return domain.TestStatusSkipped, name
+	case a.pending:
+		return domain.TestStatusSkipped, decoratorPending
+	case name[0] == 'F':
+		return domain.TestStatusFocused, name
+	case a.focus:
+		return domain.TestStatusFocused, decoratorFocus
+	}
+	return domain.TestStatusActive, ""
+}
+
+func processNode(call *sitter.Node, name string, kind nodeKind, source []byte, filename string, parentSuite *domain.TestSuite, file *domain.TestFile) {
+	args := parseArgs(call, source, filename)
+	status, modifier := args.status(name)
+
+	switch kind {
+	case kindContainer, kindTable:
+		if !args.hasName {
+			return
+		}
+		suite := domain.TestSuite{
+			Name:     args.name,
+			Status:   status,
+			Modifier: modifier,
+			Location: args.location,
+			Tags:     args.labels,
+		}
+		if kind == kindContainer && args.body != nil {
+			if body := args.body.ChildByFieldName("body"); body != nil {
+				walkNodes(body, source, filename, &suite, file)
+			}
+		}
+		for _, entry := range args.entries {
+			entryName, _ := dslCall(entry, source)
+			processNode(entry, entryName, kindEntry, source, filename, &suite, file)
+		}
+		addSuite(suite, parentSuite, file)
+
+	case kindSpec:
+		if !args.hasName {
+			return
+		}
+		test := domain.Test{
+			Name:     args.name,
+			Status:   status,
+			Modifier: modifier,
+			Location: args.location,
+			Tags:     args.labels,
+		}
+		if args.body == nil && status == domain.TestStatusActive {
+			// Ginkgo treats a spec without a body as pending.
+			test.Status = domain.TestStatusTodo
+		} else if status == domain.TestStatusActive {
+			applySkip(args.body, source, &test)
+		}
+		addTest(test, parentSuite, file)
+
+	case kindEntry:
+		entryName := args.name
+		if !args.hasName {
+			entryName = dynamicNamePlaceholder
+		}
+		addTest(domain.Test{
+			Name:     entryName,
+			Status:   status,
+			Modifier: modifier,
+			Location: args.location,
+			Tags:     args.labels,
+		}, parentSuite, file)
+	}
+}
+
+// applySkip marks the spec skipped when its body unconditionally calls Skip.
+func applySkip(fn *sitter.Node, source []byte, test *domain.Test) {
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		if stmt.Type() != nodeExpressionStatement || stmt.NamedChildCount() == 0 {
+			continue
+		}
+		call := stmt.NamedChild(0)
+		if call.Type() != nodeCallExpression || calleeName(call, source) != funcSkip {
+			continue
+		}
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = funcSkip
+		if reasons := stringArgs(call, source); len(reasons) > 0 {
+			test.SkipReason = reasons[0]
+		}
+		return
+	}
+}
+
+func stringArgs(call *sitter.Node, source []byte) []string {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+	var values []string
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		switch arg := args.NamedChild(i); arg.Type() {
+		case nodeInterpretedStringLiteral, nodeRawStringLiteral:
+			values = append(values, unquote(parser.GetNodeText(arg, source)))
+		}
+	}
+	return values
+}
+
+func unquote(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	if len(s) >= 2 {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func addSuite(suite domain.TestSuite, parentSuite *domain.TestSuite, file *domain.TestFile) {
+	if parentSuite != nil {
+		parentSuite.Suites = append(parentSuite.Suites, suite)
+		return
+	}
+	file.Suites = append(file.Suites, suite)
+}
+
+func addTest(test domain.Test, parentSuite *domain.TestSuite, file *domain.TestFile) {
+	if parentSuite != nil {
+		parentSuite.Tests = append(parentSuite.Tests, test)
+		return
+	}
+	file.Tests = append(file.Tests, test)
+}
@@ -0,0 +1,171 @@
+package ginkgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "ginkgo", def.Name)
+	assert.Equal(t, framework.PrioritySpecialized, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageGo}, def.Languages)
+	assert.Nil(t, def.ConfigParser)
+	assert.NotNil(t, def.Parser)
+	assert.Len(t, def.Matchers, 1)
+}
+
+func TestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath  string
+		shouldMatch bool
+	}{
+		{"github.com/onsi/ginkgo", true},
+		{"github.com/onsi/ginkgo/v2", true},
+		{"github.com/onsi/ginkgo/extensions/table", true},
+		{"github.com/onsi/gomega", false},
+		{"testing", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.importPath})
+			assert.Equal(t, tt.shouldMatch, result.Confidence > 0)
+		})
+	}
+}
+
+func TestGinkgoParser_Parse(t *testing.T) {
+	source := `
+package books_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBooks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Books Suite")
+}
+
+var _ = Describe("Book", Label("books"), func() {
+	var book *Book
+
+	BeforeEach(func() {
+		book = &Book{}
+	})
+
+	Context("with more than 300 pages", func() {
+		It("is a novel", func() {
+			Expect(book.Category()).To(Equal(CategoryNovel))
+		})
+
+		FIt("is focused", func() {})
+	})
+
+	When("the author is unknown", func() {
+		PIt("is pending", func() {})
+		It("is pending by decorator", Pending, func() {})
+		It("has no body yet")
+		It("is skipped at runtime", func() {
+			Skip("needs fixture")
+		})
+	})
+
+	XDescribe("disabled", func() {
+		It("still listed", func() {})
+	})
+})
+`
+	testFile, err := (&GinkgoParser{}).Parse(context.Background(), []byte(source), "book_test.go")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ginkgo", testFile.Framework)
+	assert.Empty(t, testFile.Tests, "RunSpecs bootstrap should not be a test")
+	require.Len(t, testFile.Suites, 1)
+
+	book := testFile.Suites[0]
+	assert.Equal(t, "Book", book.Name)
+	assert.Equal(t, []string{"books"}, book.Tags)
+	require.Len(t, book.Suites, 3)
+
+	context := book.Suites[0]
+	assert.Equal(t, "with more than 300 pages", context.Name)
+	require.Len(t, context.Tests, 2)
+	assert.Equal(t, domain.TestStatusActive, context.Tests[0].Status)
+	assert.Equal(t, domain.TestStatusFocused, context.Tests[1].Status)
+	assert.Equal(t, "FIt", context.Tests[1].Modifier)
+
+	when := book.Suites[1]
+	require.Len(t, when.Tests, 4)
+	assert.Equal(t, domain.TestStatusSkipped, when.Tests[0].Status)
+	assert.Equal(t, "PIt", when.Tests[0].Modifier)
+	assert.Equal(t, domain.TestStatusSkipped, when.Tests[1].Status)
+	assert.Equal(t, "Pending", when.Tests[1].Modifier)
+	assert.Equal(t, domain.TestStatusTodo, when.Tests[2].Status)
+	assert.Equal(t, domain.TestStatusSkipped, when.Tests[3].Status)
+	assert.Equal(t, "needs fixture", when.Tests[3].SkipReason)
+
+	disabled := book.Suites[2]
+	assert.Equal(t, domain.TestStatusSkipped, disabled.Status)
+	assert.Equal(t, "XDescribe", disabled.Modifier)
+	require.Len(t, disabled.Tests, 1)
+}
+
+func TestGinkgoParser_DescribeTable(t *testing.T) {
+	source := `
+package math_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+)
+
+var _ = ginkgo.Describe("Math", func() {
+	ginkgo.DescribeTable("adding",
+		func(a, b, sum int) {},
+		ginkgo.Entry("small numbers", 1, 2, 3),
+		ginkgo.FEntry("negative numbers", -1, -2, -3),
+		ginkgo.Entry("labeled", ginkgo.Label("slow"), 100, 200, 300),
+		ginkgo.XEntry("overflow", 1<<62, 1<<62, 0),
+		ginkgo.Entry(nil, 0, 0, 0),
+	)
+})
+`
+	testFile, err := (&GinkgoParser{}).Parse(context.Background(), []byte(source), "math_test.go")
+	require.NoError(t, err)
+	require.Len(t, testFile.Suites, 1)
+	require.Len(t, testFile.Suites[0].Suites, 1)
+
+	table := testFile.Suites[0].Suites[0]
+	assert.Equal(t, "adding", table.Name)
+	require.Len(t, table.Tests, 5)
+
+	expected := []struct {
+		name   string
+		status domain.TestStatus
+	}{
+		{"small numbers", domain.TestStatusActive},
+		{"negative numbers", domain.TestStatusFocused},
+		{"labeled", domain.TestStatusActive},
+		{"overflow", domain.TestStatusSkipped},
+		{"(dynamic)", domain.TestStatusActive},
+	}
+	for i, want := range expected {
+		assert.Equal(t, want.name, table.Tests[i].Name)
+		assert.Equal(t, want.status, table.Tests[i].Status)
+	}
+	assert.Equal(t, []string{"slow"}, table.Tests[2].Tags)
+}
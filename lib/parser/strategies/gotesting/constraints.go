@@ -0,0 +1,72 @@
+package gotesting
+
+import (
+	"bufio"
+	"bytes"
+	"go/build/constraint"
+	"strings"
+)
+
+// parseBuildTags returns the build tags a file requires through its
+// //go:build (or legacy // +build) constraint, e.g. "integration" for
+// //go:build integration && !short. Negated tags and Go version tags are omitted.
+func parseBuildTags(source []byte) []string {
+	var expr constraint.Expr
+
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "/*") {
+			continue
+		}
+		// Constraints must appear before the package clause.
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+		if !constraint.IsGoBuild(line) && !constraint.IsPlusBuild(line) {
+			continue
+		}
+
+		parsed, err := constraint.Parse(line)
+		if err != nil {
+			continue
+		}
+		if constraint.IsGoBuild(line) {
+			// //go:build takes precedence over // +build lines.
+			expr = parsed
+			break
+		}
+		if expr == nil {
+			expr = parsed
+		} else {
+			expr = &constraint.AndExpr{X: expr, Y: parsed}
+		}
+	}
+
+	var tags []string
+	collectBuildTags(expr, false, &tags)
+	return tags
+}
+
+func collectBuildTags(expr constraint.Expr, negated bool, tags *[]string) {
+	switch e := expr.(type) {
+	case *constraint.TagExpr:
+		if negated || strings.HasPrefix(e.Tag, "go1.") {
+			return
+		}
+		for _, tag := range *tags {
+			if tag == e.Tag {
+				return
+			}
+		}
+		*tags = append(*tags, e.Tag)
+	case *constraint.NotExpr:
+		collectBuildTags(e.X, !negated, tags)
+	case *constraint.AndExpr:
+		collectBuildTags(e.X, negated, tags)
+		collectBuildTags(e.Y, negated, tags)
+	case *constraint.OrExpr:
+		collectBuildTags(e.X, negated, tags)
+		collectBuildTags(e.Y, negated, tags)
+	}
+}
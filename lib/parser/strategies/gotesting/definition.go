@@ -13,6 +13,7 @@ import (
 	"github.com/kubrickcode/specvital/lib/parser/domain"
 	"github.com/kubrickcode/specvital/lib/parser/framework"
 	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
 )
 
 const (
@@ -27,6 +28,8 @@ const (
 	nodeRawStringLiteral         = "raw_string_literal"
 	nodeIdentifier               = "identifier"
 	nodeBinaryExpression         = "binary_expression"
+	nodeExpressionStatement      = "expression_statement"
+	nodeFuncLiteral              = "func_literal"
 	methodRun                    = "Run"
 	typeTestingB                 = "testing.B"
 	typeTestingF                 = "testing.F"
@@ -62,9 +65,36 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil, // Go doesn't have config files
 		Parser:       &GoTestingParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions: append([]string{
+			"t.Error*", "t.Fatal*", "t.Fail*", "b.Error*", "b.Fatal*", "b.Fail*", "f.Error*", "f.Fatal*", "f.Fail*",
+			"assert.*", "require.*", // testify
+			"*.Require.*", "*.Assert.*", // testify suites: s.Require().NoError
+		}, suiteAssertions()...),
 	}
 }
 
+// suiteAssertionMethods are the testify assertions suite.Suite promotes,
+// called on the suite receiver (s.Equal).
+var suiteAssertionMethods = []string{
+	"Condition", "Contains", "DirExists", "ElementsMatch", "Empty", "Equal*", "Error*", "Eventually*",
+	"Exactly", "Fail*", "False", "FileExists", "Greater*", "HTTP*", "Implements", "InDelta*", "InEpsilon*",
+	"IsType", "JSONEq", "Len", "Less*", "Negative", "Never", "Nil", "NoError*", "Not*", "Panics*",
+	"Positive", "Regexp", "Same", "Subset", "True", "WithinDuration", "YAMLEq", "Zero",
+}
+
+// suiteReceivers are the receiver names conventionally given to testify suites.
+var suiteReceivers = []string{"s", "suite"}
+
+func suiteAssertions() []string {
+	patterns := make([]string, 0, len(suiteReceivers)*len(suiteAssertionMethods))
+	for _, receiver := range suiteReceivers {
+		for _, method := range suiteAssertionMethods {
+			patterns = append(patterns, receiver+"."+method)
+		}
+	}
+	return patterns
+}
+
 // GoTestFileMatcher matches *_test.go files.
 type GoTestFileMatcher struct{}
 
@@ -90,7 +120,18 @@ func (p *GoTestingParser) Parse(ctx context.Context, source []byte, filename str
 	defer tree.Close()
 	root := tree.RootNode()
 
-	suites, tests := parseTestFunctions(root, source, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites, tests := parseTestFunctions(root, source, filename, expand)
+
+	// Build constraints gate the whole file, so they apply to every top-level test.
+	if tags := parseBuildTags(source); len(tags) > 0 {
+		for i := range suites {
+			suites[i].Tags = tags
+		}
+		for i := range tests {
+			tests[i].Tags = tags
+		}
+	}
 
 	testFile := &domain.TestFile{
 		Path:      filename,
@@ -103,7 +144,9 @@ func (p *GoTestingParser) Parse(ctx context.Context, source []byte, filename str
 	return testFile, nil
 }
 
-func extractSubtests(body *sitter.Node, source []byte, filename string) []domain.Test {
+// extractSubtests collects t.Run subtests. With expand, table-driven subtests whose
+// names resolve statically are expanded into one test per case.
+func extractSubtests(root, body *sitter.Node, source []byte, filename string, expand bool) []domain.Test {
 	var subtests []domain.Test
 
 	parser.WalkTree(body, func(node *sitter.Node) bool {
@@ -117,7 +160,7 @@ func extractSubtests(body *sitter.Node, source []byte, filename string) []domain
 		}
 
 		field := funcNode.ChildByFieldName("field")
-		if field == nil || parser.GetNodeText(field, source) != methodRun {
+		if field == nil || parser.GetNodeText(field, source) != methodRun || isSuiteRunCall(node, source) {
 			return true
 		}
 
@@ -131,11 +174,22 @@ func extractSubtests(body *sitter.Node, source []byte, filename string) []domain
 			return true
 		}
 
-		subtests = append(subtests, domain.Test{
+		subtest := domain.Test{
 			Name:     name,
 			Status:   domain.TestStatusActive,
 			Location: parser.GetLocation(node, filename),
-		})
+		}
+		if fn := findSubtestFunc(args); fn != nil {
+			applySkip(fn.ChildByFieldName("body"), source, &subtest.Status, &subtest.Modifier, &subtest.SkipReason)
+		}
+		if expand && name == dynamicNamePlaceholder {
+			names := resolveTableNames(root, node, args.NamedChild(0), source)
+			if cases := parameterized.Expand(subtest, names); cases != nil {
+				subtests = append(subtests, cases...)
+				return true
+			}
+		}
+		subtests = append(subtests, subtest)
 
 		return true
 	})
@@ -143,6 +197,75 @@ func extractSubtests(body *sitter.Node, source []byte, filename string) []domain
 	return subtests
 }
 
+func findSubtestFunc(args *sitter.Node) *sitter.Node {
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		if arg := args.NamedChild(i); arg.Type() == nodeFuncLiteral {
+			return arg
+		}
+	}
+	return nil
+}
+
+// findSkipCall returns the first t.Skip/t.Skipf/t.SkipNow call made directly
+// in the body. Calls nested in conditionals are runtime decisions and ignored.
+func findSkipCall(body *sitter.Node, source []byte) *sitter.Node {
+	if body == nil {
+		return nil
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		if stmt.Type() != nodeExpressionStatement || stmt.NamedChildCount() == 0 {
+			continue
+		}
+
+		call := stmt.NamedChild(0)
+		if call.Type() != nodeCallExpression {
+			continue
+		}
+
+		funcNode := call.ChildByFieldName("function")
+		if funcNode == nil || funcNode.Type() != nodeSelectorExpression {
+			continue
+		}
+
+		field := funcNode.ChildByFieldName("field")
+		if field == nil {
+			continue
+		}
+
+		switch parser.GetNodeText(field, source) {
+		case "Skip", "Skipf", "SkipNow":
+			return call
+		}
+	}
+
+	return nil
+}
+
+// applySkip marks the test skipped when its body unconditionally skips,
+// recording the first argument as the reason when it is a string literal.
+func applySkip(body *sitter.Node, source []byte, status *domain.TestStatus, modifier *string, reason *string) {
+	call := findSkipCall(body, source)
+	if call == nil {
+		return
+	}
+
+	funcNode := call.ChildByFieldName("function")
+	*status = domain.TestStatusSkipped
+	*modifier = parser.GetNodeText(funcNode, source)
+
+	args := call.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return
+	}
+
+	switch first := args.NamedChild(0); first.Type() {
+	case nodeInterpretedStringLiteral, nodeRawStringLiteral:
+		*reason = trimQuotes(parser.GetNodeText(first, source))
+	}
+}
+
 func extractSubtestName(args *sitter.Node, source []byte) string {
 	for i := 0; i < int(args.ChildCount()); i++ {
 		child := args.Child(i)
@@ -197,10 +320,27 @@ func classifyTestFunction(name string) goTestFuncType {
 	return funcTypeNone
 }
 
-func parseTestFunctions(root *sitter.Node, source []byte, filename string) ([]domain.TestSuite, []domain.Test) {
+// funcKind maps non-test function types to their kind. Test functions are left
+// empty so the scanner can classify them by path (integration, e2e) or default to unit.
+func funcKind(funcType goTestFuncType) domain.TestKind {
+	switch funcType {
+	case funcTypeBenchmark:
+		return domain.TestKindBenchmark
+	case funcTypeExample:
+		return domain.TestKindExample
+	case funcTypeFuzz:
+		return domain.TestKindFuzz
+	}
+	return ""
+}
+
+func parseTestFunctions(root *sitter.Node, source []byte, filename string, expand bool) ([]domain.TestSuite, []domain.Test) {
 	var suites []domain.TestSuite
 	var tests []domain.Test
 
+	testifySuites := parseTestifySuites(root, source, filename, expand)
+	attached := make(map[string]bool)
+
 	for i := 0; i < int(root.ChildCount()); i++ {
 		child := root.Child(i)
 		if child.Type() != nodeFunctionDeclaration {
@@ -217,33 +357,84 @@ func parseTestFunctions(root *sitter.Node, source []byte, filename string) ([]do
 			continue
 		}
 
-		body := child.ChildByFieldName("body")
-		var subtests []domain.Test
-		if body != nil && funcType == funcTypeTest {
-			subtests = extractSubtests(body, source, filename)
+		// A runner calling suite.Run(t, new(MySuite)) reports the suite's methods
+		// as its subtests (TestMySuite/TestMethod).
+		var members []*testifySuite
+		if body := child.ChildByFieldName("body"); body != nil && funcType == funcTypeTest {
+			for _, typeName := range findSuiteRunTypes(body, source) {
+				if s := findTestifySuite(testifySuites, typeName); s != nil && !s.empty() && !attached[typeName] {
+					attached[typeName] = true
+					members = append(members, s)
+				}
+			}
+		}
+		if len(members) == 0 {
+			addTestFunction(root, child, name, source, filename, expand && funcType == funcTypeTest, &suites, &tests)
+			continue
 		}
 
-		if len(subtests) > 0 {
-			suite := domain.TestSuite{
-				Name:     name,
-				Status:   domain.TestStatusActive,
-				Location: parser.GetLocation(child, filename),
-				Tests:    subtests,
-			}
-			suites = append(suites, suite)
-		} else {
-			test := domain.Test{
-				Name:     name,
-				Status:   domain.TestStatusActive,
-				Location: parser.GetLocation(child, filename),
-			}
-			tests = append(tests, test)
+		suite := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(child, filename),
+			Tests:    extractSubtests(root, child.ChildByFieldName("body"), source, filename, expand),
+		}
+		for _, s := range members {
+			suite.Tests = append(suite.Tests, s.methods...)
+			suite.Suites = append(suite.Suites, s.suites...)
+		}
+		applySkip(child.ChildByFieldName("body"), source, &suite.Status, &suite.Modifier, &suite.SkipReason)
+		suites = append(suites, suite)
+	}
+
+	// Suites run from another file are grouped under their type name.
+	for _, s := range testifySuites {
+		if attached[s.name] || s.empty() {
+			continue
 		}
+		suites = append(suites, domain.TestSuite{
+			Name:     s.name,
+			Status:   domain.TestStatusActive,
+			Location: s.location,
+			Tests:    s.methods,
+			Suites:   s.suites,
+		})
 	}
 
 	return suites, tests
 }
 
+// addTestFunction adds a test function or suite method: a suite when it has
+// t.Run subtests, otherwise a single test.
+func addTestFunction(root, decl *sitter.Node, name string, source []byte, filename string, expand bool, suites *[]domain.TestSuite, tests *[]domain.Test) {
+	body := decl.ChildByFieldName("body")
+	var subtests []domain.Test
+	if body != nil && classifyTestFunction(name) == funcTypeTest {
+		subtests = extractSubtests(root, body, source, filename, expand)
+	}
+
+	if len(subtests) > 0 {
+		suite := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(decl, filename),
+			Tests:    subtests,
+		}
+		applySkip(body, source, &suite.Status, &suite.Modifier, &suite.SkipReason)
+		*suites = append(*suites, suite)
+		return
+	}
+
+	test := domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(decl, filename),
+		Kind:     funcKind(classifyTestFunction(name)),
+	}
+	applySkip(body, source, &test.Status, &test.Modifier, &test.SkipReason)
+	*tests = append(*tests, test)
+}
+
 func trimQuotes(s string) string {
 	if unquoted, err := strconv.Unquote(s); err == nil {
 		return unquoted
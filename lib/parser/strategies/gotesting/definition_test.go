@@ -464,6 +464,11 @@ func FuzzInput(f *testing.F) {}
 	assert.Equal(t, "BenchmarkPerf", testFile.Tests[1].Name)
 	assert.Equal(t, "ExampleUsage", testFile.Tests[2].Name)
 	assert.Equal(t, "FuzzInput", testFile.Tests[3].Name)
+
+	assert.Empty(t, testFile.Tests[0].Kind, "Test functions are classified by the scanner")
+	assert.Equal(t, domain.TestKindBenchmark, testFile.Tests[1].Kind)
+	assert.Equal(t, domain.TestKindExample, testFile.Tests[2].Kind)
+	assert.Equal(t, domain.TestKindFuzz, testFile.Tests[3].Kind)
 }
 
 func TestClassifyTestFunction(t *testing.T) {
@@ -675,3 +680,365 @@ func TestLiteral(t *testing.T) {
 		})
 	}
 }
+
+func TestGoTestingParser_BuildConstraintTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   []string
+	}{
+		{
+			name:   "go:build expression",
+			header: "//go:build integration && (postgres || mysql) && !short && go1.21\n",
+			want:   []string{"integration", "postgres", "mysql"},
+		},
+		{
+			name:   "legacy plus build lines",
+			header: "// +build e2e\n// +build !windows\n",
+			want:   []string{"e2e"},
+		},
+		{
+			name:   "no constraint",
+			header: "// Package test has no constraint.\n",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSource := tt.header + `
+package test
+
+import "testing"
+
+func TestPlain(t *testing.T) {}
+
+func TestWithSubtests(t *testing.T) {
+	t.Run("case", func(t *testing.T) {})
+}
+`
+			testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(testSource), "tagged_test.go")
+
+			require.NoError(t, err)
+			require.Len(t, testFile.Tests, 1)
+			require.Len(t, testFile.Suites, 1)
+			assert.Equal(t, tt.want, testFile.Tests[0].Tags)
+			assert.Equal(t, tt.want, testFile.Suites[0].Tags)
+			assert.Nil(t, testFile.Suites[0].Tests[0].Tags)
+		})
+	}
+}
+
+func TestGoTestingParser_SkipReason(t *testing.T) {
+	testSource := `package test
+
+import "testing"
+
+func TestFlaky(t *testing.T) {
+	t.Skip("flaky on CI")
+}
+
+func TestNoReason(t *testing.T) {
+	t.SkipNow()
+}
+
+func TestConditional(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode")
+	}
+}
+
+func TestSubtests(t *testing.T) {
+	t.Run("skipped", func(t *testing.T) {
+		t.Skipf("see issue %d", 42)
+	})
+	t.Run("active", func(t *testing.T) {})
+}
+`
+	testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(testSource), "skip_test.go")
+
+	require.NoError(t, err)
+	require.Len(t, testFile.Tests, 3)
+	require.Len(t, testFile.Suites, 1)
+
+	assert.Equal(t, domain.TestStatusSkipped, testFile.Tests[0].Status)
+	assert.Equal(t, "t.Skip", testFile.Tests[0].Modifier)
+	assert.Equal(t, "flaky on CI", testFile.Tests[0].SkipReason)
+
+	assert.Equal(t, domain.TestStatusSkipped, testFile.Tests[1].Status)
+	assert.Empty(t, testFile.Tests[1].SkipReason)
+
+	assert.Equal(t, domain.TestStatusActive, testFile.Tests[2].Status)
+	assert.Empty(t, testFile.Tests[2].SkipReason)
+
+	subtests := testFile.Suites[0].Tests
+	require.Len(t, subtests, 2)
+	assert.Equal(t, domain.TestStatusSkipped, subtests[0].Status)
+	assert.Equal(t, "see issue %d", subtests[0].SkipReason)
+	assert.Equal(t, domain.TestStatusActive, subtests[1].Status)
+}
+
+func TestGoTestingParser_TestifySuite(t *testing.T) {
+	t.Run("should group suite methods under the runner", func(t *testing.T) {
+		source := `
+package calc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CalcSuite struct {
+	suite.Suite
+	calc *Calc
+}
+
+func (s *CalcSuite) SetupTest() { s.calc = New() }
+
+func (s *CalcSuite) TestAdd() {
+	s.Run("positive", func() {})
+	s.Run("negative", func() {})
+}
+
+func (s *CalcSuite) TestDivide() {
+	s.T().Skip("pending rewrite")
+}
+
+func (s *CalcSuite) TestHelper(n int) {}
+
+func TestCalcSuite(t *testing.T) {
+	suite.Run(t, new(CalcSuite))
+}
+`
+		testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(source), "calc_test.go")
+		require.NoError(t, err)
+		assert.Empty(t, testFile.Tests)
+		require.Len(t, testFile.Suites, 1)
+
+		runner := testFile.Suites[0]
+		assert.Equal(t, "TestCalcSuite", runner.Name)
+		require.Len(t, runner.Tests, 1)
+		assert.Equal(t, "TestDivide", runner.Tests[0].Name)
+		assert.Equal(t, domain.TestStatusSkipped, runner.Tests[0].Status)
+		assert.Equal(t, "pending rewrite", runner.Tests[0].SkipReason)
+		require.Len(t, runner.Suites, 1)
+		assert.Equal(t, "TestAdd", runner.Suites[0].Name)
+		require.Len(t, runner.Suites[0].Tests, 2)
+		assert.Equal(t, "positive", runner.Suites[0].Tests[0].Name)
+	})
+
+	t.Run("should resolve suites embedding another suite and pointer literals", func(t *testing.T) {
+		source := `
+package calc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BaseSuite struct{ suite.Suite }
+
+type DBSuite struct{ BaseSuite }
+
+func (s *DBSuite) TestQuery() {}
+
+func TestDB(t *testing.T) {
+	suite.Run(t, &DBSuite{})
+}
+`
+		testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(source), "db_test.go")
+		require.NoError(t, err)
+		require.Len(t, testFile.Suites, 1)
+		assert.Equal(t, "TestDB", testFile.Suites[0].Name)
+		require.Len(t, testFile.Suites[0].Tests, 1)
+		assert.Equal(t, "TestQuery", testFile.Suites[0].Tests[0].Name)
+	})
+
+	t.Run("should group methods by type when the runner is in another file", func(t *testing.T) {
+		source := `
+package calc
+
+import "github.com/stretchr/testify/suite"
+
+type CalcSuite struct{ suite.Suite }
+
+func (s *CalcSuite) TestAdd() {}
+func (s CalcSuite) TestSub() {}
+`
+		testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(source), "calc_methods_test.go")
+		require.NoError(t, err)
+		require.Len(t, testFile.Suites, 1)
+		assert.Equal(t, "CalcSuite", testFile.Suites[0].Name)
+		require.Len(t, testFile.Suites[0].Tests, 2)
+		assert.Equal(t, "TestSub", testFile.Suites[0].Tests[1].Name)
+	})
+
+	t.Run("should keep a runner whose suite is declared elsewhere as a single test", func(t *testing.T) {
+		source := `
+package calc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCalcSuite(t *testing.T) {
+	suite.Run(t, new(CalcSuite))
+}
+`
+		testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(source), "calc_test.go")
+		require.NoError(t, err)
+		assert.Empty(t, testFile.Suites)
+		require.Len(t, testFile.Tests, 1)
+		assert.Equal(t, "TestCalcSuite", testFile.Tests[0].Name)
+	})
+}
+
+func TestGoTestingParser_TableDrivenExpansion(t *testing.T) {
+	tests := []struct {
+		name          string
+		source        string
+		expectedNames []string
+	}{
+		{
+			name: "should expand keyed struct slice",
+			source: `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	tests := []struct {
+		name string
+		in   int
+	}{
+		{name: "zero", in: 0},
+		{name: "one", in: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+`,
+			expectedNames: []string{"zero", "one"},
+		},
+		{
+			name: "should expand positional elements of a named type",
+			source: `
+package test
+import "testing"
+type testCase struct {
+	in, desc string
+}
+var cases = []testCase{
+	{"a", "first"},
+	{"b", "second"},
+}
+func TestTable(t *testing.T) {
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {})
+	}
+}
+`,
+			expectedNames: []string{"first", "second"},
+		},
+		{
+			name: "should expand map keys",
+			source: `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	for name, tc := range map[string]struct{ in int }{
+		"small": {1},
+		"large": {100},
+	} {
+		t.Run(name, func(t *testing.T) { _ = tc })
+	}
+}
+`,
+			expectedNames: []string{"small", "large"},
+		},
+		{
+			name: "should expand string slice",
+			source: `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	for _, format := range []string{"json", "yaml"} {
+		t.Run(format, func(t *testing.T) {})
+	}
+}
+`,
+			expectedNames: []string{"json", "yaml"},
+		},
+		{
+			name: "should keep placeholder when a name is not a literal",
+			source: `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	tests := []struct{ name string }{{name: "a"}, {name: prefix + "b"}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+`,
+			expectedNames: []string{"(dynamic)"},
+		},
+		{
+			name: "should keep placeholder when the table is built at runtime",
+			source: `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	for _, tc := range loadCases() {
+		t.Run(tc.name, func(t *testing.T) {})
+	}
+}
+`,
+			expectedNames: []string{"(dynamic)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+			testFile, err := (&GoTestingParser{}).Parse(ctx, []byte(tt.source), "table_test.go")
+			require.NoError(t, err)
+			require.Len(t, testFile.Suites, 1)
+
+			var names []string
+			for _, test := range testFile.Suites[0].Tests {
+				names = append(names, test.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+
+			if tt.expectedNames[0] != dynamicNamePlaceholder {
+				for i, test := range testFile.Suites[0].Tests {
+					require.NotNil(t, test.Template)
+					assert.Equal(t, i, test.Template.Index)
+					assert.Equal(t, dynamicNamePlaceholder, test.Template.Name)
+				}
+			}
+		})
+	}
+
+	t.Run("should not expand without the option", func(t *testing.T) {
+		source := `
+package test
+import "testing"
+func TestTable(t *testing.T) {
+	for _, format := range []string{"json", "yaml"} {
+		t.Run(format, func(t *testing.T) {})
+	}
+}
+`
+		testFile, err := (&GoTestingParser{}).Parse(context.Background(), []byte(source), "table_test.go")
+		require.NoError(t, err)
+		require.Len(t, testFile.Suites, 1)
+		require.Len(t, testFile.Suites[0].Tests, 1)
+		assert.Equal(t, dynamicNamePlaceholder, testFile.Suites[0].Tests[0].Name)
+	})
+}
@@ -0,0 +1,372 @@
+package gotesting
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+)
+
+const (
+	nodeCompositeLiteral     = "composite_literal"
+	nodeExpressionList       = "expression_list"
+	nodeFieldDeclaration     = "field_declaration"
+	nodeFieldDeclarationList = "field_declaration_list"
+	nodeForStatement         = "for_statement"
+	nodeKeyedElement         = "keyed_element"
+	nodeLiteralElement       = "literal_element"
+	nodeLiteralValue         = "literal_value"
+	nodeMapType              = "map_type"
+	nodeMethodDeclaration    = "method_declaration"
+	nodeRangeClause          = "range_clause"
+	nodeShortVarDeclaration  = "short_var_declaration"
+	nodeStructType           = "struct_type"
+	nodeTypeIdentifier       = "type_identifier"
+	nodeTypeSpec             = "type_spec"
+	nodeUnaryExpression      = "unary_expression"
+	nodeVarSpec              = "var_spec"
+)
+
+// tableCase is one element of a table literal: the map key (if any) and the element value.
+type tableCase struct {
+	key   *sitter.Node
+	value *sitter.Node
+}
+
+// resolveTableNames statically resolves the subtest names of a table-driven test:
+// t.Run(tc.name, ...) or t.Run(name, ...) inside a range loop over a slice or map
+// literal, either inline or bound to a variable in the test function or package.
+// Returns nil unless every case name is a string literal.
+func resolveTableNames(root, call, nameArg *sitter.Node, source []byte) []string {
+	var varName, field string
+	switch nameArg.Type() {
+	case nodeIdentifier:
+		varName = parser.GetNodeText(nameArg, source)
+	case nodeSelectorExpression:
+		operand := nameArg.ChildByFieldName("operand")
+		fieldNode := nameArg.ChildByFieldName("field")
+		if operand == nil || fieldNode == nil || operand.Type() != nodeIdentifier {
+			return nil
+		}
+		varName = parser.GetNodeText(operand, source)
+		field = parser.GetNodeText(fieldNode, source)
+	default:
+		return nil
+	}
+
+	loop, isKey := findRangeLoop(call, varName, source)
+	if loop == nil || (isKey && field != "") {
+		return nil
+	}
+
+	literal := resolveTableLiteral(root, loop, loop.ChildByFieldName("right"), source)
+	if literal == nil {
+		return nil
+	}
+
+	typeNode := literal.ChildByFieldName("type")
+	cases := tableCases(literal.ChildByFieldName("body"), typeNode)
+	if len(cases) == 0 {
+		return nil
+	}
+
+	var fields []string
+	if field != "" {
+		fields = structFields(root, tableElementType(typeNode), source)
+	}
+
+	names := make([]string, 0, len(cases))
+	for _, c := range cases {
+		var nameNode *sitter.Node
+		switch {
+		case isKey:
+			nameNode = c.key
+		case field == "":
+			nameNode = c.value
+		default:
+			nameNode = structFieldValue(c.value, field, fields, source)
+		}
+		name, ok := stringLiteral(nameNode, source)
+		if !ok {
+			return nil
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// findRangeLoop returns the range clause of the innermost enclosing for loop
+// that declares varName, and whether varName is the loop key rather than the value.
+func findRangeLoop(call *sitter.Node, varName string, source []byte) (*sitter.Node, bool) {
+	for node := call.Parent(); node != nil; node = node.Parent() {
+		switch node.Type() {
+		case nodeFunctionDeclaration, nodeMethodDeclaration:
+			return nil, false
+		case nodeForStatement:
+			clause := parser.FindChildByType(node, nodeRangeClause)
+			if clause == nil {
+				continue
+			}
+			left := clause.ChildByFieldName("left")
+			if left == nil {
+				continue
+			}
+			vars := identifiers(left, source)
+			isMap := false
+			if right := clause.ChildByFieldName("right"); right != nil && right.Type() == nodeCompositeLiteral {
+				if typeNode := right.ChildByFieldName("type"); typeNode != nil {
+					isMap = typeNode.Type() == nodeMapType
+				}
+			}
+			if len(vars) > 1 && vars[1] == varName {
+				return clause, false
+			}
+			// A single range variable over a map is its key; over a slice, its index.
+			if len(vars) > 0 && vars[0] == varName {
+				if len(vars) == 1 && !isMap {
+					return nil, false
+				}
+				return clause, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func identifiers(list *sitter.Node, source []byte) []string {
+	var names []string
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		child := list.NamedChild(i)
+		if child.Type() != nodeIdentifier {
+			return nil
+		}
+		names = append(names, parser.GetNodeText(child, source))
+	}
+	return names
+}
+
+// resolveTableLiteral returns the composite literal a range loop iterates over.
+// Identifiers are resolved to a literal declaration in the enclosing function,
+// falling back to package-level var declarations.
+func resolveTableLiteral(root, loop, expr *sitter.Node, source []byte) *sitter.Node {
+	if expr == nil {
+		return nil
+	}
+	if expr.Type() == nodeCompositeLiteral {
+		return expr
+	}
+	if expr.Type() != nodeIdentifier {
+		return nil
+	}
+
+	name := parser.GetNodeText(expr, source)
+	for node := loop.Parent(); node != nil; node = node.Parent() {
+		if node.Type() == nodeFunctionDeclaration || node.Type() == nodeMethodDeclaration {
+			if literal := findLiteralDeclaration(node.ChildByFieldName("body"), name, source); literal != nil {
+				return literal
+			}
+			break
+		}
+	}
+	return findLiteralDeclaration(root, name, source)
+}
+
+func findLiteralDeclaration(scope *sitter.Node, name string, source []byte) *sitter.Node {
+	if scope == nil {
+		return nil
+	}
+
+	var found *sitter.Node
+	parser.WalkTree(scope, func(node *sitter.Node) bool {
+		if found != nil {
+			return false
+		}
+		switch node.Type() {
+		case nodeFuncLiteral:
+			return false
+		case nodeShortVarDeclaration:
+			found = matchDeclaredLiteral(node.ChildByFieldName("left"), node.ChildByFieldName("right"), name, source)
+		case nodeVarSpec:
+			var names []string
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				if child := node.NamedChild(i); child.Type() == nodeIdentifier {
+					names = append(names, parser.GetNodeText(child, source))
+				}
+			}
+			value := node.ChildByFieldName("value")
+			if value == nil {
+				return true
+			}
+			for i, n := range names {
+				if n == name && i < int(value.NamedChildCount()) {
+					if literal := value.NamedChild(i); literal.Type() == nodeCompositeLiteral {
+						found = literal
+					}
+				}
+			}
+		}
+		return found == nil
+	})
+	return found
+}
+
+func matchDeclaredLiteral(left, right *sitter.Node, name string, source []byte) *sitter.Node {
+	if left == nil || right == nil {
+		return nil
+	}
+	for i, n := range identifiers(left, source) {
+		if n == name && i < int(right.NamedChildCount()) {
+			if literal := right.NamedChild(i); literal.Type() == nodeCompositeLiteral {
+				return literal
+			}
+		}
+	}
+	return nil
+}
+
+// tableCases lists the elements of a slice, array or map literal body.
+func tableCases(body, typeNode *sitter.Node) []tableCase {
+	if body == nil || typeNode == nil {
+		return nil
+	}
+	isMap := typeNode.Type() == nodeMapType
+
+	var cases []tableCase
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		element := body.NamedChild(i)
+		switch element.Type() {
+		case nodeLiteralElement:
+			if isMap {
+				return nil
+			}
+			cases = append(cases, tableCase{value: unwrapElement(element)})
+		case nodeKeyedElement:
+			if !isMap || element.NamedChildCount() < 2 {
+				return nil
+			}
+			cases = append(cases, tableCase{
+				key:   unwrapElement(element.NamedChild(0)),
+				value: unwrapElement(element.NamedChild(1)),
+			})
+		}
+	}
+	return cases
+}
+
+func unwrapElement(node *sitter.Node) *sitter.Node {
+	if node != nil && node.Type() == nodeLiteralElement && node.NamedChildCount() > 0 {
+		return node.NamedChild(0)
+	}
+	return node
+}
+
+// tableElementType returns the element type of a slice/array literal or the value type of a map literal.
+func tableElementType(typeNode *sitter.Node) *sitter.Node {
+	if typeNode == nil {
+		return nil
+	}
+	if typeNode.Type() == nodeMapType {
+		return typeNode.ChildByFieldName("value")
+	}
+	return typeNode.ChildByFieldName("element")
+}
+
+// structFields returns the field names of a struct type in declaration order.
+// Named types and pointers to them are resolved against type declarations in the file.
+func structFields(root, typeNode *sitter.Node, source []byte) []string {
+	if typeNode != nil && typeNode.Type() == nodePointerType && typeNode.NamedChildCount() > 0 {
+		typeNode = typeNode.NamedChild(0)
+	}
+	if typeNode != nil && typeNode.Type() == nodeTypeIdentifier {
+		typeNode = findTypeDeclaration(root, parser.GetNodeText(typeNode, source), source)
+	}
+	if typeNode == nil || typeNode.Type() != nodeStructType {
+		return nil
+	}
+
+	list := parser.FindChildByType(typeNode, nodeFieldDeclarationList)
+	if list == nil {
+		return nil
+	}
+
+	var fields []string
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		decl := list.NamedChild(i)
+		if decl.Type() != nodeFieldDeclaration {
+			continue
+		}
+		named := false
+		for j := 0; j < int(decl.ChildCount()); j++ {
+			if decl.FieldNameForChild(j) == "name" {
+				fields = append(fields, parser.GetNodeText(decl.Child(j), source))
+				named = true
+			}
+		}
+		if !named {
+			// Embedded field: occupies a position but cannot hold the name.
+			fields = append(fields, "")
+		}
+	}
+	return fields
+}
+
+func findTypeDeclaration(root *sitter.Node, name string, source []byte) *sitter.Node {
+	var found *sitter.Node
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if found != nil {
+			return false
+		}
+		if node.Type() == nodeTypeSpec {
+			if n := node.ChildByFieldName("name"); n != nil && parser.GetNodeText(n, source) == name {
+				found = node.ChildByFieldName("type")
+			}
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// structFieldValue returns the value of field in a struct literal element,
+// matching keyed elements by name and positional elements by field order.
+func structFieldValue(value *sitter.Node, field string, fields []string, source []byte) *sitter.Node {
+	if value != nil && value.Type() == nodeUnaryExpression && value.NamedChildCount() > 0 {
+		value = value.NamedChild(0)
+	}
+	if value != nil && value.Type() == nodeCompositeLiteral {
+		value = value.ChildByFieldName("body")
+	}
+	if value == nil || value.Type() != nodeLiteralValue {
+		return nil
+	}
+
+	position := 0
+	for i := 0; i < int(value.NamedChildCount()); i++ {
+		element := value.NamedChild(i)
+		switch element.Type() {
+		case nodeKeyedElement:
+			if element.NamedChildCount() < 2 {
+				continue
+			}
+			if parser.GetNodeText(unwrapElement(element.NamedChild(0)), source) == field {
+				return unwrapElement(element.NamedChild(1))
+			}
+		case nodeLiteralElement:
+			if position < len(fields) && fields[position] == field {
+				return unwrapElement(element)
+			}
+			position++
+		}
+	}
+	return nil
+}
+
+func stringLiteral(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	switch node.Type() {
+	case nodeInterpretedStringLiteral, nodeRawStringLiteral:
+		return trimQuotes(parser.GetNodeText(node, source)), true
+	}
+	return "", false
+}
@@ -0,0 +1,226 @@
+package gotesting
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+const (
+	nodeTypeDeclaration = "type_declaration"
+	testifySuiteType    = "suite.Suite"
+	testifySuitePackage = "suite"
+	funcNew             = "new"
+)
+
+// testifySuite is a testify suite type and its Test* methods declared in the file.
+type testifySuite struct {
+	name     string
+	location domain.Location
+	methods  []domain.Test
+	suites   []domain.TestSuite
+}
+
+// parseTestifySuites collects testify suites: struct types embedding suite.Suite
+// (directly or through another suite type in the file) and types passed to
+// suite.Run, with their exported Test* methods in declaration order.
+func parseTestifySuites(root *sitter.Node, source []byte, filename string, expand bool) []*testifySuite {
+	suiteTypes := findSuiteTypes(root, source)
+	for _, name := range findSuiteRunTypes(root, source) {
+		suiteTypes[name] = true
+	}
+	if len(suiteTypes) == 0 {
+		return nil
+	}
+
+	var suites []*testifySuite
+	for i := 0; i < int(root.ChildCount()); i++ {
+		child := root.Child(i)
+		switch child.Type() {
+		case nodeTypeDeclaration:
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				spec := child.NamedChild(j)
+				if spec.Type() != nodeTypeSpec {
+					continue
+				}
+				name := extractTestName(spec, source)
+				if suiteTypes[name] && findTestifySuite(suites, name) == nil {
+					suites = append(suites, &testifySuite{name: name, location: parser.GetLocation(spec, filename)})
+				}
+			}
+		case nodeMethodDeclaration:
+			receiver := receiverTypeName(child, source)
+			if !suiteTypes[receiver] {
+				continue
+			}
+			name := extractTestName(child, source)
+			if classifyTestFunction(name) != funcTypeTest || hasParameters(child) {
+				continue
+			}
+			s := findTestifySuite(suites, receiver)
+			if s == nil {
+				s = &testifySuite{name: receiver, location: parser.GetLocation(child, filename)}
+				suites = append(suites, s)
+			}
+			addTestFunction(root, child, name, source, filename, expand, &s.suites, &s.methods)
+		}
+	}
+	return suites
+}
+
+func (s *testifySuite) empty() bool {
+	return len(s.methods) == 0 && len(s.suites) == 0
+}
+
+func findTestifySuite(suites []*testifySuite, name string) *testifySuite {
+	for _, s := range suites {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// findSuiteTypes returns struct types that embed suite.Suite, following
+// embedding chains through other suite types declared in the same file.
+func findSuiteTypes(root *sitter.Node, source []byte) map[string]bool {
+	embeds := make(map[string][]string)
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != nodeTypeSpec {
+			return true
+		}
+		name := node.ChildByFieldName("name")
+		typeNode := node.ChildByFieldName("type")
+		if name == nil || typeNode == nil || typeNode.Type() != nodeStructType {
+			return false
+		}
+		embeds[parser.GetNodeText(name, source)] = embeddedTypes(typeNode, source)
+		return false
+	})
+
+	suiteTypes := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for name, embedded := range embeds {
+			if suiteTypes[name] {
+				continue
+			}
+			for _, e := range embedded {
+				if e == testifySuiteType || suiteTypes[e] {
+					suiteTypes[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return suiteTypes
+}
+
+func embeddedTypes(structType *sitter.Node, source []byte) []string {
+	list := parser.FindChildByType(structType, nodeFieldDeclarationList)
+	if list == nil {
+		return nil
+	}
+
+	var types []string
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		decl := list.NamedChild(i)
+		if decl.Type() != nodeFieldDeclaration || decl.ChildByFieldName("name") != nil {
+			continue
+		}
+		typeNode := decl.ChildByFieldName("type")
+		if typeNode != nil && typeNode.Type() == nodePointerType && typeNode.NamedChildCount() > 0 {
+			typeNode = typeNode.NamedChild(0)
+		}
+		if typeNode != nil {
+			types = append(types, parser.GetNodeText(typeNode, source))
+		}
+	}
+	return types
+}
+
+// findSuiteRunTypes returns the types passed to suite.Run in the file.
+func findSuiteRunTypes(root *sitter.Node, source []byte) []string {
+	var types []string
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() == nodeCallExpression && isSuiteRunCall(node, source) {
+			if name := suiteRunType(node, source); name != "" {
+				types = append(types, name)
+			}
+		}
+		return true
+	})
+	return types
+}
+
+// isSuiteRunCall reports whether call is testify's suite.Run(t, s),
+// which shares its selector with t.Run but is not a subtest.
+func isSuiteRunCall(call *sitter.Node, source []byte) bool {
+	funcNode := call.ChildByFieldName("function")
+	if funcNode == nil || funcNode.Type() != nodeSelectorExpression {
+		return false
+	}
+	operand := funcNode.ChildByFieldName("operand")
+	field := funcNode.ChildByFieldName("field")
+	return operand != nil && field != nil &&
+		parser.GetNodeText(operand, source) == testifySuitePackage &&
+		parser.GetNodeText(field, source) == methodRun
+}
+
+// suiteRunType resolves the suite type of suite.Run(t, new(T)) or suite.Run(t, &T{...}).
+// Suites passed through variables are not resolved.
+func suiteRunType(call *sitter.Node, source []byte) string {
+	args := call.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() < 2 {
+		return ""
+	}
+
+	arg := args.NamedChild(1)
+	switch arg.Type() {
+	case nodeCallExpression:
+		fn := arg.ChildByFieldName("function")
+		callArgs := arg.ChildByFieldName("arguments")
+		if fn == nil || callArgs == nil || parser.GetNodeText(fn, source) != funcNew || callArgs.NamedChildCount() != 1 {
+			return ""
+		}
+		if typeArg := callArgs.NamedChild(0); typeArg.Type() == nodeTypeIdentifier || typeArg.Type() == nodeIdentifier {
+			return parser.GetNodeText(typeArg, source)
+		}
+	case nodeUnaryExpression:
+		if arg.NamedChildCount() > 0 {
+			arg = arg.NamedChild(0)
+		}
+		if arg.Type() == nodeCompositeLiteral {
+			if typeNode := arg.ChildByFieldName("type"); typeNode != nil && typeNode.Type() == nodeTypeIdentifier {
+				return parser.GetNodeText(typeNode, source)
+			}
+		}
+	}
+	return ""
+}
+
+func receiverTypeName(method *sitter.Node, source []byte) string {
+	receiver := method.ChildByFieldName("receiver")
+	if receiver == nil {
+		return ""
+	}
+	param := parser.FindChildByType(receiver, nodeParameterDeclaration)
+	if param == nil {
+		return ""
+	}
+	typeNode := param.ChildByFieldName("type")
+	if typeNode != nil && typeNode.Type() == nodePointerType && typeNode.NamedChildCount() > 0 {
+		typeNode = typeNode.NamedChild(0)
+	}
+	if typeNode == nil || typeNode.Type() != nodeTypeIdentifier {
+		return ""
+	}
+	return parser.GetNodeText(typeNode, source)
+}
+
+func hasParameters(method *sitter.Node) bool {
+	params := method.ChildByFieldName("parameters")
+	return params != nil && parser.FindChildByType(params, nodeParameterDeclaration) != nil
+}
@@ -56,6 +56,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &GTestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"EXPECT_*", "ASSERT_*", "FAIL", "ADD_FAILURE*"},
 	}
 }
 
@@ -0,0 +1,217 @@
+package jasmine
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/configutil"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+const (
+	frameworkName = framework.FrameworkJasmine
+
+	funcPending     = "pending"
+	modifierPending = "pending"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageTypeScript, domain.LanguageJavaScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("jasmine", "jasmine-core"),
+			matchers.NewConfigMatcher("jasmine.json"),
+			&JasmineContentMatcher{},
+		},
+		ConfigParser: &JasmineConfigParser{},
+		Parser:       &JasmineParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"expect", "expectAsync", "fail"},
+	}
+}
+
+// JasmineContentMatcher detects Jasmine-specific API patterns in file content.
+// fdescribe/xdescribe are shared with Jest, so only Jasmine-only APIs count.
+type JasmineContentMatcher struct{}
+
+var jasminePatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\bjasmine\.(?:createSpy|createSpyObj|clock|any|anything|objectContaining|arrayContaining|getEnv)\b`), "jasmine.* API"},
+	{regexp.MustCompile(`\bjasmine\.DEFAULT_TIMEOUT_INTERVAL\b`), "jasmine.DEFAULT_TIMEOUT_INTERVAL"},
+	{regexp.MustCompile(`\.and\.(?:returnValue|returnValues|callFake|callThrough|throwError)\s*\(`), "spy strategy (.and.*)"},
+	{regexp.MustCompile(`\.calls\.(?:mostRecent|allArgs|argsFor)\s*\(`), "spy calls tracking (.calls.*)"},
+	{regexp.MustCompile(`(?m)^\s*pending\s*\(`), "pending()"},
+}
+
+func (m *JasmineContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range jasminePatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Jasmine-specific pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type JasmineConfigParser struct{}
+
+func (p *JasmineConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	// spec_dir is relative to the project root, which is where `jasmine init`
+	// puts spec/support/jasmine.json two levels below.
+	root := ""
+	if dir := filepath.Dir(configPath); filepath.Base(dir) == "support" && filepath.Base(filepath.Dir(dir)) == "spec" {
+		root = "../.."
+	}
+
+	scope := framework.NewConfigScope(configPath, root)
+	scope.Framework = frameworkName
+	scope.GlobalsMode = true
+	if match := specDirPattern.FindSubmatch(content); match != nil {
+		scope.Include = []string{string(match[1]) + "/**"}
+	}
+	if match := specFilesPattern.FindSubmatch(content); match != nil {
+		scope.TestPatterns = configutil.ExtractQuotedStrings(match[1])
+	}
+	return scope, nil
+}
+
+// Config parsing regex patterns.
+// spec_files globs use extglob syntax (e.g., "**/*[sS]pec.?(m)js"), so they are kept
+// as TestPatterns rather than used for scope matching.
+var (
+	specDirPattern   = regexp.MustCompile(`"spec_dir"\s*:\s*"([^"]+)"`)
+	specFilesPattern = regexp.MustCompile(`(?s)"spec_files"\s*:\s*\[(.*?)\]\s*[,}]`)
+)
+
+type JasmineParser struct{}
+
+func (p *JasmineParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.ParseWith(ctx, source, filename, frameworkName, func(root *sitter.Node, file *domain.TestFile) {
+		pending := make(map[domain.Location]pendingSpec)
+		collectPendingSpecs(root, source, filename, pending)
+		if len(pending) == 0 {
+			return
+		}
+		applyPending(file.Tests, pending)
+		for i := range file.Suites {
+			applyPendingToSuite(&file.Suites[i], pending)
+		}
+	})
+}
+
+// pendingSpec is the status Jasmine reports for a spec that is declared
+// without a function or calls pending() unconditionally in its body.
+type pendingSpec struct {
+	status   domain.TestStatus
+	modifier string
+	reason   string
+}
+
+func collectPendingSpecs(node *sitter.Node, source []byte, filename string, pending map[domain.Location]pendingSpec) {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == "call_expression" {
+			if spec, ok := pendingStatus(child, source); ok {
+				pending[parser.GetLocation(child, filename)] = spec
+			}
+		}
+		collectPendingSpecs(child, source, filename, pending)
+	}
+}
+
+func pendingStatus(call *sitter.Node, source []byte) (pendingSpec, bool) {
+	funcNode := call.ChildByFieldName("function")
+	args := call.ChildByFieldName("arguments")
+	if funcNode == nil || args == nil || funcNode.Type() != "identifier" {
+		return pendingSpec{}, false
+	}
+	if name := parser.GetNodeText(funcNode, source); name != jstest.FuncIt && name != "fit" {
+		return pendingSpec{}, false
+	}
+	if !jstest.IsFirstArgString(args) {
+		return pendingSpec{}, false
+	}
+
+	callback := jstest.FindCallback(args)
+	if callback == nil {
+		return pendingSpec{status: domain.TestStatusTodo}, true
+	}
+
+	body := callback.ChildByFieldName("body")
+	if body == nil || body.Type() != "statement_block" {
+		return pendingSpec{}, false
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		stmt := body.Child(i)
+		if stmt.Type() != "expression_statement" {
+			continue
+		}
+		expr := parser.FindChildByType(stmt, "call_expression")
+		if expr == nil {
+			continue
+		}
+		fn := expr.ChildByFieldName("function")
+		if fn == nil || parser.GetNodeText(fn, source) != funcPending {
+			continue
+		}
+		return pendingSpec{status: domain.TestStatusSkipped, modifier: modifierPending, reason: pendingReason(expr, source)}, true
+	}
+
+	return pendingSpec{}, false
+}
+
+// pendingReason returns the message passed to pending("reason"), if any.
+func pendingReason(call *sitter.Node, source []byte) string {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return ""
+	}
+	for i := 0; i < int(args.ChildCount()); i++ {
+		if reason := jstest.ExtractStringValue(args.Child(i), source); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+func applyPendingToSuite(suite *domain.TestSuite, pending map[domain.Location]pendingSpec) {
+	applyPending(suite.Tests, pending)
+	for i := range suite.Suites {
+		applyPendingToSuite(&suite.Suites[i], pending)
+	}
+}
+
+func applyPending(tests []domain.Test, pending map[domain.Location]pendingSpec) {
+	for i := range tests {
+		spec, ok := pending[tests[i].Location]
+		if !ok || tests[i].Status == domain.TestStatusSkipped {
+			continue
+		}
+		tests[i].Status = spec.status
+		tests[i].Modifier = spec.modifier
+		tests[i].SkipReason = spec.reason
+	}
+}
@@ -0,0 +1,200 @@
+package jasmine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "jasmine" {
+		t.Errorf("expected Name to be 'jasmine', got %q", def.Name)
+	}
+
+	if def.Priority != framework.PriorityGeneric {
+		t.Errorf("expected Priority to be %d, got %d", framework.PriorityGeneric, def.Priority)
+	}
+
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
+	}
+
+	// ImportMatcher + ConfigMatcher + ContentMatcher
+	if len(def.Matchers) != 3 {
+		t.Errorf("expected 3 matchers, got %d", len(def.Matchers))
+	}
+}
+
+func TestJasmineContentMatcher_Match(t *testing.T) {
+	matcher := &JasmineContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		content            string
+		expectedConfidence int
+	}{
+		{"jasmine.createSpy", `const cb = jasmine.createSpy('cb');`, 40},
+		{"spy strategy", `spyOn(api, 'get').and.returnValue(Promise.resolve(1));`, 40},
+		{"calls tracking", `expect(cb.calls.mostRecent().args).toEqual([1]);`, 40},
+		{"pending", "it('later', () => {\n  pending('not implemented');\n});", 40},
+		{"fdescribe alone is shared with Jest", `fdescribe('focused', () => {});`, 0},
+		{"jest mock", `const cb = jest.fn();`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+
+			result := matcher.Match(ctx, signal)
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("expected confidence %d, got %d", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestJasmineConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name                 string
+		configPath           string
+		content              string
+		expectedBaseDir      string
+		expectedInclude      []string
+		expectedTestPatterns []string
+	}{
+		{
+			name:       "jasmine init layout",
+			configPath: "/project/spec/support/jasmine.json",
+			content: `{
+  "spec_dir": "spec",
+  "spec_files": ["**/*[sS]pec.?(m)js"],
+  "helpers": ["helpers/**/*.?(m)js"]
+}`,
+			expectedBaseDir:      "/project",
+			expectedInclude:      []string{"spec/**"},
+			expectedTestPatterns: []string{"**/*[sS]pec.?(m)js"},
+		},
+		{
+			name:            "config at project root without spec_dir",
+			configPath:      "/project/jasmine.json",
+			content:         `{ "random": false }`,
+			expectedBaseDir: "/project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := (&JasmineConfigParser{}).Parse(context.Background(), tt.configPath, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if scope.Framework != "jasmine" {
+				t.Errorf("expected framework 'jasmine', got %q", scope.Framework)
+			}
+			if !scope.GlobalsMode {
+				t.Error("expected globals mode")
+			}
+			if scope.BaseDir != tt.expectedBaseDir {
+				t.Errorf("expected BaseDir %q, got %q", tt.expectedBaseDir, scope.BaseDir)
+			}
+			if len(scope.Include) != len(tt.expectedInclude) {
+				t.Errorf("expected include %v, got %v", tt.expectedInclude, scope.Include)
+			}
+			if len(scope.TestPatterns) != len(tt.expectedTestPatterns) {
+				t.Errorf("expected test patterns %v, got %v", tt.expectedTestPatterns, scope.TestPatterns)
+			}
+		})
+	}
+}
+
+func TestJasmineParser_Parse(t *testing.T) {
+	source := `describe('Calculator', () => {
+  it('adds', () => {
+    expect(1 + 1).toBe(2);
+  });
+
+  fit('focused spec', () => {});
+
+  xit('disabled spec', () => {});
+
+  it('not written yet');
+
+  it('waits on upstream', () => {
+    pending('blocked by #42');
+    expect(true).toBe(false);
+  });
+
+  it('conditionally pending', () => {
+    if (isWindows) {
+      pending();
+    }
+  });
+
+  fdescribe('focused group', () => {
+    it('inner', () => {});
+  });
+
+  xdescribe('disabled group', () => {
+    it('inner', () => {});
+  });
+});
+`
+
+	file, err := (&JasmineParser{}).Parse(context.Background(), []byte(source), "calculatorSpec.js")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if file.Framework != "jasmine" {
+		t.Errorf("expected framework 'jasmine', got %q", file.Framework)
+	}
+	if len(file.Suites) != 1 {
+		t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+	}
+
+	calculator := file.Suites[0]
+	expected := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+		reason   string
+	}{
+		{"adds", domain.TestStatusActive, "", ""},
+		{"focused spec", domain.TestStatusFocused, "fit", ""},
+		{"disabled spec", domain.TestStatusSkipped, "xit", ""},
+		{"not written yet", domain.TestStatusTodo, "", ""},
+		{"waits on upstream", domain.TestStatusSkipped, "pending", "blocked by #42"},
+		{"conditionally pending", domain.TestStatusActive, "", ""},
+	}
+	if len(calculator.Tests) != len(expected) {
+		t.Fatalf("expected %d tests, got %d", len(expected), len(calculator.Tests))
+	}
+	for i, want := range expected {
+		got := calculator.Tests[i]
+		if got.Name != want.name || got.Status != want.status || got.Modifier != want.modifier || got.SkipReason != want.reason {
+			t.Errorf("test %d: expected (%q, %s, %q, %q), got (%q, %s, %q, %q)",
+				i, want.name, want.status, want.modifier, want.reason,
+				got.Name, got.Status, got.Modifier, got.SkipReason)
+		}
+	}
+
+	if len(calculator.Suites) != 2 {
+		t.Fatalf("expected 2 nested suites, got %d", len(calculator.Suites))
+	}
+	if calculator.Suites[0].Status != domain.TestStatusFocused {
+		t.Errorf("expected fdescribe to be focused, got %s", calculator.Suites[0].Status)
+	}
+	if calculator.Suites[1].Status != domain.TestStatusSkipped {
+		t.Errorf("expected xdescribe to be skipped, got %s", calculator.Suites[1].Status)
+	}
+}
@@ -34,6 +34,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &JestConfigParser{},
 		Parser:       &JestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"expect", "assert", "assert.*"},
 	}
 }
 
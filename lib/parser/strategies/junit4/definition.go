@@ -39,6 +39,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &JUnit4Parser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "Assert.*", "fail", "verify"},
 	}
 }
 
@@ -135,6 +136,7 @@ func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.T
 
 	modifiers := javaast.GetModifiers(node)
 	classStatus, classModifier := getClassStatusAndModifier(modifiers, source)
+	classReason := javaast.GetAnnotationStringValue(javaast.FindAnnotation(modifiers, source, "Ignore"), source)
 	runWith := getRunWithValue(modifiers, source)
 
 	body := javaast.GetClassBody(node)
@@ -148,7 +150,7 @@ func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.T
 		child := body.Child(i)
 
 		if child.Type() == javaast.NodeMethodDeclaration {
-			if test := parseTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+			if test := parseTestMethod(child, source, filename, classStatus, classModifier, classReason); test != nil {
 				tests = append(tests, *test)
 			}
 		}
@@ -159,11 +161,12 @@ func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.T
 	}
 
 	suite := &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tests:      tests,
 	}
 
 	if runWith != "" {
@@ -173,7 +176,7 @@ func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.T
 	return suite
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string) *domain.Test {
 	modifiers := javaast.GetModifiers(node)
 	if modifiers == nil {
 		return nil
@@ -183,6 +186,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	isTest := false
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 
 	for _, ann := range annotations {
 		name := javaast.GetAnnotationName(ann, source)
@@ -193,6 +197,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 		case "Ignore":
 			status = domain.TestStatusSkipped
 			modifier = "@Ignore"
+			reason = javaast.GetAnnotationStringValue(ann, source)
 		}
 	}
 
@@ -206,10 +211,11 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	}
 
 	return &domain.Test{
-		Name:     methodName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       methodName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
 	}
 }
 
@@ -38,6 +38,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &JUnit5Parser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "Assertions.*", "fail", "verify"},
 	}
 }
 
@@ -101,7 +102,8 @@ func (p *JUnit5Parser) Parse(ctx context.Context, source []byte, filename string
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites := parseTestClasses(root, cleanSource, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites := parseTestClasses(root, cleanSource, filename, expand)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -115,14 +117,14 @@ func (p *JUnit5Parser) Parse(ctx context.Context, source []byte, filename string
 // JUnit 5 allows arbitrary nesting, but we limit to prevent stack overflow.
 const maxNestedDepth = 20
 
-func parseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+func parseTestClasses(root *sitter.Node, source []byte, filename string, expand bool) []domain.TestSuite {
 	var suites []domain.TestSuite
 	var implicitClassTests []domain.Test
 
 	parser.WalkTree(root, func(node *sitter.Node) bool {
 		switch node.Type() {
 		case javaast.NodeClassDeclaration:
-			if suite := parseTestClassWithDepth(node, source, filename, 0); suite != nil {
+			if suite := parseTestClassWithDepth(node, source, filename, 0, expand); suite != nil {
 				suites = append(suites, *suite)
 			}
 			return false // Don't recurse into nested classes here
@@ -130,8 +132,8 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 		case javaast.NodeMethodDeclaration:
 			// Handle Java 21+ implicit classes: methods directly under program node
 			if node.Parent() != nil && node.Parent().Type() == "program" {
-				if test := parseTestMethod(node, source, filename, domain.TestStatusActive, ""); test != nil {
-					implicitClassTests = append(implicitClassTests, *test)
+				if test := parseTestMethod(node, source, filename, domain.TestStatusActive, "", ""); test != nil {
+					implicitClassTests = append(implicitClassTests, expandTest(*test, node, source, expand)...)
 				}
 			}
 		}
@@ -156,7 +158,7 @@ func getImplicitClassName(filename string) string {
 	return strings.TrimSuffix(filepath.Base(filename), ".java")
 }
 
-func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int) *domain.TestSuite {
+func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int, expand bool) *domain.TestSuite {
 	if depth > maxNestedDepth {
 		return nil
 	}
@@ -167,6 +169,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	modifiers := javaast.GetModifiers(node)
 	classStatus, classModifier := getClassStatusAndModifier(modifiers, source)
+	classReason := javaast.GetAnnotationStringValue(javaast.FindAnnotation(modifiers, source, "Disabled"), source)
 
 	body := javaast.GetClassBody(node)
 	if body == nil {
@@ -181,15 +184,15 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 		switch child.Type() {
 		case javaast.NodeMethodDeclaration:
-			if test := parseTestMethod(child, source, filename, classStatus, classModifier); test != nil {
-				tests = append(tests, *test)
+			if test := parseTestMethod(child, source, filename, classStatus, classModifier, classReason); test != nil {
+				tests = append(tests, expandTest(*test, child, source, expand)...)
 			}
 
 		case javaast.NodeClassDeclaration:
 			// Handle @Nested classes
 			nestedModifiers := javaast.GetModifiers(child)
 			if javaast.HasAnnotation(nestedModifiers, source, "Nested") {
-				if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
+				if nested := parseTestClassWithDepth(child, source, filename, depth+1, expand); nested != nil {
 					nestedSuites = append(nestedSuites, *nested)
 				}
 			}
@@ -201,16 +204,18 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	}
 
 	return &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
-		Suites:   nestedSuites,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getTags(modifiers, source),
+		Tests:      tests,
+		Suites:     nestedSuites,
 	}
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string) *domain.Test {
 	modifiers := javaast.GetModifiers(node)
 	if modifiers == nil {
 		return nil
@@ -221,6 +226,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	var displayName string
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 
 	for _, ann := range annotations {
 		name := javaast.GetAnnotationName(ann, source)
@@ -231,6 +237,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 		case "Disabled":
 			status = domain.TestStatusSkipped
 			modifier = "@Disabled"
+			reason = javaast.GetAnnotationStringValue(ann, source)
 		case "DisplayName":
 			displayName = javaast.GetAnnotationArgument(ann, source)
 		default:
@@ -257,10 +264,12 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	}
 
 	return &domain.Test{
-		Name:     testName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       testName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getTags(modifiers, source),
 	}
 }
 
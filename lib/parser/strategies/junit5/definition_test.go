@@ -2,6 +2,7 @@ package junit5
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -1040,3 +1041,209 @@ func TestGetImplicitClassName(t *testing.T) {
 		})
 	}
 }
+
+func TestJUnit5Parser_Parse_ExpandParameterized(t *testing.T) {
+	p := &JUnit5Parser{}
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	tests := []struct {
+		name   string
+		method string
+		want   []string
+	}{
+		{
+			name: "ValueSource uses default invocation names",
+			method: `
+    @ParameterizedTest
+    @ValueSource(ints = {1, -2})
+    void isOdd(int number) {}`,
+			want: []string{"isOdd [1] number=1", "isOdd [2] number=-2"},
+		},
+		{
+			name: "CsvSource with quoted values and custom name pattern",
+			method: `
+    @ParameterizedTest(name = "{0} has rank {1}")
+    @CsvSource({"apple, 1", "'lemon, lime', 2", "'', 3", ", 4"})
+    void rank(String fruit, int rank) {}`,
+			want: []string{
+				"rank apple has rank 1",
+				"rank lemon, lime has rank 2",
+				"rank  has rank 3",
+				"rank null has rank 4",
+			},
+		},
+		{
+			name: "CsvSource with custom delimiter and displayName pattern",
+			method: `
+    @ParameterizedTest(name = "{displayName} #{index}: {arguments}")
+    @DisplayName("sum")
+    @CsvSource(value = {"1|2"}, delimiter = '|')
+    void add(int a, int b) {}`,
+			want: []string{"sum #1: 1, 2"},
+		},
+		{
+			name: "NullAndEmptySource combined with ValueSource",
+			method: `
+    @ParameterizedTest
+    @NullAndEmptySource
+    @ValueSource(strings = {" "})
+    void blank(String text) {}`,
+			want: []string{"blank [1] text=null", "blank [2] text=", "blank [3] text= "},
+		},
+		{
+			name: "MethodSource keeps the template test",
+			method: `
+    @ParameterizedTest
+    @MethodSource("cases")
+    @ValueSource(ints = {1})
+    void dynamic(int value) {}`,
+			want: []string{"dynamic"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := "class SampleTest {" + tt.method + "\n}"
+
+			testFile, err := p.Parse(ctx, []byte(source), "SampleTest.java")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(testFile.Suites) != 1 {
+				t.Fatalf("expected 1 Suite, got %d", len(testFile.Suites))
+			}
+
+			var got []string
+			for _, test := range testFile.Suites[0].Tests {
+				got = append(got, test.Name)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Tests[%d]: expected %q, got %q", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestJUnit5Parser_Parse_ParameterizedNotExpandedByDefault(t *testing.T) {
+	source := `
+class SampleTest {
+    @ParameterizedTest
+    @ValueSource(ints = {1, 2, 3})
+    void isOdd(int number) {}
+}`
+
+	testFile, err := (&JUnit5Parser{}).Parse(context.Background(), []byte(source), "SampleTest.java")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := testFile.Suites[0].Tests
+	if len(tests) != 1 || tests[0].Name != "isOdd" || tests[0].Template != nil {
+		t.Errorf("expected single template test 'isOdd', got %+v", tests)
+	}
+}
+
+func TestJUnit5Parser_Parse_Tags(t *testing.T) {
+	source := `
+@Tag("integration")
+class RepositoryTest {
+    @Test
+    @Tag("slow")
+    @Tags({@Tag("db"), @Tag("network")})
+    void fetches() {}
+
+    @Test
+    void saves() {}
+}`
+
+	testFile, err := (&JUnit5Parser{}).Parse(context.Background(), []byte(source), "RepositoryTest.java")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 2 {
+		t.Fatalf("expected 1 suite with 2 tests, got %+v", testFile.Suites)
+	}
+
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "integration" {
+		t.Errorf("expected suite tags 'integration', got '%s'", got)
+	}
+	if got := strings.Join(suite.Tests[0].Tags, ","); got != "slow,db,network" {
+		t.Errorf("expected test tags 'slow,db,network', got '%s'", got)
+	}
+	if suite.Tests[1].Tags != nil {
+		t.Errorf("expected no tags on untagged test, got %v", suite.Tests[1].Tags)
+	}
+}
+
+func TestJUnit5KotlinParser_Parse_Tags(t *testing.T) {
+	source := `
+@Tag("integration")
+class RepositoryTest {
+    @Test
+    @Tag("slow")
+    fun fetches() {}
+}`
+
+	testFile, err := (&JUnit5Parser{}).Parse(context.Background(), []byte(source), "RepositoryTest.kt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 1 {
+		t.Fatalf("expected 1 suite with 1 test, got %+v", testFile.Suites)
+	}
+	if got := strings.Join(testFile.Suites[0].Tags, ","); got != "integration" {
+		t.Errorf("expected suite tags 'integration', got '%s'", got)
+	}
+	if got := strings.Join(testFile.Suites[0].Tests[0].Tags, ","); got != "slow" {
+		t.Errorf("expected test tags 'slow', got '%s'", got)
+	}
+}
+
+func TestJUnit5Parser_Parse_SkipReason(t *testing.T) {
+	source := `
+@Disabled("migrating to testcontainers")
+class RepositoryTest {
+    @Test
+    void inherits() {}
+
+    @Test
+    @Disabled(value = "flaky on CI")
+    void own() {}
+}
+
+class ServiceTest {
+    @Test
+    @Disabled
+    void noReason() {}
+}`
+
+	testFile, err := (&JUnit5Parser{}).Parse(context.Background(), []byte(source), "RepositoryTest.java")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 2 {
+		t.Fatalf("expected 2 suites, got %d", len(testFile.Suites))
+	}
+
+	suite := testFile.Suites[0]
+	if suite.SkipReason != "migrating to testcontainers" {
+		t.Errorf("expected suite reason 'migrating to testcontainers', got '%s'", suite.SkipReason)
+	}
+	if got := suite.Tests[0].SkipReason; got != "migrating to testcontainers" {
+		t.Errorf("expected inherited reason, got '%s'", got)
+	}
+	if got := suite.Tests[1].SkipReason; got != "flaky on CI" {
+		t.Errorf("expected reason 'flaky on CI', got '%s'", got)
+	}
+
+	test := testFile.Suites[1].Tests[0]
+	if test.Status != domain.TestStatusSkipped || test.SkipReason != "" {
+		t.Errorf("expected skipped test without reason, got status=%s reason='%s'", test.Status, test.SkipReason)
+	}
+}
@@ -101,6 +101,7 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 
 	modifiers := kotlinast.GetModifiers(node)
 	classStatus, classModifier := getKotlinClassStatus(modifiers, source)
+	classReason := getKotlinSkipReason(modifiers, source)
 
 	body := kotlinast.GetClassBody(node)
 	if body == nil {
@@ -112,7 +113,7 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 	for i := 0; i < int(body.ChildCount()); i++ {
 		child := body.Child(i)
 		if child.Type() == kotlinast.NodeFunctionDeclaration {
-			if test := parseKotlinTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+			if test := parseKotlinTestMethod(child, source, filename, classStatus, classModifier, classReason); test != nil {
 				tests = append(tests, *test)
 			}
 		}
@@ -123,15 +124,17 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 	}
 
 	return &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getKotlinTags(modifiers, source),
+		Tests:      tests,
 	}
 }
 
-func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string) *domain.Test {
 	modifiers := kotlinast.GetModifiers(node)
 
 	// Check for test annotations
@@ -148,12 +151,14 @@ func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, cl
 
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 
 	// Check for Disabled annotation
 	if kotlinast.HasAnnotation(modifiers, source, "Disabled") ||
 		kotlinast.HasAnnotation(modifiers, source, "Ignore") {
 		status = domain.TestStatusSkipped
 		modifier = "@Disabled"
+		reason = getKotlinSkipReason(modifiers, source)
 	}
 
 	methodName := getKotlinFunctionName(node, source)
@@ -169,10 +174,12 @@ func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, cl
 	}
 
 	return &domain.Test{
-		Name:     testName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       testName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getKotlinTags(modifiers, source),
 	}
 }
 
@@ -214,6 +221,44 @@ func getDisplayNameFromAnnotations(modifiers *sitter.Node, source []byte) string
 	return ""
 }
 
+// getKotlinTags returns the values of repeatable @Tag("...") annotations.
+func getKotlinTags(modifiers *sitter.Node, source []byte) []string {
+	return getKotlinAnnotationValues(modifiers, source, "Tag")
+}
+
+// getKotlinSkipReason returns the reason of @Disabled("...") or @Ignore("...").
+func getKotlinSkipReason(modifiers *sitter.Node, source []byte) string {
+	for _, name := range []string{"Disabled", "Ignore"} {
+		if values := getKotlinAnnotationValues(modifiers, source, name); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// getKotlinAnnotationValues returns the first string argument of each annotation with the given name.
+func getKotlinAnnotationValues(modifiers *sitter.Node, source []byte, annotationName string) []string {
+	if modifiers == nil {
+		return nil
+	}
+	var values []string
+	for i := 0; i < int(modifiers.ChildCount()); i++ {
+		child := modifiers.Child(i)
+		if child.Type() != kotlinast.NodeAnnotation || kotlinast.GetAnnotationName(child, source) != annotationName {
+			continue
+		}
+		// Arguments live under constructor_invocation: @Tag("slow").
+		for j := 0; j < int(child.ChildCount()); j++ {
+			if invocation := child.Child(j); invocation.Type() == kotlinast.NodeConstructorInvocation {
+				if value := getKotlinAnnotationArgument(invocation, source); value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+	}
+	return values
+}
+
 func getKotlinClassStatus(modifiers *sitter.Node, source []byte) (domain.TestStatus, string) {
 	if modifiers == nil {
 		return domain.TestStatusActive, ""
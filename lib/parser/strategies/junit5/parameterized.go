@@ -0,0 +1,272 @@
+package junit5
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/javaast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+// defaultInvocationPattern is JUnit's default @ParameterizedTest display name.
+const defaultInvocationPattern = "[{index}] {argumentsWithNames}"
+
+// valueSourceElements lists the @ValueSource attributes that carry literal arrays.
+var valueSourceElements = []string{
+	"strings", "ints", "longs", "doubles", "floats", "shorts", "bytes", "chars", "booleans", "classes",
+}
+
+// expandTest applies parameterized expansion when enabled.
+func expandTest(test domain.Test, node *sitter.Node, source []byte, expand bool) []domain.Test {
+	if !expand {
+		return []domain.Test{test}
+	}
+	return expandParameterizedTest(test, node, source)
+}
+
+// expandParameterizedTest returns one test per invocation of a @ParameterizedTest
+// whose arguments come only from @ValueSource, @CsvSource, @NullSource, @EmptySource
+// or @NullAndEmptySource. Each invocation is named "<test> [<index>] <arguments>"
+// unless the name pattern already references {displayName}.
+// Returns the template test unchanged if any argument source cannot be resolved statically.
+func expandParameterizedTest(test domain.Test, node *sitter.Node, source []byte) []domain.Test {
+	annotations := javaast.GetAnnotations(javaast.GetModifiers(node))
+
+	pattern := ""
+	isParameterized := false
+	var invocations [][]string
+
+	for _, ann := range annotations {
+		name := javaast.GetAnnotationName(ann, source)
+
+		switch name {
+		case "ParameterizedTest":
+			isParameterized = true
+			if value := javaast.GetAnnotationElement(ann, source, "name"); value != nil {
+				pattern = javaStringLiteral(value, source)
+			}
+		case "ValueSource":
+			args, ok := resolveValueSource(ann, source)
+			if !ok {
+				return []domain.Test{test}
+			}
+			invocations = append(invocations, args...)
+		case "CsvSource":
+			args, ok := resolveCsvSource(ann, source)
+			if !ok {
+				return []domain.Test{test}
+			}
+			invocations = append(invocations, args...)
+		case "NullSource":
+			invocations = append(invocations, []string{"null"})
+		case "EmptySource":
+			invocations = append(invocations, []string{""})
+		case "NullAndEmptySource":
+			invocations = append(invocations, []string{"null"}, []string{""})
+		default:
+			// @MethodSource, @EnumSource, @CsvFileSource, @ArgumentsSource, ... resolve at runtime.
+			if strings.HasSuffix(name, "Source") {
+				return []domain.Test{test}
+			}
+		}
+	}
+
+	if !isParameterized || len(invocations) == 0 {
+		return []domain.Test{test}
+	}
+
+	if pattern == "" {
+		pattern = defaultInvocationPattern
+	}
+	paramNames := javaast.GetParameterNames(node, source)
+
+	names := make([]string, len(invocations))
+	for i, args := range invocations {
+		rendered := formatInvocationName(pattern, test.Name, i+1, args, paramNames)
+		if strings.Contains(pattern, "{displayName}") {
+			names[i] = rendered
+		} else {
+			names[i] = test.Name + " " + rendered
+		}
+	}
+
+	return parameterized.Expand(test, names)
+}
+
+func resolveValueSource(ann *sitter.Node, source []byte) ([][]string, bool) {
+	for _, key := range valueSourceElements {
+		value := javaast.GetAnnotationElement(ann, source, key)
+		if value == nil {
+			continue
+		}
+
+		var invocations [][]string
+		for _, elem := range arrayElements(value) {
+			text, ok := javaLiteral(elem, source)
+			if !ok {
+				return nil, false
+			}
+			invocations = append(invocations, []string{text})
+		}
+		return invocations, true
+	}
+	return nil, false
+}
+
+func resolveCsvSource(ann *sitter.Node, source []byte) ([][]string, bool) {
+	if javaast.GetAnnotationElement(ann, source, "textBlock") != nil {
+		return nil, false
+	}
+
+	value := javaast.GetAnnotationElement(ann, source, "value")
+	if value == nil {
+		return nil, false
+	}
+
+	delimiter := ","
+	if d := javaast.GetAnnotationElement(ann, source, "delimiter"); d != nil {
+		text, ok := javaLiteral(d, source)
+		if !ok {
+			return nil, false
+		}
+		delimiter = text
+	}
+	if d := javaast.GetAnnotationElement(ann, source, "delimiterString"); d != nil {
+		text, ok := javaLiteral(d, source)
+		if !ok {
+			return nil, false
+		}
+		delimiter = text
+	}
+
+	var invocations [][]string
+	for _, elem := range arrayElements(value) {
+		if elem.Type() != "string_literal" {
+			return nil, false
+		}
+		invocations = append(invocations, splitCsvLine(javaStringLiteral(elem, source), delimiter))
+	}
+	return invocations, true
+}
+
+// splitCsvLine splits one @CsvSource record using JUnit's defaults:
+// single-quote quoting, surrounding whitespace trimmed, empty unquoted values are null.
+func splitCsvLine(line, delimiter string) []string {
+	var values []string
+	var current strings.Builder
+	quoted := false
+	wasQuoted := false
+
+	flush := func() {
+		value := current.String()
+		if !wasQuoted {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				value = "null"
+			}
+		}
+		values = append(values, value)
+		current.Reset()
+		wasQuoted = false
+	}
+
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\'':
+			if quoted && i+1 < len(line) && line[i+1] == '\'' {
+				current.WriteByte('\'')
+				i++
+				continue
+			}
+			if !quoted {
+				current.Reset()
+			}
+			quoted = !quoted
+			wasQuoted = true
+		case !quoted && strings.HasPrefix(line[i:], delimiter):
+			flush()
+			i += len(delimiter) - 1
+		default:
+			if wasQuoted && !quoted {
+				// Whitespace after a closing quote is ignored.
+				continue
+			}
+			current.WriteByte(line[i])
+		}
+	}
+	flush()
+
+	return values
+}
+
+// formatInvocationName renders a @ParameterizedTest name pattern.
+func formatInvocationName(pattern, displayName string, index int, args, paramNames []string) string {
+	withNames := make([]string, len(args))
+	for i, arg := range args {
+		if i < len(paramNames) {
+			withNames[i] = paramNames[i] + "=" + arg
+		} else {
+			withNames[i] = arg
+		}
+	}
+
+	replacements := []string{
+		"{displayName}", displayName,
+		"{index}", strconv.Itoa(index),
+		"{argumentsWithNames}", strings.Join(withNames, ", "),
+		"{arguments}", strings.Join(args, ", "),
+	}
+	for i, arg := range args {
+		replacements = append(replacements, "{"+strconv.Itoa(i)+"}", arg)
+	}
+
+	return strings.NewReplacer(replacements...).Replace(pattern)
+}
+
+func arrayElements(node *sitter.Node) []*sitter.Node {
+	if node.Type() != "element_value_array_initializer" {
+		return []*sitter.Node{node}
+	}
+
+	var elements []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() != "line_comment" && child.Type() != "block_comment" {
+			elements = append(elements, child)
+		}
+	}
+	return elements
+}
+
+// javaLiteral returns the display text of a literal annotation value.
+func javaLiteral(node *sitter.Node, source []byte) (string, bool) {
+	text := node.Content(source)
+
+	switch node.Type() {
+	case "string_literal":
+		return javaStringLiteral(node, source), true
+	case "character_literal":
+		return strings.Trim(text, "'"), true
+	case "decimal_integer_literal", "hex_integer_literal", "octal_integer_literal", "binary_integer_literal",
+		"decimal_floating_point_literal", "hex_floating_point_literal", "true", "false", "null_literal":
+		return text, true
+	case "class_literal":
+		return text, true
+	case "unary_expression":
+		operand := node.ChildByFieldName("operand")
+		if operand != nil && strings.HasSuffix(operand.Type(), "_literal") {
+			return strings.ReplaceAll(text, " ", ""), true
+		}
+	}
+	return "", false
+}
+
+func javaStringLiteral(node *sitter.Node, source []byte) string {
+	text := node.Content(source)
+	if len(text) >= 2 && text[0] == '"' && text[len(text)-1] == '"' {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
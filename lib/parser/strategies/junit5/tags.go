@@ -0,0 +1,36 @@
+package junit5
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/javaast"
+)
+
+// getTags returns the values of @Tag annotations, including those grouped in @Tags({...}).
+func getTags(modifiers *sitter.Node, source []byte) []string {
+	var tags []string
+	for _, ann := range javaast.GetAnnotations(modifiers) {
+		switch javaast.GetAnnotationName(ann, source) {
+		case "Tag":
+			tags = append(tags, javaast.GetStringElements(javaast.GetAnnotationElement(ann, source, "value"), source)...)
+		case "Tags":
+			value := javaast.GetAnnotationElement(ann, source, "value")
+			if value == nil {
+				continue
+			}
+			nested := []*sitter.Node{value}
+			if value.Type() == "element_value_array_initializer" {
+				nested = nil
+				for i := 0; i < int(value.NamedChildCount()); i++ {
+					nested = append(nested, value.NamedChild(i))
+				}
+			}
+			for _, inner := range nested {
+				if inner.Type() == "annotation" && javaast.GetAnnotationName(inner, source) == "Tag" {
+					tags = append(tags, javaast.GetStringElements(javaast.GetAnnotationElement(inner, source, "value"), source)...)
+				}
+			}
+		}
+	}
+	return tags
+}
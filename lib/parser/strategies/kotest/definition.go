@@ -44,6 +44,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &KotestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"should*", "*.should*", "assert*", "fail"},
 	}
 }
 
@@ -51,6 +51,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &MinitestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "refute*", "flunk", "*.must_*", "*.wont_*"},
 	}
 }
 
@@ -224,27 +225,32 @@ func processMethod(node *sitter.Node, source []byte, filename string, file *doma
 	}
 
 	status := domain.TestStatusActive
-	body := node.ChildByFieldName("body")
-	if body != nil && hasSkipCall(body, source) {
-		status = domain.TestStatusSkipped
+	var reason string
+	if body := node.ChildByFieldName("body"); body != nil {
+		if skipCall := findSkipCall(body, source); skipCall != nil {
+			status = domain.TestStatusSkipped
+			reason = skipCallReason(skipCall, source)
+		}
 	}
 
 	test := domain.Test{
-		Name:     methodName,
-		Status:   status,
-		Location: parser.GetLocation(node, filename),
+		Name:       methodName,
+		Status:     status,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
 	}
 
 	addTestToTarget(test, currentSuite, file)
 }
 
-func hasSkipCall(node *sitter.Node, source []byte) bool {
-	return hasSkipCallWithDepth(node, source, 0)
+// findSkipCall returns the first skip call (skip or skip "reason") within node, or nil.
+func findSkipCall(node *sitter.Node, source []byte) *sitter.Node {
+	return findSkipCallWithDepth(node, source, 0)
 }
 
-func hasSkipCallWithDepth(node *sitter.Node, source []byte, depth int) bool {
+func findSkipCallWithDepth(node *sitter.Node, source []byte, depth int) *sitter.Node {
 	if depth > maxSkipSearchDepth {
-		return false
+		return nil
 	}
 
 	for i := 0; i < int(node.ChildCount()); i++ {
@@ -252,22 +258,36 @@ func hasSkipCallWithDepth(node *sitter.Node, source []byte, depth int) bool {
 		if child.Type() == rubyast.NodeCall || child.Type() == rubyast.NodeMethodCall {
 			methodNode := child.ChildByFieldName("method")
 			if methodNode != nil && parser.GetNodeText(methodNode, source) == "skip" {
-				return true
+				return child
 			}
 			nameNode := parser.FindChildByType(child, rubyast.NodeIdentifier)
 			if nameNode != nil && parser.GetNodeText(nameNode, source) == "skip" {
-				return true
+				return child
 			}
 		} else if child.Type() == rubyast.NodeIdentifier {
 			if parser.GetNodeText(child, source) == "skip" {
-				return true
+				return child
 			}
 		}
-		if hasSkipCallWithDepth(child, source, depth+1) {
-			return true
+		if found := findSkipCallWithDepth(child, source, depth+1); found != nil {
+			return found
 		}
 	}
-	return false
+	return nil
+}
+
+// skipCallReason returns the literal message of skip "reason", or "" for a bare skip.
+func skipCallReason(skipCall *sitter.Node, source []byte) string {
+	args := skipCall.ChildByFieldName("arguments")
+	if args == nil {
+		return ""
+	}
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		if arg := args.NamedChild(i); arg.Type() == rubyast.NodeString {
+			return rubyast.ExtractStringContent(arg, source)
+		}
+	}
+	return ""
 }
 
 func processCallExpression(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite) {
@@ -329,15 +349,19 @@ func processTest(node *sitter.Node, source []byte, filename string, file *domain
 
 	// Check for skip in block
 	status := domain.TestStatusActive
-	block := findBlock(node)
-	if block != nil && hasSkipCall(block, source) {
-		status = domain.TestStatusSkipped
+	var reason string
+	if block := findBlock(node); block != nil {
+		if skipCall := findSkipCall(block, source); skipCall != nil {
+			status = domain.TestStatusSkipped
+			reason = skipCallReason(skipCall, source)
+		}
 	}
 
 	test := domain.Test{
-		Name:     name,
-		Status:   status,
-		Location: parser.GetLocation(node, filename),
+		Name:       name,
+		Status:     status,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
 	}
 
 	addTestToTarget(test, parentSuite, file)
@@ -38,6 +38,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &MochaConfigParser{},
 		Parser:       &MochaParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"expect", "should", "*.should.*", "assert", "assert.*"},
 	}
 }
 
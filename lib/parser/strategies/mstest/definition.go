@@ -36,6 +36,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &MSTestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"Assert.*", "*Assert.*", "*.Should"},
 	}
 }
 
@@ -181,6 +182,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classReason := dotnetast.GetIgnoreReason(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -193,7 +195,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	for _, child := range dotnetast.GetDeclarationChildren(body) {
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
-			if test := parseTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+			if test := parseTestMethod(child, source, filename, classStatus, classModifier, classReason); test != nil {
 				tests = append(tests, *test)
 			}
 
@@ -209,16 +211,17 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	}
 
 	return &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
-		Suites:   nestedSuites,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tests:      tests,
+		Suites:     nestedSuites,
 	}
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string) *domain.Test {
 	attrLists := dotnetast.GetAttributeLists(node)
 	if len(attrLists) == 0 {
 		return nil
@@ -228,6 +231,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	isTest := false
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 	var displayName string
 
 	for _, attr := range attributes {
@@ -250,6 +254,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	if ignored, mod := isIgnoredWithModifier(attrLists, source); ignored {
 		status = domain.TestStatusSkipped
 		modifier = mod
+		reason = dotnetast.GetIgnoreReason(attrLists, source)
 	}
 
 	methodName := dotnetast.GetMethodName(node, source)
@@ -263,9 +268,10 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	}
 
 	return &domain.Test{
-		Name:     testName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       testName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
 	}
 }
@@ -0,0 +1,253 @@
+// Package munit implements MUnit support for Scala test files.
+package munit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalaast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalabuild"
+)
+
+const frameworkName = framework.FrameworkMUnit
+
+// Modifiers recorded on tests whose status is not active: the TestOptions
+// method that set it, or the class annotation.
+const (
+	ModifierFail             = ".fail"
+	ModifierIgnore           = ".ignore"
+	ModifierIgnoreAnnotation = "@Ignore"
+	ModifierOnly             = ".only"
+	ModifierPending          = ".pending"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			scalaast.NewPackageMatcher("munit"),
+			scalabuild.NewConfigMatcher(),
+			&MUnitContentMatcher{},
+		},
+		ConfigParser: &scalabuild.ConfigParser{},
+		Parser:       &MUnitParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "intercept", "fail"},
+	}
+}
+
+// MUnitContentMatcher matches suites that extend munit.* without importing it.
+type MUnitContentMatcher struct{}
+
+var munitSuitePattern = regexp.MustCompile(`\bextends\s+munit\.\w*Suite\b`)
+
+func (m *MUnitContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	if munitSuitePattern.Match(content) {
+		return framework.PartialMatch(40, "Found MUnit suite: extends munit.*Suite")
+	}
+
+	return framework.NoMatch()
+}
+
+// MUnitParser maps suite classes to suites and test(...)/property(...) calls,
+// including fixture.test(...), to tests. TestOptions chained on the name
+// ("name".ignore, .only, .fail, .pending, .tag(Slow), .flaky) set status and tags.
+type MUnitParser struct{}
+
+func (p *MUnitParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("munit parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	var suites []domain.TestSuite
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if !scalaast.IsTestDefinition(node) {
+			return true
+		}
+		if suite := parseSuite(node, source, filename); suite != nil {
+			suites = append(suites, *suite)
+		}
+		return false
+	})
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+		Suites:    suites,
+	}, nil
+}
+
+func parseSuite(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	body := scalaast.DefinitionBody(node)
+	if body == nil {
+		return nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     scalaast.DefinitionName(node, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+	if scalaast.HasAnnotation(node, source, "Ignore") {
+		suite.Status = domain.TestStatusSkipped
+		suite.Modifier = ModifierIgnoreAnnotation
+	}
+
+	parser.WalkTree(body, func(n *sitter.Node) bool {
+		switch n.Type() {
+		case scalaast.NodeClassDefinition, scalaast.NodeObjectDefinition, scalaast.NodeFunctionDefinition:
+			return false
+		case scalaast.NodeCallExpression:
+			if test := parseTest(n, source, filename); test != nil {
+				suite.Tests = append(suite.Tests, *test)
+				return false
+			}
+		}
+		return true
+	})
+
+	if len(suite.Tests) == 0 {
+		return nil
+	}
+	return suite
+}
+
+// parseTest handles test(options) { body } where the callee is test, property
+// or a fixture's test method.
+func parseTest(node *sitter.Node, source []byte, filename string) *domain.Test {
+	head := node.ChildByFieldName("function")
+	if head == nil || head.Type() != scalaast.NodeCallExpression {
+		return nil
+	}
+	if !isTestCallee(head.ChildByFieldName("function"), source) {
+		return nil
+	}
+	args := scalaast.Arguments(head.ChildByFieldName("arguments"))
+	if len(args) == 0 {
+		return nil
+	}
+
+	test := &domain.Test{
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+	if !applyOptions(args[0], source, test) {
+		return nil
+	}
+	return test
+}
+
+func isTestCallee(fn *sitter.Node, source []byte) bool {
+	if fn == nil {
+		return false
+	}
+	switch fn.Type() {
+	case scalaast.NodeIdentifier:
+		name := fn.Content(source)
+		return name == "test" || name == "property"
+	case scalaast.NodeFieldExpression:
+		// fixture.test("...")
+		field := fn.ChildByFieldName("field")
+		return field != nil && field.Content(source) == "test"
+	}
+	return false
+}
+
+// applyOptions reads the test name and the TestOptions chained on it, e.g.
+// "name".only.tag(Slow). Returns false when the name is not a literal.
+func applyOptions(node *sitter.Node, source []byte, test *domain.Test) bool {
+	var tags []string
+	for {
+		if name, ok := scalaast.StringValue(node, source); ok {
+			test.Name = name
+			// Options were collected outermost first.
+			for i, j := 0, len(tags)-1; i < j; i, j = i+1, j-1 {
+				tags[i], tags[j] = tags[j], tags[i]
+			}
+			test.Tags = tags
+			return true
+		}
+
+		var args *sitter.Node
+		if node.Type() == scalaast.NodeCallExpression {
+			args = node.ChildByFieldName("arguments")
+			node = node.ChildByFieldName("function")
+		}
+		if node == nil || node.Type() != scalaast.NodeFieldExpression {
+			return false
+		}
+		field := node.ChildByFieldName("field")
+		node = node.ChildByFieldName("value")
+		if field == nil || node == nil {
+			return false
+		}
+
+		switch field.Content(source) {
+		case "ignore":
+			test.Status, test.Modifier = domain.TestStatusSkipped, ModifierIgnore
+		case "only":
+			test.Status, test.Modifier = domain.TestStatusFocused, ModifierOnly
+		case "fail":
+			test.Status, test.Modifier = domain.TestStatusXfail, ModifierFail
+		case "pending":
+			test.Status, test.Modifier = domain.TestStatusTodo, ModifierPending
+		case "flaky":
+			tags = append(tags, "flaky")
+		case "tag":
+			for _, arg := range scalaast.Arguments(args) {
+				if tag := tagName(arg, source); tag != "" {
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+}
+
+// tagName names a tag value: Slow, Tags.Slow or new Tag("Slow").
+func tagName(node *sitter.Node, source []byte) string {
+	switch node.Type() {
+	case scalaast.NodeIdentifier:
+		return node.Content(source)
+	case scalaast.NodeFieldExpression:
+		if field := node.ChildByFieldName("field"); field != nil {
+			return field.Content(source)
+		}
+	case "instance_expression":
+		var name string
+		parser.WalkTree(node, func(n *sitter.Node) bool {
+			if name != "" {
+				return false
+			}
+			if value, ok := scalaast.StringValue(n, source); ok {
+				name = value
+				return false
+			}
+			return true
+		})
+		return name
+	}
+	return ""
+}
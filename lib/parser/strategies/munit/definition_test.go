@@ -0,0 +1,147 @@
+package munit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "munit", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageScala}, def.Languages)
+	assert.NotNil(t, def.ConfigParser)
+}
+
+func TestMUnitImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"munit.FunSuite", true},
+		{"munit", true},
+		{"munitx.Helper", false},
+		{"org.scalatest.funsuite.AnyFunSuite", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.importPath})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func TestMUnitContentMatcher_Match(t *testing.T) {
+	matcher := &MUnitContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"FunSuite", "class CartSuite extends munit.FunSuite {", true},
+		{"ScalaCheckSuite", "class PropSuite extends munit.ScalaCheckSuite {", true},
+		{"ScalaTest FunSuite", "class CartSuite extends FunSuite {", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(tt.content)})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func TestMUnitParser_Parse(t *testing.T) {
+	source := `package shop
+
+class CartSuite extends munit.FunSuite {
+  val Slow = new munit.Tag("Slow")
+  val db = FunFixture[Db](setup = _ => Db(), teardown = _.close())
+
+  test("adds items") {
+    assertEquals(Cart().add("apple").size, 1)
+  }
+
+  test("removes items".ignore) {}
+  test("focused".only) {}
+  test("known bug".fail) {}
+  test("later".pending) {}
+  test("slow checkout".tag(Slow).flaky) {}
+  test("tagged".tag(new Tag("network"))) {}
+
+  db.test("persists") { db =>
+    db.save(Cart())
+  }
+
+  property("size is never negative") {
+    forAll { (n: Int) => Cart(n).size >= 0 }
+  }
+
+  List(1, 2).foreach { n =>
+    test(s"bulk $n") {}
+  }
+
+  test(options) {}
+}
+
+@Ignore
+class LegacySuite extends munit.FunSuite {
+  test("old") {}
+}
+`
+	file, err := (&MUnitParser{}).Parse(context.Background(), []byte(source), "src/test/scala/shop/CartSuite.scala")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.LanguageScala, file.Language)
+	assert.Equal(t, "munit", file.Framework)
+	require.Len(t, file.Suites, 2)
+
+	suite := file.Suites[0]
+	assert.Equal(t, "CartSuite", suite.Name)
+	require.Len(t, suite.Tests, 10)
+
+	tests := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+		tags     []string
+	}{
+		{"adds items", domain.TestStatusActive, "", nil},
+		{"removes items", domain.TestStatusSkipped, ModifierIgnore, nil},
+		{"focused", domain.TestStatusFocused, ModifierOnly, nil},
+		{"known bug", domain.TestStatusXfail, ModifierFail, nil},
+		{"later", domain.TestStatusTodo, ModifierPending, nil},
+		{"slow checkout", domain.TestStatusActive, "", []string{"Slow", "flaky"}},
+		{"tagged", domain.TestStatusActive, "", []string{"network"}},
+		{"persists", domain.TestStatusActive, "", nil},
+		{"size is never negative", domain.TestStatusActive, "", nil},
+		{"bulk $n", domain.TestStatusActive, "", nil},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, suite.Tests[i].Name)
+			assert.Equal(t, tt.status, suite.Tests[i].Status)
+			assert.Equal(t, tt.modifier, suite.Tests[i].Modifier)
+			assert.Equal(t, tt.tags, suite.Tests[i].Tags)
+		})
+	}
+	assert.Equal(t, 7, suite.Tests[0].Location.StartLine)
+	assert.Equal(t, 9, suite.Tests[0].Location.EndLine)
+
+	legacy := file.Suites[1]
+	assert.Equal(t, domain.TestStatusSkipped, legacy.Status)
+	assert.Equal(t, ModifierIgnoreAnnotation, legacy.Modifier)
+}
@@ -0,0 +1,40 @@
+package nodetest
+
+import (
+	"context"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+const frameworkName = framework.FrameworkNodeTest
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Node.js built-in test runner (node:test) definition.
+// The runner has no globals, so test files always import node:test;
+// subtests declared with t.test() and { skip, todo, only } options are
+// handled by the shared jstest parser.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageTypeScript, domain.LanguageJavaScript},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("node:test"),
+		},
+		ConfigParser: nil,
+		Parser:       &NodeTestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"t.assert.*", "assert", "assert.*"},
+	}
+}
+
+type NodeTestParser struct{}
+
+func (p *NodeTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.Parse(ctx, source, filename, frameworkName)
+}
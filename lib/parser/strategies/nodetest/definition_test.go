@@ -0,0 +1,150 @@
+package nodetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	if def.Name != "node-test" {
+		t.Errorf("expected Name to be 'node-test', got %q", def.Name)
+	}
+
+	if def.Priority != framework.PriorityGeneric {
+		t.Errorf("expected Priority to be %d, got %d", framework.PriorityGeneric, def.Priority)
+	}
+
+	if def.ConfigParser != nil {
+		t.Error("expected ConfigParser to be nil")
+	}
+
+	if def.Parser == nil {
+		t.Error("expected Parser to be non-nil")
+	}
+}
+
+func TestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		imp      string
+		expected bool
+	}{
+		{"node:test", "node:test", true},
+		{"node:assert", "node:assert", false},
+		{"bare test", "test", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := false
+			for _, m := range def.Matchers {
+				result := m.Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.imp})
+				if result.Confidence > 0 {
+					matched = true
+				}
+			}
+			if matched != tt.expected {
+				t.Errorf("import %q: expected matched=%v, got %v", tt.imp, tt.expected, matched)
+			}
+		})
+	}
+}
+
+func TestNodeTestParser_Parse(t *testing.T) {
+	source := `import { describe, it, test } from 'node:test';
+import assert from 'node:assert';
+
+describe('math', () => {
+  it('adds', () => {
+    assert.strictEqual(1 + 1, 2);
+  });
+
+  it('is flaky', { skip: 'flaky on CI' }, () => {});
+
+  it.todo('divides');
+
+  it('runs alone', { only: true }, () => {});
+});
+
+test('parent', async (t) => {
+  await t.test('first child', () => {});
+  await t.test('second child', { todo: true }, async (t) => {
+    await t.test('grandchild', () => {});
+  });
+});
+
+test('conditional', { skip: process.env.CI }, () => {});
+`
+
+	file, err := (&NodeTestParser{}).Parse(context.Background(), []byte(source), "math.test.js")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if file.Framework != "node-test" {
+		t.Errorf("expected framework 'node-test', got %q", file.Framework)
+	}
+
+	if len(file.Suites) != 2 {
+		t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+	}
+
+	math := file.Suites[0]
+	if len(math.Tests) != 4 {
+		t.Fatalf("expected 4 tests in 'math', got %d", len(math.Tests))
+	}
+
+	tests := []struct {
+		index    int
+		name     string
+		status   domain.TestStatus
+		modifier string
+		reason   string
+	}{
+		{0, "adds", domain.TestStatusActive, "", ""},
+		{1, "is flaky", domain.TestStatusSkipped, "skip", "flaky on CI"},
+		{2, "divides", domain.TestStatusTodo, "todo", ""},
+		{3, "runs alone", domain.TestStatusFocused, "only", ""},
+	}
+	for _, tt := range tests {
+		got := math.Tests[tt.index]
+		if got.Name != tt.name || got.Status != tt.status || got.Modifier != tt.modifier || got.SkipReason != tt.reason {
+			t.Errorf("test %d: expected (%q, %s, %q, %q), got (%q, %s, %q, %q)",
+				tt.index, tt.name, tt.status, tt.modifier, tt.reason,
+				got.Name, got.Status, got.Modifier, got.SkipReason)
+		}
+	}
+
+	parent := file.Suites[1]
+	if parent.Name != "parent" {
+		t.Errorf("expected subtest parent to become suite 'parent', got %q", parent.Name)
+	}
+	if len(parent.Tests) != 1 || parent.Tests[0].Name != "first child" {
+		t.Errorf("expected leaf subtest 'first child', got %+v", parent.Tests)
+	}
+	if len(parent.Suites) != 1 {
+		t.Fatalf("expected 1 nested subtest suite, got %d", len(parent.Suites))
+	}
+	second := parent.Suites[0]
+	if second.Name != "second child" || second.Status != domain.TestStatusTodo {
+		t.Errorf("expected todo suite 'second child', got %q (%s)", second.Name, second.Status)
+	}
+	if len(second.Tests) != 1 || second.Tests[0].Name != "grandchild" {
+		t.Errorf("expected 'grandchild' under 'second child', got %+v", second.Tests)
+	}
+
+	if len(file.Tests) != 1 {
+		t.Fatalf("expected 1 top-level test, got %d", len(file.Tests))
+	}
+	if file.Tests[0].Status != domain.TestStatusActive {
+		t.Errorf("expected runtime skip condition to stay active, got %s", file.Tests[0].Status)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 
@@ -36,6 +37,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &NUnitParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"Assert.*", "*Assert.*", "*.Should"},
 	}
 }
 
@@ -100,7 +102,8 @@ func (p *NUnitParser) Parse(ctx context.Context, source []byte, filename string)
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites := parseTestClasses(root, source, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites := parseTestClasses(root, source, filename, expand)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -145,12 +148,12 @@ func getNamedParameterFromAttribute(attr *sitter.Node, source []byte, paramName
 	return ""
 }
 
-func parseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+func parseTestClasses(root *sitter.Node, source []byte, filename string, expand bool) []domain.TestSuite {
 	var suites []domain.TestSuite
 
 	parser.WalkTree(root, func(node *sitter.Node) bool {
 		if node.Type() == dotnetast.NodeClassDeclaration {
-			if suite := parseTestClassWithDepth(node, source, filename, 0); suite != nil {
+			if suite := parseTestClassWithDepth(node, source, filename, 0, expand); suite != nil {
 				suites = append(suites, *suite)
 			}
 			return false
@@ -161,7 +164,7 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 	return suites
 }
 
-func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int) *domain.TestSuite {
+func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int, expand bool) *domain.TestSuite {
 	if depth > maxNestedDepth {
 		return nil
 	}
@@ -173,6 +176,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classReason := dotnetast.GetIgnoreReason(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -185,10 +189,10 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	for _, child := range dotnetast.GetDeclarationChildren(body) {
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
-			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier)...)
+			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier, classReason, expand)...)
 
 		case dotnetast.NodeClassDeclaration:
-			if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
+			if nested := parseTestClassWithDepth(child, source, filename, depth+1, expand); nested != nil {
 				nestedSuites = append(nestedSuites, *nested)
 			}
 		}
@@ -199,16 +203,18 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	}
 
 	return &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
-		Suites:   nestedSuites,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getCategories(attrLists, source),
+		Tests:      tests,
+		Suites:     nestedSuites,
 	}
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) []domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string, expand bool) []domain.Test {
 	attrLists := dotnetast.GetAttributeLists(node)
 	if len(attrLists) == 0 {
 		return nil
@@ -222,14 +228,17 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	attributes := dotnetast.GetAttributes(attrLists)
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 
 	// Check for [Ignore] attribute (reuses class-level check)
 	if methodStatus, methodModifier := getClassStatusAndModifier(attrLists, source); methodStatus == domain.TestStatusSkipped {
 		status = methodStatus
 		modifier = methodModifier
+		reason = dotnetast.GetIgnoreReason(attrLists, source)
 	}
 
 	location := parser.GetLocation(node, filename)
+	tags := getCategories(attrLists, source)
 	var tests []domain.Test
 	hasSimpleTest := false
 	hasTestCaseSource := false
@@ -247,13 +256,22 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 			testName := getNamedParameterFromAttribute(attr, source, "TestName")
 			if testName == "" {
 				testName = methodName
+				if expand {
+					testName = formatTestCaseName(methodName, dotnetast.GetPositionalAttributeArguments(attr, source))
+				}
 			}
-			tests = append(tests, domain.Test{
-				Name:     testName,
-				Status:   status,
-				Modifier: modifier,
-				Location: location,
-			})
+			test := domain.Test{
+				Name:       testName,
+				Status:     status,
+				Modifier:   modifier,
+				SkipReason: reason,
+				Location:   location,
+				Tags:       tags,
+			}
+			if expand {
+				test.Template = &domain.TestTemplate{Index: len(tests), Name: methodName}
+			}
+			tests = append(tests, test)
 
 		case "TestCaseSource", "TestCaseSourceAttribute":
 			hasTestCaseSource = true
@@ -272,12 +290,19 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 			testName = testDescription
 		}
 		return []domain.Test{{
-			Name:     testName,
-			Status:   status,
-			Modifier: modifier,
-			Location: location,
+			Name:       testName,
+			Status:     status,
+			Modifier:   modifier,
+			SkipReason: reason,
+			Location:   location,
+			Tags:       tags,
 		}}
 	}
 
 	return nil
 }
+
+// formatTestCaseName renders the default NUnit [TestCase] name, e.g. Add(1,2,"x").
+func formatTestCaseName(methodName string, args []string) string {
+	return methodName + "(" + strings.Join(args, ",") + ")"
+}
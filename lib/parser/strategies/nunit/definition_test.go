@@ -2,6 +2,7 @@ package nunit
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -662,3 +663,81 @@ public class MixedAttributeTests
 		}
 	})
 }
+
+func TestNUnitParser_Parse_ExpandTestCase(t *testing.T) {
+	p := &NUnitParser{}
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	source := `
+using NUnit.Framework;
+
+public class MathTests
+{
+    [TestCase(1, 2, 3)]
+    [TestCase("a", 'b', ExpectedResult = 0)]
+    [TestCase(5, 5, 10, TestName = "Doubles")]
+    public void Add(object a, object b, int expected) { }
+
+    [TestCaseSource(nameof(Cases))]
+    public void FromSource(int value) { }
+}
+`
+	testFile, err := p.Parse(ctx, []byte(source), "MathTests.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{`Add(1,2,3)`, `Add("a",'b')`, "Doubles", "FromSource"}
+	tests := testFile.Suites[0].Tests
+	if len(tests) != len(want) {
+		t.Fatalf("expected %d Tests, got %d", len(want), len(tests))
+	}
+	for i, name := range want {
+		if tests[i].Name != name {
+			t.Errorf("Tests[%d]: expected '%s', got '%s'", i, name, tests[i].Name)
+		}
+	}
+	if tests[2].Template == nil || tests[2].Template.Name != "Add" || tests[2].Template.Index != 2 {
+		t.Errorf("expected template link to Add[2], got %+v", tests[2].Template)
+	}
+	if tests[3].Template != nil {
+		t.Errorf("expected no template link for [TestCaseSource], got %+v", tests[3].Template)
+	}
+}
+
+func TestNUnitParser_Parse_Categories(t *testing.T) {
+	source := `
+using NUnit.Framework;
+
+[TestFixture, Category("Integration")]
+public class RepositoryTests
+{
+    [Test]
+    [Category("Slow"), Category("Db")]
+    public void Fetch() { }
+
+    [TestCase(1)]
+    [TestCase(2)]
+    [Category("Fast")]
+    public void Count(int n) { }
+}
+`
+	testFile, err := (&NUnitParser{}).Parse(context.Background(), []byte(source), "RepositoryTests.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 3 {
+		t.Fatalf("expected 1 suite with 3 tests, got %+v", testFile.Suites)
+	}
+
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "Integration" {
+		t.Errorf("expected suite tags 'Integration', got '%s'", got)
+	}
+	want := []string{"Slow,Db", "Fast", "Fast"}
+	for i, test := range suite.Tests {
+		if got := strings.Join(test.Tags, ","); got != want[i] {
+			t.Errorf("test %d: expected tags '%s', got '%s'", i, want[i], got)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package nunit
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/dotnetast"
+)
+
+// getCategories returns the names of [Category("...")] attributes.
+func getCategories(attrLists []*sitter.Node, source []byte) []string {
+	var categories []string
+	for _, attr := range dotnetast.GetAttributes(attrLists) {
+		name := dotnetast.GetAttributeName(attr, source)
+		if name != "Category" && name != "CategoryAttribute" {
+			continue
+		}
+		if args := dotnetast.GetPositionalStringArguments(attr, source); len(args) > 0 && args[0] != "" {
+			categories = append(categories, args[0])
+		}
+	}
+	return categories
+}
@@ -42,6 +42,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &PHPUnitParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"$this->assert*", "$this->expectException*", "$this->fail", "self::assert*", "static::assert*"},
 	}
 }
 
@@ -44,6 +44,8 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &PlaywrightConfigParser{},
 		Parser:       &PlaywrightParser{},
 		Priority:     framework.PriorityE2E,
+		DefaultKind:  domain.TestKindE2E,
+		Assertions:   []string{"expect", "expect.soft", "expect.poll"},
 	}
 }
 
@@ -474,10 +476,12 @@ func processSuite(callNode *sitter.Node, args *sitter.Node, source []byte, filen
 	}
 
 	suite := domain.TestSuite{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: jstest.ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
+		Tags:       extractTags(name, args, source),
 	}
 
 	if callback := jstest.FindCallback(args); callback != nil {
@@ -504,10 +508,12 @@ func processTest(callNode *sitter.Node, args *sitter.Node, source []byte, filena
 	}
 
 	test := domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: jstest.ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
+		Tags:       extractTags(name, args, source),
 	}
 
 	jstest.AddTestToTarget(test, parentSuite, file)
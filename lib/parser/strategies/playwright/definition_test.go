@@ -688,3 +688,26 @@ test('should work normally', async ({ page }) => {
 		assert.Equal(t, "should work normally", testFile.Tests[0].Name)
 	})
 }
+
+func TestPlaywrightParser_Tags(t *testing.T) {
+	testSource := `
+import { test } from '@playwright/test';
+
+test.describe('checkout @e2e', { tag: '@payments' }, () => {
+  test('pays with card @smoke', { tag: ['@slow', '@smoke', tagName] }, async ({ page }) => {});
+
+  test('email@example.com stays in the title', async ({ page }) => {});
+});
+`
+
+	testFile, err := (&PlaywrightParser{}).Parse(context.Background(), []byte(testSource), "checkout.spec.ts")
+
+	require.NoError(t, err)
+	require.Len(t, testFile.Suites, 1)
+	suite := testFile.Suites[0]
+	assert.Equal(t, []string{"e2e", "payments"}, suite.Tags)
+
+	require.Len(t, suite.Tests, 2)
+	assert.Equal(t, []string{"smoke", "slow"}, suite.Tests[0].Tags)
+	assert.Nil(t, suite.Tests[1].Tags)
+}
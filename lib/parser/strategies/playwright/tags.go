@@ -0,0 +1,64 @@
+package playwright
+
+import (
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/jstest"
+)
+
+// titleTagPattern matches @tag tokens embedded in test and describe titles.
+var titleTagPattern = regexp.MustCompile(`(?:^|\s)@([\w-]+)`)
+
+// extractTags returns tags from the title ('login @smoke') and from the details
+// argument ({ tag: '@slow' } or { tag: ['@a', '@b'] }), without the leading '@'.
+func extractTags(name string, args *sitter.Node, source []byte) []string {
+	var tags []string
+	for _, match := range titleTagPattern.FindAllStringSubmatch(name, -1) {
+		tags = appendTag(tags, match[1])
+	}
+
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		details := args.NamedChild(i)
+		if details.Type() != "object" {
+			continue
+		}
+		for j := 0; j < int(details.NamedChildCount()); j++ {
+			pair := details.NamedChild(j)
+			if pair.Type() != "pair" {
+				continue
+			}
+			key := pair.ChildByFieldName("key")
+			value := pair.ChildByFieldName("value")
+			if key == nil || value == nil || jstest.UnquoteString(parser.GetNodeText(key, source)) != "tag" {
+				continue
+			}
+
+			values := []*sitter.Node{value}
+			if value.Type() == "array" {
+				values = nil
+				for k := 0; k < int(value.NamedChildCount()); k++ {
+					values = append(values, value.NamedChild(k))
+				}
+			}
+			for _, v := range values {
+				if tag := jstest.ExtractStringValue(v, source); len(tag) > 1 && tag[0] == '@' {
+					tags = appendTag(tags, tag[1:])
+				}
+			}
+		}
+	}
+
+	return tags
+}
+
+func appendTag(tags []string, tag string) []string {
+	for _, t := range tags {
+		if t == tag {
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
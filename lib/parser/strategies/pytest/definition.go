@@ -38,6 +38,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &PytestConfigParser{},
 		Parser:       &PytestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"pytest.raises", "pytest.warns", "pytest.fail"},
 	}
 }
 
@@ -144,7 +145,8 @@ func (p *PytestParser) Parse(ctx context.Context, source []byte, filename string
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites, tests := parseTestModule(root, source, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites, tests := parseTestModule(root, source, filename, expand)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -155,7 +157,7 @@ func (p *PytestParser) Parse(ctx context.Context, source []byte, filename string
 	}, nil
 }
 
-func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domain.TestSuite, []domain.Test) {
+func parseTestModule(root *sitter.Node, source []byte, filename string, expand bool) ([]domain.TestSuite, []domain.Test) {
 	var suites []domain.TestSuite
 	var tests []domain.Test
 
@@ -169,7 +171,7 @@ func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domai
 			}
 
 		case pyast.NodeClassDefinition:
-			if suite := parseTestClass(child, source, filename); suite != nil {
+			if suite := parseTestClass(child, source, filename, expand); suite != nil {
 				suites = append(suites, *suite)
 			}
 
@@ -181,21 +183,34 @@ func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domai
 			}
 
 			decorators := pyast.GetDecorators(child)
-			status, modifier := getStatusAndModifierFromDecorators(decorators, source)
+			status, modifier, reason := getStatusAndModifierFromDecorators(decorators, source)
 
 			switch definition.Type() {
 			case pyast.NodeFunctionDefinition:
 				if test := parseTestFunctionWithStatus(definition, source, filename, status, modifier); test != nil {
-					tests = append(tests, *test)
+					test.SkipReason = reason
+					test.Tags = getMarkerTags(decorators, source)
+					tests = append(tests, expandParametrizedTest(*test, decorators, source, expand)...)
 				}
 			case pyast.NodeClassDefinition:
-				if suite := parseTestClassWithStatus(definition, source, filename, status, modifier); suite != nil {
+				if suite := parseTestClassWithStatus(definition, source, filename, status, modifier, reason, expand); suite != nil {
+					suite.Tags = domain.MergeTags(getMarkerTags(decorators, source), suite.Tags)
 					suites = append(suites, *suite)
 				}
 			}
 		}
 	}
 
+	// Module-level pytestmark applies to every test in the file.
+	if moduleTags := getPytestmarkTags(root, source); len(moduleTags) > 0 {
+		for i := range tests {
+			tests[i].Tags = domain.MergeTags(moduleTags, tests[i].Tags)
+		}
+		for i := range suites {
+			suites[i].Tags = domain.MergeTags(moduleTags, suites[i].Tags)
+		}
+	}
+
 	return suites, tests
 }
 
@@ -222,11 +237,11 @@ func parseTestFunctionWithStatus(node *sitter.Node, source []byte, filename stri
 	}
 }
 
-func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
-	return parseTestClassWithStatus(node, source, filename, domain.TestStatusActive, "")
+func parseTestClass(node *sitter.Node, source []byte, filename string, expand bool) *domain.TestSuite {
+	return parseTestClassWithStatus(node, source, filename, domain.TestStatusActive, "", "", expand)
 }
 
-func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.TestSuite {
+func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string, expand bool) *domain.TestSuite {
 	nameNode := node.ChildByFieldName("name")
 	if nameNode == nil {
 		return nil
@@ -253,6 +268,7 @@ func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string,
 				if test.Status == domain.TestStatusActive && classStatus != domain.TestStatusActive {
 					test.Status = classStatus
 					test.Modifier = classModifier
+					test.SkipReason = classReason
 				}
 				tests = append(tests, *test)
 			}
@@ -264,15 +280,18 @@ func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string,
 			}
 
 			decorators := pyast.GetDecorators(child)
-			status, modifier := getStatusAndModifierFromDecorators(decorators, source)
+			status, modifier, reason := getStatusAndModifierFromDecorators(decorators, source)
 			// Inherit class status/modifier if method has default (active) status
 			if status == domain.TestStatusActive && classStatus != domain.TestStatusActive {
 				status = classStatus
 				modifier = classModifier
+				reason = classReason
 			}
 
 			if test := parseTestFunctionWithStatus(definition, source, filename, status, modifier); test != nil {
-				tests = append(tests, *test)
+				test.SkipReason = reason
+				test.Tags = getMarkerTags(decorators, source)
+				tests = append(tests, expandParametrizedTest(*test, decorators, source, expand)...)
 			}
 		}
 	}
@@ -282,26 +301,64 @@ func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string,
 	}
 
 	return &domain.TestSuite{
-		Name:     name,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
+		Name:       name,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getPytestmarkTags(body, source),
+		Tests:      tests,
 	}
 }
 
-func getStatusAndModifierFromDecorators(decorators []*sitter.Node, source []byte) (domain.TestStatus, string) {
+// getStatusAndModifierFromDecorators returns the status implied by skip/skipif/xfail marks,
+// along with the literal reason= given to the mark, if any.
+func getStatusAndModifierFromDecorators(decorators []*sitter.Node, source []byte) (domain.TestStatus, string, string) {
 	for _, dec := range decorators {
+		// Marks inside parametrize cases (pytest.param(..., marks=...)) apply to that case only.
+		if parametrizeCall(dec, source) != nil {
+			continue
+		}
+
 		text := parser.GetNodeText(dec, source)
 
 		switch {
 		case strings.Contains(text, "pytest.mark.skip"):
-			return domain.TestStatusSkipped, "@pytest.mark.skip"
+			return domain.TestStatusSkipped, "@pytest.mark.skip", markReason(dec, source, "skip", "skipif")
 		case strings.Contains(text, "pytest.mark.xfail"):
-			return domain.TestStatusXfail, "@pytest.mark.xfail"
+			return domain.TestStatusXfail, "@pytest.mark.xfail", markReason(dec, source, "xfail")
 		}
 	}
-	return domain.TestStatusActive, ""
+	return domain.TestStatusActive, "", ""
+}
+
+// markReason returns the reason given to the first pytest.mark.<name>(...) call under node.
+// skip also accepts the reason positionally; skipif and xfail take the condition first.
+func markReason(node *sitter.Node, source []byte, names ...string) string {
+	var reason string
+	parser.WalkTree(node, func(n *sitter.Node) bool {
+		if reason != "" || n.Type() != "call" {
+			return reason == ""
+		}
+		fn := n.ChildByFieldName("function")
+		if fn == nil {
+			return true
+		}
+		fnName := parser.GetNodeText(fn, source)
+		for _, name := range names {
+			if fnName != "pytest.mark."+name && fnName != "mark."+name {
+				continue
+			}
+			position := -1
+			if name == "skip" {
+				position = 0
+			}
+			reason = pyast.GetReasonArgument(n, source, position)
+			return false
+		}
+		return true
+	})
+	return reason
 }
 
 func isTestFunction(name string) bool {
@@ -2,6 +2,7 @@ package pytest
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -516,3 +517,216 @@ class MyTests(unittest.TestCase):
 		}
 	})
 }
+
+func TestPytestParser_Parse_ExpandParametrize(t *testing.T) {
+	p := &PytestParser{}
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	names := func(tests []domain.Test) []string {
+		var out []string
+		for _, test := range tests {
+			out = append(out, test.Name)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{
+			name: "tuple cases use joined literal IDs",
+			source: `
+@pytest.mark.parametrize("x,y,expected", [(1, 2, 3), (2, -3, -1.5)])
+def test_add(x, y, expected):
+    pass
+`,
+			want: []string{"test_add[1-2-3]", "test_add[2--3--1.5]"},
+		},
+		{
+			name: "explicit ids and pytest.param ids",
+			source: `
+@pytest.mark.parametrize("value", ["a", pytest.param("b", id="second")], ids=["first", "ignored"])
+def test_value(value):
+    pass
+`,
+			want: []string{"test_value[first]", "test_value[second]"},
+		},
+		{
+			name: "non-literal values fall back to argname and index",
+			source: `
+@pytest.mark.parametrize("user", [User(), User()])
+def test_user(user):
+    pass
+`,
+			want: []string{"test_user[user0]", "test_user[user1]"},
+		},
+		{
+			name: "stacked decorators produce the cartesian product",
+			source: `
+@pytest.mark.parametrize("x", [0, 1])
+@pytest.mark.parametrize("y", [2, 3])
+def test_foo(x, y):
+    pass
+`,
+			want: []string{"test_foo[2-0]", "test_foo[2-1]", "test_foo[3-0]", "test_foo[3-1]"},
+		},
+		{
+			name: "duplicate IDs get a counter suffix",
+			source: `
+@pytest.mark.parametrize("x", ["a", "a"])
+def test_dup(x):
+    pass
+`,
+			want: []string{"test_dup[a0]", "test_dup[a1]"},
+		},
+		{
+			name: "variable argvalues keep the template test",
+			source: `
+@pytest.mark.parametrize("x", CASES)
+def test_cases(x):
+    pass
+`,
+			want: []string{"test_cases"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testFile, err := p.Parse(ctx, []byte(tt.source), "test_param.py")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := names(testFile.Tests)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("pytest.param marks and class methods", func(t *testing.T) {
+		source := `
+class TestMath:
+    @pytest.mark.parametrize("n", [1, pytest.param(2, marks=pytest.mark.xfail)])
+    def test_square(self, n):
+        pass
+`
+		testFile, err := p.Parse(ctx, []byte(source), "test_param.py")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 2 {
+			t.Fatalf("expected 1 suite with 2 tests, got %+v", testFile.Suites)
+		}
+		cases := testFile.Suites[0].Tests
+		if cases[0].Status != domain.TestStatusActive {
+			t.Errorf("expected first case active, got '%s'", cases[0].Status)
+		}
+		if cases[1].Status != domain.TestStatusXfail {
+			t.Errorf("expected second case xfail, got '%s'", cases[1].Status)
+		}
+		if cases[1].Template == nil || cases[1].Template.Name != "test_square" || cases[1].Template.Index != 1 {
+			t.Errorf("expected template link to test_square[1], got %+v", cases[1].Template)
+		}
+	})
+}
+
+func TestPytestParser_Parse_Markers(t *testing.T) {
+	source := `
+import pytest
+
+pytestmark = pytest.mark.integration
+
+@pytest.mark.slow
+@pytest.mark.skipif(True, reason="ci")
+@pytest.mark.parametrize("x", [pytest.param(1, marks=pytest.mark.flaky)])
+def test_module(x):
+    pass
+
+@pytest.mark.db
+class TestRepo:
+    pytestmark = [pytest.mark.smoke, pytest.mark.db]
+
+    @pytest.mark.usefixtures("conn")
+    @pytest.mark.network
+    def test_fetch(self):
+        pass
+`
+	p := &PytestParser{}
+	testFile, err := p.Parse(context.Background(), []byte(source), "test_markers.py")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(testFile.Tests) != 1 {
+		t.Fatalf("expected 1 module test, got %d", len(testFile.Tests))
+	}
+	if got := strings.Join(testFile.Tests[0].Tags, ","); got != "integration,slow" {
+		t.Errorf("expected module test tags 'integration,slow', got '%s'", got)
+	}
+
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 1 {
+		t.Fatalf("expected 1 suite with 1 test, got %+v", testFile.Suites)
+	}
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "integration,db,smoke" {
+		t.Errorf("expected suite tags 'integration,db,smoke', got '%s'", got)
+	}
+	if got := strings.Join(suite.Tests[0].Tags, ","); got != "network" {
+		t.Errorf("expected method tags 'network', got '%s'", got)
+	}
+}
+
+func TestPytestParser_Parse_SkipReason(t *testing.T) {
+	source := `
+import pytest
+
+@pytest.mark.skip("flaky on CI")
+def test_positional():
+    pass
+
+@pytest.mark.skipif(sys.platform == "win32", reason="posix only")
+def test_keyword():
+    pass
+
+@pytest.mark.xfail(reason="bug #123")
+def test_xfail():
+    pass
+
+@pytest.mark.skip
+def test_no_reason():
+    pass
+
+@pytest.mark.skip(reason="legacy")
+class TestLegacy:
+    def test_inherits(self):
+        pass
+`
+	testFile, err := (&PytestParser{}).Parse(context.Background(), []byte(source), "test_skip.py")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Tests) != 4 {
+		t.Fatalf("expected 4 tests, got %d", len(testFile.Tests))
+	}
+
+	want := []string{"flaky on CI", "posix only", "bug #123", ""}
+	for i, test := range testFile.Tests {
+		if test.SkipReason != want[i] {
+			t.Errorf("test %q: expected reason '%s', got '%s'", test.Name, want[i], test.SkipReason)
+		}
+	}
+
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 1 {
+		t.Fatalf("expected 1 suite with 1 test, got %+v", testFile.Suites)
+	}
+	if got := testFile.Suites[0].SkipReason; got != "legacy" {
+		t.Errorf("expected suite reason 'legacy', got '%s'", got)
+	}
+	if got := testFile.Suites[0].Tests[0].SkipReason; got != "legacy" {
+		t.Errorf("expected inherited reason 'legacy', got '%s'", got)
+	}
+}
@@ -0,0 +1,317 @@
+package pytest
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+const parametrizeDecorator = "pytest.mark.parametrize"
+
+// parametrizeCase is one resolved parameter set with its pytest ID.
+type parametrizeCase struct {
+	id       string
+	status   domain.TestStatus
+	modifier string
+	reason   string
+}
+
+// expandParametrizedTest returns one test per case of the @pytest.mark.parametrize
+// decorators, named the way pytest reports them (test_add[1-2]).
+// Stacked decorators produce the cartesian product, with IDs of the decorator
+// closest to the function first. Returns the template test unchanged when
+// expansion is disabled or any parameter set cannot be resolved statically.
+func expandParametrizedTest(test domain.Test, decorators []*sitter.Node, source []byte, expand bool) []domain.Test {
+	if !expand {
+		return []domain.Test{test}
+	}
+
+	cases, ok := resolveParametrizeCases(decorators, source)
+	if !ok || len(cases) == 0 {
+		return []domain.Test{test}
+	}
+
+	names := make([]string, len(cases))
+	for i, c := range cases {
+		names[i] = test.Name + "[" + c.id + "]"
+	}
+
+	tests := parameterized.Expand(test, names)
+	for i, c := range cases {
+		if c.status != domain.TestStatusActive && tests[i].Status == domain.TestStatusActive {
+			tests[i].Status = c.status
+			tests[i].Modifier = c.modifier
+			tests[i].SkipReason = c.reason
+		}
+	}
+	return tests
+}
+
+func resolveParametrizeCases(decorators []*sitter.Node, source []byte) ([]parametrizeCase, bool) {
+	var combined []parametrizeCase
+
+	// Decorators apply bottom-up: the one closest to the function defines the leading ID.
+	for i := len(decorators) - 1; i >= 0; i-- {
+		call := parametrizeCall(decorators[i], source)
+		if call == nil {
+			continue
+		}
+
+		cases, ok := resolveParametrizeCall(call, source)
+		if !ok {
+			return nil, false
+		}
+
+		if combined == nil {
+			combined = cases
+			continue
+		}
+
+		product := make([]parametrizeCase, 0, len(combined)*len(cases))
+		for _, outer := range combined {
+			for _, inner := range cases {
+				merged := parametrizeCase{
+					id:       outer.id + "-" + inner.id,
+					status:   outer.status,
+					modifier: outer.modifier,
+					reason:   outer.reason,
+				}
+				if merged.status == domain.TestStatusActive {
+					merged.status = inner.status
+					merged.modifier = inner.modifier
+					merged.reason = inner.reason
+				}
+				product = append(product, merged)
+			}
+		}
+		combined = product
+	}
+
+	return combined, true
+}
+
+// parametrizeCall returns the call node of a @pytest.mark.parametrize decorator.
+func parametrizeCall(decorator *sitter.Node, source []byte) *sitter.Node {
+	for i := 0; i < int(decorator.NamedChildCount()); i++ {
+		child := decorator.NamedChild(i)
+		if child.Type() != "call" {
+			continue
+		}
+		fn := child.ChildByFieldName("function")
+		if fn == nil {
+			continue
+		}
+		name := parser.GetNodeText(fn, source)
+		if name == parametrizeDecorator || name == "mark.parametrize" || name == "parametrize" {
+			return child
+		}
+	}
+	return nil
+}
+
+func resolveParametrizeCall(call *sitter.Node, source []byte) ([]parametrizeCase, bool) {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return nil, false
+	}
+
+	var argnamesNode, argvaluesNode, idsNode *sitter.Node
+	positional := 0
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() == "keyword_argument" {
+			name := arg.ChildByFieldName("name")
+			value := arg.ChildByFieldName("value")
+			if name == nil || value == nil {
+				continue
+			}
+			switch parser.GetNodeText(name, source) {
+			case "argnames":
+				argnamesNode = value
+			case "argvalues":
+				argvaluesNode = value
+			case "ids":
+				idsNode = value
+			}
+			continue
+		}
+
+		switch positional {
+		case 0:
+			argnamesNode = arg
+		case 1:
+			argvaluesNode = arg
+		}
+		positional++
+	}
+
+	argnames, ok := resolveArgnames(argnamesNode, source)
+	if !ok || argvaluesNode == nil {
+		return nil, false
+	}
+	if argvaluesNode.Type() != "list" && argvaluesNode.Type() != "tuple" {
+		return nil, false
+	}
+
+	var explicitIDs []*sitter.Node
+	if idsNode != nil {
+		if idsNode.Type() != "list" && idsNode.Type() != "tuple" {
+			return nil, false
+		}
+		explicitIDs = namedChildren(idsNode)
+	}
+
+	var cases []parametrizeCase
+	for idx, element := range namedChildren(argvaluesNode) {
+		c := parametrizeCase{status: domain.TestStatusActive}
+		values := []*sitter.Node{element}
+
+		if isPytestParam(element, source) {
+			values = nil
+			paramArgs := element.ChildByFieldName("arguments")
+			for _, arg := range namedChildren(paramArgs) {
+				if arg.Type() != "keyword_argument" {
+					values = append(values, arg)
+					continue
+				}
+				name := parser.GetNodeText(arg.ChildByFieldName("name"), source)
+				value := arg.ChildByFieldName("value")
+				switch name {
+				case "id":
+					if value != nil && value.Type() == "string" {
+						c.id = stringContent(value, source)
+					}
+				case "marks":
+					c.status, c.modifier, c.reason = getStatusAndModifierFromDecorators([]*sitter.Node{value}, source)
+				}
+			}
+		} else if len(argnames) > 1 {
+			if element.Type() != "tuple" && element.Type() != "list" {
+				return nil, false
+			}
+			values = namedChildren(element)
+		}
+
+		if c.id == "" && idx < len(explicitIDs) && explicitIDs[idx].Type() == "string" {
+			c.id = stringContent(explicitIDs[idx], source)
+		}
+		if c.id == "" {
+			if len(values) != len(argnames) {
+				return nil, false
+			}
+			ids := make([]string, len(values))
+			for i, value := range values {
+				ids[i] = parametrizeValueID(value, argnames[i], idx, source)
+			}
+			c.id = strings.Join(ids, "-")
+		}
+
+		cases = append(cases, c)
+	}
+
+	disambiguateIDs(cases)
+	return cases, true
+}
+
+func resolveArgnames(node *sitter.Node, source []byte) ([]string, bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	switch node.Type() {
+	case "string":
+		var names []string
+		for _, name := range strings.Split(stringContent(node, source), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		return names, len(names) > 0
+	case "list", "tuple":
+		var names []string
+		for _, child := range namedChildren(node) {
+			if child.Type() != "string" {
+				return nil, false
+			}
+			names = append(names, stringContent(child, source))
+		}
+		return names, len(names) > 0
+	}
+	return nil, false
+}
+
+// parametrizeValueID mirrors pytest's default ID generation: literals are
+// rendered with str(), anything else falls back to argname + index.
+func parametrizeValueID(node *sitter.Node, argname string, idx int, source []byte) string {
+	switch node.Type() {
+	case "string":
+		return stringContent(node, source)
+	case "integer", "float", "true", "false", "none":
+		return parser.GetNodeText(node, source)
+	case "unary_operator":
+		operand := node.ChildByFieldName("argument")
+		if operand != nil && (operand.Type() == "integer" || operand.Type() == "float") {
+			return strings.ReplaceAll(parser.GetNodeText(node, source), " ", "")
+		}
+	}
+	return argname + strconv.Itoa(idx)
+}
+
+// disambiguateIDs appends a counter to duplicate IDs, as pytest does.
+func disambiguateIDs(cases []parametrizeCase) {
+	counts := make(map[string]int, len(cases))
+	for _, c := range cases {
+		counts[c.id]++
+	}
+
+	next := make(map[string]int, len(cases))
+	for i, c := range cases {
+		if counts[c.id] < 2 {
+			continue
+		}
+		cases[i].id = c.id + strconv.Itoa(next[c.id])
+		next[c.id]++
+	}
+}
+
+func isPytestParam(node *sitter.Node, source []byte) bool {
+	if node.Type() != "call" {
+		return false
+	}
+	fn := node.ChildByFieldName("function")
+	if fn == nil {
+		return false
+	}
+	name := parser.GetNodeText(fn, source)
+	return name == "pytest.param" || name == "param"
+}
+
+func stringContent(node *sitter.Node, source []byte) string {
+	var b strings.Builder
+	for _, child := range namedChildren(node) {
+		if child.Type() == "string_content" {
+			b.WriteString(parser.GetNodeText(child, source))
+		}
+	}
+	return b.String()
+}
+
+func namedChildren(node *sitter.Node) []*sitter.Node {
+	if node == nil {
+		return nil
+	}
+	children := make([]*sitter.Node, 0, node.NamedChildCount())
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "comment" {
+			continue
+		}
+		children = append(children, child)
+	}
+	return children
+}
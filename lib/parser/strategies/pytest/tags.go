@@ -0,0 +1,81 @@
+package pytest
+
+import (
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+)
+
+// markerPattern matches pytest.mark.<name> references in decorators and pytestmark assignments.
+var markerPattern = regexp.MustCompile(`(?:^|[^\w.])(?:pytest\.)?mark\.(\w+)`)
+
+// builtinMarkers are pytest markers that control execution rather than group tests.
+// They are reported through Status/Modifier or parameterization instead of Tags.
+var builtinMarkers = map[string]bool{
+	"filterwarnings": true,
+	"parametrize":    true,
+	"skip":           true,
+	"skipif":         true,
+	"usefixtures":    true,
+	"xfail":          true,
+}
+
+// getMarkerTags returns custom marker names (slow, integration, ...) applied by decorators.
+func getMarkerTags(decorators []*sitter.Node, source []byte) []string {
+	var tags []string
+	for _, dec := range decorators {
+		// Marks inside parametrize cases apply to a single case, not the test.
+		if parametrizeCall(dec, source) != nil {
+			continue
+		}
+		tags = appendMarkers(tags, parser.GetNodeText(dec, source))
+	}
+	return tags
+}
+
+// getPytestmarkTags returns markers assigned to pytestmark directly under node
+// (module or class body), e.g. pytestmark = [pytest.mark.slow, pytest.mark.db].
+func getPytestmarkTags(node *sitter.Node, source []byte) []string {
+	var tags []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		stmt := node.NamedChild(i)
+		if stmt.Type() != "expression_statement" || stmt.NamedChildCount() == 0 {
+			continue
+		}
+
+		assignment := stmt.NamedChild(0)
+		if assignment.Type() != "assignment" {
+			continue
+		}
+
+		left := assignment.ChildByFieldName("left")
+		right := assignment.ChildByFieldName("right")
+		if left == nil || right == nil || parser.GetNodeText(left, source) != "pytestmark" {
+			continue
+		}
+		tags = appendMarkers(tags, parser.GetNodeText(right, source))
+	}
+	return tags
+}
+
+func appendMarkers(tags []string, text string) []string {
+	for _, match := range markerPattern.FindAllStringSubmatch(text, -1) {
+		name := match[1]
+		if builtinMarkers[name] || containsTag(tags, name) {
+			continue
+		}
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
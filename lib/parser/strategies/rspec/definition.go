@@ -42,6 +42,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &RSpecParser{},
 		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"expect", "is_expected.*", "*.should", "*.should_not"},
 	}
 }
 
@@ -295,11 +296,18 @@ func processSuite(node *sitter.Node, source []byte, filename string, file *domai
 		return
 	}
 
+	var reason string
+	if status == domain.TestStatusActive {
+		status, modifier, reason = extractSkipMetadata(node, source)
+	}
+
 	suite := domain.TestSuite{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       extractMetadataTags(node, source),
 	}
 
 	// Parse the block content
@@ -318,11 +326,18 @@ func processTest(node *sitter.Node, source []byte, filename string, file *domain
 		name = "(anonymous)"
 	}
 
+	var reason string
+	if status == domain.TestStatusActive {
+		status, modifier, reason = extractSkipMetadata(node, source)
+	}
+
 	test := domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       extractMetadataTags(node, source),
 	}
 
 	addTestToTarget(test, parentSuite, file)
@@ -349,11 +364,13 @@ func processPendingBlock(node *sitter.Node, source []byte, filename string, file
 		addSuiteToTarget(suite, currentSuite, file)
 	} else {
 		// Just a pending marker, create a skipped test
+		// The string given to a bare skip/pending is its reason.
 		test := domain.Test{
-			Name:     name,
-			Status:   domain.TestStatusSkipped,
-			Modifier: modifier,
-			Location: parser.GetLocation(node, filename),
+			Name:       name,
+			Status:     domain.TestStatusSkipped,
+			Modifier:   modifier,
+			SkipReason: name,
+			Location:   parser.GetLocation(node, filename),
 		}
 		addTestToTarget(test, currentSuite, file)
 	}
@@ -2,6 +2,7 @@ package rspec
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -444,3 +445,74 @@ end
 		})
 	}
 }
+
+func TestRSpecParser_Metadata(t *testing.T) {
+	source := `
+describe User, :slow, type: :model do
+  it "saves", :db, focus: true, retry: 3, skip: false do
+  end
+
+  it :validates, { priority: "high", flaky: nil } do
+  end
+
+  it "runs" do
+  end
+end
+`
+	testFile, err := (&RSpecParser{}).Parse(context.Background(), []byte(source), "user_spec.rb")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 3 {
+		t.Fatalf("expected 1 suite with 3 tests, got %+v", testFile.Suites)
+	}
+
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "slow,type:model" {
+		t.Errorf("expected suite tags 'slow,type:model', got '%s'", got)
+	}
+	want := []string{"db,focus,retry:3", "priority:high", ""}
+	for i, test := range suite.Tests {
+		if got := strings.Join(test.Tags, ","); got != want[i] {
+			t.Errorf("test %q: expected tags '%s', got '%s'", test.Name, want[i], got)
+		}
+	}
+}
+
+func TestRSpecParser_SkipReason(t *testing.T) {
+	source := `
+describe User do
+  it "saves", skip: "flaky on CI" do
+  end
+
+  it "validates", pending: "needs fixture" do
+  end
+
+  xit "loads" do
+  end
+
+  skip "not implemented yet"
+end
+`
+	testFile, err := (&RSpecParser{}).Parse(context.Background(), []byte(source), "user_spec.rb")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 4 {
+		t.Fatalf("expected 1 suite with 4 tests, got %+v", testFile.Suites)
+	}
+
+	tests := testFile.Suites[0].Tests
+	if tests[0].Status != domain.TestStatusSkipped || tests[0].SkipReason != "flaky on CI" {
+		t.Errorf("expected skipped with reason 'flaky on CI', got status=%s reason='%s'", tests[0].Status, tests[0].SkipReason)
+	}
+	if tests[1].SkipReason != "needs fixture" {
+		t.Errorf("expected reason 'needs fixture', got '%s'", tests[1].SkipReason)
+	}
+	if tests[2].SkipReason != "" {
+		t.Errorf("expected no reason for xit, got '%s'", tests[2].SkipReason)
+	}
+	if tests[3].SkipReason != "not implemented yet" {
+		t.Errorf("expected reason 'not implemented yet', got '%s'", tests[3].SkipReason)
+	}
+}
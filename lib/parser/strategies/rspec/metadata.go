@@ -0,0 +1,138 @@
+package rspec
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/rubyast"
+)
+
+// statusMetadata are metadata keys RSpec treats as status rather than grouping.
+var statusMetadata = map[string]bool{
+	"pending": true,
+	"skip":    true,
+}
+
+// extractMetadataTags returns the metadata following the description of an
+// example or group: `:slow` becomes "slow", `db: true` becomes "db" and
+// `type: :model` becomes "type:model". Keys set to false or nil are omitted.
+func extractMetadataTags(node *sitter.Node, source []byte) []string {
+	args := node.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+
+	var tags []string
+	described := false
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+
+		switch arg.Type() {
+		case rubyast.NodeSymbol, rubyast.NodeSimpleSymbol:
+			if !described {
+				described = true
+				continue
+			}
+			tags = appendTag(tags, rubyast.ExtractSymbolContent(arg, source))
+		case "pair":
+			tags = appendPairTag(tags, arg, source)
+		case "hash":
+			for j := 0; j < int(arg.NamedChildCount()); j++ {
+				if pair := arg.NamedChild(j); pair.Type() == "pair" {
+					tags = appendPairTag(tags, pair, source)
+				}
+			}
+		default:
+			described = true
+		}
+	}
+	return tags
+}
+
+// extractSkipMetadata returns the status set by skip:/pending: metadata
+// (it "works", skip: "flaky on CI"), along with its literal reason.
+// Returns TestStatusActive if neither key is set to a truthy value.
+func extractSkipMetadata(node *sitter.Node, source []byte) (domain.TestStatus, string, string) {
+	args := node.ChildByFieldName("arguments")
+	if args == nil {
+		return domain.TestStatusActive, "", ""
+	}
+
+	var pairs []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		switch arg.Type() {
+		case "pair":
+			pairs = append(pairs, arg)
+		case "hash":
+			for j := 0; j < int(arg.NamedChildCount()); j++ {
+				if pair := arg.NamedChild(j); pair.Type() == "pair" {
+					pairs = append(pairs, pair)
+				}
+			}
+		}
+	}
+
+	for _, pair := range pairs {
+		keyNode := pair.ChildByFieldName("key")
+		valueNode := pair.ChildByFieldName("value")
+		if keyNode == nil || valueNode == nil {
+			continue
+		}
+
+		key := strings.Trim(parser.GetNodeText(keyNode, source), ":")
+		if !statusMetadata[key] {
+			continue
+		}
+
+		var reason string
+		switch valueNode.Type() {
+		case "false", "nil":
+			continue
+		case rubyast.NodeString:
+			reason = rubyast.ExtractStringContent(valueNode, source)
+		}
+
+		status, modifier := getStatusAndModifierFromMethod(key)
+		return status, modifier + ":", reason
+	}
+
+	return domain.TestStatusActive, "", ""
+}
+
+func appendPairTag(tags []string, pair *sitter.Node, source []byte) []string {
+	keyNode := pair.ChildByFieldName("key")
+	valueNode := pair.ChildByFieldName("value")
+	if keyNode == nil || valueNode == nil {
+		return tags
+	}
+
+	key := strings.TrimSuffix(strings.TrimPrefix(parser.GetNodeText(keyNode, source), ":"), ":")
+	if keyNode.Type() == rubyast.NodeString {
+		key = rubyast.ExtractStringContent(keyNode, source)
+	}
+
+	switch valueNode.Type() {
+	case "true":
+		return appendTag(tags, key)
+	case "false", "nil":
+		return tags
+	case rubyast.NodeSymbol, rubyast.NodeSimpleSymbol:
+		return appendTag(tags, key+":"+rubyast.ExtractSymbolContent(valueNode, source))
+	case rubyast.NodeString:
+		return appendTag(tags, key+":"+rubyast.ExtractStringContent(valueNode, source))
+	default:
+		return appendTag(tags, key+":"+parser.GetNodeText(valueNode, source))
+	}
+}
+
+func appendTag(tags []string, tag string) []string {
+	name, _, _ := strings.Cut(tag, ":")
+	if tag == "" || statusMetadata[name] {
+		return tags
+	}
+	return append(tags, tag)
+}
@@ -0,0 +1,424 @@
+// Package scalatest implements ScalaTest support for Scala test files.
+package scalatest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalaast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalabuild"
+)
+
+const frameworkName = framework.FrameworkScalaTest
+
+// Modifiers recorded on tests whose status is not active.
+const (
+	ModifierIgnore            = "ignore"
+	ModifierIgnoreAnnotation  = "@Ignore"
+	ModifierPending           = "pending"
+	ModifierPendingUntilFixed = "pendingUntilFixed"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			scalaast.NewPackageMatcher("org.scalatest"),
+			scalabuild.NewConfigMatcher(),
+			&ScalaTestContentMatcher{},
+		},
+		ConfigParser: &scalabuild.ConfigParser{},
+		Parser:       &ScalaTestParser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"assert*", "should*", "must*", "intercept", "fail"},
+	}
+}
+
+// ScalaTestContentMatcher matches classes extending a ScalaTest style trait.
+type ScalaTestContentMatcher struct{}
+
+var scalaTestStylePattern = regexp.MustCompile(`\bextends\s+(?:Any|Async)?(?:FunSuite|FlatSpec|WordSpec|FreeSpec|FunSpec|FeatureSpec|PropSpec)(?:Like)?\b`)
+
+func (m *ScalaTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	if match := scalaTestStylePattern.Find(content); match != nil {
+		return framework.PartialMatch(40, "Found ScalaTest style: "+string(match))
+	}
+
+	return framework.NoMatch()
+}
+
+// ScalaTestParser extracts tests from every ScalaTest style: FunSuite and
+// PropSpec test(...), FunSpec describe/it, FeatureSpec Feature/Scenario,
+// FlatSpec "x" should "y" in, and the nested WordSpec and FreeSpec forms.
+type ScalaTestParser struct{}
+
+func (p *ScalaTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("scalatest parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	w := &walker{source: source, filename: filename}
+	var suites []domain.TestSuite
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if !scalaast.IsTestDefinition(node) {
+			return true
+		}
+		if suite := w.definition(node); suite != nil {
+			suites = append(suites, *suite)
+		}
+		return false
+	})
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+		Suites:    suites,
+	}, nil
+}
+
+type walker struct {
+	source   []byte
+	filename string
+}
+
+// container is the suite tests are added to, plus the FlatSpec subject that
+// "it should ..." clauses refer to (an index into suite.Suites, or -1).
+type container struct {
+	suite   *domain.TestSuite
+	subject int
+}
+
+func (w *walker) definition(node *sitter.Node) *domain.TestSuite {
+	body := scalaast.DefinitionBody(node)
+	if body == nil {
+		return nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     scalaast.DefinitionName(node, w.source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	if scalaast.HasAnnotation(node, w.source, "Ignore") {
+		suite.Status = domain.TestStatusSkipped
+		suite.Modifier = ModifierIgnoreAnnotation
+	}
+
+	w.walk(body, &container{suite: suite, subject: -1})
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return nil
+	}
+	return suite
+}
+
+func (w *walker) walk(node *sitter.Node, c *container) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		w.visit(node.NamedChild(i), c)
+	}
+}
+
+func (w *walker) visit(node *sitter.Node, c *container) {
+	switch node.Type() {
+	case scalaast.NodeClassDefinition, scalaast.NodeObjectDefinition, scalaast.NodeFunctionDefinition:
+		// Helper definitions register tests only when called.
+		return
+	case scalaast.NodeCallExpression:
+		if w.call(node, c) {
+			return
+		}
+	case scalaast.NodeInfixExpression:
+		if w.infix(node, c) {
+			return
+		}
+	}
+	// Tests registered in loops or helper blocks.
+	w.walk(node, c)
+}
+
+var (
+	// suiteCalls open a nested scope: FunSpec describe, FeatureSpec Feature.
+	suiteCalls = map[string]bool{"describe": true, "Feature": true, "feature": true}
+
+	// testCalls register a test: FunSuite/PropSpec test and property,
+	// FunSpec it/they, FeatureSpec Scenario, and ignore in all of them.
+	testCalls = map[string]bool{
+		"test": true, "it": true, "they": true, "property": true,
+		"Scenario": true, "scenario": true, "ignore": true,
+	}
+)
+
+// call handles name("description", tags...) { body }.
+func (w *walker) call(node *sitter.Node, c *container) bool {
+	head := node.ChildByFieldName("function")
+	if head == nil || head.Type() != scalaast.NodeCallExpression {
+		return false
+	}
+	fn := head.ChildByFieldName("function")
+	if fn == nil || fn.Type() != scalaast.NodeIdentifier {
+		return false
+	}
+	args := scalaast.Arguments(head.ChildByFieldName("arguments"))
+	if len(args) == 0 {
+		return false
+	}
+	name, ok := scalaast.StringValue(args[0], w.source)
+	if !ok {
+		return false
+	}
+	body := node.ChildByFieldName("arguments")
+
+	switch callName := fn.Content(w.source); {
+	case suiteCalls[callName]:
+		suite := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+		}
+		w.walk(body, &container{suite: &suite, subject: -1})
+		c.suite.Suites = append(c.suite.Suites, suite)
+		return true
+	case testCalls[callName]:
+		test := w.test(name, node, body, w.tags(args[1:]))
+		if callName == "ignore" {
+			test.Status = domain.TestStatusSkipped
+			test.Modifier = ModifierIgnore
+		}
+		c.suite.Tests = append(c.suite.Tests, test)
+		return true
+	}
+	return false
+}
+
+// wordVerbs open nested scopes in WordSpec ("x" when/should/must/can {})
+// and FreeSpec ("x" - {}).
+var wordVerbs = map[string]bool{
+	"when": true, "should": true, "must": true, "can": true,
+	"that": true, "which": true, "-": true,
+}
+
+// flatVerbs join a FlatSpec subject and description.
+var flatVerbs = map[string]bool{"should": true, "must": true, "can": true}
+
+// infix handles the string-based styles.
+func (w *walker) infix(node *sitter.Node, c *container) bool {
+	left := node.ChildByFieldName("left")
+	op := node.ChildByFieldName("operator")
+	right := node.ChildByFieldName("right")
+	if left == nil || op == nil || right == nil {
+		return false
+	}
+
+	switch verb := op.Content(w.source); {
+	case verb == "in" || verb == "ignore" || verb == "is":
+		return w.infixTest(node, left, verb, right, c)
+	case verb == "of" && scalaast.IsIdentifier(left, w.source, "behavior"):
+		// behavior of "Queue"
+		if subject, ok := scalaast.StringValue(right, w.source); ok {
+			c.subject = w.subjectSuite(subject, node, c)
+			return true
+		}
+	case wordVerbs[verb] && right.Type() == scalaast.NodeBlock:
+		text, ok := scalaast.StringValue(left, w.source)
+		if !ok {
+			return false
+		}
+		if verb != "-" {
+			text += " " + verb
+		}
+		suite := domain.TestSuite{
+			Name:     text,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+		}
+		w.walk(right, &container{suite: &suite, subject: -1})
+		c.suite.Suites = append(c.suite.Suites, suite)
+		return true
+	}
+	return false
+}
+
+// infixTest handles "<description> in|ignore|is <body>" where the description
+// is a string (WordSpec, FreeSpec), a FlatSpec clause, or either followed by
+// taggedAs(...). A FlatSpec clause on "ignore" instead of "it" is ignored.
+func (w *walker) infixTest(node, left *sitter.Node, verb string, body *sitter.Node, c *container) bool {
+	var tags []string
+	if clause := left; clause.Type() == scalaast.NodeInfixExpression {
+		if op := clause.ChildByFieldName("operator"); op != nil && op.Content(w.source) == "taggedAs" {
+			tags = w.tags(tagNodes(clause.ChildByFieldName("right")))
+			left = clause.ChildByFieldName("left")
+		}
+	}
+
+	target := c.suite
+	ignored := verb == "ignore"
+	name, ok := scalaast.StringValue(left, w.source)
+	if !ok {
+		// FlatSpec: "A Stack" should "pop" / it should "pop" / they should "pop"
+		if left.Type() != scalaast.NodeInfixExpression {
+			return false
+		}
+		subject, op, desc := left.ChildByFieldName("left"), left.ChildByFieldName("operator"), left.ChildByFieldName("right")
+		if subject == nil || op == nil || !flatVerbs[op.Content(w.source)] {
+			return false
+		}
+		description, ok := scalaast.StringValue(desc, w.source)
+		if !ok {
+			return false
+		}
+		name = op.Content(w.source) + " " + description
+
+		if text, ok := scalaast.StringValue(subject, w.source); ok {
+			c.subject = w.subjectSuite(text, left, c)
+		} else if scalaast.IsIdentifier(subject, w.source, "ignore") {
+			ignored = true
+		} else if !scalaast.IsIdentifier(subject, w.source, "it") && !scalaast.IsIdentifier(subject, w.source, "they") {
+			return false
+		}
+		if c.subject >= 0 {
+			target = &c.suite.Suites[c.subject]
+			if end := parser.GetLocation(node, w.filename).EndLine; end > target.Location.EndLine {
+				target.Location.EndLine = end
+			}
+		}
+	}
+
+	test := w.test(name, node, body, tags)
+	switch {
+	case ignored:
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = ModifierIgnore
+	case verb == "is":
+		test.Status = domain.TestStatusTodo
+		test.Modifier = ModifierPending
+	}
+	target.Tests = append(target.Tests, test)
+	return true
+}
+
+// subjectSuite returns the index of the FlatSpec subject suite named text,
+// adding it when the subject has not been seen in this scope.
+func (w *walker) subjectSuite(text string, node *sitter.Node, c *container) int {
+	for i, suite := range c.suite.Suites {
+		if suite.Name == text {
+			return i
+		}
+	}
+	c.suite.Suites = append(c.suite.Suites, domain.TestSuite{
+		Name:     text,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	})
+	return len(c.suite.Suites) - 1
+}
+
+func (w *walker) test(name string, node, body *sitter.Node, tags []string) domain.Test {
+	test := domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Tags:     tags,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	switch {
+	case isPending(body, w.source):
+		test.Status = domain.TestStatusTodo
+		test.Modifier = ModifierPending
+	case callsPendingUntilFixed(body, w.source):
+		test.Status = domain.TestStatusXfail
+		test.Modifier = ModifierPendingUntilFixed
+	}
+	return test
+}
+
+// isPending reports whether a test body is "(pending)" or ends with "pending".
+func isPending(body *sitter.Node, source []byte) bool {
+	if body == nil {
+		return false
+	}
+	if scalaast.IsIdentifier(body, source, "pending") {
+		return true
+	}
+	if body.Type() != scalaast.NodeBlock || body.NamedChildCount() == 0 {
+		return false
+	}
+	return scalaast.IsIdentifier(body.NamedChild(int(body.NamedChildCount())-1), source, "pending")
+}
+
+func callsPendingUntilFixed(body *sitter.Node, source []byte) bool {
+	if body == nil {
+		return false
+	}
+	found := false
+	parser.WalkTree(body, func(node *sitter.Node) bool {
+		if found {
+			return false
+		}
+		if node.Type() == scalaast.NodeIdentifier && node.Content(source) == "pendingUntilFixed" {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// tagNodes returns the tag expressions of taggedAs(a) or taggedAs(a, b).
+func tagNodes(node *sitter.Node) []*sitter.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Type() != scalaast.NodeParenthesizedExpression && node.Type() != "tuple_expression" {
+		return []*sitter.Node{node}
+	}
+	nodes := make([]*sitter.Node, 0, node.NamedChildCount())
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		nodes = append(nodes, node.NamedChild(i))
+	}
+	return nodes
+}
+
+// tags names tag arguments: Slow and Tags.Slow yield "Slow", Tag("db") yields "db".
+func (w *walker) tags(nodes []*sitter.Node) []string {
+	var tags []string
+	for _, node := range nodes {
+		switch node.Type() {
+		case scalaast.NodeIdentifier:
+			tags = append(tags, node.Content(w.source))
+		case scalaast.NodeFieldExpression:
+			if field := node.ChildByFieldName("field"); field != nil {
+				tags = append(tags, field.Content(w.source))
+			}
+		case scalaast.NodeCallExpression:
+			fn := node.ChildByFieldName("function")
+			args := scalaast.Arguments(node.ChildByFieldName("arguments"))
+			if fn != nil && fn.Content(w.source) == "Tag" && len(args) == 1 {
+				if name, ok := scalaast.StringValue(args[0], w.source); ok {
+					tags = append(tags, name)
+				}
+			}
+		}
+	}
+	return tags
+}
@@ -0,0 +1,250 @@
+package scalatest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "scalatest", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageScala}, def.Languages)
+	assert.NotNil(t, def.ConfigParser)
+}
+
+func TestScalaTestImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"org.scalatest.funsuite.AnyFunSuite", true},
+		{"org.scalatest", true},
+		{"org.scalatestplus.mockito.MockitoSugar", false},
+		{"munit.FunSuite", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.importPath})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func TestScalaTestContentMatcher_Match(t *testing.T) {
+	matcher := &ScalaTestContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"AnyFunSuite", "class CartSuite extends AnyFunSuite {", true},
+		{"AsyncFlatSpec", "class ApiSpec extends AsyncFlatSpec with Matchers {", true},
+		{"legacy WordSpec", "class SetSpec extends WordSpec {", true},
+		{"specs2", "class HelloSpec extends Specification {", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(tt.content)})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func parse(t *testing.T, source string) *domain.TestFile {
+	t.Helper()
+	file, err := (&ScalaTestParser{}).Parse(context.Background(), []byte(source), "src/test/scala/CartSpec.scala")
+	require.NoError(t, err)
+	return file
+}
+
+func testNames(tests []domain.Test) []string {
+	names := make([]string, 0, len(tests))
+	for _, test := range tests {
+		names = append(names, test.Name)
+	}
+	return names
+}
+
+func TestScalaTestParser_FunSuite(t *testing.T) {
+	file := parse(t, `package shop
+
+import org.scalatest.funsuite.AnyFunSuite
+import org.scalatest.Tag
+
+object DbTest extends Tag("db")
+
+class CartSuite extends AnyFunSuite {
+  test("adds items", Slow, Tag("db")) {
+    assert(Cart().add("apple").size == 1)
+  }
+
+  ignore("removes items") {
+  }
+
+  test("applies coupons") (pending)
+
+  test("checks out") {
+    pendingUntilFixed {
+      assert(false)
+    }
+  }
+
+  for (n <- 1 to 3) {
+    test(s"bulk add $n") {}
+  }
+
+  def helper(): Unit = test("not registered") {}
+}
+`)
+
+	require.Len(t, file.Suites, 1)
+	suite := file.Suites[0]
+	assert.Equal(t, "CartSuite", suite.Name)
+	assert.Equal(t, domain.LanguageScala, file.Language)
+	assert.Equal(t, "scalatest", file.Framework)
+
+	require.Len(t, suite.Tests, 5)
+	assert.Equal(t, []string{"adds items", "removes items", "applies coupons", "checks out", "bulk add $n"}, testNames(suite.Tests))
+
+	assert.Equal(t, []string{"Slow", "db"}, suite.Tests[0].Tags)
+	assert.Equal(t, 9, suite.Tests[0].Location.StartLine)
+	assert.Equal(t, 11, suite.Tests[0].Location.EndLine)
+
+	assert.Equal(t, domain.TestStatusSkipped, suite.Tests[1].Status)
+	assert.Equal(t, ModifierIgnore, suite.Tests[1].Modifier)
+	assert.Equal(t, domain.TestStatusTodo, suite.Tests[2].Status)
+	assert.Equal(t, ModifierPending, suite.Tests[2].Modifier)
+	assert.Equal(t, domain.TestStatusXfail, suite.Tests[3].Status)
+	assert.Equal(t, ModifierPendingUntilFixed, suite.Tests[3].Modifier)
+	assert.Equal(t, domain.TestStatusActive, suite.Tests[4].Status)
+}
+
+func TestScalaTestParser_FlatSpec(t *testing.T) {
+	file := parse(t, `class StackSpec extends AnyFlatSpec with Matchers {
+  "A Stack" should "pop values in last-in-first-out order" in {
+  }
+
+  it should "throw if empty" taggedAs(Slow, Tags.Db) in {
+  }
+
+  they must "be immutable" ignore {
+  }
+
+  ignore should "peek without popping" in {
+    assert(Stack(1).peek == 1)
+  }
+
+  behavior of "An empty Queue"
+
+  it should "have size 0" in {}
+  it can "grow" is (pending)
+}
+`)
+
+	require.Len(t, file.Suites, 1)
+	suite := file.Suites[0]
+	require.Len(t, suite.Suites, 2)
+
+	stack := suite.Suites[0]
+	assert.Equal(t, "A Stack", stack.Name)
+	assert.Equal(t, 2, stack.Location.StartLine)
+	assert.Equal(t, 13, stack.Location.EndLine)
+	assert.Equal(t, []string{"should pop values in last-in-first-out order", "should throw if empty", "must be immutable", "should peek without popping"}, testNames(stack.Tests))
+	assert.Equal(t, []string{"Slow", "Db"}, stack.Tests[1].Tags)
+	assert.Equal(t, domain.TestStatusSkipped, stack.Tests[2].Status)
+	assert.Equal(t, domain.TestStatusSkipped, stack.Tests[3].Status)
+	assert.Equal(t, ModifierIgnore, stack.Tests[3].Modifier)
+
+	queue := suite.Suites[1]
+	assert.Equal(t, "An empty Queue", queue.Name)
+	assert.Equal(t, []string{"should have size 0", "can grow"}, testNames(queue.Tests))
+	assert.Equal(t, domain.TestStatusTodo, queue.Tests[1].Status)
+}
+
+func TestScalaTestParser_WordAndFreeSpec(t *testing.T) {
+	file := parse(t, `class SetSpec extends AnyWordSpec {
+  "A Set" when {
+    "empty" should {
+      "have size 0" in {}
+      "throw on head" ignore {}
+    }
+  }
+}
+
+class ListSpec extends AnyFreeSpec {
+  "A List" - {
+    "when empty" - {
+      "has no head" in {}
+    }
+  }
+}
+`)
+
+	require.Len(t, file.Suites, 2)
+
+	set := file.Suites[0]
+	require.Len(t, set.Suites, 1)
+	assert.Equal(t, "A Set when", set.Suites[0].Name)
+	require.Len(t, set.Suites[0].Suites, 1)
+	empty := set.Suites[0].Suites[0]
+	assert.Equal(t, "empty should", empty.Name)
+	assert.Equal(t, []string{"have size 0", "throw on head"}, testNames(empty.Tests))
+	assert.Equal(t, domain.TestStatusSkipped, empty.Tests[1].Status)
+
+	list := file.Suites[1]
+	require.Len(t, list.Suites, 1)
+	assert.Equal(t, "A List", list.Suites[0].Name)
+	require.Len(t, list.Suites[0].Suites, 1)
+	assert.Equal(t, "when empty", list.Suites[0].Suites[0].Name)
+	assert.Equal(t, []string{"has no head"}, testNames(list.Suites[0].Suites[0].Tests))
+}
+
+func TestScalaTestParser_FunSpecAndFeatureSpec(t *testing.T) {
+	file := parse(t, `@Ignore
+class CheckoutSpec extends AnyFunSpec {
+  describe("Checkout") {
+    it("charges the card") {}
+    ignore("sends a receipt") {}
+  }
+}
+
+class LoginFeature extends AnyFeatureSpec with GivenWhenThen {
+  Feature("Login") {
+    Scenario("valid password") {}
+  }
+}
+
+class Helper {
+  def build(): Cart = Cart()
+}
+`)
+
+	require.Len(t, file.Suites, 2)
+
+	checkout := file.Suites[0]
+	assert.Equal(t, domain.TestStatusSkipped, checkout.Status)
+	assert.Equal(t, ModifierIgnoreAnnotation, checkout.Modifier)
+	require.Len(t, checkout.Suites, 1)
+	assert.Equal(t, "Checkout", checkout.Suites[0].Name)
+	assert.Equal(t, []string{"charges the card", "sends a receipt"}, testNames(checkout.Suites[0].Tests))
+
+	login := file.Suites[1]
+	require.Len(t, login.Suites, 1)
+	assert.Equal(t, "Login", login.Suites[0].Name)
+	assert.Equal(t, []string{"valid password"}, testNames(login.Suites[0].Tests))
+}
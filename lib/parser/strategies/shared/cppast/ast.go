@@ -0,0 +1,347 @@
+// Package cppast provides shared C++ AST utilities for macro-based test framework parsers
+// (Catch2, doctest, Boost.Test).
+package cppast
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+// C++ AST node types.
+const (
+	NodeArgumentList         = "argument_list"
+	NodeBinaryExpression     = "binary_expression"
+	NodeCallExpression       = "call_expression"
+	NodeCompoundStatement    = "compound_statement"
+	NodeConcatenatedString   = "concatenated_string"
+	NodeDeclarationList      = "declaration_list"
+	NodeExpressionStatement  = "expression_statement"
+	NodeFunctionDeclarator   = "function_declarator"
+	NodeFunctionDefinition   = "function_definition"
+	NodeIdentifier           = "identifier"
+	NodeLinkageSpecification = "linkage_specification"
+	NodeNamespaceDefinition  = "namespace_definition"
+	NodeParameterDeclaration = "parameter_declaration"
+	NodeParameterList        = "parameter_list"
+	NodeQualifiedIdentifier  = "qualified_identifier"
+	NodeStringContent        = "string_content"
+	NodeStringLiteral        = "string_literal"
+	NodeTypeIdentifier       = "type_identifier"
+)
+
+// preprocessor blocks whose children are scanned as if they were inline.
+var transparentBlocks = map[string]bool{
+	"preproc_if":      true,
+	"preproc_ifdef":   true,
+	"preproc_else":    true,
+	"preproc_elif":    true,
+	"preproc_elifdef": true,
+}
+
+// MacroCall is a function-like macro invocation such as TEST_CASE("name") { ... }.
+// Tree-sitter has no macro expansion, so a call is either an expression statement
+// followed by a compound statement, or (when all arguments are bare identifiers)
+// a function definition whose declarator is the macro name.
+type MacroCall struct {
+	// Args are the macro arguments: expressions, or parameter declarations in the
+	// function definition form.
+	Args []*sitter.Node
+	// Body is the block following the invocation. Nil for body-less macros
+	// such as BOOST_AUTO_TEST_SUITE_END().
+	Body *sitter.Node
+	// Name is the macro name (e.g., "TEST_CASE").
+	Name string
+	// Node is the expression statement or function definition of the invocation.
+	Node *sitter.Node
+}
+
+// Location returns the range of the invocation including its body.
+func (m MacroCall) Location(filename string) domain.Location {
+	loc := parser.GetLocation(m.Node, filename)
+	if m.Body != nil {
+		end := parser.GetLocation(m.Body, filename)
+		loc.EndLine = end.EndLine
+		loc.EndCol = end.EndCol
+	}
+	return loc
+}
+
+// MacroCalls returns the macro invocations directly inside container, in source order.
+// Namespace, extern "C" and preprocessor conditional blocks are searched transparently.
+func MacroCalls(container *sitter.Node, source []byte) []MacroCall {
+	if container == nil {
+		return nil
+	}
+
+	var calls []MacroCall
+	count := int(container.ChildCount())
+	for i := 0; i < count; i++ {
+		child := container.Child(i)
+
+		switch {
+		case child.Type() == NodeExpressionStatement:
+			call, ok := expressionMacro(child, source)
+			if !ok {
+				continue
+			}
+			if i+1 < count && container.Child(i+1).Type() == NodeCompoundStatement {
+				call.Body = container.Child(i + 1)
+				i++
+			}
+			calls = append(calls, call)
+
+		case child.Type() == NodeFunctionDefinition:
+			if call, ok := definitionMacro(child, source); ok {
+				calls = append(calls, call)
+			}
+
+		case child.Type() == NodeNamespaceDefinition || child.Type() == NodeLinkageSpecification:
+			calls = append(calls, MacroCalls(child.ChildByFieldName("body"), source)...)
+
+		case transparentBlocks[child.Type()]:
+			calls = append(calls, MacroCalls(child, source)...)
+		}
+	}
+	return calls
+}
+
+func expressionMacro(stmt *sitter.Node, source []byte) (MacroCall, bool) {
+	call := parser.FindChildByType(stmt, NodeCallExpression)
+	if call == nil {
+		return MacroCall{}, false
+	}
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != NodeIdentifier {
+		return MacroCall{}, false
+	}
+
+	var args []*sitter.Node
+	if argList := call.ChildByFieldName("arguments"); argList != nil {
+		for i := 0; i < int(argList.NamedChildCount()); i++ {
+			args = append(args, argList.NamedChild(i))
+		}
+	}
+
+	return MacroCall{
+		Args: args,
+		Name: parser.GetNodeText(fn, source),
+		Node: stmt,
+	}, true
+}
+
+func definitionMacro(def *sitter.Node, source []byte) (MacroCall, bool) {
+	declarator := def.ChildByFieldName("declarator")
+	if declarator == nil || declarator.Type() != NodeFunctionDeclarator {
+		return MacroCall{}, false
+	}
+	name := declarator.ChildByFieldName("declarator")
+	if name == nil || name.Type() != NodeIdentifier {
+		return MacroCall{}, false
+	}
+
+	var args []*sitter.Node
+	if params := declarator.ChildByFieldName("parameters"); params != nil {
+		for i := 0; i < int(params.NamedChildCount()); i++ {
+			args = append(args, params.NamedChild(i))
+		}
+	}
+
+	return MacroCall{
+		Args: args,
+		Body: def.ChildByFieldName("body"),
+		Name: parser.GetNodeText(name, source),
+		Node: def,
+	}, true
+}
+
+// StringArg returns the value of the string literal at index i.
+// Decorated arguments like `"name" * doctest::skip()` yield the leftmost literal.
+// Returns false if the argument is missing or not a literal.
+func (m MacroCall) StringArg(i int, source []byte) (string, bool) {
+	if i >= len(m.Args) {
+		return "", false
+	}
+	return StringValue(m.Args[i], source)
+}
+
+// StringValue returns the value of a string literal, adjacent literals concatenated.
+// Binary expressions are followed to their left operand.
+func StringValue(node *sitter.Node, source []byte) (string, bool) {
+	for node != nil && node.Type() == NodeBinaryExpression {
+		node = node.ChildByFieldName("left")
+	}
+	if node == nil {
+		return "", false
+	}
+
+	switch node.Type() {
+	case NodeStringLiteral:
+		text := parser.GetNodeText(node, source)
+		if unquoted, err := strconv.Unquote(text); err == nil {
+			return unquoted, true
+		}
+		return strings.Trim(text, `"`), true
+	case NodeConcatenatedString:
+		var b strings.Builder
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			part, ok := StringValue(node.NamedChild(i), source)
+			if !ok {
+				return "", false
+			}
+			b.WriteString(part)
+		}
+		return b.String(), true
+	}
+	return "", false
+}
+
+// IdentifierArg returns the bare identifier at index i, in either macro form.
+func (m MacroCall) IdentifierArg(i int, source []byte) (string, bool) {
+	if i >= len(m.Args) {
+		return "", false
+	}
+	arg := m.Args[i]
+	switch arg.Type() {
+	case NodeIdentifier, NodeTypeIdentifier:
+		return parser.GetNodeText(arg, source), true
+	case NodeParameterDeclaration:
+		if typ := arg.ChildByFieldName("type"); typ != nil && typ.Type() == NodeTypeIdentifier {
+			if arg.ChildByFieldName("declarator") == nil {
+				return parser.GetNodeText(typ, source), true
+			}
+		}
+	}
+	return "", false
+}
+
+// DecoratorCalls returns the names of the calls combined with `*` in a decorated
+// argument, without namespace qualifiers (e.g., `"x" * doctest::skip()` -> ["skip"]).
+func DecoratorCalls(node *sitter.Node, source []byte) []DecoratorCall {
+	var calls []DecoratorCall
+	parser.WalkTree(node, func(n *sitter.Node) bool {
+		if n.Type() != NodeCallExpression {
+			return true
+		}
+		fn := n.ChildByFieldName("function")
+		if fn == nil {
+			return true
+		}
+		name := parser.GetNodeText(fn, source)
+		if idx := strings.LastIndex(name, "::"); idx >= 0 {
+			name = name[idx+2:]
+		}
+		if idx := strings.Index(name, "<"); idx >= 0 {
+			name = name[:idx]
+		}
+		calls = append(calls, DecoratorCall{Args: n.ChildByFieldName("arguments"), Name: name})
+		return false
+	})
+	return calls
+}
+
+// DecoratorCall is one decorator applied to a test, such as doctest::skip(true).
+type DecoratorCall struct {
+	// Args is the argument list of the call.
+	Args *sitter.Node
+	// Name is the unqualified function name.
+	Name string
+}
+
+// FirstArgText returns the source text of the first argument, or "" if there is none.
+func (d DecoratorCall) FirstArgText(source []byte) string {
+	if d.Args == nil || d.Args.NamedChildCount() == 0 {
+		return ""
+	}
+	return parser.GetNodeText(d.Args.NamedChild(0), source)
+}
+
+// HeaderMatcher matches #include signals by header directory or file name, so that
+// vendored copies (e.g., "third_party/catch.hpp") are recognised as well.
+type HeaderMatcher struct {
+	// Dirs are header directory prefixes (e.g., "catch2/").
+	Dirs []string
+	// Files are header file names (e.g., "catch.hpp").
+	Files []string
+}
+
+// Match evaluates if an import signal names one of the framework headers.
+func (m *HeaderMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalImport {
+		return framework.NoMatch()
+	}
+
+	header := signal.Value
+	for _, dir := range m.Dirs {
+		if strings.HasPrefix(header, dir) || strings.Contains(header, "/"+dir) {
+			return framework.DefiniteMatch("include: " + header)
+		}
+	}
+	base := path.Base(header)
+	for _, file := range m.Files {
+		if base == file {
+			return framework.DefiniteMatch("include: " + header)
+		}
+	}
+	return framework.NoMatch()
+}
+
+// SectionNamer returns the display name of a nested section macro
+// (SECTION, SUBCASE, GIVEN, ...), or false if call is not a section.
+type SectionNamer func(call MacroCall, source []byte) (string, bool)
+
+// BuildSections converts the section macros directly inside body into leaf tests and
+// nested suites. A section that contains sections becomes a suite; a leaf section a test,
+// matching how Catch2 and doctest run a test case once per leaf section.
+// Sections take the status and modifier of the enclosing test case.
+func BuildSections(body *sitter.Node, source []byte, filename string, sectionName SectionNamer, status domain.TestStatus, modifier string) ([]domain.Test, []domain.TestSuite) {
+	var tests []domain.Test
+	var suites []domain.TestSuite
+
+	for _, call := range MacroCalls(body, source) {
+		name, ok := sectionName(call, source)
+		if !ok {
+			continue
+		}
+
+		childTests, childSuites := BuildSections(call.Body, source, filename, sectionName, status, modifier)
+		if len(childTests) == 0 && len(childSuites) == 0 {
+			tests = append(tests, domain.Test{
+				Location: call.Location(filename),
+				Modifier: modifier,
+				Name:     name,
+				Status:   status,
+			})
+			continue
+		}
+
+		suites = append(suites, domain.TestSuite{
+			Location: call.Location(filename),
+			Modifier: modifier,
+			Name:     name,
+			Status:   status,
+			Suites:   childSuites,
+			Tests:    childTests,
+		})
+	}
+
+	return tests, suites
+}
+
+// BDDSectionPrefixes maps Catch2/doctest BDD section macros to the prefix
+// prepended to their description in reports.
+var BDDSectionPrefixes = map[string]string{
+	"GIVEN":     "Given: ",
+	"AND_GIVEN": "And given: ",
+	"WHEN":      "When: ",
+	"AND_WHEN":  "And when: ",
+	"THEN":      "Then: ",
+	"AND_THEN":  "And: ",
+}
@@ -0,0 +1,89 @@
+package cppast
+
+import (
+	"context"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/cpp"
+
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func parseCpp(t *testing.T, content string) *sitter.Node {
+	t.Helper()
+	parser := sitter.NewParser()
+	parser.SetLanguage(cpp.GetLanguage())
+	tree, err := parser.ParseCtx(context.Background(), nil, []byte(content))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	return tree.RootNode()
+}
+
+func TestMacroCalls(t *testing.T) {
+	source := `
+namespace unit {
+TEST_CASE("expression " "form", "[tag]") {
+    REQUIRE(true);
+}
+}
+
+BOOST_AUTO_TEST_CASE(definition_form)
+{
+}
+
+#ifdef ENABLE_SLOW
+SUITE_END()
+#endif
+`
+	root := parseCpp(t, source)
+	calls := MacroCalls(root, []byte(source))
+
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 macro calls, got %d", len(calls))
+	}
+
+	first := calls[0]
+	if first.Name != "TEST_CASE" || first.Body == nil {
+		t.Errorf("expected TEST_CASE with body, got %q (body %v)", first.Name, first.Body != nil)
+	}
+	if name, ok := first.StringArg(0, []byte(source)); !ok || name != "expression form" {
+		t.Errorf("StringArg(0) = %q, %v", name, ok)
+	}
+	if loc := first.Location("a.cpp"); loc.StartLine != 3 || loc.EndLine != 5 {
+		t.Errorf("Location() = %d-%d, want 3-5", loc.StartLine, loc.EndLine)
+	}
+
+	second := calls[1]
+	if name, ok := second.IdentifierArg(0, []byte(source)); second.Name != "BOOST_AUTO_TEST_CASE" || !ok || name != "definition_form" {
+		t.Errorf("unexpected definition form call: %q %q", second.Name, name)
+	}
+
+	if calls[2].Name != "SUITE_END" || calls[2].Body != nil {
+		t.Errorf("expected body-less SUITE_END, got %q", calls[2].Name)
+	}
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	matcher := &HeaderMatcher{Dirs: []string{"catch2/"}, Files: []string{"catch.hpp"}}
+
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{"catch2/catch_test_macros.hpp", true},
+		{"vendor/catch2/catch_all.hpp", true},
+		{"catch.hpp", true},
+		{"../third_party/catch.hpp", true},
+		{"mycatch2/x.hpp", false},
+		{"catch.h", false},
+	}
+
+	for _, tt := range tests {
+		result := matcher.Match(context.Background(), framework.Signal{Type: framework.SignalImport, Value: tt.header})
+		if (result.Confidence > 0) != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.header, result.Confidence > 0, tt.want)
+		}
+	}
+}
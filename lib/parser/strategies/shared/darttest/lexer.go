@@ -0,0 +1,254 @@
+package darttest
+
+// tokenKind classifies the tokens the test parser cares about.
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenPunct
+	tokenOther
+)
+
+// token is a lexical token of Dart source. Comments and whitespace are dropped.
+type token struct {
+	kind tokenKind
+	// text is the raw source of the token.
+	text string
+	// value is the string content for tokenString, with escapes resolved and
+	// interpolations kept as written.
+	value string
+	// interpolated reports whether a string contains $name or ${expr}.
+	interpolated bool
+	start        int
+	end          int
+	line         int
+	col          int
+}
+
+// lexer splits Dart source into tokens. It understands just enough of the
+// language (comments, raw/multi-line strings and interpolation) to keep
+// brackets balanced and string literals intact.
+type lexer struct {
+	source    []byte
+	pos       int
+	line      int
+	lineStart int
+}
+
+func tokenize(source []byte) []token {
+	l := &lexer{source: source, line: 1}
+	var tokens []token
+	for {
+		tok, ok := l.next()
+		if !ok {
+			return tokens
+		}
+		tokens = append(tokens, tok)
+	}
+}
+
+func (l *lexer) next() (token, bool) {
+	l.skipTrivia()
+	if l.pos >= len(l.source) {
+		return token{}, false
+	}
+
+	start, line, col := l.pos, l.line, l.pos-l.lineStart
+	c := l.source[l.pos]
+
+	var tok token
+	switch {
+	case c == '\'' || c == '"':
+		tok = l.scanString(false)
+	case (c == 'r' || c == 'R') && l.peek(1) != 0 && isQuote(l.peek(1)):
+		l.pos++
+		tok = l.scanString(true)
+	case isIdentStart(c):
+		for l.pos < len(l.source) && isIdentPart(l.source[l.pos]) {
+			l.pos++
+		}
+		tok = token{kind: tokenIdent}
+	case isDigit(c):
+		for l.pos < len(l.source) && (isIdentPart(l.source[l.pos]) || l.source[l.pos] == '.') {
+			l.pos++
+		}
+		tok = token{kind: tokenOther}
+	default:
+		l.pos++
+		tok = token{kind: tokenPunct}
+	}
+
+	tok.start, tok.end = start, l.pos
+	tok.text = string(l.source[start:l.pos])
+	tok.line, tok.col = line, col
+	return tok, true
+}
+
+func (l *lexer) skipTrivia() {
+	for l.pos < len(l.source) {
+		c := l.source[l.pos]
+		switch {
+		case c == '\n':
+			l.newline()
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			l.pos++
+		case c == '/' && l.peek(1) == '/':
+			for l.pos < len(l.source) && l.source[l.pos] != '\n' {
+				l.pos++
+			}
+		case c == '/' && l.peek(1) == '*':
+			l.skipBlockComment()
+		default:
+			return
+		}
+	}
+}
+
+// skipBlockComment skips a /* */ comment. Dart block comments nest.
+func (l *lexer) skipBlockComment() {
+	depth := 0
+	for l.pos < len(l.source) {
+		switch {
+		case l.source[l.pos] == '/' && l.peek(1) == '*':
+			depth++
+			l.pos += 2
+		case l.source[l.pos] == '*' && l.peek(1) == '/':
+			depth--
+			l.pos += 2
+			if depth == 0 {
+				return
+			}
+		case l.source[l.pos] == '\n':
+			l.newline()
+		default:
+			l.pos++
+		}
+	}
+}
+
+// scanString reads a string literal starting at the opening quote.
+func (l *lexer) scanString(raw bool) token {
+	quote := l.source[l.pos]
+	triple := l.peek(1) == quote && l.peek(2) == quote
+	if triple {
+		l.pos += 3
+	} else {
+		l.pos++
+	}
+
+	tok := token{kind: tokenString}
+	var value []byte
+	for l.pos < len(l.source) {
+		c := l.source[l.pos]
+		switch {
+		case c == quote && (!triple || (l.peek(1) == quote && l.peek(2) == quote)):
+			if triple {
+				l.pos += 3
+			} else {
+				l.pos++
+			}
+			tok.value = string(value)
+			return tok
+		case c == '\n' && !triple:
+			// Unterminated single-line string; stop at the line break.
+			tok.value = string(value)
+			return tok
+		case c == '\n':
+			value = append(value, c)
+			l.newline()
+		case c == '\\' && !raw && l.pos+1 < len(l.source):
+			value = append(value, unescape(l.source[l.pos+1])...)
+			if l.source[l.pos+1] == '\n' {
+				l.pos++
+				l.newline()
+				continue
+			}
+			l.pos += 2
+		case c == '$' && !raw && l.peek(1) == '{':
+			start := l.pos
+			l.skipInterpolation()
+			value = append(value, l.source[start:l.pos]...)
+			tok.interpolated = true
+		case c == '$' && !raw && isIdentStart(l.peek(1)):
+			tok.interpolated = true
+			value = append(value, c)
+			l.pos++
+		default:
+			value = append(value, c)
+			l.pos++
+		}
+	}
+	tok.value = string(value)
+	return tok
+}
+
+// skipInterpolation skips ${ ... }, including nested strings and braces.
+func (l *lexer) skipInterpolation() {
+	l.pos += 2
+	depth := 1
+	for l.pos < len(l.source) {
+		c := l.source[l.pos]
+		switch {
+		case isQuote(c):
+			l.scanString(false)
+			continue
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				l.pos++
+				return
+			}
+		case c == '\n':
+			l.newline()
+			continue
+		}
+		l.pos++
+	}
+}
+
+func (l *lexer) newline() {
+	l.pos++
+	l.line++
+	l.lineStart = l.pos
+}
+
+func (l *lexer) peek(offset int) byte {
+	if l.pos+offset < len(l.source) {
+		return l.source[l.pos+offset]
+	}
+	return 0
+}
+
+func unescape(c byte) []byte {
+	switch c {
+	case 'n':
+		return []byte{'\n'}
+	case 't':
+		return []byte{'\t'}
+	case 'r':
+		return []byte{'\r'}
+	case '\n':
+		return nil
+	default:
+		return []byte{c}
+	}
+}
+
+func isQuote(c byte) bool {
+	return c == '\'' || c == '"'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
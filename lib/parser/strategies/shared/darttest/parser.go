@@ -0,0 +1,324 @@
+// Package darttest parses Dart test files written against package:test and
+// flutter_test.
+//
+// The tree-sitter bindings used by the other strategies ship no Dart grammar,
+// so the parser works on a token stream: it finds group/test calls, matches
+// their brackets and reads the description and named arguments.
+package darttest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// Test API functions.
+const (
+	FuncGroup       = "group"
+	FuncTest        = "test"
+	FuncTestWidgets = "testWidgets"
+	FuncTestGoldens = "testGoldens"
+)
+
+// Named arguments that change the status or tags of a group or test.
+const (
+	ArgSkip = "skip"
+	ArgSolo = "solo"
+	ArgTags = "tags"
+)
+
+// Modifiers recorded on skipped or focused tests.
+const (
+	ModifierSkip = "skip"
+	ModifierSolo = "solo"
+)
+
+var suiteFunctions = map[string]bool{
+	FuncGroup: true,
+}
+
+var testFunctions = map[string]bool{
+	FuncTest:        true,
+	FuncTestWidgets: true,
+	FuncTestGoldens: true,
+}
+
+// Parse extracts groups and tests from a Dart test file.
+func Parse(ctx context.Context, source []byte, filename string, frameworkName string) (*domain.TestFile, error) {
+	p := &fileParser{
+		source:   source,
+		filename: filename,
+		tokens:   tokenize(source),
+	}
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageDart,
+		Framework: frameworkName,
+	}
+	p.parseRange(0, len(p.tokens), file, nil)
+
+	return file, nil
+}
+
+type fileParser struct {
+	source   []byte
+	filename string
+	tokens   []token
+}
+
+// argument is a top-level argument of a call, as a token range.
+type argument struct {
+	name  string
+	start int
+	end   int
+}
+
+// parseRange walks tokens[from:to] and adds every group and test call it finds.
+// Calls inside loops and helper closures are found as well, since only the
+// call shape matters.
+func (p *fileParser) parseRange(from, to int, file *domain.TestFile, parent *domain.TestSuite) {
+	for i := from; i < to; i++ {
+		name := p.tokens[i].text
+		isSuite, isTest := suiteFunctions[name], testFunctions[name]
+		if p.tokens[i].kind != tokenIdent || (!isSuite && !isTest) || !p.isCall(i) {
+			continue
+		}
+
+		closing := p.matchingBracket(i + 1)
+		if closing < 0 || closing >= to {
+			return
+		}
+		args := p.splitArguments(i+2, closing)
+
+		if isSuite {
+			suite := p.buildSuite(i, closing, args)
+			if pos := positional(args); len(pos) > 1 {
+				p.parseRange(pos[1].start, pos[1].end, file, &suite)
+			}
+			addSuite(suite, parent, file)
+		} else {
+			addTest(p.buildTest(i, closing, args), parent, file)
+		}
+		i = closing
+	}
+}
+
+// isCall reports whether the identifier at i is a free function call, so that
+// member calls (x.test()) and declarations (void test()) are ignored.
+func (p *fileParser) isCall(i int) bool {
+	if i+1 >= len(p.tokens) || p.tokens[i+1].text != "(" {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	prev := p.tokens[i-1]
+	if prev.kind == tokenIdent {
+		return prev.text == "return" || prev.text == "await"
+	}
+	return prev.text != "." && prev.text != ">"
+}
+
+func (p *fileParser) buildSuite(start, end int, args []argument) domain.TestSuite {
+	status, modifier, reason := p.status(args)
+	return domain.TestSuite{
+		Name:       p.description(args),
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Tags:       p.tags(args),
+		Location:   p.location(start, end),
+	}
+}
+
+func (p *fileParser) buildTest(start, end int, args []argument) domain.Test {
+	status, modifier, reason := p.status(args)
+	return domain.Test{
+		Name:       p.description(args),
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Tags:       p.tags(args),
+		Location:   p.location(start, end),
+	}
+}
+
+// description returns the first positional argument: adjacent string literals
+// are joined, anything else (a Type, a variable) is kept as written.
+func (p *fileParser) description(args []argument) string {
+	pos := positional(args)
+	if len(pos) == 0 {
+		return ""
+	}
+	arg := pos[0]
+	if value, ok := p.stringValue(arg.start, arg.end); ok {
+		return value
+	}
+	return p.text(arg.start, arg.end)
+}
+
+// status reads skip: and solo:. A string skip value is the reason; values
+// other than literals are runtime conditions and are ignored.
+func (p *fileParser) status(args []argument) (domain.TestStatus, string, string) {
+	focused := false
+	for _, arg := range args {
+		switch arg.name {
+		case ArgSkip:
+			if reason, ok := p.stringValue(arg.start, arg.end); ok {
+				return domain.TestStatusSkipped, ModifierSkip, reason
+			}
+			if p.isTrue(arg) {
+				return domain.TestStatusSkipped, ModifierSkip, ""
+			}
+		case ArgSolo:
+			focused = p.isTrue(arg)
+		}
+	}
+	if focused {
+		return domain.TestStatusFocused, ModifierSolo, ""
+	}
+	return domain.TestStatusActive, "", ""
+}
+
+// tags reads tags: given as a string or a list or set of strings.
+func (p *fileParser) tags(args []argument) []string {
+	for _, arg := range args {
+		if arg.name != ArgTags {
+			continue
+		}
+		var tags []string
+		for i := arg.start; i < arg.end; i++ {
+			if tok := p.tokens[i]; tok.kind == tokenString && !tok.interpolated && tok.value != "" {
+				tags = append(tags, tok.value)
+			}
+		}
+		return tags
+	}
+	return nil
+}
+
+func (p *fileParser) isTrue(arg argument) bool {
+	return arg.end-arg.start == 1 && p.tokens[arg.start].text == "true"
+}
+
+// stringValue returns the joined value of adjacent string literals
+// ('a' 'b') spanning tokens[from:to].
+func (p *fileParser) stringValue(from, to int) (string, bool) {
+	if from >= to {
+		return "", false
+	}
+	var b strings.Builder
+	for i := from; i < to; i++ {
+		if p.tokens[i].kind != tokenString {
+			return "", false
+		}
+		b.WriteString(p.tokens[i].value)
+	}
+	return b.String(), true
+}
+
+// text returns the source spanned by tokens[from:to] with whitespace collapsed.
+func (p *fileParser) text(from, to int) string {
+	if from >= to {
+		return ""
+	}
+	raw := string(p.source[p.tokens[from].start:p.tokens[to-1].end])
+	return strings.Join(strings.Fields(raw), " ")
+}
+
+func (p *fileParser) location(start, end int) domain.Location {
+	first, last := p.tokens[start], p.tokens[end]
+	return domain.Location{
+		File:      p.filename,
+		StartLine: first.line,
+		StartCol:  first.col,
+		EndLine:   last.line,
+		EndCol:    last.col + 1,
+	}
+}
+
+// matchingBracket returns the index of the bracket closing the one at open.
+func (p *fileParser) matchingBracket(open int) int {
+	depth := 0
+	for i := open; i < len(p.tokens); i++ {
+		if p.tokens[i].kind != tokenPunct {
+			continue
+		}
+		switch p.tokens[i].text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitArguments splits tokens[from:to] on top-level commas.
+func (p *fileParser) splitArguments(from, to int) []argument {
+	var args []argument
+	depth := 0
+	start := from
+	flush := func(end int) {
+		if start >= end {
+			return
+		}
+		arg := argument{start: start, end: end}
+		if end-start >= 2 && p.tokens[start].kind == tokenIdent && p.tokens[start+1].text == ":" {
+			arg.name = p.tokens[start].text
+			arg.start += 2
+		}
+		args = append(args, arg)
+	}
+
+	for i := from; i < to; i++ {
+		tok := p.tokens[i]
+		if tok.kind != tokenPunct {
+			continue
+		}
+		switch tok.text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case ",":
+			if depth == 0 {
+				flush(i)
+				start = i + 1
+			}
+		}
+	}
+	flush(to)
+	return args
+}
+
+func positional(args []argument) []argument {
+	var result []argument
+	for _, arg := range args {
+		if arg.name == "" {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
+
+func addTest(test domain.Test, parent *domain.TestSuite, file *domain.TestFile) {
+	if parent != nil {
+		parent.Tests = append(parent.Tests, test)
+		return
+	}
+	file.Tests = append(file.Tests, test)
+}
+
+func addSuite(suite domain.TestSuite, parent *domain.TestSuite, file *domain.TestFile) {
+	if parent != nil {
+		parent.Suites = append(parent.Suites, suite)
+		return
+	}
+	file.Suites = append(file.Suites, suite)
+}
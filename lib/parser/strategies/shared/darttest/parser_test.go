@@ -0,0 +1,203 @@
+package darttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestParse(t *testing.T) {
+	source := `import 'package:test/test.dart';
+
+void main() {
+  group('Calculator', () {
+    setUp(() {});
+
+    test('adds two numbers', () {
+      expect(add(1, 2), equals(3));
+    });
+
+    group('division', () {
+      test('by zero throws', () {
+        expect(() => divide(1, 0), throwsArgumentError);
+      });
+    });
+  });
+
+  test('standalone', () {});
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "calculator_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.LanguageDart, file.Language)
+	assert.Equal(t, "dart-test", file.Framework)
+	require.Len(t, file.Suites, 1)
+	require.Len(t, file.Tests, 1)
+	assert.Equal(t, "standalone", file.Tests[0].Name)
+
+	suite := file.Suites[0]
+	assert.Equal(t, "Calculator", suite.Name)
+	assert.Equal(t, 4, suite.Location.StartLine)
+	assert.Equal(t, 16, suite.Location.EndLine)
+	require.Len(t, suite.Tests, 1)
+	assert.Equal(t, "adds two numbers", suite.Tests[0].Name)
+	assert.Equal(t, domain.TestStatusActive, suite.Tests[0].Status)
+	assert.Equal(t, 7, suite.Tests[0].Location.StartLine)
+	assert.Equal(t, 9, suite.Tests[0].Location.EndLine)
+
+	require.Len(t, suite.Suites, 1)
+	assert.Equal(t, "division", suite.Suites[0].Name)
+	require.Len(t, suite.Suites[0].Tests, 1)
+	assert.Equal(t, "by zero throws", suite.Suites[0].Tests[0].Name)
+}
+
+func TestParse_Flutter(t *testing.T) {
+	source := `import 'package:flutter_test/flutter_test.dart';
+
+void main() {
+  testWidgets('Counter increments', (WidgetTester tester) async {
+    await tester.pumpWidget(const MyApp());
+    await tester.tap(find.byIcon(Icons.add));
+  });
+
+  testGoldens('Counter golden', (tester) async {});
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "widget_test.dart", "flutter-test")
+	require.NoError(t, err)
+
+	require.Len(t, file.Tests, 2)
+	assert.Equal(t, "Counter increments", file.Tests[0].Name)
+	assert.Equal(t, "Counter golden", file.Tests[1].Name)
+}
+
+func TestParse_Status(t *testing.T) {
+	source := `void main() {
+  test('skipped with reason', () {}, skip: 'flaky on CI');
+  test('skipped', () {}, skip: true);
+  test('not skipped', () {}, skip: false);
+  test('conditional', () {}, skip: Platform.isWindows);
+  test('focused', () {}, solo: true);
+  testWidgets('widget skip', (tester) async {}, skip: true);
+
+  group('skipped group', () {
+    test('inner', () {});
+  }, skip: 'needs server');
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "status_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	require.Len(t, file.Tests, 6)
+	tests := []struct {
+		status   domain.TestStatus
+		modifier string
+		reason   string
+	}{
+		{domain.TestStatusSkipped, ModifierSkip, "flaky on CI"},
+		{domain.TestStatusSkipped, ModifierSkip, ""},
+		{domain.TestStatusActive, "", ""},
+		{domain.TestStatusActive, "", ""},
+		{domain.TestStatusFocused, ModifierSolo, ""},
+		{domain.TestStatusSkipped, ModifierSkip, ""},
+	}
+	for i, tt := range tests {
+		t.Run(file.Tests[i].Name, func(t *testing.T) {
+			assert.Equal(t, tt.status, file.Tests[i].Status)
+			assert.Equal(t, tt.modifier, file.Tests[i].Modifier)
+			assert.Equal(t, tt.reason, file.Tests[i].SkipReason)
+		})
+	}
+
+	require.Len(t, file.Suites, 1)
+	assert.Equal(t, domain.TestStatusSkipped, file.Suites[0].Status)
+	assert.Equal(t, "needs server", file.Suites[0].SkipReason)
+	require.Len(t, file.Suites[0].Tests, 1)
+}
+
+func TestParse_Tags(t *testing.T) {
+	source := `void main() {
+  group('api', () {
+    test('list', () {}, tags: ['slow', 'network']);
+  }, tags: 'integration');
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "tags_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	require.Len(t, file.Suites, 1)
+	assert.Equal(t, []string{"integration"}, file.Suites[0].Tags)
+	require.Len(t, file.Suites[0].Tests, 1)
+	assert.Equal(t, []string{"slow", "network"}, file.Suites[0].Tests[0].Tags)
+}
+
+func TestParse_Descriptions(t *testing.T) {
+	source := `void main() {
+  group(Calculator, () {
+    test('adjacent ' "strings", () {});
+    test(r'raw \d+', () {});
+    test('interpolated $value and ${items.length}', () {});
+    test('''multi
+line''', () {});
+    test('escaped \'quote\'', () {});
+  });
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "names_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	require.Len(t, file.Suites, 1)
+	suite := file.Suites[0]
+	assert.Equal(t, "Calculator", suite.Name)
+
+	var names []string
+	for _, test := range suite.Tests {
+		names = append(names, test.Name)
+	}
+	assert.Equal(t, []string{
+		"adjacent strings",
+		`raw \d+`,
+		"interpolated $value and ${items.length}",
+		"multi\nline",
+		"escaped 'quote'",
+	}, names)
+}
+
+func TestParse_IgnoresNonCalls(t *testing.T) {
+	source := `// test('commented out', () {});
+/* group('block', () {
+  /* nested */ test('inside', () {});
+}); */
+void test(String name) {}
+
+void main() {
+  final test = Tester();
+  test.run();
+  runner.test('member call', () {});
+  print("test('in a string', () {})");
+
+  for (final value in [1, 2]) {
+    test('loop $value', () {});
+  }
+}
+`
+	file, err := Parse(context.Background(), []byte(source), "noise_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	require.Len(t, file.Tests, 1)
+	assert.Equal(t, "loop $value", file.Tests[0].Name)
+	assert.Equal(t, 14, file.Tests[0].Location.StartLine)
+}
+
+func TestParse_Empty(t *testing.T) {
+	file, err := Parse(context.Background(), []byte(""), "empty_test.dart", "dart-test")
+	require.NoError(t, err)
+
+	assert.Empty(t, file.Suites)
+	assert.Empty(t, file.Tests)
+}
@@ -224,6 +224,86 @@ func ParseAssignmentExpression(argNode *sitter.Node, source []byte) (string, str
 	return "", ""
 }
 
+// GetPositionalAttributeArguments returns the source text of positional attribute arguments,
+// skipping named ones (TestName = "...", Skip = "...").
+// For [InlineData(1, "a", Skip = "x")], returns ["1", "\"a\""].
+func GetPositionalAttributeArguments(attr *sitter.Node, source []byte) []string {
+	argList := FindAttributeArgumentList(attr)
+	if argList == nil {
+		return nil
+	}
+
+	var args []string
+	for i := 0; i < int(argList.NamedChildCount()); i++ {
+		arg := argList.NamedChild(i)
+		if arg.Type() != NodeAttributeArgument || arg.NamedChildCount() != 1 {
+			continue
+		}
+		if expr := arg.NamedChild(0); expr.Type() != NodeAssignmentExpression {
+			args = append(args, expr.Content(source))
+		}
+	}
+	return args
+}
+
+// GetPositionalStringArguments returns the contents of positional string arguments,
+// with an empty string for arguments that are not string literals.
+// For [Trait("Category", Slow)], returns ["Category", ""].
+func GetPositionalStringArguments(attr *sitter.Node, source []byte) []string {
+	argList := FindAttributeArgumentList(attr)
+	if argList == nil {
+		return nil
+	}
+
+	var args []string
+	for i := 0; i < int(argList.NamedChildCount()); i++ {
+		arg := argList.NamedChild(i)
+		if arg.Type() != NodeAttributeArgument || arg.NamedChildCount() != 1 {
+			continue
+		}
+		if expr := arg.NamedChild(0); expr.Type() != NodeAssignmentExpression {
+			args = append(args, ExtractStringContent(expr, source))
+		}
+	}
+	return args
+}
+
+// GetIgnoreReason returns the message of an [Ignore("...")] attribute (NUnit, MSTest),
+// or "" if the attribute is absent or has no literal message.
+func GetIgnoreReason(attributeLists []*sitter.Node, source []byte) string {
+	for _, attr := range GetAttributes(attributeLists) {
+		name := GetAttributeName(attr, source)
+		if name != "Ignore" && name != "IgnoreAttribute" {
+			continue
+		}
+		if args := GetPositionalStringArguments(attr, source); len(args) > 0 {
+			return args[0]
+		}
+		return ""
+	}
+	return ""
+}
+
+// GetParameterNames returns the parameter names of a method_declaration node.
+func GetParameterNames(node *sitter.Node, source []byte) []string {
+	params := node.ChildByFieldName("parameters")
+	if params == nil {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		param := params.NamedChild(i)
+		if param.Type() != "parameter" {
+			continue
+		}
+		if nameNode := param.ChildByFieldName("name"); nameNode != nil {
+			names = append(names, nameNode.Content(source))
+		}
+	}
+	return names
+}
+
 // IsCSharpTestFileName checks if a filename follows C# test file naming conventions.
 // Matches: *Test.cs, *Tests.cs, Test*.cs, *Spec.cs, *Specs.cs
 func IsCSharpTestFileName(filename string) bool {
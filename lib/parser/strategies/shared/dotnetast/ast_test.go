@@ -2,6 +2,7 @@ package dotnetast
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -55,6 +56,59 @@ func TestGetMethodName(t *testing.T) {
 	}
 }
 
+func TestGetPositionalAttributeArguments(t *testing.T) {
+	source := `public class C {
+    [InlineData(1, "a", null, Skip = "flaky")]
+    public void Add(int x, string y, object z) { }
+}`
+	root := parseCS(t, source)
+
+	var args, params []string
+	walkTree(root, func(n *sitter.Node) bool {
+		if n.Type() == NodeMethodDeclaration {
+			attrs := GetAttributes(GetAttributeLists(n))
+			args = GetPositionalAttributeArguments(attrs[0], []byte(source))
+			params = GetParameterNames(n, []byte(source))
+			return false
+		}
+		return true
+	})
+
+	if strings.Join(args, "|") != `1|"a"|null` {
+		t.Errorf("expected positional args [1 \"a\" null], got %q", args)
+	}
+	if strings.Join(params, "|") != "x|y|z" {
+		t.Errorf("expected parameter names [x y z], got %q", params)
+	}
+}
+
+func TestGetPositionalStringArguments(t *testing.T) {
+	source := `public class C {
+    [Trait("Category", Traits.Slow, Name = "x")]
+    [Category(@"db")]
+    public void Fetch() { }
+}`
+	root := parseCS(t, source)
+
+	var trait, category []string
+	walkTree(root, func(n *sitter.Node) bool {
+		if n.Type() == NodeMethodDeclaration {
+			attrs := GetAttributes(GetAttributeLists(n))
+			trait = GetPositionalStringArguments(attrs[0], []byte(source))
+			category = GetPositionalStringArguments(attrs[1], []byte(source))
+			return false
+		}
+		return true
+	})
+
+	if strings.Join(trait, "|") != "Category|" {
+		t.Errorf("expected [Category \"\"], got %q", trait)
+	}
+	if strings.Join(category, "|") != "db" {
+		t.Errorf("expected [db], got %q", category)
+	}
+}
+
 func TestGetAttributeName(t *testing.T) {
 	tests := []struct {
 		name     string
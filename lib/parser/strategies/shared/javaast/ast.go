@@ -130,6 +130,113 @@ func GetAnnotationArgument(annotation *sitter.Node, source []byte) string {
 	return ""
 }
 
+// GetAnnotationElement returns the value node of the named annotation element.
+// For key "value", the single unnamed element of @Foo(x) is also returned.
+// Returns nil if the annotation has no such element.
+func GetAnnotationElement(annotation *sitter.Node, source []byte, key string) *sitter.Node {
+	args := annotation.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() != "element_value_pair" {
+			if key == "value" {
+				return arg
+			}
+			continue
+		}
+
+		keyNode := arg.ChildByFieldName("key")
+		if keyNode != nil && keyNode.Content(source) == key {
+			return arg.ChildByFieldName("value")
+		}
+	}
+	return nil
+}
+
+// FindAnnotation returns the first annotation with the given name, or nil.
+func FindAnnotation(modifiers *sitter.Node, source []byte, annotationName string) *sitter.Node {
+	for _, ann := range GetAnnotations(modifiers) {
+		if GetAnnotationName(ann, source) == annotationName {
+			return ann
+		}
+	}
+	return nil
+}
+
+// GetAnnotationStringValue returns the string literal of the annotation's value element,
+// as in @Disabled("flaky") or @Ignore(value = "flaky"). Returns "" if absent or not a literal.
+func GetAnnotationStringValue(annotation *sitter.Node, source []byte) string {
+	if annotation == nil {
+		return ""
+	}
+	values := GetStringElements(GetAnnotationElement(annotation, source, "value"), source)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// GetStringElements returns the string literals of an annotation element value,
+// which may be a single literal ("a") or an array initializer ({"a", "b"}).
+// Non-literal values such as constant references are skipped.
+func GetStringElements(value *sitter.Node, source []byte) []string {
+	if value == nil {
+		return nil
+	}
+
+	nodes := []*sitter.Node{value}
+	if value.Type() == "element_value_array_initializer" {
+		nodes = nil
+		for i := 0; i < int(value.NamedChildCount()); i++ {
+			nodes = append(nodes, value.NamedChild(i))
+		}
+	}
+
+	var values []string
+	for _, node := range nodes {
+		if node.Type() != "string_literal" {
+			continue
+		}
+		text := node.Content(source)
+		if len(text) >= 2 {
+			values = append(values, text[1:len(text)-1])
+		}
+	}
+	return values
+}
+
+// GetParameterNames returns the formal parameter names of a method_declaration node.
+func GetParameterNames(node *sitter.Node, source []byte) []string {
+	params := node.ChildByFieldName("parameters")
+	if params == nil {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		param := params.NamedChild(i)
+		if param.Type() != "formal_parameter" && param.Type() != "spread_parameter" {
+			continue
+		}
+		nameNode := param.ChildByFieldName("name")
+		if nameNode == nil {
+			// spread_parameter nests the name in a variable_declarator
+			for j := 0; j < int(param.NamedChildCount()); j++ {
+				if child := param.NamedChild(j); child.Type() == "variable_declarator" {
+					nameNode = child.ChildByFieldName("name")
+				}
+			}
+		}
+		if nameNode != nil {
+			names = append(names, nameNode.Content(source))
+		}
+	}
+	return names
+}
+
 // SanitizeSource removes NULL bytes from source code that would cause tree-sitter parsing failures.
 // Some files (e.g., OSS-Fuzz test data) contain NULL bytes in string literals which cause
 // tree-sitter to produce ERROR nodes instead of valid AST.
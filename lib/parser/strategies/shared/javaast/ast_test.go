@@ -244,6 +244,109 @@ class Test {
 	})
 }
 
+func TestGetAnnotationElement(t *testing.T) {
+	source := []byte(`
+class Test {
+    @CsvSource(value = {"a, 1"}, delimiter = '|')
+    @ValueSource(ints = {1, 2})
+    @DisplayName("shorthand")
+    void testMethod(int count, String... names) {}
+}
+`)
+	tree, err := tspool.Parse(context.Background(), domain.LanguageJava, source)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	defer tree.Close()
+
+	methodNode := findNodeByType(tree.RootNode(), NodeMethodDeclaration)
+	annotations := GetAnnotations(GetModifiers(methodNode))
+	if len(annotations) != 3 {
+		t.Fatalf("expected 3 annotations, got %d", len(annotations))
+	}
+
+	if got := GetAnnotationElement(annotations[0], source, "delimiter"); got == nil || got.Content(source) != "'|'" {
+		t.Errorf("expected delimiter '|', got %v", got)
+	}
+	if got := GetAnnotationElement(annotations[1], source, "ints"); got == nil || got.Type() != "element_value_array_initializer" {
+		t.Errorf("expected ints array initializer, got %v", got)
+	}
+	if got := GetAnnotationElement(annotations[2], source, "value"); got == nil || got.Content(source) != `"shorthand"` {
+		t.Errorf("expected shorthand value element, got %v", got)
+	}
+	if got := GetAnnotationElement(annotations[1], source, "strings"); got != nil {
+		t.Errorf("expected nil for missing element, got %v", got)
+	}
+
+	names := GetParameterNames(methodNode, source)
+	if len(names) != 2 || names[0] != "count" || names[1] != "names" {
+		t.Errorf("expected [count names], got %v", names)
+	}
+}
+
+func TestGetStringElements(t *testing.T) {
+	source := []byte(`
+class Test {
+    @Test(groups = {"fast", CONSTANT, "db"}, description = "single")
+    void testMethod() {}
+}
+`)
+	tree, err := tspool.Parse(context.Background(), domain.LanguageJava, source)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	defer tree.Close()
+
+	annotation := GetAnnotations(GetModifiers(findNodeByType(tree.RootNode(), NodeMethodDeclaration)))[0]
+
+	groups := GetStringElements(GetAnnotationElement(annotation, source, "groups"), source)
+	if len(groups) != 2 || groups[0] != "fast" || groups[1] != "db" {
+		t.Errorf("expected [fast db], got %v", groups)
+	}
+	description := GetStringElements(GetAnnotationElement(annotation, source, "description"), source)
+	if len(description) != 1 || description[0] != "single" {
+		t.Errorf("expected [single], got %v", description)
+	}
+	if got := GetStringElements(nil, source); got != nil {
+		t.Errorf("expected nil for missing element, got %v", got)
+	}
+}
+
+func TestGetAnnotationStringValue(t *testing.T) {
+	source := []byte(`
+class Test {
+    @Disabled("flaky on CI")
+    @Ignore(value = "slow")
+    @Tag(SLOW)
+    @Test
+    void testMethod() {}
+}
+`)
+	tree, err := tspool.Parse(context.Background(), domain.LanguageJava, source)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	defer tree.Close()
+
+	modifiers := GetModifiers(findNodeByType(tree.RootNode(), NodeMethodDeclaration))
+
+	tests := []struct {
+		annotation string
+		want       string
+	}{
+		{annotation: "Disabled", want: "flaky on CI"},
+		{annotation: "Ignore", want: "slow"},
+		{annotation: "Tag", want: ""},
+		{annotation: "Test", want: ""},
+		{annotation: "Missing", want: ""},
+	}
+	for _, tt := range tests {
+		if got := GetAnnotationStringValue(FindAnnotation(modifiers, source, tt.annotation), source); got != tt.want {
+			t.Errorf("@%s: expected %q, got %q", tt.annotation, tt.want, got)
+		}
+	}
+}
+
 // findNodeByType recursively finds the first node of the given type.
 func findNodeByType(node *sitter.Node, nodeType string) *sitter.Node {
 	if node.Type() == nodeType {
@@ -23,13 +23,25 @@ const (
 	// ESLint RuleTester method
 	MethodRun = "run"
 
+	// Test context method declaring a Deno subtest (t.step); node:test uses t.test.
+	MethodStep = "step"
+
 	ModifierConcurrent = "concurrent"
 	ModifierEach       = "each"
+	ModifierFailing    = "failing"
 	ModifierFor        = "for"
+	ModifierIgnore     = "ignore"
 	ModifierOnly       = "only"
+	ModifierSerial     = "serial"
 	ModifierSkip       = "skip"
 	ModifierTodo       = "todo"
 
+	// Conditional registration modifiers: test.if(cond)(name, fn) (Bun), test.skipIf(cond) (Bun, Vitest).
+	ModifierIf     = "if"
+	ModifierRunIf  = "runIf"
+	ModifierSkipIf = "skipIf"
+	ModifierTodoIf = "todoIf"
+
 	DynamicCasesSuffix     = " (dynamic cases)"
 	DynamicNamePlaceholder = "(dynamic)"
 	ObjectPlaceholder      = "<object>"
@@ -68,6 +80,8 @@ func ParseModifierStatus(modifier string) domain.TestStatus {
 		return domain.TestStatusTodo
 	case ModifierOnly:
 		return domain.TestStatusFocused
+	case ModifierFailing:
+		return domain.TestStatusXfail
 	default:
 		return domain.TestStatusActive
 	}
@@ -0,0 +1,353 @@
+package jstest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+// eachPlaceholderPattern matches printf-style placeholders supported by Jest and Vitest titles.
+var eachPlaceholderPattern = regexp.MustCompile(`%[sdifjoOp#$%]`)
+
+// eachVariablePattern matches $variable and $variable.path interpolations used with object rows.
+var eachVariablePattern = regexp.MustCompile(`\$(#|[A-Za-z_][\w]*(?:\.[\w]+)*)`)
+
+// eachValue is a statically resolved argument of a .each() row.
+type eachValue struct {
+	text     string
+	isString bool
+	isNumber bool
+}
+
+// eachRow is one case of a .each() table.
+// Array and primitive rows populate args; object rows and tagged template rows populate fields.
+type eachRow struct {
+	args   []eachValue
+	fields map[string]eachValue
+}
+
+// eachTable is a resolved .each() call keyed by the location of the generated test or suite.
+type eachTable struct {
+	nameTemplate string
+	rows         []eachRow
+}
+
+// ExpandEachCases replaces the single "(dynamic cases)" entries produced for .each()/.for()
+// calls with one entry per case when the case table is statically resolvable.
+// Tables containing identifiers, spreads or function calls are left untouched (ADR-02).
+func ExpandEachCases(root *sitter.Node, source []byte, filename string, file *domain.TestFile) {
+	tables := collectEachTables(root, source, filename)
+	if len(tables) == 0 {
+		return
+	}
+
+	file.Tests = expandEachTests(file.Tests, tables)
+	file.Suites = expandEachSuites(file.Suites, tables)
+}
+
+func collectEachTables(root *sitter.Node, source []byte, filename string) map[domain.Location]eachTable {
+	tables := make(map[domain.Location]eachTable)
+
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" {
+			return true
+		}
+
+		innerCall := node.ChildByFieldName("function")
+		outerArgs := node.ChildByFieldName("arguments")
+		if innerCall == nil || outerArgs == nil || innerCall.Type() != "call_expression" {
+			return true
+		}
+
+		innerFunc := innerCall.ChildByFieldName("function")
+		innerArgs := innerCall.ChildByFieldName("arguments")
+		if innerFunc == nil || innerArgs == nil {
+			return true
+		}
+
+		funcName, _, _ := ParseFunctionName(innerFunc, source)
+		if !strings.HasSuffix(funcName, "."+ModifierEach) && !strings.HasSuffix(funcName, "."+ModifierFor) {
+			return true
+		}
+
+		nameTemplate := ExtractTestName(outerArgs, source)
+		if nameTemplate == "" {
+			return true
+		}
+
+		if rows, ok := resolveEachRows(innerArgs, source); ok && len(rows) > 0 {
+			tables[parser.GetLocation(node, filename)] = eachTable{
+				nameTemplate: nameTemplate,
+				rows:         rows,
+			}
+		}
+		return true
+	})
+
+	return tables
+}
+
+func expandEachTests(tests []domain.Test, tables map[domain.Location]eachTable) []domain.Test {
+	var result []domain.Test
+	for _, test := range tests {
+		table, ok := tables[test.Location]
+		if !ok || test.Name != table.nameTemplate+DynamicCasesSuffix {
+			result = append(result, test)
+			continue
+		}
+
+		test.Name = table.nameTemplate
+		result = append(result, parameterized.Expand(test, table.names())...)
+	}
+	return result
+}
+
+func expandEachSuites(suites []domain.TestSuite, tables map[domain.Location]eachTable) []domain.TestSuite {
+	var result []domain.TestSuite
+	for _, suite := range suites {
+		suite.Tests = expandEachTests(suite.Tests, tables)
+		suite.Suites = expandEachSuites(suite.Suites, tables)
+
+		table, ok := tables[suite.Location]
+		if !ok || suite.Name != table.nameTemplate+DynamicCasesSuffix {
+			result = append(result, suite)
+			continue
+		}
+
+		result = append(result, parameterized.ExpandSuite(suite, table.names())...)
+	}
+	return result
+}
+
+func (t eachTable) names() []string {
+	names := make([]string, len(t.rows))
+	for i, row := range t.rows {
+		names[i] = formatEachTitle(t.nameTemplate, row, i)
+	}
+	return names
+}
+
+// resolveEachRows extracts the case table from the arguments of .each()/.for().
+// Supports array tables and tagged template tables. Returns false if any value
+// cannot be resolved statically.
+func resolveEachRows(args *sitter.Node, source []byte) ([]eachRow, bool) {
+	if args.Type() == "template_string" {
+		return resolveTemplateTable(args, source)
+	}
+
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		child := args.NamedChild(i)
+		if child.Type() == "array" {
+			return resolveArrayTable(child, source)
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+func resolveArrayTable(array *sitter.Node, source []byte) ([]eachRow, bool) {
+	rows := make([]eachRow, 0, array.NamedChildCount())
+
+	for i := 0; i < int(array.NamedChildCount()); i++ {
+		elem := array.NamedChild(i)
+
+		switch elem.Type() {
+		case "comment":
+			continue
+		case "array":
+			var args []eachValue
+			for j := 0; j < int(elem.NamedChildCount()); j++ {
+				value, ok := resolveEachValue(elem.NamedChild(j), source)
+				if !ok {
+					return nil, false
+				}
+				args = append(args, value)
+			}
+			rows = append(rows, eachRow{args: args})
+		case "object":
+			fields, ok := resolveObjectRow(elem, source)
+			if !ok {
+				return nil, false
+			}
+			rows = append(rows, eachRow{fields: fields})
+		default:
+			value, ok := resolveEachValue(elem, source)
+			if !ok {
+				return nil, false
+			}
+			rows = append(rows, eachRow{args: []eachValue{value}})
+		}
+	}
+
+	return rows, true
+}
+
+func resolveObjectRow(object *sitter.Node, source []byte) (map[string]eachValue, bool) {
+	fields := make(map[string]eachValue)
+
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		pair := object.NamedChild(i)
+		if pair.Type() != "pair" {
+			return nil, false
+		}
+
+		keyNode := pair.ChildByFieldName("key")
+		valueNode := pair.ChildByFieldName("value")
+		if keyNode == nil || valueNode == nil {
+			return nil, false
+		}
+
+		key := parser.GetNodeText(keyNode, source)
+		if keyNode.Type() == "string" {
+			key = UnquoteString(key)
+		}
+
+		value, ok := resolveEachValue(valueNode, source)
+		if !ok {
+			return nil, false
+		}
+		fields[key] = value
+	}
+
+	return fields, true
+}
+
+// resolveTemplateTable parses a tagged template table:
+//
+//	a    | b    | expected
+//	${1} | ${1} | ${2}
+func resolveTemplateTable(tmpl *sitter.Node, source []byte) ([]eachRow, bool) {
+	var headers []string
+	var values []eachValue
+
+	for i := 0; i < int(tmpl.NamedChildCount()); i++ {
+		child := tmpl.NamedChild(i)
+
+		switch child.Type() {
+		case "string_fragment":
+			if headers == nil {
+				header := strings.TrimSpace(parser.GetNodeText(child, source))
+				for _, name := range strings.Split(header, "|") {
+					headers = append(headers, strings.TrimSpace(name))
+				}
+			}
+		case "template_substitution":
+			if child.NamedChildCount() != 1 {
+				return nil, false
+			}
+			value, ok := resolveEachValue(child.NamedChild(0), source)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+	}
+
+	if len(headers) == 0 || len(values)%len(headers) != 0 {
+		return nil, false
+	}
+
+	rows := make([]eachRow, 0, len(values)/len(headers))
+	for start := 0; start < len(values); start += len(headers) {
+		fields := make(map[string]eachValue, len(headers))
+		for j, name := range headers {
+			fields[name] = values[start+j]
+		}
+		rows = append(rows, eachRow{fields: fields})
+	}
+
+	return rows, true
+}
+
+func resolveEachValue(node *sitter.Node, source []byte) (eachValue, bool) {
+	text := parser.GetNodeText(node, source)
+
+	switch node.Type() {
+	case "string":
+		return eachValue{text: UnquoteString(text), isString: true}, true
+	case "template_string":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if node.NamedChild(i).Type() == "template_substitution" {
+				return eachValue{}, false
+			}
+		}
+		return eachValue{text: UnquoteString(text), isString: true}, true
+	case "number":
+		return eachValue{text: text, isNumber: true}, true
+	case "true", "false", "null", "undefined":
+		return eachValue{text: text}, true
+	case "unary_expression":
+		operand := node.ChildByFieldName("argument")
+		if operand != nil && operand.Type() == "number" {
+			return eachValue{text: strings.ReplaceAll(text, " ", ""), isNumber: true}, true
+		}
+	}
+
+	return eachValue{}, false
+}
+
+// formatEachTitle renders a .each() title for one row following Jest/Vitest rules:
+// printf placeholders consume positional args, $variable reads object fields.
+func formatEachTitle(template string, row eachRow, index int) string {
+	argIndex := 0
+	title := eachPlaceholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		switch match {
+		case "%%":
+			return "%"
+		case "%#":
+			return strconv.Itoa(index)
+		case "%$":
+			return strconv.Itoa(index + 1)
+		}
+		if row.fields != nil || argIndex >= len(row.args) {
+			return match
+		}
+		value := row.args[argIndex]
+		argIndex++
+		return formatEachValue(match, value)
+	})
+
+	if row.fields == nil {
+		return title
+	}
+
+	return eachVariablePattern.ReplaceAllStringFunc(title, func(match string) string {
+		path := match[1:]
+		if path == "#" {
+			return strconv.Itoa(index)
+		}
+		value, ok := row.fields[path]
+		if !ok {
+			return match
+		}
+		return formatEachValue("%p", value)
+	})
+}
+
+func formatEachValue(placeholder string, value eachValue) string {
+	switch placeholder {
+	case "%d", "%i", "%f":
+		if !value.isNumber {
+			return "NaN"
+		}
+		if placeholder == "%i" {
+			if dot := strings.IndexByte(value.text, '.'); dot >= 0 {
+				return value.text[:dot]
+			}
+		}
+		return value.text
+	case "%p", "%j", "%o", "%O":
+		if value.isString {
+			return strconv.Quote(value.text)
+		}
+		return value.text
+	default:
+		return value.text
+	}
+}
@@ -189,7 +189,7 @@ func ParseSimpleMemberExpression(obj, prop *sitter.Node, source []byte) (string,
 	propName := parser.GetNodeText(prop, source)
 
 	switch propName {
-	case ModifierConcurrent:
+	case ModifierConcurrent, ModifierSerial:
 		return objName, domain.TestStatusActive, ""
 	case ModifierEach:
 		return objName + "." + ModifierEach, domain.TestStatusActive, ""
@@ -201,11 +201,31 @@ func ParseSimpleMemberExpression(obj, prop *sitter.Node, source []byte) (string,
 		return objName, domain.TestStatusSkipped, ModifierSkip
 	case ModifierTodo:
 		return objName, domain.TestStatusTodo, ModifierTodo
+	case ModifierFailing:
+		return objName, domain.TestStatusXfail, ModifierFailing
+	case ModifierIf, ModifierRunIf, ModifierSkipIf, ModifierTodoIf:
+		// The condition is only known at runtime, so the declaration stays active.
+		return objName + "." + propName, domain.TestStatusActive, propName
 	default:
 		return "", domain.TestStatusActive, ""
 	}
 }
 
+// SplitConditional returns the base function of a conditional registration
+// such as "test.skipIf", and whether funcName is one.
+func SplitConditional(funcName string) (string, bool) {
+	idx := strings.LastIndex(funcName, ".")
+	if idx < 0 {
+		return "", false
+	}
+	switch funcName[idx+1:] {
+	case ModifierIf, ModifierRunIf, ModifierSkipIf, ModifierTodoIf:
+		return funcName[:idx], true
+	default:
+		return "", false
+	}
+}
+
 func ParseNestedMemberExpression(obj, prop *sitter.Node, source []byte) (string, domain.TestStatus, string) {
 	innerObj := obj.ChildByFieldName("object")
 	innerProp := obj.ChildByFieldName("property")
@@ -218,8 +238,8 @@ func ParseNestedMemberExpression(obj, prop *sitter.Node, source []byte) (string,
 	middleProp := parser.GetNodeText(innerProp, source)
 	propName := parser.GetNodeText(prop, source)
 
-	// Handle test.concurrent.skip, describe.concurrent.only, etc.
-	if middleProp == ModifierConcurrent {
+	// Handle test.concurrent.skip, describe.concurrent.only, AVA's test.serial.failing, etc.
+	if middleProp == ModifierConcurrent || middleProp == ModifierSerial {
 		status := ParseModifierStatus(propName)
 		modifier := ""
 		if status != domain.TestStatusActive {
@@ -383,3 +403,46 @@ func ExtractRuleTesterName(args *sitter.Node, source []byte) string {
 	}
 	return ""
 }
+
+// ExtractSkipComment returns the comment explaining a skipped test or suite:
+// a line comment directly above the call statement, or a trailing comment on
+// its last line. Returns empty string for non-skipped calls or if none exists.
+func ExtractSkipComment(callNode *sitter.Node, source []byte, status domain.TestStatus) string {
+	if status != domain.TestStatusSkipped {
+		return ""
+	}
+
+	stmt := callNode.Parent()
+	if stmt == nil || stmt.Type() != "expression_statement" {
+		return ""
+	}
+
+	if prev := stmt.PrevSibling(); prev != nil && prev.Type() == "comment" &&
+		prev.EndPoint().Row+1 == stmt.StartPoint().Row {
+		return commentText(parser.GetNodeText(prev, source))
+	}
+
+	if next := stmt.NextSibling(); next != nil && next.Type() == "comment" &&
+		next.StartPoint().Row == stmt.EndPoint().Row {
+		return commentText(parser.GetNodeText(next, source))
+	}
+
+	return ""
+}
+
+func commentText(comment string) string {
+	if strings.HasPrefix(comment, "//") {
+		return strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+	}
+
+	comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+	lines := strings.Split(comment, "\n")
+	parts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*"))
+		if line != "" {
+			parts = append(parts, line)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/kubrickcode/specvital/lib/parser"
 	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
 )
 
 // DetectLanguage determines the programming language based on file extension.
@@ -58,10 +59,11 @@ func ProcessTest(callNode *sitter.Node, args *sitter.Node, source []byte, filena
 	}
 
 	test := domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
 	AddTestToTarget(test, parentSuite, file)
@@ -76,10 +78,11 @@ func ProcessSuite(callNode *sitter.Node, args *sitter.Node, source []byte, filen
 	}
 
 	suite := domain.TestSuite{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
 	if callback := FindCallback(args); callback != nil {
@@ -91,16 +94,17 @@ func ProcessSuite(callNode *sitter.Node, args *sitter.Node, source []byte, filen
 
 // ProcessEachTests creates a single test from a .each() call.
 // Per ADR-02, dynamic test patterns are counted as 1 test regardless of runtime count.
-func ProcessEachTests(callNode *sitter.Node, _ []string, nameTemplate string, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, status domain.TestStatus, modifier string) {
+func ProcessEachTests(callNode *sitter.Node, _ []string, nameTemplate string, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, status domain.TestStatus, modifier string) {
 	if nameTemplate == "" {
 		return
 	}
 
 	test := domain.Test{
-		Name:     nameTemplate + DynamicCasesSuffix,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       nameTemplate + DynamicCasesSuffix,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
 	AddTestToTarget(test, parentSuite, file)
@@ -118,10 +122,11 @@ func ProcessEachSuites(callNode *sitter.Node, _ []string, nameTemplate string, c
 	}
 
 	suite := domain.TestSuite{
-		Name:     nameTemplate + DynamicCasesSuffix,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       nameTemplate + DynamicCasesSuffix,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: ExtractSkipComment(callNode, source, status),
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
 	ParseCallbackBody(callback, source, filename, file, &suite)
@@ -142,6 +147,16 @@ func ProcessEachCall(outerCall, innerCall, outerArgs *sitter.Node, source []byte
 		return
 	}
 
+	if base, ok := SplitConditional(funcName); ok {
+		switch base {
+		case FuncDescribe, FuncContext, FuncSuite:
+			processTestSuite(outerCall, outerArgs, source, filename, file, currentSuite, status, modifier, false)
+		case FuncIt, FuncTest, FuncSpecify:
+			processTestCase(outerCall, outerArgs, source, filename, file, currentSuite, status, modifier, false)
+		}
+		return
+	}
+
 	testCases := ExtractEachTestCases(innerArgs, source)
 	nameTemplate := ExtractTestName(outerArgs, source)
 	callback := FindCallback(outerArgs)
@@ -152,7 +167,7 @@ func ProcessEachCall(outerCall, innerCall, outerArgs *sitter.Node, source []byte
 		ProcessEachSuites(outerCall, testCases, nameTemplate, callback, source, filename, file, currentSuite, status, modifier)
 	case FuncIt + "." + ModifierEach, FuncTest + "." + ModifierEach, FuncSpecify + "." + ModifierEach,
 		FuncIt + "." + ModifierFor, FuncTest + "." + ModifierFor, FuncSpecify + "." + ModifierFor:
-		ProcessEachTests(outerCall, testCases, nameTemplate, filename, file, currentSuite, status, modifier)
+		ProcessEachTests(outerCall, testCases, nameTemplate, source, filename, file, currentSuite, status, modifier)
 	}
 }
 
@@ -232,14 +247,20 @@ func processTestCase(callNode *sitter.Node, args *sitter.Node, source []byte, fi
 		name += DynamicCasesSuffix
 	}
 
+	skipReason := ExtractSkipComment(callNode, source, status)
+	if status == domain.TestStatusActive {
+		status, modifier, skipReason = applyOptionsStatus(args, source, modifier)
+	}
+
 	test := domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: skipReason,
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
-	AddTestToTarget(test, parentSuite, file)
+	AddTestWithSubtests(test, FindCallback(args), source, filename, parentSuite, file)
 }
 
 func processTestSuite(callNode *sitter.Node, args *sitter.Node, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, status domain.TestStatus, modifier string, isDynamic bool) {
@@ -259,11 +280,17 @@ func processTestSuite(callNode *sitter.Node, args *sitter.Node, source []byte, f
 		name += DynamicCasesSuffix
 	}
 
+	skipReason := ExtractSkipComment(callNode, source, status)
+	if status == domain.TestStatusActive {
+		status, modifier, skipReason = applyOptionsStatus(args, source, modifier)
+	}
+
 	suite := domain.TestSuite{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(callNode, filename),
+		Name:       name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: skipReason,
+		Location:   parser.GetLocation(callNode, filename),
 	}
 
 	if callback := FindCallback(args); callback != nil {
@@ -273,6 +300,16 @@ func processTestSuite(callNode *sitter.Node, args *sitter.Node, source []byte, f
 	AddSuiteToTarget(suite, parentSuite, file)
 }
 
+// applyOptionsStatus reads the status of an otherwise active declaration from its
+// options object (node:test and Vitest `{ skip: true }`), keeping modifier when none is set.
+func applyOptionsStatus(args *sitter.Node, source []byte, modifier string) (domain.TestStatus, string, string) {
+	status, optModifier, reason := ExtractOptionsStatus(FindOptions(args), source)
+	if status == domain.TestStatusActive {
+		return status, modifier, ""
+	}
+	return status, optModifier, reason
+}
+
 // processRuleTesterRun handles ESLint/Stylelint RuleTester.run() calls.
 // RuleTester.run() internally generates multiple tests from valid/invalid cases.
 // Per ADR-02, linter test utilities are counted as 1 dynamic test.
@@ -461,7 +498,13 @@ func parseDynamicCallback(callback *sitter.Node, source []byte, filename string,
 }
 
 // Parse is the main entry point for parsing JavaScript/TypeScript test files.
-func Parse(ctx context.Context, source []byte, filename string, framework string) (*domain.TestFile, error) {
+func Parse(ctx context.Context, source []byte, filename string, frameworkName string) (*domain.TestFile, error) {
+	return ParseWith(ctx, source, filename, frameworkName, nil)
+}
+
+// ParseWith parses like Parse, then hands the syntax tree to extend so a framework
+// can pick up registration forms the shared walker does not know (e.g., Deno.test).
+func ParseWith(ctx context.Context, source []byte, filename string, frameworkName string, extend func(root *sitter.Node, file *domain.TestFile)) (*domain.TestFile, error) {
 	lang := DetectLanguage(filename)
 
 	tree, err := parser.ParseWithPool(ctx, lang, source)
@@ -474,10 +517,17 @@ func Parse(ctx context.Context, source []byte, filename string, framework string
 	testFile := &domain.TestFile{
 		Path:      filename,
 		Language:  lang,
-		Framework: framework,
+		Framework: frameworkName,
 	}
 
 	ParseNode(root, source, filename, testFile, nil)
+	if extend != nil {
+		extend(root, testFile)
+	}
+
+	if framework.ParseOptionsFromContext(ctx).ExpandParameterized {
+		ExpandEachCases(root, source, filename, testFile)
+	}
 
 	return testFile, nil
 }
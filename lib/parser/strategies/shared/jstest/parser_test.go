@@ -2,9 +2,11 @@ package jstest
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
 )
 
 func TestDetectLanguage(t *testing.T) {
@@ -263,6 +265,73 @@ func TestParse_Modifiers(t *testing.T) {
 	}
 }
 
+func TestParse_SkipReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		source     string
+		wantReason string
+		isSuite    bool
+	}{
+		{
+			name:       "should use comment above xit",
+			source:     "// flaky on CI\nxit('test', () => {});",
+			wantReason: "flaky on CI",
+		},
+		{
+			name:       "should use trailing comment on it.skip",
+			source:     "it.skip('test', () => {}); // waiting on API v2",
+			wantReason: "waiting on API v2",
+		},
+		{
+			name:       "should use block comment above describe.skip",
+			source:     "/*\n * broken after upgrade\n */\ndescribe.skip('Suite', () => {});",
+			wantReason: "broken after upgrade",
+			isSuite:    true,
+		},
+		{
+			name:       "should ignore comment separated by blank line",
+			source:     "// unrelated\n\nit.skip('test', () => {});",
+			wantReason: "",
+		},
+		{
+			name:       "should ignore comment on active test",
+			source:     "// covers the happy path\nit('test', () => {});",
+			wantReason: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := Parse(context.Background(), []byte(tt.source), "test.ts", "jest")
+
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var reason string
+			if tt.isSuite {
+				if len(file.Suites) != 1 {
+					t.Fatalf("len(Suites) = %d, want 1", len(file.Suites))
+				}
+				reason = file.Suites[0].SkipReason
+			} else {
+				if len(file.Tests) != 1 {
+					t.Fatalf("len(Tests) = %d, want 1", len(file.Tests))
+				}
+				reason = file.Tests[0].SkipReason
+			}
+
+			if reason != tt.wantReason {
+				t.Errorf("SkipReason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestParse_Each(t *testing.T) {
 	t.Parallel()
 
@@ -1845,3 +1914,286 @@ func TestParse_ConditionalSkip(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_ConditionalRegistration(t *testing.T) {
+	t.Parallel()
+
+	source := `
+test.skipIf(isCI)('local only', () => {});
+test.runIf(isLinux)('linux only', () => {});
+test.if(hasGPU)('gpu', () => {});
+describe.skipIf(isWindows)('posix', () => {
+  it('paths', () => {});
+});
+test.failing('known bug', () => {});
+test.serial.failing('serial bug', t => {});
+`
+
+	file, err := Parse(context.Background(), []byte(source), "test.ts", "vitest")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	wantTests := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+	}{
+		{"local only", domain.TestStatusActive, "skipIf"},
+		{"linux only", domain.TestStatusActive, "runIf"},
+		{"gpu", domain.TestStatusActive, "if"},
+		{"known bug", domain.TestStatusXfail, "failing"},
+		{"serial bug", domain.TestStatusXfail, "failing"},
+	}
+	if len(file.Tests) != len(wantTests) {
+		t.Fatalf("len(Tests) = %d, want %d", len(file.Tests), len(wantTests))
+	}
+	for i, want := range wantTests {
+		got := file.Tests[i]
+		if got.Name != want.name || got.Status != want.status || got.Modifier != want.modifier {
+			t.Errorf("Tests[%d] = (%q, %q, %q), want (%q, %q, %q)",
+				i, got.Name, got.Status, got.Modifier, want.name, want.status, want.modifier)
+		}
+	}
+
+	if len(file.Suites) != 1 || file.Suites[0].Name != "posix" || len(file.Suites[0].Tests) != 1 {
+		t.Errorf("Suites = %+v, want conditional suite 'posix' with 1 test", file.Suites)
+	}
+}
+
+func TestParse_OptionsStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		source       string
+		wantStatus   domain.TestStatus
+		wantModifier string
+		wantReason   string
+	}{
+		{
+			name:         "should mark { skip: true } as skipped",
+			source:       `test('a', { skip: true }, () => {});`,
+			wantStatus:   domain.TestStatusSkipped,
+			wantModifier: "skip",
+		},
+		{
+			name:         "should use skip string as reason",
+			source:       `test('a', { skip: 'flaky on CI' }, () => {});`,
+			wantStatus:   domain.TestStatusSkipped,
+			wantModifier: "skip",
+			wantReason:   "flaky on CI",
+		},
+		{
+			name:         "should mark { todo: true } as todo",
+			source:       `it('a', { todo: true }, () => {});`,
+			wantStatus:   domain.TestStatusTodo,
+			wantModifier: "todo",
+		},
+		{
+			name:         "should mark { only: true } as focused",
+			source:       `test('a', { only: true }, () => {});`,
+			wantStatus:   domain.TestStatusFocused,
+			wantModifier: "only",
+		},
+		{
+			name:       "should keep runtime condition active",
+			source:     `test('a', { skip: isCI }, () => {});`,
+			wantStatus: domain.TestStatusActive,
+		},
+		{
+			name:       "should ignore unrelated options",
+			source:     `test('a', { timeout: 1000 }, () => {});`,
+			wantStatus: domain.TestStatusActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := Parse(context.Background(), []byte(tt.source), "test.ts", "node-test")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(file.Tests) != 1 {
+				t.Fatalf("len(Tests) = %d, want 1", len(file.Tests))
+			}
+
+			got := file.Tests[0]
+			if got.Status != tt.wantStatus || got.Modifier != tt.wantModifier || got.SkipReason != tt.wantReason {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)",
+					got.Status, got.Modifier, got.SkipReason, tt.wantStatus, tt.wantModifier, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestParse_Subtests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		source     string
+		wantSuites int
+		wantTests  int
+	}{
+		{
+			name: "should turn test with t.test subtests into suite",
+			source: `test('parent', async (t) => {
+  await t.test('a', () => {});
+  await t.test('b', () => {});
+});`,
+			wantSuites: 1,
+		},
+		{
+			name: "should turn test with t.step subtests into suite",
+			source: `it('parent', async (ctx) => {
+  await ctx.step('a', () => {});
+});`,
+			wantSuites: 1,
+		},
+		{
+			name:      "should keep test without subtests as test",
+			source:    `test('plain', (t) => { t.assert.ok(true); });`,
+			wantTests: 1,
+		},
+		{
+			name: "should ignore global test.step with destructured context",
+			source: `test('playwright', async ({ page }) => {
+  await test.step('login', async () => {});
+});`,
+			wantTests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := Parse(context.Background(), []byte(tt.source), "test.ts", "node-test")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if len(file.Suites) != tt.wantSuites {
+				t.Errorf("len(Suites) = %d, want %d", len(file.Suites), tt.wantSuites)
+			}
+			if len(file.Tests) != tt.wantTests {
+				t.Errorf("len(Tests) = %d, want %d", len(file.Tests), tt.wantTests)
+			}
+		})
+	}
+}
+
+func TestParse_EachExpanded(t *testing.T) {
+	t.Parallel()
+
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	tests := []struct {
+		name       string
+		source     string
+		wantTests  []string
+		wantSuites []string
+	}{
+		{
+			name:      "should expand it.each array rows with printf placeholders",
+			source:    `it.each([[1, 2, 3], [2, 3, 5]])('add(%i, %i) -> %d', () => {});`,
+			wantTests: []string{"add(1, 2) -> 3", "add(2, 3) -> 5"},
+		},
+		{
+			name:      "should expand test.each primitive rows",
+			source:    `test.each(['foo', 'bar'])('val %s #%#', () => {});`,
+			wantTests: []string{"val foo #0", "val bar #1"},
+		},
+		{
+			name: "should expand object rows with $variable interpolation",
+			source: `it.each([
+  { input: 'a', expected: 1 },
+  { input: 'b', expected: 2 },
+])('maps $input to $expected', ({ input, expected }) => {});`,
+			wantTests: []string{`maps "a" to 1`, `maps "b" to 2`},
+		},
+		{
+			name:      "should expand tagged template tables",
+			source:    "test.each`\n  a    | b    | expected\n  ${1} | ${1} | ${2}\n  ${2} | ${-1} | ${1}\n`('returns $expected when $a is added to $b', () => {});",
+			wantTests: []string{"returns 2 when 1 is added to 1", "returns 1 when 2 is added to -1"},
+		},
+		{
+			name:       "should expand describe.each into one suite per row",
+			source:     `describe.each([['chrome'], ['firefox']])('browser %s', () => { it('works', () => {}); });`,
+			wantSuites: []string{"browser chrome", "browser firefox"},
+		},
+		{
+			name:      "should keep variable-based tables as a single dynamic test",
+			source:    `it.each(testData)('test %s', () => {});`,
+			wantTests: []string{"test %s (dynamic cases)"},
+		},
+		{
+			name:      "should keep tables with non-literal values as a single dynamic test",
+			source:    `it.each([[a, 1], [b, 2]])('test %s', () => {});`,
+			wantTests: []string{"test %s (dynamic cases)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := Parse(ctx, []byte(tt.source), "test.ts", "jest")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var gotTests []string
+			for _, test := range file.Tests {
+				gotTests = append(gotTests, test.Name)
+			}
+			var gotSuites []string
+			for _, suite := range file.Suites {
+				gotSuites = append(gotSuites, suite.Name)
+				if len(suite.Tests) != 1 {
+					t.Errorf("suite %q has %d tests, want 1", suite.Name, len(suite.Tests))
+				}
+			}
+
+			if !reflect.DeepEqual(gotTests, tt.wantTests) {
+				t.Errorf("test names = %q, want %q", gotTests, tt.wantTests)
+			}
+			if !reflect.DeepEqual(gotSuites, tt.wantSuites) {
+				t.Errorf("suite names = %q, want %q", gotSuites, tt.wantSuites)
+			}
+		})
+	}
+}
+
+func TestParse_EachExpandedTemplateLink(t *testing.T) {
+	t.Parallel()
+
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+	source := `describe('math', () => {
+  it.each([[1], [2]])('square of %d', () => {});
+});`
+
+	file, err := Parse(ctx, []byte(source), "test.ts", "vitest")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := file.Suites[0].Tests
+	if len(tests) != 2 {
+		t.Fatalf("len(Tests) = %d, want 2", len(tests))
+	}
+	for i, test := range tests {
+		if test.Template == nil {
+			t.Fatalf("Tests[%d].Template is nil", i)
+		}
+		if test.Template.Name != "square of %d" || test.Template.Index != i {
+			t.Errorf("Tests[%d].Template = %+v, want {Index: %d, Name: square of %%d}", i, *test.Template, i)
+		}
+		if test.Location.StartLine != 2 {
+			t.Errorf("Tests[%d].Location.StartLine = %d, want 2", i, test.Location.StartLine)
+		}
+	}
+}
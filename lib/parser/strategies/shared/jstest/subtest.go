@@ -0,0 +1,265 @@
+package jstest
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// TestCall holds the parts of a test registration call whose arguments may be
+// positional (name, options, fn) or a single definition object ({ name, fn }),
+// as accepted by node:test, Deno.test and their subtest methods.
+type TestCall struct {
+	Callback *sitter.Node
+	Name     string
+	Options  *sitter.Node
+}
+
+// ResolveTestCall extracts name, options object and callback from call arguments.
+// A named function passed without a name string provides the name
+// (e.g., Deno.test(function addsNumbers() {})).
+func ResolveTestCall(args *sitter.Node, source []byte) TestCall {
+	var call TestCall
+	dynamic := false
+
+	for i := 0; i < int(args.ChildCount()); i++ {
+		child := args.Child(i)
+		switch child.Type() {
+		case "(", ")", ",":
+			continue
+		case "string", "template_string":
+			if call.Name == "" {
+				call.Name = UnquoteString(parser.GetNodeText(child, source))
+			}
+		case "object":
+			call.Options = child
+			resolveDefinitionObject(child, source, &call, &dynamic)
+		case "arrow_function", "function_expression", "function":
+			if call.Callback == nil {
+				call.Callback = child
+			}
+			if call.Name == "" {
+				if name := child.ChildByFieldName("name"); name != nil {
+					call.Name = parser.GetNodeText(name, source)
+				}
+			}
+		default:
+			if i == 1 {
+				dynamic = true
+			}
+		}
+	}
+
+	if call.Name == "" && dynamic {
+		call.Name = DynamicNamePlaceholder
+	}
+
+	return call
+}
+
+func resolveDefinitionObject(object *sitter.Node, source []byte, call *TestCall, dynamic *bool) {
+	for i := 0; i < int(object.ChildCount()); i++ {
+		member := object.Child(i)
+		switch member.Type() {
+		case "pair":
+			key := propertyKey(member.ChildByFieldName("key"), source)
+			value := member.ChildByFieldName("value")
+			if value == nil {
+				continue
+			}
+			switch key {
+			case "name":
+				if name := ExtractStringValue(value, source); name != "" {
+					call.Name = name
+				} else if call.Name == "" {
+					*dynamic = true
+				}
+			case "fn":
+				switch value.Type() {
+				case "arrow_function", "function_expression", "function":
+					call.Callback = value
+				}
+			}
+		case "method_definition":
+			if propertyKey(member.ChildByFieldName("name"), source) == "fn" {
+				call.Callback = member
+			}
+		}
+	}
+}
+
+// ExtractOptionsStatus reads status flags from a test options object:
+// skip and ignore (Deno) mark the test skipped, todo marks it todo, only focuses it.
+// A string value is taken as the reason (node:test { skip: "flaky" }).
+// Non-literal values are runtime conditions and are ignored.
+func ExtractOptionsStatus(options *sitter.Node, source []byte) (domain.TestStatus, string, string) {
+	if options == nil {
+		return domain.TestStatusActive, "", ""
+	}
+
+	flags := make(map[string]string)
+	for i := 0; i < int(options.ChildCount()); i++ {
+		pair := options.Child(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		key := propertyKey(pair.ChildByFieldName("key"), source)
+		value := pair.ChildByFieldName("value")
+		if value == nil {
+			continue
+		}
+		switch value.Type() {
+		case "true":
+			flags[key] = ""
+		case "string", "template_string":
+			flags[key] = ExtractStringValue(value, source)
+		}
+	}
+
+	for _, key := range []string{ModifierSkip, ModifierIgnore} {
+		if reason, ok := flags[key]; ok {
+			return domain.TestStatusSkipped, key, reason
+		}
+	}
+	if reason, ok := flags[ModifierTodo]; ok {
+		return domain.TestStatusTodo, ModifierTodo, reason
+	}
+	if _, ok := flags[ModifierOnly]; ok {
+		return domain.TestStatusFocused, ModifierOnly, ""
+	}
+
+	return domain.TestStatusActive, "", ""
+}
+
+// FindOptions returns the first object argument of a test call, or nil.
+func FindOptions(args *sitter.Node) *sitter.Node {
+	for i := 0; i < int(args.ChildCount()); i++ {
+		if child := args.Child(i); child.Type() == "object" {
+			return child
+		}
+	}
+	return nil
+}
+
+// AddTestWithSubtests adds test to its parent. When callback declares subtests
+// through its context parameter (node:test t.test(), Deno t.step()), the test
+// becomes a suite holding them, since each subtest is reported on its own.
+func AddTestWithSubtests(test domain.Test, callback *sitter.Node, source []byte, filename string, parentSuite *domain.TestSuite, file *domain.TestFile) {
+	suite := domain.TestSuite{
+		Name:       test.Name,
+		Status:     test.Status,
+		Modifier:   test.Modifier,
+		SkipReason: test.SkipReason,
+		Location:   test.Location,
+	}
+	parseSubtests(callback, source, filename, &suite)
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		AddTestToTarget(test, parentSuite, file)
+		return
+	}
+	AddSuiteToTarget(suite, parentSuite, file)
+}
+
+func parseSubtests(callback *sitter.Node, source []byte, filename string, suite *domain.TestSuite) {
+	if callback == nil {
+		return
+	}
+	param := contextParamName(callback, source)
+	body := callback.ChildByFieldName("body")
+	if param == "" || body == nil {
+		return
+	}
+	collectSubtests(body, param, source, filename, suite)
+}
+
+func collectSubtests(node *sitter.Node, param string, source []byte, filename string, suite *domain.TestSuite) {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == "call_expression" && isSubtestCall(child, param, source) {
+			processSubtest(child, source, filename, suite)
+			continue
+		}
+		collectSubtests(child, param, source, filename, suite)
+	}
+}
+
+func isSubtestCall(call *sitter.Node, param string, source []byte) bool {
+	funcNode := call.ChildByFieldName("function")
+	if funcNode == nil || funcNode.Type() != "member_expression" {
+		return false
+	}
+	obj := funcNode.ChildByFieldName("object")
+	prop := funcNode.ChildByFieldName("property")
+	if obj == nil || prop == nil || parser.GetNodeText(obj, source) != param {
+		return false
+	}
+	method := parser.GetNodeText(prop, source)
+	return method == FuncTest || method == MethodStep
+}
+
+func processSubtest(call *sitter.Node, source []byte, filename string, parentSuite *domain.TestSuite) {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return
+	}
+
+	resolved := ResolveTestCall(args, source)
+	if resolved.Name == "" {
+		return
+	}
+
+	status, modifier, reason := ExtractOptionsStatus(resolved.Options, source)
+	test := domain.Test{
+		Name:       resolved.Name,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(call, filename),
+	}
+	AddTestWithSubtests(test, resolved.Callback, source, filename, parentSuite, nil)
+}
+
+// contextParamName returns the name of the first callback parameter (the t in
+// `async (t) => {}`), or "" if the callback takes none or destructures it.
+func contextParamName(callback *sitter.Node, source []byte) string {
+	if param := callback.ChildByFieldName("parameter"); param != nil {
+		if param.Type() == "identifier" {
+			return parser.GetNodeText(param, source)
+		}
+		return ""
+	}
+
+	params := callback.ChildByFieldName("parameters")
+	if params == nil {
+		return ""
+	}
+	for i := 0; i < int(params.ChildCount()); i++ {
+		param := params.Child(i)
+		switch param.Type() {
+		case "identifier":
+			return parser.GetNodeText(param, source)
+		case "required_parameter", "optional_parameter":
+			if pattern := param.ChildByFieldName("pattern"); pattern != nil && pattern.Type() == "identifier" {
+				return parser.GetNodeText(pattern, source)
+			}
+			return ""
+		case "(", ")":
+			continue
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+func propertyKey(key *sitter.Node, source []byte) string {
+	if key == nil {
+		return ""
+	}
+	if value := ExtractStringValue(key, source); value != "" {
+		return value
+	}
+	return parser.GetNodeText(key, source)
+}
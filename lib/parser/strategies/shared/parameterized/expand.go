@@ -0,0 +1,69 @@
+// Package parameterized provides shared helpers for expanding parameterized tests
+// into one domain.Test per statically resolvable case.
+package parameterized
+
+import "github.com/kubrickcode/specvital/lib/parser/domain"
+
+// Expand returns one test per case name, each linked back to template.
+// The template's location, status and modifier are inherited by every case.
+// Returns nil when names is empty so callers can fall back to the template.
+func Expand(template domain.Test, names []string) []domain.Test {
+	if len(names) == 0 {
+		return nil
+	}
+
+	tests := make([]domain.Test, len(names))
+	for i, name := range names {
+		test := template
+		test.Name = name
+		test.Template = &domain.TestTemplate{
+			Index: i,
+			Name:  template.Name,
+		}
+		tests[i] = test
+	}
+	return tests
+}
+
+// ExpandSuite returns one copy of template per case name.
+// Nested suites and tests are deep-copied so the expanded suites do not share slices.
+// Returns nil when names is empty so callers can fall back to the template.
+func ExpandSuite(template domain.TestSuite, names []string) []domain.TestSuite {
+	if len(names) == 0 {
+		return nil
+	}
+
+	suites := make([]domain.TestSuite, len(names))
+	for i, name := range names {
+		suite := cloneSuite(template)
+		suite.Name = name
+		suites[i] = suite
+	}
+	return suites
+}
+
+func cloneSuite(s domain.TestSuite) domain.TestSuite {
+	clone := s
+	if s.Tests != nil {
+		clone.Tests = make([]domain.Test, len(s.Tests))
+		for i, test := range s.Tests {
+			clone.Tests[i] = cloneTest(test)
+		}
+	}
+	if s.Suites != nil {
+		clone.Suites = make([]domain.TestSuite, len(s.Suites))
+		for i, sub := range s.Suites {
+			clone.Suites[i] = cloneSuite(sub)
+		}
+	}
+	return clone
+}
+
+func cloneTest(t domain.Test) domain.Test {
+	clone := t
+	if t.Template != nil {
+		tmpl := *t.Template
+		clone.Template = &tmpl
+	}
+	return clone
+}
@@ -0,0 +1,60 @@
+package parameterized
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+func TestExpand(t *testing.T) {
+	t.Run("should link every case back to the template", func(t *testing.T) {
+		template := domain.Test{
+			Name:     "test_add",
+			Status:   domain.TestStatusSkipped,
+			Modifier: "@pytest.mark.skip",
+			Location: domain.Location{File: "test_math.py", StartLine: 3, EndLine: 4},
+		}
+
+		tests := Expand(template, []string{"test_add[1-2]", "test_add[3-4]"})
+
+		require.Len(t, tests, 2)
+		for i, test := range tests {
+			assert.Equal(t, template.Status, test.Status)
+			assert.Equal(t, template.Modifier, test.Modifier)
+			assert.Equal(t, template.Location, test.Location)
+			require.NotNil(t, test.Template)
+			assert.Equal(t, i, test.Template.Index)
+			assert.Equal(t, "test_add", test.Template.Name)
+		}
+		assert.Equal(t, "test_add[1-2]", tests[0].Name)
+		assert.Equal(t, "test_add[3-4]", tests[1].Name)
+	})
+
+	t.Run("should return nil without cases", func(t *testing.T) {
+		assert.Nil(t, Expand(domain.Test{Name: "test_add"}, nil))
+	})
+}
+
+func TestExpandSuite(t *testing.T) {
+	template := domain.TestSuite{
+		Name:   "math %s",
+		Tests:  []domain.Test{{Name: "adds"}},
+		Suites: []domain.TestSuite{{Name: "nested", Tests: []domain.Test{{Name: "inner"}}}},
+	}
+
+	suites := ExpandSuite(template, []string{"math int", "math float"})
+
+	require.Len(t, suites, 2)
+	assert.Equal(t, "math int", suites[0].Name)
+	assert.Equal(t, "math float", suites[1].Name)
+	assert.Equal(t, 2, suites[1].CountTests())
+
+	suites[0].Tests[0].Name = "changed"
+	suites[0].Suites[0].Tests[0].Name = "changed"
+	assert.Equal(t, "adds", suites[1].Tests[0].Name, "expanded suites must not share test slices")
+	assert.Equal(t, "inner", suites[1].Suites[0].Tests[0].Name)
+	assert.Equal(t, "adds", template.Tests[0].Name)
+}
@@ -38,3 +38,64 @@ func GetDecorators(node *sitter.Node) []*sitter.Node {
 	}
 	return decorators
 }
+
+// GetDecoratorCall returns the call expression of a decorator such as @pytest.mark.skip(...),
+// or nil for bare decorators (@pytest.mark.skip).
+func GetDecoratorCall(decorator *sitter.Node) *sitter.Node {
+	for i := 0; i < int(decorator.NamedChildCount()); i++ {
+		if child := decorator.NamedChild(i); child.Type() == "call" {
+			return child
+		}
+	}
+	return nil
+}
+
+// GetReasonArgument returns the string passed as reason= to a call, falling back to the
+// positional argument at position (e.g., 0 for skip("..."), 1 for skipIf(cond, "...")).
+// Returns "" if the reason is missing or not a plain string literal.
+func GetReasonArgument(call *sitter.Node, source []byte, position int) string {
+	if call == nil || call.Type() != "call" {
+		return ""
+	}
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return ""
+	}
+
+	var positional *sitter.Node
+	index := 0
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		switch arg.Type() {
+		case "comment":
+			continue
+		case "keyword_argument":
+			name := arg.ChildByFieldName("name")
+			if name != nil && name.Content(source) == "reason" {
+				return stringLiteralContent(arg.ChildByFieldName("value"), source)
+			}
+		default:
+			if index == position {
+				positional = arg
+			}
+			index++
+		}
+	}
+	return stringLiteralContent(positional, source)
+}
+
+func stringLiteralContent(node *sitter.Node, source []byte) string {
+	if node == nil || node.Type() != "string" {
+		return ""
+	}
+	var content string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		switch child := node.NamedChild(i); child.Type() {
+		case "string_content", "escape_sequence":
+			content += child.Content(source)
+		case "interpolation":
+			return ""
+		}
+	}
+	return content
+}
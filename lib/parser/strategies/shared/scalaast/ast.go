@@ -0,0 +1,234 @@
+// Package scalaast provides shared Scala AST traversal utilities for test framework parsers.
+package scalaast
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+// Scala AST node types.
+const (
+	NodeAnnotation                   = "annotation"
+	NodeArguments                    = "arguments"
+	NodeBlock                        = "block"
+	NodeCallExpression               = "call_expression"
+	NodeClassDefinition              = "class_definition"
+	NodeExtendsClause                = "extends_clause"
+	NodeFieldExpression              = "field_expression"
+	NodeFunctionDefinition           = "function_definition"
+	NodeIdentifier                   = "identifier"
+	NodeInfixExpression              = "infix_expression"
+	NodeInterpolatedString           = "interpolated_string"
+	NodeInterpolatedStringExpression = "interpolated_string_expression"
+	NodeInterpolation                = "interpolation"
+	NodeObjectDefinition             = "object_definition"
+	NodeOperatorIdentifier           = "operator_identifier"
+	NodeParenthesizedExpression      = "parenthesized_expression"
+	NodeStableTypeIdentifier         = "stable_type_identifier"
+	NodeString                       = "string"
+	NodeTemplateBody                 = "template_body"
+	NodeTypeIdentifier               = "type_identifier"
+)
+
+// IsTestDefinition reports whether the node is a class or object definition,
+// the two places Scala test frameworks discover tests.
+func IsTestDefinition(node *sitter.Node) bool {
+	return node.Type() == NodeClassDefinition || node.Type() == NodeObjectDefinition
+}
+
+// DefinitionName returns the name of a class or object definition.
+func DefinitionName(node *sitter.Node, source []byte) string {
+	if name := node.ChildByFieldName("name"); name != nil {
+		return name.Content(source)
+	}
+	return ""
+}
+
+// DefinitionBody returns the template body of a class or object definition.
+func DefinitionBody(node *sitter.Node) *sitter.Node {
+	return node.ChildByFieldName("body")
+}
+
+// ParentTypes returns the simple names of the types a definition extends or
+// mixes in: "class A extends munit.FunSuite with Matchers" yields FunSuite and Matchers.
+func ParentTypes(node *sitter.Node, source []byte) []string {
+	extends := node.ChildByFieldName("extend")
+	if extends == nil {
+		return nil
+	}
+
+	var types []string
+	for i := 0; i < int(extends.NamedChildCount()); i++ {
+		child := extends.NamedChild(i)
+		if name := simpleTypeName(child, source); name != "" {
+			types = append(types, name)
+		}
+	}
+	return types
+}
+
+func simpleTypeName(node *sitter.Node, source []byte) string {
+	switch node.Type() {
+	case NodeTypeIdentifier:
+		return node.Content(source)
+	case NodeStableTypeIdentifier:
+		if last := node.NamedChild(int(node.NamedChildCount()) - 1); last != nil {
+			return last.Content(source)
+		}
+	case "generic_type":
+		if t := node.ChildByFieldName("type"); t != nil {
+			return simpleTypeName(t, source)
+		}
+	}
+	return ""
+}
+
+// HasAnnotation reports whether a definition carries @name (or @pkg.name).
+func HasAnnotation(node *sitter.Node, source []byte, name string) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != NodeAnnotation {
+			continue
+		}
+		annotation := child.ChildByFieldName("name")
+		if annotation != nil && simpleTypeName(annotation, source) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// StringValue returns the value of a string literal. Interpolated strings
+// (s"...", f"...") are returned verbatim without the interpolator and quotes.
+// Returns false for any other node.
+func StringValue(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+
+	switch node.Type() {
+	case NodeString:
+		return unquote(node.Content(source)), true
+	case NodeInterpolatedStringExpression:
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			if child.Type() == NodeInterpolatedString {
+				return trimQuotes(child.Content(source)), true
+			}
+		}
+	}
+	return "", false
+}
+
+func unquote(literal string) string {
+	if strings.HasPrefix(literal, `"""`) {
+		return trimQuotes(literal)
+	}
+	if value, err := strconv.Unquote(literal); err == nil {
+		return value
+	}
+	return trimQuotes(literal)
+}
+
+func trimQuotes(literal string) string {
+	if strings.HasPrefix(literal, `"""`) && strings.HasSuffix(literal, `"""`) && len(literal) >= 6 {
+		return literal[3 : len(literal)-3]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(literal, `"`), `"`)
+}
+
+// Unwrap strips parentheses and single-expression blocks: "(pending)" and
+// "{ pending }" both unwrap to the identifier.
+func Unwrap(node *sitter.Node) *sitter.Node {
+	for node != nil {
+		switch node.Type() {
+		case NodeParenthesizedExpression, NodeBlock, NodeArguments:
+			if node.NamedChildCount() != 1 {
+				return node
+			}
+			node = node.NamedChild(0)
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// IsIdentifier reports whether the node, once unwrapped, is the identifier name.
+func IsIdentifier(node *sitter.Node, source []byte, name string) bool {
+	node = Unwrap(node)
+	return node != nil && node.Type() == NodeIdentifier && node.Content(source) == name
+}
+
+// Arguments returns the named children of an arguments node.
+func Arguments(node *sitter.Node) []*sitter.Node {
+	if node == nil || node.Type() != NodeArguments {
+		return nil
+	}
+	args := make([]*sitter.Node, 0, node.NamedChildCount())
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		args = append(args, node.NamedChild(i))
+	}
+	return args
+}
+
+// IsScalaTestFile reports whether path follows sbt/Maven test conventions:
+// *Spec.scala, *Suite.scala, *Test.scala, *Tests.scala, or any file under a test directory.
+func IsScalaTestFile(path string) bool {
+	normalizedPath := strings.ReplaceAll(path, "\\", "/")
+
+	if strings.Contains(normalizedPath, "/src/main/") || strings.HasPrefix(normalizedPath, "src/main/") {
+		return false
+	}
+
+	base := normalizedPath
+	if idx := strings.LastIndex(normalizedPath, "/"); idx >= 0 {
+		base = normalizedPath[idx+1:]
+	}
+	if !strings.HasSuffix(base, ".scala") {
+		return false
+	}
+
+	name := strings.TrimSuffix(base, ".scala")
+	for _, suffix := range []string{"Spec", "Suite", "Test", "Tests"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return strings.Contains(normalizedPath, "/test/") ||
+		strings.HasPrefix(normalizedPath, "test/") ||
+		strings.Contains(normalizedPath, "/src/test/")
+}
+
+// PackageMatcher matches imports of a package or anything below it.
+// Scala imports name a package ("org.scalatest"), a member
+// ("org.scalatest.funsuite.AnyFunSuite") or a selector group, so exact
+// matching on a single path is not enough.
+type PackageMatcher struct {
+	Packages []string
+}
+
+// NewPackageMatcher creates a PackageMatcher for the given package roots.
+func NewPackageMatcher(packages ...string) *PackageMatcher {
+	return &PackageMatcher{Packages: packages}
+}
+
+func (m *PackageMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalImport {
+		return framework.NoMatch()
+	}
+
+	for _, pkg := range m.Packages {
+		if signal.Value == pkg || strings.HasPrefix(signal.Value, pkg+".") {
+			return framework.DefiniteMatch("import: " + signal.Value)
+		}
+	}
+
+	return framework.NoMatch()
+}
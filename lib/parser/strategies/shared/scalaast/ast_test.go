@@ -0,0 +1,55 @@
+package scalaast
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestIsScalaTestFile(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"src/test/scala/shop/CartSpec.scala", true},
+		{"modules/core/src/test/scala/CartSuite.scala", true},
+		{"CartTest.scala", true},
+		{"test/shop/Fixtures.scala", true},
+		{"src/main/scala/shop/CartSpec.scala", false},
+		{"src/main/scala/shop/Cart.scala", false},
+		{"src/test/scala/CartSpec.java", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := IsScalaTestFile(tt.path); got != tt.expected {
+				t.Errorf("IsScalaTestFile(%q) = %v, want %v", tt.path, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPackageMatcher_Match(t *testing.T) {
+	matcher := NewPackageMatcher("org.scalatest")
+	ctx := context.Background()
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"org.scalatest", true},
+		{"org.scalatest.flatspec.AnyFlatSpec", true},
+		{"org.scalatestplus.scalacheck", false},
+		{"org", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.value})
+			if (result.Confidence > 0) != tt.expected {
+				t.Errorf("Match(%q) confidence = %d, want match=%v", tt.value, result.Confidence, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package scalabuild reads sbt and mill build files to find the Scala test
+// framework a project depends on.
+//
+// The scanner hands each config file to the first definition whose matcher
+// accepts its name, so ScalaTest, MUnit and specs2 share this parser and the
+// framework is decided from the build file content rather than by which
+// definition claimed it.
+package scalabuild
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/framework/matchers"
+)
+
+// BuildFiles are the sbt and mill build definitions read as framework config.
+var BuildFiles = []string{"build.sbt", "build.sc", "build.mill"}
+
+// NewConfigMatcher matches sbt and mill build files.
+func NewConfigMatcher() *matchers.ConfigMatcher {
+	return matchers.NewConfigMatcher(BuildFiles...)
+}
+
+var dependencyPatterns = []struct {
+	pattern   *regexp.Regexp
+	framework string
+}{
+	// sbt: "org.scalatest" %% "scalatest" % "3.2.18" % Test
+	// mill: ivy"org.scalatest::scalatest:3.2.18", mvn"org.scalameta::munit::1.0.0"
+	{regexp.MustCompile(`"org\.scalatest"\s*%{2,3}\s*"scalatest|(?:ivy|mvn)"org\.scalatest:{2,3}scalatest`), framework.FrameworkScalaTest},
+	{regexp.MustCompile(`"org\.scalameta"\s*%{2,3}\s*"munit|(?:ivy|mvn)"org\.scalameta:{2,3}munit`), framework.FrameworkMUnit},
+	{regexp.MustCompile(`"org\.specs2"\s*%{2,3}\s*"specs2|(?:ivy|mvn)"org\.specs2:{2,3}specs2`), framework.FrameworkSpecs2},
+	// mill: object test extends ScalaTests with TestModule.Munit
+	{regexp.MustCompile(`\bTestModule\.ScalaTest\b`), framework.FrameworkScalaTest},
+	{regexp.MustCompile(`\bTestModule\.Munit\b`), framework.FrameworkMUnit},
+	{regexp.MustCompile(`\bTestModule\.Specs2\b`), framework.FrameworkSpecs2},
+}
+
+// DetectFramework returns the test framework declared first in a build file,
+// or an empty string when none is declared.
+func DetectFramework(content []byte) string {
+	best, bestOffset := "", -1
+	for _, p := range dependencyPatterns {
+		loc := p.pattern.FindIndex(content)
+		if loc == nil {
+			continue
+		}
+		if bestOffset < 0 || loc[0] < bestOffset {
+			best, bestOffset = p.framework, loc[0]
+		}
+	}
+	return best
+}
+
+// ConfigParser scopes a build file's directory to the framework it declares.
+// A build without a known test dependency yields a scope with no framework,
+// which detection ignores.
+type ConfigParser struct{}
+
+func (p *ConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = DetectFramework(content)
+	return scope, nil
+}
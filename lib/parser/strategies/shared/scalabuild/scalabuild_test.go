@@ -0,0 +1,91 @@
+package scalabuild
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestDetectFramework(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name: "sbt scalatest",
+			content: `libraryDependencies ++= Seq(
+  "org.typelevel" %% "cats-core" % "2.10.0",
+  "org.scalatest" %% "scalatest" % "3.2.18" % Test
+)`,
+			expected: framework.FrameworkScalaTest,
+		},
+		{
+			name:     "sbt munit for Scala.js",
+			content:  `libraryDependencies += "org.scalameta" %%% "munit" % "1.0.0" % Test`,
+			expected: framework.FrameworkMUnit,
+		},
+		{
+			name:     "sbt specs2",
+			content:  `libraryDependencies += "org.specs2" %% "specs2-core" % "4.20.5" % Test`,
+			expected: framework.FrameworkSpecs2,
+		},
+		{
+			name: "mill ivy dependency",
+			content: `object test extends ScalaTests {
+  def ivyDeps = Agg(ivy"org.scalameta::munit:1.0.0")
+  def testFramework = "munit.Framework"
+}`,
+			expected: framework.FrameworkMUnit,
+		},
+		{
+			name:     "mill test module",
+			content:  `object test extends ScalaTests with TestModule.ScalaTest`,
+			expected: framework.FrameworkScalaTest,
+		},
+		{
+			name: "first declared wins",
+			content: `"org.specs2" %% "specs2-core" % "4.20.5" % Test,
+"org.scalatest" %% "scalatest" % "3.2.18" % Test`,
+			expected: framework.FrameworkSpecs2,
+		},
+		{
+			name:     "no test framework",
+			content:  `libraryDependencies += "org.typelevel" %% "cats-core" % "2.10.0"`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectFramework([]byte(tt.content)))
+		})
+	}
+}
+
+func TestConfigParser_Parse(t *testing.T) {
+	configPath := filepath.Join("/repo", "modules", "core", "build.sbt")
+	scope, err := (&ConfigParser{}).Parse(context.Background(), configPath, []byte(`"org.scalatest" %% "scalatest" % "3.2.18" % Test`))
+	require.NoError(t, err)
+
+	assert.Equal(t, framework.FrameworkScalaTest, scope.Framework)
+	assert.Equal(t, filepath.Join("/repo", "modules", "core"), scope.BaseDir)
+	assert.True(t, scope.Contains(filepath.Join("/repo", "modules", "core", "src", "test", "scala", "CartSpec.scala")))
+}
+
+func TestNewConfigMatcher(t *testing.T) {
+	matcher := NewConfigMatcher()
+	ctx := context.Background()
+
+	for _, name := range []string{"build.sbt", "build.sc", "build.mill"} {
+		result := matcher.Match(ctx, framework.Signal{Type: framework.SignalConfigFile, Value: name})
+		assert.Equal(t, 100, result.Confidence, name)
+	}
+	result := matcher.Match(ctx, framework.Signal{Type: framework.SignalConfigFile, Value: "pom.xml"})
+	assert.Equal(t, 0, result.Confidence)
+}
@@ -0,0 +1,312 @@
+// Package specs2 implements specs2 support for Scala test files.
+package specs2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser"
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalaast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/scalabuild"
+)
+
+const frameworkName = framework.FrameworkSpecs2
+
+// Modifiers recorded on examples whose status is not active.
+const (
+	ModifierPending           = "pending"
+	ModifierPendingUntilFixed = "pendingUntilFixed"
+	ModifierSkipped           = "skipped"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			scalaast.NewPackageMatcher("org.specs2"),
+			scalabuild.NewConfigMatcher(),
+			&Specs2ContentMatcher{},
+		},
+		ConfigParser: &scalabuild.ConfigParser{},
+		Parser:       &Specs2Parser{},
+		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"must*", "should*", "*.must*", "*.should*", "failure"},
+	}
+}
+
+// Specs2ContentMatcher matches specifications and s2 acceptance strings.
+type Specs2ContentMatcher struct{}
+
+var specs2Patterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\bextends\s+(?:mutable\.)?Specification(?:WithJUnit)?\b`), "extends Specification"},
+	{regexp.MustCompile(`\bs2"""`), "s2 acceptance string"},
+}
+
+func (m *Specs2ContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range specs2Patterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found specs2 pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// Specs2Parser reads unit specifications ("text" should/can/>> { examples },
+// "example" in/>> { body }) and acceptance specifications, whose s2 string
+// lists one example per interpolated $reference.
+type Specs2Parser struct{}
+
+func (p *Specs2Parser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("specs2 parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	w := &walker{source: source, filename: filename}
+	var suites []domain.TestSuite
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if !scalaast.IsTestDefinition(node) {
+			return true
+		}
+		if suite := w.specification(node); suite != nil {
+			suites = append(suites, *suite)
+		}
+		return false
+	})
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+		Suites:    suites,
+	}, nil
+}
+
+type walker struct {
+	source   []byte
+	filename string
+}
+
+func (w *walker) specification(node *sitter.Node) *domain.TestSuite {
+	body := scalaast.DefinitionBody(node)
+	if body == nil {
+		return nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     scalaast.DefinitionName(node, w.source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	w.walk(body, suite)
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return nil
+	}
+	return suite
+}
+
+func (w *walker) walk(node *sitter.Node, suite *domain.TestSuite) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		w.visit(node.NamedChild(i), suite)
+	}
+}
+
+func (w *walker) visit(node *sitter.Node, suite *domain.TestSuite) {
+	switch node.Type() {
+	case scalaast.NodeClassDefinition, scalaast.NodeObjectDefinition:
+		return
+	case scalaast.NodeFunctionDefinition:
+		// def is = s2"""..."""
+		w.acceptance(node, suite)
+		return
+	case scalaast.NodeInfixExpression:
+		if w.fragment(node, suite) {
+			return
+		}
+	}
+	w.walk(node, suite)
+}
+
+// blockVerbs group examples: "The string" should { ... }.
+var blockVerbs = map[string]bool{"should": true, "can": true, ">>": true}
+
+// exampleVerbs define an example: "contains 11 characters" in { ... }.
+var exampleVerbs = map[string]bool{"in": true, ">>": true}
+
+// fragment handles "text" <verb> <body>. ">>" is both a group and an example
+// operator; it groups when its block contains further fragments.
+func (w *walker) fragment(node *sitter.Node, suite *domain.TestSuite) bool {
+	text, ok := scalaast.StringValue(node.ChildByFieldName("left"), w.source)
+	op := node.ChildByFieldName("operator")
+	body := node.ChildByFieldName("right")
+	if !ok || op == nil || body == nil {
+		return false
+	}
+	verb := op.Content(w.source)
+
+	if blockVerbs[verb] && body.Type() == scalaast.NodeBlock && (verb != ">>" || w.containsFragment(body)) {
+		name := text
+		if verb != ">>" {
+			name += " " + verb
+		}
+		group := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+		}
+		w.walk(body, &group)
+		suite.Suites = append(suite.Suites, group)
+		return true
+	}
+
+	if !exampleVerbs[verb] {
+		return false
+	}
+	test := domain.Test{
+		Name:     text,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	w.applyResult(body, &test)
+	suite.Tests = append(suite.Tests, test)
+	return true
+}
+
+func (w *walker) containsFragment(block *sitter.Node) bool {
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if child.Type() != scalaast.NodeInfixExpression {
+			continue
+		}
+		op := child.ChildByFieldName("operator")
+		if _, ok := scalaast.StringValue(child.ChildByFieldName("left"), w.source); ok && op != nil {
+			verb := op.Content(w.source)
+			if blockVerbs[verb] || exampleVerbs[verb] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyResult reads the status from an example body: skipped, pending (with an
+// optional reason) or a result marked .pendingUntilFixed.
+func (w *walker) applyResult(body *sitter.Node, test *domain.Test) {
+	result := scalaast.Unwrap(body)
+	if result == nil {
+		return
+	}
+
+	var reasonArgs *sitter.Node
+	if result.Type() == scalaast.NodeCallExpression {
+		fn := result.ChildByFieldName("function")
+		if fn != nil && fn.Type() == scalaast.NodeFieldExpression {
+			if field := fn.ChildByFieldName("field"); field != nil && field.Content(w.source) == ModifierPendingUntilFixed {
+				test.Status = domain.TestStatusXfail
+				test.Modifier = ModifierPendingUntilFixed
+				return
+			}
+		}
+		reasonArgs = result.ChildByFieldName("arguments")
+		result = fn
+	}
+	if result == nil {
+		return
+	}
+	if result.Type() == scalaast.NodeFieldExpression {
+		if field := result.ChildByFieldName("field"); field != nil && field.Content(w.source) == ModifierPendingUntilFixed {
+			test.Status = domain.TestStatusXfail
+			test.Modifier = ModifierPendingUntilFixed
+		}
+		return
+	}
+
+	switch {
+	case scalaast.IsIdentifier(result, w.source, ModifierSkipped):
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = ModifierSkipped
+	case scalaast.IsIdentifier(result, w.source, ModifierPending):
+		test.Status = domain.TestStatusTodo
+		test.Modifier = ModifierPending
+	default:
+		return
+	}
+	if args := scalaast.Arguments(reasonArgs); len(args) == 1 {
+		if reason, ok := scalaast.StringValue(args[0], w.source); ok {
+			test.SkipReason = reason
+		}
+	}
+}
+
+// acceptance reads def is = s2"""...""": every interpolated reference is an
+// example described by the text before it on the same line.
+func (w *walker) acceptance(node *sitter.Node, suite *domain.TestSuite) {
+	name := node.ChildByFieldName("name")
+	body := node.ChildByFieldName("body")
+	if name == nil || name.Content(w.source) != "is" || body == nil || body.Type() != scalaast.NodeInterpolatedStringExpression {
+		return
+	}
+	interpolator := body.ChildByFieldName("interpolator")
+	if interpolator == nil || interpolator.Content(w.source) != "s2" {
+		return
+	}
+
+	var text *sitter.Node
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		if child := body.NamedChild(i); child.Type() == scalaast.NodeInterpolatedString {
+			text = child
+		}
+	}
+	if text == nil {
+		return
+	}
+
+	lineStart := int(text.StartByte())
+	for i := 0; i < int(text.NamedChildCount()); i++ {
+		ref := text.NamedChild(i)
+		if ref.Type() != scalaast.NodeInterpolation {
+			continue
+		}
+		start := int(ref.StartByte())
+		if nl := bytes.LastIndexByte(w.source[lineStart:start], '\n'); nl >= 0 {
+			lineStart += nl + 1
+		}
+		description := strings.TrimSpace(strings.TrimPrefix(string(w.source[lineStart:start]), `"""`))
+		lineStart = int(ref.EndByte())
+		if description == "" {
+			continue
+		}
+		suite.Tests = append(suite.Tests, domain.Test{
+			Name:     description,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(ref, w.filename),
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package specs2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/framework"
+)
+
+func TestNewDefinition(t *testing.T) {
+	def := NewDefinition()
+
+	assert.Equal(t, "specs2", def.Name)
+	assert.Equal(t, framework.PriorityGeneric, def.Priority)
+	assert.Equal(t, []domain.Language{domain.LanguageScala}, def.Languages)
+	assert.NotNil(t, def.ConfigParser)
+}
+
+func TestSpecs2ImportMatcher(t *testing.T) {
+	def := NewDefinition()
+	ctx := context.Background()
+
+	tests := []struct {
+		importPath string
+		expected   bool
+	}{
+		{"org.specs2.mutable.Specification", true},
+		{"org.specs2", true},
+		{"org.scalatest", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			result := def.Matchers[0].Match(ctx, framework.Signal{Type: framework.SignalImport, Value: tt.importPath})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func TestSpecs2ContentMatcher_Match(t *testing.T) {
+	matcher := &Specs2ContentMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{"mutable", "class HelloSpec extends mutable.Specification {", true},
+		{"acceptance", "  def is = s2\"\"\"\n  example $e1\n\"\"\"", true},
+		{"ScalaTest", "class HelloSpec extends AnyFlatSpec {", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileContent, Context: []byte(tt.content)})
+			assert.Equal(t, tt.expected, result.Confidence > 0)
+		})
+	}
+}
+
+func parse(t *testing.T, source string) *domain.TestFile {
+	t.Helper()
+	file, err := (&Specs2Parser{}).Parse(context.Background(), []byte(source), "src/test/scala/HelloSpec.scala")
+	require.NoError(t, err)
+	return file
+}
+
+func TestSpecs2Parser_Unit(t *testing.T) {
+	file := parse(t, `import org.specs2.mutable.Specification
+
+class HelloSpec extends Specification {
+  "The 'Hello world' string" should {
+    "contain 11 characters" in {
+      "Hello world" must haveSize(11)
+    }
+    "start with 'Hello'" >> {
+      "Hello world" must startWith("Hello")
+    }
+    "end with 'world'" in skipped("needs i18n")
+    "be translated" >> pending
+    "be reversible" in {
+      reverse("Hello world") must_== "dlrow olleH"
+    }.pendingUntilFixed("#42")
+  }
+
+  "grouping" >> {
+    "with arrows" >> { ok }
+  }
+}
+`)
+
+	assert.Equal(t, domain.LanguageScala, file.Language)
+	assert.Equal(t, "specs2", file.Framework)
+	require.Len(t, file.Suites, 1)
+	spec := file.Suites[0]
+	assert.Equal(t, "HelloSpec", spec.Name)
+	require.Len(t, spec.Suites, 2)
+
+	hello := spec.Suites[0]
+	assert.Equal(t, "The 'Hello world' string should", hello.Name)
+	assert.Equal(t, 4, hello.Location.StartLine)
+	require.Len(t, hello.Tests, 5)
+
+	tests := []struct {
+		name     string
+		status   domain.TestStatus
+		modifier string
+		reason   string
+	}{
+		{"contain 11 characters", domain.TestStatusActive, "", ""},
+		{"start with 'Hello'", domain.TestStatusActive, "", ""},
+		{"end with 'world'", domain.TestStatusSkipped, ModifierSkipped, "needs i18n"},
+		{"be translated", domain.TestStatusTodo, ModifierPending, ""},
+		{"be reversible", domain.TestStatusXfail, ModifierPendingUntilFixed, ""},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, hello.Tests[i].Name)
+			assert.Equal(t, tt.status, hello.Tests[i].Status)
+			assert.Equal(t, tt.modifier, hello.Tests[i].Modifier)
+			assert.Equal(t, tt.reason, hello.Tests[i].SkipReason)
+		})
+	}
+	assert.Equal(t, 5, hello.Tests[0].Location.StartLine)
+	assert.Equal(t, 7, hello.Tests[0].Location.EndLine)
+
+	grouping := spec.Suites[1]
+	assert.Equal(t, "grouping", grouping.Name)
+	require.Len(t, grouping.Tests, 1)
+	assert.Equal(t, "with arrows", grouping.Tests[0].Name)
+}
+
+func TestSpecs2Parser_Acceptance(t *testing.T) {
+	file := parse(t, `import org.specs2._
+
+class HelloWorldSpec extends Specification {
+  def is = s2"""
+
+  This is a specification to check the 'Hello world' string
+
+  The 'Hello world' string should
+    contain 11 characters                             $e1
+    start with 'Hello'                                $e2
+    end with 'world' $e3 and be printable $e4
+                                                      """
+
+  def e1 = "Hello world" must haveSize(11)
+  def e2 = "Hello world" must startWith("Hello")
+  def e3 = "Hello world" must endWith("world")
+  def e4 = ok
+}
+`)
+
+	require.Len(t, file.Suites, 1)
+	spec := file.Suites[0]
+
+	var names []string
+	for _, test := range spec.Tests {
+		names = append(names, test.Name)
+	}
+	assert.Equal(t, []string{"contain 11 characters", "start with 'Hello'", "end with 'world'", "and be printable"}, names)
+	assert.Equal(t, 9, spec.Tests[0].Location.StartLine)
+	assert.Equal(t, 11, spec.Tests[3].Location.StartLine)
+}
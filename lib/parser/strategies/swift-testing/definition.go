@@ -41,6 +41,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &SwiftTestingParser{},
 		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"#expect", "#require", "Issue.record"},
 	}
 }
 
@@ -62,6 +63,8 @@ func (m *SwiftTestingFileMatcher) Match(ctx context.Context, signal framework.Si
 // SwiftTestingContentMatcher matches Swift Testing-specific patterns.
 type SwiftTestingContentMatcher struct{}
 
+var disabledReasonPattern = regexp.MustCompile(`\.disabled\(\s*"((?:[^"\\]|\\.)*)"`)
+
 var swiftTestingPatterns = []struct {
 	pattern *regexp.Regexp
 	desc    string
@@ -203,10 +206,12 @@ func parseTestFunction(node *sitter.Node, source []byte, filename string) *domai
 
 	status := domain.TestStatusActive
 	modifier := ""
+	reason := ""
 
 	if hasAttributeContaining(node, source, ".disabled") {
 		status = domain.TestStatusSkipped
 		modifier = "@Test(.disabled)"
+		reason = findDisabledReason(node, source)
 	}
 
 	if isAsyncFunction(node, source) {
@@ -214,13 +219,27 @@ func parseTestFunction(node *sitter.Node, source []byte, filename string) *domai
 	}
 
 	return &domain.Test{
-		Name:     funcName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       funcName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
 	}
 }
 
+// findDisabledReason extracts the comment from a .disabled("...") trait.
+func findDisabledReason(node *sitter.Node, source []byte) string {
+	var reason string
+	findAttribute(node, source, func(content string) bool {
+		if m := disabledReasonPattern.FindStringSubmatch(content); m != nil {
+			reason = m[1]
+			return true
+		}
+		return false
+	})
+	return reason
+}
+
 // hasAttribute checks if a node has an attribute with the given prefix.
 func hasAttribute(node *sitter.Node, source []byte, prefix string) bool {
 	return findAttribute(node, source, func(content string) bool {
@@ -0,0 +1,157 @@
+package testng
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/javaast"
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/parameterized"
+)
+
+// dataProviders maps @DataProvider names to their statically resolved rows.
+// Each row holds the source text of its arguments.
+type dataProviders map[string][][]string
+
+// collectDataProviders resolves @DataProvider methods in a class body that return
+// a literal Object[][] (e.g. return new Object[][] {{1, "a"}, {2, "b"}};).
+// Providers built at runtime are omitted, which keeps their tests unexpanded.
+func collectDataProviders(body *sitter.Node, source []byte) dataProviders {
+	providers := make(dataProviders)
+
+	for i := 0; i < int(body.ChildCount()); i++ {
+		method := body.Child(i)
+		if method.Type() != javaast.NodeMethodDeclaration {
+			continue
+		}
+
+		for _, ann := range javaast.GetAnnotations(javaast.GetModifiers(method)) {
+			if javaast.GetAnnotationName(ann, source) != "DataProvider" {
+				continue
+			}
+
+			name := javaast.GetMethodName(method, source)
+			if value := javaast.GetAnnotationElement(ann, source, "name"); value != nil {
+				if value.Type() != "string_literal" {
+					break
+				}
+				name = strings.Trim(value.Content(source), `"`)
+			}
+
+			if rows, ok := resolveProviderRows(method, source); ok {
+				providers[name] = rows
+			}
+			break
+		}
+	}
+
+	return providers
+}
+
+func resolveProviderRows(method *sitter.Node, source []byte) ([][]string, bool) {
+	block := method.ChildByFieldName("body")
+	if block == nil {
+		return nil, false
+	}
+
+	var returned *sitter.Node
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		stmt := block.NamedChild(i)
+		if stmt.Type() != "return_statement" {
+			continue
+		}
+		if returned != nil || stmt.NamedChildCount() != 1 {
+			return nil, false
+		}
+		returned = stmt.NamedChild(0)
+	}
+	if returned == nil {
+		return nil, false
+	}
+
+	outer := arrayInitializer(returned)
+	if outer == nil {
+		return nil, false
+	}
+
+	var rows [][]string
+	for i := 0; i < int(outer.NamedChildCount()); i++ {
+		row := arrayInitializer(outer.NamedChild(i))
+		if row == nil {
+			return nil, false
+		}
+
+		var args []string
+		for j := 0; j < int(row.NamedChildCount()); j++ {
+			arg := row.NamedChild(j)
+			if !isJavaLiteral(arg) {
+				return nil, false
+			}
+			args = append(args, arg.Content(source))
+		}
+		rows = append(rows, args)
+	}
+
+	return rows, true
+}
+
+// expandDataProviderTest returns one test per row of the test's data provider,
+// named "<test>(<arg>, <arg>)" with arguments as written in source.
+// Returns the template test unchanged if the provider is unknown or lives in another class.
+func expandDataProviderTest(test domain.Test, method *sitter.Node, source []byte, providers dataProviders) []domain.Test {
+	if providers == nil {
+		return []domain.Test{test}
+	}
+
+	for _, ann := range javaast.GetAnnotations(javaast.GetModifiers(method)) {
+		if javaast.GetAnnotationName(ann, source) != "Test" {
+			continue
+		}
+		if javaast.GetAnnotationElement(ann, source, "dataProviderClass") != nil {
+			return []domain.Test{test}
+		}
+
+		value := javaast.GetAnnotationElement(ann, source, "dataProvider")
+		if value == nil || value.Type() != "string_literal" {
+			return []domain.Test{test}
+		}
+
+		rows, ok := providers[strings.Trim(value.Content(source), `"`)]
+		if !ok || len(rows) == 0 {
+			return []domain.Test{test}
+		}
+
+		names := make([]string, len(rows))
+		for i, args := range rows {
+			names[i] = test.Name + "(" + strings.Join(args, ", ") + ")"
+		}
+		return parameterized.Expand(test, names)
+	}
+
+	return []domain.Test{test}
+}
+
+// arrayInitializer unwraps "new Object[][] {...}" and "{...}" to the array_initializer node.
+func arrayInitializer(node *sitter.Node) *sitter.Node {
+	switch node.Type() {
+	case "array_initializer":
+		return node
+	case "array_creation_expression":
+		if value := node.ChildByFieldName("value"); value != nil && value.Type() == "array_initializer" {
+			return value
+		}
+	}
+	return nil
+}
+
+func isJavaLiteral(node *sitter.Node) bool {
+	switch node.Type() {
+	case "string_literal", "character_literal", "true", "false", "null_literal", "class_literal":
+		return true
+	case "unary_expression":
+		operand := node.ChildByFieldName("operand")
+		return operand != nil && strings.HasSuffix(operand.Type(), "_literal")
+	}
+	return strings.HasSuffix(node.Type(), "_integer_literal") || strings.HasSuffix(node.Type(), "_floating_point_literal")
+}
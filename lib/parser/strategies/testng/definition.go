@@ -46,7 +46,8 @@ func NewDefinition() *framework.Definition {
 		// Both frameworks use @Test but with different packages (org.testng vs org.junit.jupiter).
 		// Import matching provides strong disambiguation (60 pts), but priority ensures correct
 		// framework selection when imports are ambiguous (e.g., wildcard imports).
-		Priority: framework.PrioritySpecialized,
+		Priority:   framework.PrioritySpecialized,
+		Assertions: []string{"assert*", "Assert.*", "fail", "verify"},
 	}
 }
 
@@ -154,7 +155,8 @@ func (p *TestNGParser) Parse(ctx context.Context, source []byte, filename string
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites := parseTestClasses(root, cleanSource, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites := parseTestClasses(root, cleanSource, filename, expand)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -164,12 +166,12 @@ func (p *TestNGParser) Parse(ctx context.Context, source []byte, filename string
 	}, nil
 }
 
-func parseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+func parseTestClasses(root *sitter.Node, source []byte, filename string, expand bool) []domain.TestSuite {
 	var suites []domain.TestSuite
 
 	parser.WalkTree(root, func(node *sitter.Node) bool {
 		if node.Type() == javaast.NodeClassDeclaration {
-			if suite := parseTestClassWithDepth(node, source, filename, 0); suite != nil {
+			if suite := parseTestClassWithDepth(node, source, filename, 0, expand); suite != nil {
 				suites = append(suites, *suite)
 			}
 			return false // Don't recurse into nested classes here; handled by parseTestClassWithDepth
@@ -180,7 +182,7 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 	return suites
 }
 
-func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int) *domain.TestSuite {
+func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int, expand bool) *domain.TestSuite {
 	if depth > maxNestedDepth {
 		return nil
 	}
@@ -199,6 +201,11 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 		return nil
 	}
 
+	var providers dataProviders
+	if expand {
+		providers = collectDataProviders(body, source)
+	}
+
 	var tests []domain.Test
 	var nestedSuites []domain.TestSuite
 
@@ -208,12 +215,12 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 		switch child.Type() {
 		case javaast.NodeMethodDeclaration:
 			if test := parseTestMethod(child, source, filename, classStatus, classModifier, hasClassLevelTest); test != nil {
-				tests = append(tests, *test)
+				tests = append(tests, expandDataProviderTest(*test, child, source, providers)...)
 			}
 
 		case javaast.NodeClassDeclaration:
 			// Handle nested classes (TestNG doesn't require @Nested annotation unlike JUnit5)
-			if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
+			if nested := parseTestClassWithDepth(child, source, filename, depth+1, expand); nested != nil {
 				nestedSuites = append(nestedSuites, *nested)
 			}
 		}
@@ -228,6 +235,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 		Status:   classStatus,
 		Modifier: classModifier,
 		Location: parser.GetLocation(node, filename),
+		Tags:     getGroups(modifiers, source),
 		Tests:    tests,
 		Suites:   nestedSuites,
 	}
@@ -283,6 +291,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 		Status:   status,
 		Modifier: modifier,
 		Location: parser.GetLocation(node, filename),
+		Tags:     getGroups(modifiers, source),
 	}
 }
 
@@ -328,6 +337,16 @@ func getTestDescription(annotation *sitter.Node, source []byte) string {
 	return ""
 }
 
+// getGroups returns the groups declared by @Test(groups = ...).
+func getGroups(modifiers *sitter.Node, source []byte) []string {
+	for _, ann := range javaast.GetAnnotations(modifiers) {
+		if javaast.GetAnnotationName(ann, source) == "Test" {
+			return javaast.GetStringElements(javaast.GetAnnotationElement(ann, source, "groups"), source)
+		}
+	}
+	return nil
+}
+
 func getClassStatusAndModifier(modifiers *sitter.Node, source []byte) (domain.TestStatus, string) {
 	if modifiers == nil {
 		return domain.TestStatusActive, ""
@@ -2,6 +2,7 @@ package testng
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -769,3 +770,106 @@ public class ClassContainer {
 		}
 	})
 }
+
+func TestTestNGParser_Parse_ExpandDataProvider(t *testing.T) {
+	p := &TestNGParser{}
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	source := `
+import org.testng.annotations.DataProvider;
+import org.testng.annotations.Test;
+
+public class CalculatorTest {
+    @DataProvider(name = "sums")
+    public Object[][] sums() {
+        return new Object[][] {
+            {1, 2, 3},
+            {-1, 1, 0},
+        };
+    }
+
+    @DataProvider
+    public Object[][] names() {
+        return new Object[][] { new Object[] {"alice"}, {null} };
+    }
+
+    @DataProvider(name = "computed")
+    public Object[][] computed() {
+        return load();
+    }
+
+    @Test(dataProvider = "sums")
+    public void testAdd(int a, int b, int expected) {}
+
+    @Test(dataProvider = "names")
+    public void testGreet(String name) {}
+
+    @Test(dataProvider = "computed")
+    public void testComputed(int value) {}
+
+    @Test(dataProvider = "sums", dataProviderClass = OtherProviders.class)
+    public void testExternal(int a, int b, int expected) {}
+}
+`
+	testFile, err := p.Parse(ctx, []byte(source), "CalculatorTest.java")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 {
+		t.Fatalf("expected 1 Suite, got %d", len(testFile.Suites))
+	}
+
+	want := []string{
+		"testAdd(1, 2, 3)",
+		"testAdd(-1, 1, 0)",
+		`testGreet("alice")`,
+		"testGreet(null)",
+		"testComputed",
+		"testExternal",
+	}
+	tests := testFile.Suites[0].Tests
+	if len(tests) != len(want) {
+		t.Fatalf("expected %d Tests, got %d", len(want), len(tests))
+	}
+	for i, name := range want {
+		if tests[i].Name != name {
+			t.Errorf("Tests[%d]: expected '%s', got '%s'", i, name, tests[i].Name)
+		}
+	}
+	if tests[1].Template == nil || tests[1].Template.Name != "testAdd" || tests[1].Template.Index != 1 {
+		t.Errorf("expected template link to testAdd[1], got %+v", tests[1].Template)
+	}
+	if tests[4].Template != nil {
+		t.Errorf("expected no template link for unresolved provider, got %+v", tests[4].Template)
+	}
+}
+
+func TestTestNGParser_Parse_Groups(t *testing.T) {
+	source := `
+@Test(groups = "integration")
+public class RepositoryTest {
+    @Test(groups = {"slow", "db"})
+    public void fetches() {}
+
+    public void saves() {}
+}`
+
+	testFile, err := (&TestNGParser{}).Parse(context.Background(), []byte(source), "RepositoryTest.java")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 2 {
+		t.Fatalf("expected 1 suite with 2 tests, got %+v", testFile.Suites)
+	}
+
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "integration" {
+		t.Errorf("expected suite tags 'integration', got '%s'", got)
+	}
+	if got := strings.Join(suite.Tests[0].Tags, ","); got != "slow,db" {
+		t.Errorf("expected test tags 'slow,db', got '%s'", got)
+	}
+	if suite.Tests[1].Tags != nil {
+		t.Errorf("expected no tags on implicit test, got %v", suite.Tests[1].Tags)
+	}
+}
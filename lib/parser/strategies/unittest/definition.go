@@ -33,6 +33,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &UnittestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"self.assert*", "self.fail*"},
 	}
 }
 
@@ -133,9 +134,9 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 			}
 
 			decorators := pyast.GetDecorators(child)
-			status, modifier := getStatusAndModifierFromDecorators(decorators, source)
+			status, modifier, reason := getStatusAndModifierFromDecorators(decorators, source)
 
-			if suite := parseTestCaseClassWithStatus(definition, source, filename, status, modifier); suite != nil {
+			if suite := parseTestCaseClassWithStatus(definition, source, filename, status, modifier, reason); suite != nil {
 				suites = append(suites, *suite)
 			}
 		}
@@ -145,10 +146,10 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 }
 
 func parseTestCaseClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
-	return parseTestCaseClassWithStatus(node, source, filename, domain.TestStatusActive, "")
+	return parseTestCaseClassWithStatus(node, source, filename, domain.TestStatusActive, "", "")
 }
 
-func parseTestCaseClassWithStatus(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.TestSuite {
+func parseTestCaseClassWithStatus(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string) *domain.TestSuite {
 	nameNode := node.ChildByFieldName("name")
 	if nameNode == nil {
 		return nil
@@ -179,6 +180,7 @@ func parseTestCaseClassWithStatus(node *sitter.Node, source []byte, filename str
 				if test.Status == domain.TestStatusActive && classStatus != domain.TestStatusActive {
 					test.Status = classStatus
 					test.Modifier = classModifier
+					test.SkipReason = classReason
 				}
 				tests = append(tests, *test)
 			}
@@ -190,14 +192,16 @@ func parseTestCaseClassWithStatus(node *sitter.Node, source []byte, filename str
 			}
 
 			decorators := pyast.GetDecorators(child)
-			status, modifier := getStatusAndModifierFromDecorators(decorators, source)
+			status, modifier, reason := getStatusAndModifierFromDecorators(decorators, source)
 			// Inherit class status/modifier if method has default (active) status
 			if status == domain.TestStatusActive && classStatus != domain.TestStatusActive {
 				status = classStatus
 				modifier = classModifier
+				reason = classReason
 			}
 
 			if test := parseTestMethodWithStatus(definition, source, filename, status, modifier); test != nil {
+				test.SkipReason = reason
 				tests = append(tests, *test)
 			}
 		}
@@ -208,11 +212,12 @@ func parseTestCaseClassWithStatus(node *sitter.Node, source []byte, filename str
 	}
 
 	return &domain.TestSuite{
-		Name:     name,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
+		Name:       name,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tests:      tests,
 	}
 }
 
@@ -249,20 +254,23 @@ func isTestCaseClass(node *sitter.Node, source []byte) bool {
 	return strings.Contains(text, "TestCase") || strings.Contains(text, "unittest.TestCase")
 }
 
-func getStatusAndModifierFromDecorators(decorators []*sitter.Node, source []byte) (domain.TestStatus, string) {
+// getStatusAndModifierFromDecorators returns the status implied by skip decorators,
+// along with the literal reason passed to them, if any.
+func getStatusAndModifierFromDecorators(decorators []*sitter.Node, source []byte) (domain.TestStatus, string, string) {
 	for _, dec := range decorators {
 		text := parser.GetNodeText(dec, source)
+		call := pyast.GetDecoratorCall(dec)
 
 		switch {
 		case strings.Contains(text, "unittest.skipUnless"):
-			return domain.TestStatusSkipped, "@unittest.skipUnless"
+			return domain.TestStatusSkipped, "@unittest.skipUnless", pyast.GetReasonArgument(call, source, 1)
 		case strings.Contains(text, "unittest.skipIf"):
-			return domain.TestStatusSkipped, "@unittest.skipIf"
+			return domain.TestStatusSkipped, "@unittest.skipIf", pyast.GetReasonArgument(call, source, 1)
 		case strings.Contains(text, "unittest.skip"):
-			return domain.TestStatusSkipped, "@unittest.skip"
+			return domain.TestStatusSkipped, "@unittest.skip", pyast.GetReasonArgument(call, source, 0)
 		case strings.Contains(text, "unittest.expectedFailure"):
-			return domain.TestStatusXfail, "@unittest.expectedFailure"
+			return domain.TestStatusXfail, "@unittest.expectedFailure", ""
 		}
 	}
-	return domain.TestStatusActive, ""
+	return domain.TestStatusActive, "", ""
 }
@@ -34,6 +34,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: &VitestConfigParser{},
 		Parser:       &VitestParser{},
 		Priority:     framework.PrioritySpecialized,
+		Assertions:   []string{"expect", "expect.soft", "expect.poll", "expectTypeOf", "assert", "assert.*", "assertType"},
 	}
 }
 
@@ -43,6 +43,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &XCTestParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"XCTAssert*", "XCTFail", "XCTUnwrap"},
 	}
 }
 
@@ -187,11 +188,13 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string) *domain.
 
 	status := domain.TestStatusActive
 	modifier := ""
+	reason := ""
 
 	// Check for throws XCTSkip pattern in function body
 	if hasXCTSkip(node, source) {
 		status = domain.TestStatusSkipped
 		modifier = "XCTSkip"
+		reason = findXCTSkipReason(node, source)
 	}
 
 	// Check for async keyword
@@ -200,11 +203,23 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string) *domain.
 	}
 
 	return &domain.Test{
-		Name:     funcName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
+		Name:       funcName,
+		Status:     status,
+		Modifier:   modifier,
+		SkipReason: reason,
+		Location:   parser.GetLocation(node, filename),
+	}
+}
+
+var xctSkipReasonPattern = regexp.MustCompile(`XCTSkip(?:If|Unless)?\([^"]*"((?:[^"\\]|\\.)*)"`)
+
+// findXCTSkipReason returns the first string literal passed to XCTSkip,
+// which is its message (for XCTSkipIf/XCTSkipUnless it follows the condition).
+func findXCTSkipReason(node *sitter.Node, source []byte) string {
+	if m := xctSkipReasonPattern.FindStringSubmatch(node.Content(source)); m != nil {
+		return m[1]
 	}
+	return ""
 }
 
 func hasXCTSkip(node *sitter.Node, source []byte) bool {
@@ -37,6 +37,7 @@ func NewDefinition() *framework.Definition {
 		ConfigParser: nil,
 		Parser:       &XUnitParser{},
 		Priority:     framework.PriorityGeneric,
+		Assertions:   []string{"Assert.*", "*.Should"},
 	}
 }
 
@@ -101,7 +102,8 @@ func (p *XUnitParser) Parse(ctx context.Context, source []byte, filename string)
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites := parseTestClasses(root, source, filename)
+	expand := framework.ParseOptionsFromContext(ctx).ExpandParameterized
+	suites := parseTestClasses(root, source, filename, expand)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -126,6 +128,21 @@ func getClassStatusAndModifier(attrLists []*sitter.Node, source []byte) (domain.
 	return domain.TestStatusActive, ""
 }
 
+// getClassSkipReason returns the reason given to a class-level [Skip("...")] attribute.
+func getClassSkipReason(attrLists []*sitter.Node, source []byte) string {
+	for _, attr := range dotnetast.GetAttributes(attrLists) {
+		name := dotnetast.GetAttributeName(attr, source)
+		if name != "Skip" && name != "SkipAttribute" {
+			continue
+		}
+		if args := dotnetast.GetPositionalStringArguments(attr, source); len(args) > 0 {
+			return args[0]
+		}
+		return getNamedParameterFromAttribute(attr, source, "Reason")
+	}
+	return ""
+}
+
 // getNamedParameterFromAttribute extracts a named parameter value from an attribute.
 // Used for DisplayName from [Fact(DisplayName = "...")] or [Theory(DisplayName = "...")].
 func getNamedParameterFromAttribute(attr *sitter.Node, source []byte, paramName string) string {
@@ -165,12 +182,12 @@ func isSkipped(attr *sitter.Node, source []byte) bool {
 	return false
 }
 
-func parseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+func parseTestClasses(root *sitter.Node, source []byte, filename string, expand bool) []domain.TestSuite {
 	var suites []domain.TestSuite
 
 	parser.WalkTree(root, func(node *sitter.Node) bool {
 		if node.Type() == dotnetast.NodeClassDeclaration {
-			if suite := parseTestClassWithDepth(node, source, filename, 0); suite != nil {
+			if suite := parseTestClassWithDepth(node, source, filename, 0, expand); suite != nil {
 				suites = append(suites, *suite)
 			}
 			return false
@@ -181,7 +198,7 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 	return suites
 }
 
-func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int) *domain.TestSuite {
+func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int, expand bool) *domain.TestSuite {
 	if depth > maxNestedDepth {
 		return nil
 	}
@@ -193,6 +210,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classReason := getClassSkipReason(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -205,10 +223,10 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	for _, child := range dotnetast.GetDeclarationChildren(body) {
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
-			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier)...)
+			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier, classReason, expand)...)
 
 		case dotnetast.NodeClassDeclaration:
-			if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
+			if nested := parseTestClassWithDepth(child, source, filename, depth+1, expand); nested != nil {
 				nestedSuites = append(nestedSuites, *nested)
 			}
 		}
@@ -219,16 +237,18 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	}
 
 	return &domain.TestSuite{
-		Name:     className,
-		Status:   classStatus,
-		Modifier: classModifier,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
-		Suites:   nestedSuites,
+		Name:       className,
+		Status:     classStatus,
+		Modifier:   classModifier,
+		SkipReason: classReason,
+		Location:   parser.GetLocation(node, filename),
+		Tags:       getTraits(attrLists, source),
+		Tests:      tests,
+		Suites:     nestedSuites,
 	}
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) []domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier, classReason string, expand bool) []domain.Test {
 	attrLists := dotnetast.GetAttributeLists(node)
 	if len(attrLists) == 0 {
 		return nil
@@ -242,13 +262,17 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 	attributes := dotnetast.GetAttributes(attrLists)
 	status := classStatus
 	modifier := classModifier
+	reason := classReason
 	location := parser.GetLocation(node, filename)
+	tags := getTraits(attrLists, source)
 
 	var tests []domain.Test
+	var inlineArgs [][]string
 	hasFact := false
 	hasTheory := false
 	var displayName string
 	var theorySkipped bool
+	var theoryReason string
 
 	for _, attr := range attributes {
 		name := dotnetast.GetAttributeName(attr, source)
@@ -260,6 +284,7 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 			if isSkipped(attr, source) {
 				status = domain.TestStatusSkipped
 				modifier = "Skip"
+				reason = getNamedParameterFromAttribute(attr, source, "Skip")
 			}
 
 		case isTheoryAttribute(name):
@@ -267,26 +292,41 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 			displayName = getNamedParameterFromAttribute(attr, source, "DisplayName")
 			if isSkipped(attr, source) {
 				theorySkipped = true
+				theoryReason = getNamedParameterFromAttribute(attr, source, "Skip")
 			}
 
 		case name == "InlineData" || name == "InlineDataAttribute":
 			testStatus := status
 			testModifier := modifier
+			testReason := reason
 			if theorySkipped {
 				testStatus = domain.TestStatusSkipped
 				testModifier = "Skip"
+				testReason = theoryReason
+			}
+			// [InlineData(..., Skip = "...")] skips a single case.
+			if isSkipped(attr, source) {
+				testStatus = domain.TestStatusSkipped
+				testModifier = "Skip"
+				testReason = getNamedParameterFromAttribute(attr, source, "Skip")
 			}
 			tests = append(tests, domain.Test{
-				Name:     methodName,
-				Status:   testStatus,
-				Modifier: testModifier,
-				Location: location,
+				Name:       methodName,
+				Status:     testStatus,
+				Modifier:   testModifier,
+				SkipReason: testReason,
+				Location:   location,
+				Tags:       tags,
 			})
+			inlineArgs = append(inlineArgs, dotnetast.GetPositionalAttributeArguments(attr, source))
 		}
 	}
 
 	// If [InlineData] attributes were found, return them
 	if len(tests) > 0 {
+		if expand {
+			nameInlineDataCases(tests, inlineArgs, methodName, displayName, dotnetast.GetParameterNames(node, source))
+		}
 		return tests
 	}
 
@@ -297,10 +337,12 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 			testName = displayName
 		}
 		return []domain.Test{{
-			Name:     testName,
-			Status:   status,
-			Modifier: modifier,
-			Location: location,
+			Name:       testName,
+			Status:     status,
+			Modifier:   modifier,
+			SkipReason: reason,
+			Location:   location,
+			Tags:       tags,
 		}}
 	}
 
@@ -313,15 +355,19 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 		}
 		testStatus := status
 		testModifier := modifier
+		testReason := reason
 		if theorySkipped {
 			testStatus = domain.TestStatusSkipped
 			testModifier = "Skip"
+			testReason = theoryReason
 		}
 		return []domain.Test{{
-			Name:     testName,
-			Status:   testStatus,
-			Modifier: testModifier,
-			Location: location,
+			Name:       testName,
+			Status:     testStatus,
+			Modifier:   testModifier,
+			SkipReason: testReason,
+			Location:   location,
+			Tags:       tags,
 		}}
 	}
 
@@ -343,3 +389,25 @@ func isTheoryAttribute(name string) bool {
 	return name == "Theory" || strings.HasSuffix(name, "Theory") ||
 		name == "TheoryAttribute" || strings.HasSuffix(name, "TheoryAttribute")
 }
+
+// nameInlineDataCases renames [InlineData] tests the way xUnit displays them,
+// e.g. Add(a: 1, b: "x"), and links each case back to its [Theory].
+func nameInlineDataCases(tests []domain.Test, inlineArgs [][]string, methodName, displayName string, paramNames []string) {
+	base := methodName
+	if displayName != "" {
+		base = displayName
+	}
+
+	for i := range tests {
+		args := make([]string, len(inlineArgs[i]))
+		for j, arg := range inlineArgs[i] {
+			if j < len(paramNames) {
+				args[j] = paramNames[j] + ": " + arg
+			} else {
+				args[j] = arg
+			}
+		}
+		tests[i].Name = base + "(" + strings.Join(args, ", ") + ")"
+		tests[i].Template = &domain.TestTemplate{Index: i, Name: base}
+	}
+}
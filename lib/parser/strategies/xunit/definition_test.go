@@ -2,6 +2,7 @@ package xunit
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/kubrickcode/specvital/lib/parser/domain"
@@ -831,3 +832,126 @@ func TestIsTheoryAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestXUnitParser_Parse_ExpandInlineData(t *testing.T) {
+	p := &XUnitParser{}
+	ctx := framework.WithParseOptions(context.Background(), framework.ParseOptions{ExpandParameterized: true})
+
+	source := `
+using Xunit;
+
+public class MathTests
+{
+    [Theory]
+    [InlineData(1, 2, 3)]
+    [InlineData(-2, 3, 1, Skip = "flaky")]
+    public void Add(int a, int b, int expected) { }
+
+    [Theory(DisplayName = "Parse")]
+    [InlineData("42")]
+    public void Parse_ReturnsNumber(string text) { }
+
+    [Theory]
+    [MemberData(nameof(Cases))]
+    public void FromMember(int value) { }
+}
+`
+	testFile, err := p.Parse(ctx, []byte(source), "MathTests.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"Add(a: 1, b: 2, expected: 3)",
+		"Add(a: -2, b: 3, expected: 1)",
+		`Parse(text: "42")`,
+		"FromMember",
+	}
+	tests := testFile.Suites[0].Tests
+	if len(tests) != len(want) {
+		t.Fatalf("expected %d Tests, got %d", len(want), len(tests))
+	}
+	for i, name := range want {
+		if tests[i].Name != name {
+			t.Errorf("Tests[%d]: expected '%s', got '%s'", i, name, tests[i].Name)
+		}
+	}
+	if tests[1].Template == nil || tests[1].Template.Name != "Add" || tests[1].Template.Index != 1 {
+		t.Errorf("expected template link to Add[1], got %+v", tests[1].Template)
+	}
+	if tests[3].Template != nil {
+		t.Errorf("expected no template link for [MemberData], got %+v", tests[3].Template)
+	}
+}
+
+func TestXUnitParser_Parse_Traits(t *testing.T) {
+	source := `
+using Xunit;
+
+[Trait("Category", "Integration")]
+public class RepositoryTests
+{
+    [Fact]
+    [Trait("Category", "Slow")]
+    [Trait("Owner", "data")]
+    [Trait("Category", Categories.Computed)]
+    public void Fetch() { }
+
+    [Theory]
+    [InlineData(1)]
+    [Trait("Category", "Fast")]
+    public void Count(int n) { }
+}
+`
+	testFile, err := (&XUnitParser{}).Parse(context.Background(), []byte(source), "RepositoryTests.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 2 {
+		t.Fatalf("expected 1 suite with 2 tests, got %+v", testFile.Suites)
+	}
+
+	suite := testFile.Suites[0]
+	if got := strings.Join(suite.Tags, ","); got != "Integration" {
+		t.Errorf("expected suite tags 'Integration', got '%s'", got)
+	}
+	if got := strings.Join(suite.Tests[0].Tags, ","); got != "Slow,Owner:data" {
+		t.Errorf("expected tags 'Slow,Owner:data', got '%s'", got)
+	}
+	if got := strings.Join(suite.Tests[1].Tags, ","); got != "Fast" {
+		t.Errorf("expected tags 'Fast', got '%s'", got)
+	}
+}
+
+func TestXUnitParser_Parse_SkipReason(t *testing.T) {
+	source := `
+using Xunit;
+
+public class RepositoryTests
+{
+    [Fact(Skip = "flaky on CI")]
+    public void Fetch() { }
+
+    [Theory(Skip = "pending schema")]
+    [InlineData(1)]
+    public void Count(int n) { }
+
+    [Fact]
+    public void Save() { }
+}
+`
+	testFile, err := (&XUnitParser{}).Parse(context.Background(), []byte(source), "RepositoryTests.cs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 3 {
+		t.Fatalf("expected 1 suite with 3 tests, got %+v", testFile.Suites)
+	}
+
+	want := []string{"flaky on CI", "pending schema", ""}
+	for i, test := range testFile.Suites[0].Tests {
+		if test.SkipReason != want[i] {
+			t.Errorf("test %q: expected reason '%s', got '%s'", test.Name, want[i], test.SkipReason)
+		}
+	}
+}
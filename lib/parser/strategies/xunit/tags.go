@@ -0,0 +1,31 @@
+package xunit
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/strategies/shared/dotnetast"
+)
+
+// getTraits returns [Trait(name, value)] attributes as tags.
+// The conventional "Category" trait contributes its value alone;
+// any other trait is reported as "name:value".
+func getTraits(attrLists []*sitter.Node, source []byte) []string {
+	var traits []string
+	for _, attr := range dotnetast.GetAttributes(attrLists) {
+		name := dotnetast.GetAttributeName(attr, source)
+		if name != "Trait" && name != "TraitAttribute" {
+			continue
+		}
+
+		args := dotnetast.GetPositionalStringArguments(attr, source)
+		if len(args) != 2 || args[0] == "" || args[1] == "" {
+			continue
+		}
+		if args[0] == "Category" {
+			traits = append(traits, args[1])
+		} else {
+			traits = append(traits, args[0]+":"+args[1])
+		}
+	}
+	return traits
+}
@@ -20,6 +20,9 @@ type FileResult struct {
 	// Confidence indicates the detection confidence level.
 	// Values: "scope", "import", "content", "filename", "unknown", or empty for discovery errors.
 	Confidence string
+
+	// Cached is true when the result was served from the ResultCache without parsing.
+	Cached bool
 }
 
 // IsSuccess returns true if the file was parsed successfully.
@@ -0,0 +1,60 @@
+package testmap
+
+import (
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// goModule is a Go module declared by a go.mod in the repository.
+type goModule struct {
+	path string
+	dir  string
+}
+
+// resolveGo maps a Go test file to the non-test files of its own package
+// and of every repository package it imports.
+func resolveGo(idx *index, testPath string, content []byte) []string {
+	targets := idx.goPackageFiles(path.Dir(testPath))
+
+	file, err := parser.ParseFile(token.NewFileSet(), testPath, content, parser.ImportsOnly)
+	if err != nil {
+		return targets
+	}
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if dir, ok := idx.goImportDir(importPath); ok {
+			targets = append(targets, idx.goPackageFiles(dir)...)
+		}
+	}
+	return targets
+}
+
+// goImportDir returns the repository directory of an import path, matching
+// the module with the longest path first.
+func (idx *index) goImportDir(importPath string) (string, bool) {
+	for _, mod := range idx.goModules {
+		if importPath == mod.path {
+			return mod.dir, true
+		}
+		if rest, ok := strings.CutPrefix(importPath, mod.path+"/"); ok {
+			return cleanJoin(mod.dir, rest)
+		}
+	}
+	return "", false
+}
+
+func (idx *index) goPackageFiles(dir string) []string {
+	var files []string
+	for _, f := range idx.byDir[dir] {
+		if path.Ext(f) == ".go" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
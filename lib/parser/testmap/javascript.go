@@ -0,0 +1,290 @@
+package testmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	jsStaticImportPattern  = regexp.MustCompile(`(?:^|[\s;])(?:import|export)\s+(?:[^'"]*?\s+from\s+)?['"]([^'"\n]+)['"]`)
+	jsDynamicImportPattern = regexp.MustCompile(`\b(?:require|import|jest\.mock|vi\.mock)\s*\(\s*['"]([^'"\n]+)['"]`)
+)
+
+// jsExtensions are tried in order when an import omits the file extension.
+var jsExtensions = []string{".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs", ".mts", ".cts"}
+
+// jsCompiledExtensions map the extensions written in ESM imports of
+// TypeScript projects to the source extensions they are compiled from.
+var jsCompiledExtensions = map[string][]string{
+	".js":  {".ts", ".tsx"},
+	".jsx": {".tsx"},
+	".mjs": {".mts"},
+	".cjs": {".cts"},
+}
+
+// tsconfig holds the module resolution settings of a tsconfig.json.
+type tsconfig struct {
+	// baseURL is empty when the tsconfig does not configure baseUrl.
+	baseURL string
+	// pathsBase is the directory paths are relative to: baseUrl when set,
+	// else the tsconfig directory.
+	pathsBase string
+	paths     map[string][]string
+	patterns  []string
+}
+
+func resolveJS(idx *index, testPath string, content []byte) []string {
+	var specs []string
+	for _, pattern := range []*regexp.Regexp{jsStaticImportPattern, jsDynamicImportPattern} {
+		for _, m := range pattern.FindAllSubmatch(content, -1) {
+			specs = append(specs, string(m[1]))
+		}
+	}
+
+	dir := path.Dir(testPath)
+	var targets []string
+	for _, spec := range specs {
+		if target, ok := idx.resolveJSSpecifier(dir, spec); ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func (idx *index) resolveJSSpecifier(dir, spec string) (string, bool) {
+	if spec == "." || spec == ".." || strings.HasPrefix(spec, "./") || strings.HasPrefix(spec, "../") {
+		p, ok := cleanJoin(dir, spec)
+		if !ok {
+			return "", false
+		}
+		return idx.resolveJSPath(p)
+	}
+	if strings.HasPrefix(spec, "/") || strings.Contains(spec, ":") {
+		return "", false
+	}
+
+	if cfg := idx.nearestTSConfig(dir); cfg != nil {
+		if target, ok := idx.resolveTSConfigPath(cfg, spec); ok {
+			return target, true
+		}
+	}
+	return idx.resolveWorkspacePackage(spec)
+}
+
+// resolveJSPath resolves an extensionless, compiled or directory import path.
+func (idx *index) resolveJSPath(p string) (string, bool) {
+	if idx.isSource[p] {
+		return p, true
+	}
+
+	ext := path.Ext(p)
+	if sourceExts, ok := jsCompiledExtensions[ext]; ok {
+		base := strings.TrimSuffix(p, ext)
+		for _, sourceExt := range sourceExts {
+			if idx.isSource[base+sourceExt] {
+				return base + sourceExt, true
+			}
+		}
+	}
+
+	for _, ext := range jsExtensions {
+		if idx.isSource[p+ext] {
+			return p + ext, true
+		}
+	}
+	for _, ext := range jsExtensions {
+		if index := path.Join(p, "index"+ext); idx.isSource[index] {
+			return index, true
+		}
+	}
+	return "", false
+}
+
+// nearestTSConfig returns the closest tsconfig.json above dir that
+// configures baseUrl or paths.
+func (idx *index) nearestTSConfig(dir string) *tsconfig {
+	for {
+		if cfg, ok := idx.tsconfigs[dir]; ok {
+			return cfg
+		}
+		if dir == "." {
+			return nil
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+// resolveTSConfigPath resolves spec through the paths mapping, trying
+// patterns with the longest prefix first as the TypeScript compiler does,
+// then relative to baseUrl.
+func (idx *index) resolveTSConfigPath(cfg *tsconfig, spec string) (string, bool) {
+	for _, pattern := range cfg.patterns {
+		replacements := cfg.paths[pattern]
+		prefix, suffix, wildcard := strings.Cut(pattern, "*")
+		var matched string
+		switch {
+		case !wildcard && spec == pattern:
+		case wildcard && len(spec) >= len(prefix)+len(suffix) && strings.HasPrefix(spec, prefix) && strings.HasSuffix(spec, suffix):
+			matched = spec[len(prefix) : len(spec)-len(suffix)]
+		default:
+			continue
+		}
+
+		for _, replacement := range replacements {
+			p, ok := cleanJoin(cfg.pathsBase, strings.Replace(replacement, "*", matched, 1))
+			if !ok {
+				continue
+			}
+			if target, ok := idx.resolveJSPath(p); ok {
+				return target, true
+			}
+		}
+	}
+
+	if cfg.baseURL == "" {
+		return "", false
+	}
+	p, ok := cleanJoin(cfg.baseURL, spec)
+	if !ok {
+		return "", false
+	}
+	return idx.resolveJSPath(p)
+}
+
+// resolveWorkspacePackage resolves imports of packages declared by a
+// package.json in the repository, preferring the longest package name.
+func (idx *index) resolveWorkspacePackage(spec string) (string, bool) {
+	name := ""
+	for candidate := range idx.packages {
+		if (spec == candidate || strings.HasPrefix(spec, candidate+"/")) && len(candidate) > len(name) {
+			name = candidate
+		}
+	}
+	if name == "" {
+		return "", false
+	}
+
+	dir := idx.packages[name]
+	rest := strings.TrimPrefix(strings.TrimPrefix(spec, name), "/")
+	if rest == "" {
+		rest = "index"
+	}
+	for _, root := range []string{path.Join(dir, "src"), dir} {
+		if target, ok := idx.resolveJSPath(path.Join(root, rest)); ok {
+			return target, true
+		}
+	}
+	return "", false
+}
+
+// parseTSConfig reads the baseUrl and paths of a tsconfig.json in dir.
+// It returns nil when neither is configured.
+func parseTSConfig(dir string, content []byte) *tsconfig {
+	var raw struct {
+		CompilerOptions struct {
+			BaseURL string              `json:"baseUrl"`
+			Paths   map[string][]string `json:"paths"`
+		} `json:"compilerOptions"`
+	}
+	if err := json.Unmarshal(stripJSONC(content), &raw); err != nil {
+		return nil
+	}
+	opts := raw.CompilerOptions
+	if opts.BaseURL == "" && len(opts.Paths) == 0 {
+		return nil
+	}
+
+	cfg := &tsconfig{pathsBase: dir, paths: opts.Paths}
+	for pattern := range opts.Paths {
+		cfg.patterns = append(cfg.patterns, pattern)
+	}
+	sort.Slice(cfg.patterns, func(i, j int) bool {
+		pi, _, _ := strings.Cut(cfg.patterns[i], "*")
+		pj, _, _ := strings.Cut(cfg.patterns[j], "*")
+		if len(pi) != len(pj) {
+			return len(pi) > len(pj)
+		}
+		return cfg.patterns[i] < cfg.patterns[j]
+	})
+	if opts.BaseURL != "" {
+		baseURL, ok := cleanJoin(dir, opts.BaseURL)
+		if !ok {
+			return nil
+		}
+		cfg.baseURL, cfg.pathsBase = baseURL, baseURL
+	}
+	return cfg
+}
+
+// stripJSONC removes the comments and trailing commas tsconfig.json allows.
+func stripJSONC(content []byte) []byte {
+	return stripTrailingCommas(stripJSONComments(content))
+}
+
+func stripJSONComments(content []byte) []byte {
+	out := make([]byte, 0, len(content))
+	inString := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inString:
+			out = append(out, c)
+			if c == '\\' && i+1 < len(content) {
+				i++
+				out = append(out, content[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			out = append(out, c)
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			i += 2
+			for i+1 < len(content) && (content[i] != '*' || content[i+1] != '/') {
+				i++
+			}
+			i++
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func stripTrailingCommas(content []byte) []byte {
+	out := make([]byte, 0, len(content))
+	inString := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inString:
+			out = append(out, c)
+			if c == '\\' && i+1 < len(content) {
+				i++
+				out = append(out, content[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			out = append(out, c)
+		case c == ',':
+			next := bytes.TrimLeft(content[i+1:], " \t\r\n")
+			if len(next) > 0 && (next[0] == '}' || next[0] == ']') {
+				continue
+			}
+			out = append(out, c)
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+package testmap
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	jvmPackagePattern = regexp.MustCompile(`(?m)^[ \t]*package[ \t]+([\w.]+)`)
+	jvmImportPattern  = regexp.MustCompile(`(?m)^[ \t]*import[ \t]+(?:static[ \t]+)?(\w+(?:\.\w+)*)(\.\*)?`)
+)
+
+// Naming conventions linking a test class to the class under test in the
+// same package: FooTest, FooTests, FooIT, FooSpec and TestFoo test Foo.
+var (
+	jvmTestSuffixes = []string{"Tests", "Test", "IT", "Spec"}
+	jvmTestPrefixes = []string{"Test"}
+)
+
+// resolveJVM maps Java and Kotlin class imports to their source files, and
+// a test class to the same-package class it is named after.
+func resolveJVM(idx *index, testPath string, content []byte) []string {
+	var targets []string
+
+	pkg := ""
+	if m := jvmPackagePattern.FindSubmatch(content); m != nil {
+		pkg = string(m[1])
+	}
+	for _, class := range jvmClassUnderTest(stem(testPath)) {
+		targets = append(targets, idx.jvmClassFiles(pkg, class)...)
+	}
+
+	for _, m := range jvmImportPattern.FindAllSubmatch(content, -1) {
+		name := string(m[1])
+		if len(m[2]) > 0 {
+			targets = append(targets, idx.jvmPackageFiles(name)...)
+			continue
+		}
+		// Static imports and nested classes name members of the class file:
+		// drop trailing segments until a class file matches.
+		segments := strings.Split(name, ".")
+		for n := len(segments); n >= 2; n-- {
+			files := idx.jvmClassFiles(strings.Join(segments[:n-1], "."), segments[n-1])
+			if len(files) > 0 {
+				targets = append(targets, files...)
+				break
+			}
+		}
+	}
+
+	return targets
+}
+
+// jvmClassUnderTest returns the class names a test class is named after.
+func jvmClassUnderTest(testClass string) []string {
+	var classes []string
+	for _, suffix := range jvmTestSuffixes {
+		if class, ok := strings.CutSuffix(testClass, suffix); ok && class != "" {
+			classes = append(classes, class)
+		}
+	}
+	for _, prefix := range jvmTestPrefixes {
+		if class, ok := strings.CutPrefix(testClass, prefix); ok && class != "" {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// jvmClassFiles returns the source files declaring class in pkg, located
+// under a directory matching the package path.
+func (idx *index) jvmClassFiles(pkg, class string) []string {
+	pkgDir := strings.ReplaceAll(pkg, ".", "/")
+	var files []string
+	for _, f := range idx.byStem[class] {
+		ext := path.Ext(f)
+		if ext != ".java" && ext != ".kt" {
+			continue
+		}
+		if dirHasSuffix(path.Dir(f), pkgDir) {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// jvmPackageFiles returns the source files of a wildcard-imported package.
+func (idx *index) jvmPackageFiles(pkg string) []string {
+	pkgDir := strings.ReplaceAll(pkg, ".", "/")
+	var files []string
+	for dir, dirFiles := range idx.byDir {
+		if !dirHasSuffix(dir, pkgDir) {
+			continue
+		}
+		for _, f := range dirFiles {
+			if ext := path.Ext(f); ext == ".java" || ext == ".kt" {
+				files = append(files, f)
+			}
+		}
+	}
+	return files
+}
+
+// dirHasSuffix reports whether dir ends with the path segments of suffix.
+func dirHasSuffix(dir, suffix string) bool {
+	if suffix == "" {
+		return true
+	}
+	return dir == suffix || strings.HasSuffix(dir, "/"+suffix)
+}
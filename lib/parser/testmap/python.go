@@ -0,0 +1,97 @@
+package testmap
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	pyFromImportPattern = regexp.MustCompile(`(?m)^[ \t]*from[ \t]+(\.*[\w.]*)[ \t]+import[ \t]+(\([^)]*\)|[^\n#]+)`)
+	pyImportPattern     = regexp.MustCompile(`(?m)^[ \t]*import[ \t]+([^\n#]+)`)
+)
+
+// resolvePython maps "import a.b" and "from a.b import c" (including
+// relative imports) to modules and packages of the repository. Absolute
+// imports are resolved from the root, src/ and pyproject.toml projects.
+func resolvePython(idx *index, testPath string, content []byte) []string {
+	dir := path.Dir(testPath)
+	var targets []string
+
+	for _, m := range pyImportPattern.FindAllSubmatch(content, -1) {
+		for _, name := range splitPythonNames(string(m[1])) {
+			targets = append(targets, idx.resolvePythonModule(dir, name)...)
+		}
+	}
+
+	for _, m := range pyFromImportPattern.FindAllSubmatch(content, -1) {
+		module := string(m[1])
+		// The imported names may be submodules rather than attributes.
+		candidates := []string{module}
+		for _, name := range splitPythonNames(strings.Trim(string(m[2]), "()")) {
+			if name == "*" {
+				continue
+			}
+			sep := "."
+			if strings.HasSuffix(module, ".") {
+				sep = ""
+			}
+			candidates = append(candidates, module+sep+name)
+		}
+
+		for i, candidate := range candidates {
+			resolved := idx.resolvePythonModule(dir, candidate)
+			if i > 0 && len(resolved) == 0 {
+				continue
+			}
+			targets = append(targets, resolved...)
+		}
+	}
+
+	return targets
+}
+
+// splitPythonNames splits an import list into dotted names, dropping aliases.
+func splitPythonNames(list string) []string {
+	var names []string
+	for _, part := range strings.Split(list, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 || fields[0] == "\\" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(fields[0], "\\"))
+	}
+	return names
+}
+
+// resolvePythonModule resolves a dotted module name, relative to dir when it
+// starts with dots.
+func (idx *index) resolvePythonModule(dir, module string) []string {
+	dots := len(module) - len(strings.TrimLeft(module, "."))
+	name := strings.ReplaceAll(module[dots:], ".", "/")
+
+	var roots []string
+	if dots > 0 {
+		base := dir
+		for i := 1; i < dots; i++ {
+			base = path.Dir(base)
+		}
+		roots = []string{base}
+	} else {
+		if name == "" {
+			return nil
+		}
+		roots = append([]string{".", "src"}, idx.pythonRoots...)
+	}
+
+	for _, root := range roots {
+		p, ok := cleanJoin(root, name)
+		if !ok {
+			continue
+		}
+		if target, ok := idx.firstSource(p+".py", path.Join(p, "__init__.py")); ok {
+			return []string{target}
+		}
+	}
+	return nil
+}
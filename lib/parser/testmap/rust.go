@@ -0,0 +1,188 @@
+package testmap
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	rustModPattern   = regexp.MustCompile(`(?m)^[ \t]*(?:pub(?:\([^)]*\))?[ \t]+)?mod[ \t]+(\w+)[ \t]*;`)
+	rustUsePattern   = regexp.MustCompile(`(?m)^[ \t]*(?:pub(?:\([^)]*\))?[ \t]+)?use[ \t]+([^;]+);`)
+	rustAliasPattern = regexp.MustCompile(`\s+as\s+\w+`)
+)
+
+// rustTargetDirs hold Cargo targets that are separate crates rather than
+// modules of the library: their files are tests, not production source.
+var rustTargetDirs = []string{"tests", "benches", "examples"}
+
+// isRustInlineTest reports whether a Rust test file is a library module
+// carrying #[cfg(test)] unit tests, making it production source as well.
+func isRustInlineTest(relPath string) bool {
+	if path.Ext(relPath) != ".rs" || strings.HasSuffix(relPath, "_test.rs") {
+		return false
+	}
+	for _, segment := range strings.Split(path.Dir(relPath), "/") {
+		for _, dir := range rustTargetDirs {
+			if segment == dir {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resolveRust maps "mod" declarations and "use" paths starting with crate,
+// self, super or a repository crate name to module files. Files with inline
+// unit tests are mapped to themselves.
+func resolveRust(idx *index, testPath string, content []byte) []string {
+	var targets []string
+	inline := isRustInlineTest(testPath)
+	if inline {
+		targets = append(targets, testPath)
+	}
+
+	modDir := rustModuleDir(testPath, inline)
+	for _, m := range rustModPattern.FindAllSubmatch(content, -1) {
+		name := string(m[1])
+		if target, ok := idx.firstSource(path.Join(modDir, name+".rs"), path.Join(modDir, name, "mod.rs")); ok {
+			targets = append(targets, target)
+		}
+	}
+
+	crateRoot := path.Dir(testPath)
+	if inline {
+		if dir, ok := idx.crateDirOf(testPath); ok {
+			crateRoot = path.Join(dir, "src")
+		}
+	}
+
+	for _, m := range rustUsePattern.FindAllSubmatch(content, -1) {
+		tree := rustAliasPattern.ReplaceAllString(string(m[1]), "")
+		for _, usePath := range expandUseTree(strings.Join(strings.Fields(tree), "")) {
+			if target, ok := idx.resolveRustPath(strings.Split(usePath, "::"), crateRoot, modDir); ok {
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	return targets
+}
+
+// rustModuleDir returns the directory holding the submodules of a file:
+// its own directory for crate roots and mod.rs, else a directory named
+// after the file.
+func rustModuleDir(relPath string, inline bool) string {
+	dir := path.Dir(relPath)
+	switch stem(relPath) {
+	case "lib", "main", "mod":
+		return dir
+	}
+	if !inline {
+		return dir
+	}
+	return path.Join(dir, stem(relPath))
+}
+
+// crateDirOf returns the directory of the repository crate containing the file.
+func (idx *index) crateDirOf(relPath string) (string, bool) {
+	dir := path.Dir(relPath)
+	for {
+		for _, crateDir := range idx.crates {
+			if crateDir == dir {
+				return dir, true
+			}
+		}
+		if dir == "." {
+			return "", false
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+// resolveRustPath resolves a use path to the deepest module file it names.
+func (idx *index) resolveRustPath(segments []string, crateRoot, modDir string) (string, bool) {
+	if len(segments) == 0 {
+		return "", false
+	}
+
+	var dir, rootFile string
+	rest := segments[1:]
+	switch first := segments[0]; first {
+	case "crate":
+		dir = crateRoot
+	case "self":
+		dir = modDir
+	case "super":
+		dir = path.Dir(modDir)
+		for len(rest) > 0 && rest[0] == "super" {
+			dir = path.Dir(dir)
+			rest = rest[1:]
+		}
+	default:
+		crateDir, ok := idx.crates[first]
+		if !ok {
+			return "", false
+		}
+		dir = path.Join(crateDir, "src")
+		rootFile = path.Join(dir, "lib.rs")
+	}
+
+	target := ""
+	for _, segment := range rest {
+		file, ok := idx.firstSource(path.Join(dir, segment+".rs"), path.Join(dir, segment, "mod.rs"))
+		if !ok {
+			break
+		}
+		target = file
+		dir = path.Join(dir, segment)
+	}
+
+	if target == "" && rootFile != "" && idx.isSource[rootFile] {
+		target = rootFile
+	}
+	return target, target != ""
+}
+
+// expandUseTree flattens a whitespace-free use tree such as
+// "a::{b,c::{d,e}}" into its paths: "a::b", "a::c::d" and "a::c::e".
+func expandUseTree(tree string) []string {
+	open := strings.Index(tree, "{")
+	if open < 0 {
+		return []string{tree}
+	}
+
+	prefix := tree[:open]
+	var items []string
+	depth, start := 0, open+1
+scan:
+	for i := open; i < len(tree); i++ {
+		switch tree[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				items = append(items, tree[start:i])
+				break scan
+			}
+		case ',':
+			if depth == 1 {
+				items = append(items, tree[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	var paths []string
+	for _, item := range items {
+		switch item {
+		case "":
+		case "self":
+			paths = append(paths, strings.TrimSuffix(prefix, "::"))
+		default:
+			paths = append(paths, expandUseTree(prefix+item)...)
+		}
+	}
+	return paths
+}
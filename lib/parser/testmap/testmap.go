@@ -0,0 +1,273 @@
+// Package testmap maps test files to the production source files they
+// exercise by resolving their imports against the repository tree.
+//
+// Resolution is static and per language:
+//   - JavaScript/TypeScript: relative imports, tsconfig baseUrl/paths and
+//     workspace package names
+//   - Go: module import paths (go.mod) and the package under test
+//   - Python: absolute and relative imports from the root, src/ and
+//     pyproject.toml project directories
+//   - Java/Kotlin: class imports and same-package naming conventions
+//     (FooTest → Foo)
+//   - Rust: use crate/self/super/<crate> paths and mod declarations
+//
+// Imports that resolve outside the repository (standard library, third-party
+// packages) are ignored.
+package testmap
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/project"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+// Options configures graph construction.
+type Options struct {
+	// SkipDir reports whether the directory (slash-separated, relative to
+	// the root) is excluded from the source tree.
+	SkipDir func(relDir string) bool
+	// IsTestFile reports whether the file (slash-separated, relative to the
+	// root) is a test file rather than production source.
+	IsTestFile func(relPath string) bool
+}
+
+// Build resolves the imports of tests against the files of src and returns
+// the resulting tests↔sources graph.
+func Build(ctx context.Context, src source.Source, tests []domain.TestFile, opts Options) (*domain.SourceGraph, error) {
+	idx, err := newIndex(ctx, src, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &domain.SourceGraph{
+		Edges:   make(map[string][]string),
+		Sources: idx.sources,
+	}
+
+	for _, test := range tests {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		testPath := filepath.ToSlash(test.Path)
+		resolve := resolverFor(testPath)
+		if resolve == nil {
+			continue
+		}
+		content, err := idx.read(ctx, testPath)
+		if err != nil {
+			continue
+		}
+
+		targets := make(map[string]bool)
+		for _, target := range resolve(idx, testPath, content) {
+			if target != testPath || idx.isSource[target] {
+				targets[target] = true
+			}
+		}
+		if len(targets) == 0 {
+			continue
+		}
+
+		sources := make([]string, 0, len(targets))
+		for target := range targets {
+			sources = append(sources, target)
+		}
+		sort.Strings(sources)
+		graph.Edges[testPath] = sources
+	}
+
+	return graph, nil
+}
+
+// resolver returns the production files imported by the test file content.
+type resolver func(idx *index, testPath string, content []byte) []string
+
+func resolverFor(relPath string) resolver {
+	switch path.Ext(relPath) {
+	case ".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs", ".mts", ".cts":
+		return resolveJS
+	case ".go":
+		return resolveGo
+	case ".py":
+		return resolvePython
+	case ".java", ".kt":
+		return resolveJVM
+	case ".rs":
+		return resolveRust
+	}
+	return nil
+}
+
+// isSourceExt reports whether files with the extension are mapped.
+func isSourceExt(relPath string) bool {
+	if strings.HasSuffix(relPath, ".d.ts") {
+		return false
+	}
+	return resolverFor(relPath) != nil
+}
+
+// index holds the repository tree and the project metadata used to resolve
+// imports. All paths are slash-separated and relative to the root.
+type index struct {
+	src source.Source
+
+	files    map[string]bool
+	isSource map[string]bool
+	sources  []string
+	byDir    map[string][]string
+	byStem   map[string][]string
+
+	crates      map[string]string
+	goModules   []goModule
+	packages    map[string]string
+	pythonRoots []string
+	tsconfigs   map[string]*tsconfig
+}
+
+func newIndex(ctx context.Context, src source.Source, opts Options) (*index, error) {
+	idx := &index{
+		src:       src,
+		files:     make(map[string]bool),
+		isSource:  make(map[string]bool),
+		byDir:     make(map[string][]string),
+		byStem:    make(map[string][]string),
+		crates:    make(map[string]string),
+		packages:  make(map[string]string),
+		tsconfigs: make(map[string]*tsconfig),
+	}
+
+	rootPath := src.Root()
+	var manifests []string
+	err := filepath.WalkDir(rootPath, func(p string, d fs.DirEntry, walkErr error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if walkErr != nil {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(rootPath, p)
+		if err != nil {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if d.IsDir() {
+			if relPath != "." && (strings.HasPrefix(d.Name(), ".") || (opts.SkipDir != nil && opts.SkipDir(relPath))) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Type()&os.ModeSymlink != 0 {
+			return nil
+		}
+
+		idx.files[relPath] = true
+		switch d.Name() {
+		case "go.mod", "package.json", "Cargo.toml", "pyproject.toml", "tsconfig.json":
+			manifests = append(manifests, relPath)
+		}
+
+		if !isSourceExt(relPath) {
+			return nil
+		}
+		isTest := opts.IsTestFile != nil && opts.IsTestFile(relPath)
+		if isTest && !isRustInlineTest(relPath) {
+			return nil
+		}
+		idx.isSource[relPath] = true
+		idx.sources = append(idx.sources, relPath)
+		dir := path.Dir(relPath)
+		idx.byDir[dir] = append(idx.byDir[dir], relPath)
+		idx.byStem[stem(relPath)] = append(idx.byStem[stem(relPath)], relPath)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(idx.sources)
+	sort.Strings(manifests)
+	for _, manifest := range manifests {
+		content, err := idx.read(ctx, manifest)
+		if err != nil {
+			continue
+		}
+		idx.addManifest(manifest, content)
+	}
+	sort.Slice(idx.goModules, func(i, j int) bool {
+		return len(idx.goModules[i].path) > len(idx.goModules[j].path)
+	})
+
+	return idx, nil
+}
+
+func (idx *index) addManifest(relPath string, content []byte) {
+	dir := path.Dir(relPath)
+	if path.Base(relPath) == "tsconfig.json" {
+		if cfg := parseTSConfig(dir, content); cfg != nil {
+			idx.tsconfigs[dir] = cfg
+		}
+		return
+	}
+
+	p, ok := project.Parse(relPath, content)
+	if !ok || p.Name == "" {
+		return
+	}
+	switch project.Kind(p.Kind) {
+	case project.KindGoModule:
+		idx.goModules = append(idx.goModules, goModule{path: p.Name, dir: dir})
+	case project.KindNPM:
+		idx.packages[p.Name] = dir
+	case project.KindCargo:
+		idx.crates[strings.ReplaceAll(p.Name, "-", "_")] = dir
+	case project.KindPython:
+		idx.pythonRoots = append(idx.pythonRoots, dir, path.Join(dir, "src"))
+	}
+}
+
+func (idx *index) read(ctx context.Context, relPath string) ([]byte, error) {
+	reader, err := idx.src.Open(ctx, relPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+	return io.ReadAll(reader)
+}
+
+// firstSource returns the first candidate that is a production source file.
+func (idx *index) firstSource(candidates ...string) (string, bool) {
+	for _, c := range candidates {
+		if idx.isSource[c] {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// stem returns the file name without its extension.
+func stem(relPath string) string {
+	base := path.Base(relPath)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// cleanJoin joins elem to dir and reports whether the result stays inside
+// the root.
+func cleanJoin(dir string, elem ...string) (string, bool) {
+	p := path.Join(append([]string{dir}, elem...)...)
+	if p == ".." || strings.HasPrefix(p, "../") || strings.HasPrefix(p, "/") {
+		return "", false
+	}
+	return p, true
+}
@@ -0,0 +1,205 @@
+package testmap
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/source"
+)
+
+func buildGraph(t *testing.T, files map[string]string, tests ...string) *domain.SourceGraph {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+
+	src, err := source.NewLocalSource(root)
+	require.NoError(t, err)
+	defer src.Close()
+
+	testFiles := make([]domain.TestFile, len(tests))
+	isTest := make(map[string]bool, len(tests))
+	for i, test := range tests {
+		testFiles[i] = domain.TestFile{Path: test}
+		isTest[test] = true
+	}
+
+	graph, err := Build(context.Background(), src, testFiles, Options{
+		SkipDir:    func(relDir string) bool { return filepath.Base(relDir) == "node_modules" },
+		IsTestFile: func(relPath string) bool { return isTest[relPath] || strings.Contains(relPath, "_test.") },
+	})
+	require.NoError(t, err)
+	return graph
+}
+
+func TestBuild_JavaScript(t *testing.T) {
+	graph := buildGraph(t, map[string]string{
+		"tsconfig.json": `{
+			// comments and trailing commas are allowed
+			"compilerOptions": {
+				"baseUrl": ".",
+				"paths": { "@/*": ["src/*"], "@billing/*": ["src/billing/*"], },
+			},
+		}`,
+		"src/billing/invoice.ts":        "export const total = 1;",
+		"src/billing/tax.ts":            "export const rate = 1;",
+		"src/billing/types.d.ts":        "export type Id = string;",
+		"src/format/index.ts":           "export {};",
+		"src/legacy.js":                 "module.exports = {};",
+		"src/utils.ts":                  "export {};",
+		"packages/ui/package.json":      `{"name": "@acme/ui"}`,
+		"packages/ui/src/index.tsx":     "export {};",
+		"packages/ui/src/button.tsx":    "export {};",
+		"node_modules/lodash/index.js":  "module.exports = {};",
+		"src/billing/invoice.test.ts":   "import { total } from './invoice';\nimport { rate } from \"@billing/tax\";\nimport '../format';\nimport type { Id } from './types';\nimport _ from 'lodash';",
+		"test/app.spec.ts":              "import {\n  a,\n} from '@/utils.js';\nconst legacy = require('../src/legacy');\njest.mock('@acme/ui/button');\nconst ui = await import('@acme/ui');",
+		"test/unresolved.spec.ts":       "import { x } from './missing';",
+		"src/billing/orphan/orphan.ts":  "export {};",
+		"src/billing/orphan/helpers.ts": "export {};",
+	}, "src/billing/invoice.test.ts", "test/app.spec.ts", "test/unresolved.spec.ts")
+
+	assert.Equal(t, map[string][]string{
+		"src/billing/invoice.test.ts": {"src/billing/invoice.ts", "src/billing/tax.ts", "src/format/index.ts"},
+		"test/app.spec.ts":            {"packages/ui/src/button.tsx", "packages/ui/src/index.tsx", "src/legacy.js", "src/utils.ts"},
+	}, graph.Edges)
+	assert.NotContains(t, graph.Sources, "src/billing/types.d.ts")
+	assert.NotContains(t, graph.Sources, "node_modules/lodash/index.js")
+
+	assert.Equal(t, []string{"src/billing/invoice.test.ts"}, graph.TestsFor("src/billing/invoice.ts"))
+	assert.Empty(t, graph.TestsFor("src/billing/orphan/orphan.ts"))
+	assert.Equal(t, []string{"src/billing/orphan"}, graph.UntestedDirs())
+}
+
+func TestBuild_Go(t *testing.T) {
+	graph := buildGraph(t, map[string]string{
+		"go.mod":                       "module example.com/shop\n",
+		"billing/invoice.go":           "package billing",
+		"billing/tax.go":               "package billing",
+		"billing/invoice_test.go":      "package billing_test\n\nimport (\n\t\"testing\"\n\n\t\"example.com/shop/billing\"\n\tmoney \"example.com/shop/internal/money\"\n)\n",
+		"internal/money/money.go":      "package money",
+		"tools/gen/go.mod":             "module example.com/shop/tools/gen\n",
+		"tools/gen/gen.go":             "package gen",
+		"tools/gen/gen_test.go":        "package gen\n\nimport \"example.com/shop/tools/gen/internal/tmpl\"\n",
+		"tools/gen/internal/tmpl/t.go": "package tmpl",
+	}, "billing/invoice_test.go", "tools/gen/gen_test.go")
+
+	assert.Equal(t, map[string][]string{
+		"billing/invoice_test.go": {"billing/invoice.go", "billing/tax.go", "internal/money/money.go"},
+		"tools/gen/gen_test.go":   {"tools/gen/gen.go", "tools/gen/internal/tmpl/t.go"},
+	}, graph.Edges)
+	assert.Empty(t, graph.UntestedDirs())
+}
+
+func TestBuild_Python(t *testing.T) {
+	graph := buildGraph(t, map[string]string{
+		"services/api/pyproject.toml":          "[project]\nname = \"api\"\n",
+		"services/api/src/api/__init__.py":     "",
+		"services/api/src/api/routes.py":       "",
+		"services/api/src/api/db/__init__.py":  "",
+		"services/api/src/api/db/session.py":   "",
+		"services/api/tests/test_routes.py":    "import os\nfrom api import routes\nfrom api.db import (\n    session as s,\n    missing,\n)\n",
+		"shop/__init__.py":                     "",
+		"shop/cart.py":                         "",
+		"shop/tests/__init__.py":               "",
+		"shop/tests/test_cart.py":              "from ..cart import Cart  # relative\nimport shop.cart as cart\n",
+		"shop/tests/helpers.py":                "",
+		"services/api/src/api/untested/mod.py": "",
+	}, "services/api/tests/test_routes.py", "shop/tests/test_cart.py", "shop/tests/__init__.py", "shop/tests/helpers.py")
+
+	assert.Equal(t, map[string][]string{
+		"services/api/tests/test_routes.py": {
+			"services/api/src/api/__init__.py",
+			"services/api/src/api/db/__init__.py",
+			"services/api/src/api/db/session.py",
+			"services/api/src/api/routes.py",
+		},
+		"shop/tests/test_cart.py": {"shop/cart.py"},
+	}, graph.Edges)
+	assert.Equal(t, []string{"services/api/src/api/untested"}, graph.UntestedDirs())
+}
+
+func TestBuild_JVM(t *testing.T) {
+	graph := buildGraph(t, map[string]string{
+		"billing/src/main/java/com/acme/billing/Invoice.java":      "package com.acme.billing;",
+		"billing/src/main/java/com/acme/billing/Tax.java":          "package com.acme.billing;",
+		"billing/src/main/kotlin/com/acme/money/Money.kt":          "package com.acme.money",
+		"billing/src/main/java/com/acme/money/Currency.java":       "package com.acme.money;",
+		"billing/src/main/java/com/acme/util/Strings.java":         "package com.acme.util;",
+		"billing/src/test/java/com/acme/billing/InvoiceTest.java":  "package com.acme.billing;\n\nimport static com.acme.util.Strings.isBlank;\nimport com.acme.money.*;\nimport org.junit.jupiter.api.Test;\n",
+		"billing/src/test/kotlin/com/acme/billing/TaxSpec.kt":      "package com.acme.billing\n\nimport com.acme.billing.Invoice.Line\n",
+		"billing/src/test/java/com/acme/billing/ReportTests.java":  "package com.acme.billing;",
+		"billing/src/main/java/com/acme/reporting/Reporter.java":   "package com.acme.reporting;",
+		"billing/src/test/java/com/acme/reporting/TestHelper.java": "package com.acme.reporting;",
+	}, "billing/src/test/java/com/acme/billing/InvoiceTest.java", "billing/src/test/kotlin/com/acme/billing/TaxSpec.kt", "billing/src/test/java/com/acme/billing/ReportTests.java", "billing/src/test/java/com/acme/reporting/TestHelper.java")
+
+	assert.Equal(t, map[string][]string{
+		"billing/src/test/java/com/acme/billing/InvoiceTest.java": {
+			"billing/src/main/java/com/acme/billing/Invoice.java",
+			"billing/src/main/java/com/acme/money/Currency.java",
+			"billing/src/main/java/com/acme/util/Strings.java",
+			"billing/src/main/kotlin/com/acme/money/Money.kt",
+		},
+		"billing/src/test/kotlin/com/acme/billing/TaxSpec.kt": {
+			"billing/src/main/java/com/acme/billing/Invoice.java",
+			"billing/src/main/java/com/acme/billing/Tax.java",
+		},
+	}, graph.Edges)
+	assert.Equal(t, []string{"billing/src/main/java/com/acme/reporting"}, graph.UntestedDirs())
+}
+
+func TestBuild_Rust(t *testing.T) {
+	graph := buildGraph(t, map[string]string{
+		"Cargo.toml":                         "[workspace]\nmembers = [\"crates/*\"]\n",
+		"crates/core/Cargo.toml":             "[package]\nname = \"acme-core\"\n",
+		"crates/core/src/lib.rs":             "pub mod billing;\npub mod util;\n",
+		"crates/core/src/billing/mod.rs":     "pub mod invoice;\n#[cfg(test)]\nmod tests {\n    use super::*;\n}\n",
+		"crates/core/src/billing/invoice.rs": "use crate::util::{self, fmt::Money as M};\n#[cfg(test)]\nmod tests {}\n",
+		"crates/core/src/util/mod.rs":        "pub mod fmt;",
+		"crates/core/src/util/fmt.rs":        "",
+		"crates/core/tests/common/mod.rs":    "",
+		"crates/core/tests/billing.rs":       "mod common;\nuse acme_core::billing::invoice::Invoice;\nuse acme_core::Error;\n",
+		"crates/core/src/unused/mod.rs":      "",
+	}, "crates/core/src/billing/mod.rs", "crates/core/src/billing/invoice.rs", "crates/core/tests/billing.rs", "crates/core/tests/common/mod.rs")
+
+	assert.Equal(t, map[string][]string{
+		"crates/core/src/billing/invoice.rs": {
+			"crates/core/src/billing/invoice.rs",
+			"crates/core/src/util/fmt.rs",
+			"crates/core/src/util/mod.rs",
+		},
+		"crates/core/src/billing/mod.rs": {
+			"crates/core/src/billing/invoice.rs",
+			"crates/core/src/billing/mod.rs",
+		},
+		"crates/core/tests/billing.rs": {
+			"crates/core/src/billing/invoice.rs",
+			"crates/core/src/lib.rs",
+		},
+	}, graph.Edges)
+	assert.Equal(t, []string{"crates/core/src/unused"}, graph.UntestedDirs())
+}
+
+func TestExpandUseTree(t *testing.T) {
+	assert.Equal(t, []string{"a::b"}, expandUseTree("a::b"))
+	assert.Equal(t,
+		[]string{"a", "a::b", "a::c::d", "a::c::e"},
+		expandUseTree("a::{self,b,c::{d,e},}"),
+	)
+}
+
+func TestStripJSONC(t *testing.T) {
+	got := string(stripJSONC([]byte(`{"a": "x // not a comment", /* block */ "b": [1, 2,], // line
+}`)))
+	assert.Equal(t, "{\"a\": \"x // not a comment\",  \"b\": [1, 2] \n}", got)
+}
@@ -0,0 +1,52 @@
+package tspool
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+)
+
+// FindNode returns the outermost named node spanning exactly the lines of
+// loc. Among nodes on those lines, the first starting at loc.StartCol wins,
+// so tests written on a single line are told apart; without one, the
+// outermost node is returned. Returns nil if loc has no line.
+func FindNode(root *sitter.Node, loc domain.Location) *sitter.Node {
+	if loc.StartLine == 0 {
+		return nil
+	}
+	start := uint32(loc.StartLine - 1)
+	end := start
+	if loc.EndLine > loc.StartLine {
+		end = uint32(loc.EndLine - 1)
+	}
+
+	var outermost *sitter.Node
+	if found := findRows(root, start, end, uint32(loc.StartCol), &outermost, 0); found != nil {
+		return found
+	}
+	return outermost
+}
+
+func findRows(n *sitter.Node, start, end, col uint32, outermost **sitter.Node, depth int) *sitter.Node {
+	if depth > MaxTreeDepth {
+		return nil
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		if child.StartPoint().Row > start || child.EndPoint().Row < end {
+			continue
+		}
+		if child.StartPoint().Row == start && child.EndPoint().Row == end {
+			if child.StartPoint().Column == col {
+				return child
+			}
+			if *outermost == nil {
+				*outermost = child
+			}
+		}
+		if found := findRows(child, start, end, col, outermost, depth+1); found != nil {
+			return found
+		}
+	}
+	return nil
+}
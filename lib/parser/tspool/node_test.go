@@ -0,0 +1,58 @@
+package tspool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+func TestFindNode(t *testing.T) {
+	t.Parallel()
+
+	source := []byte(`describe("cart", () => {
+  it("adds", () => { add(); }); it("removes", () => { remove(); });
+
+  it("totals", () => {
+    total();
+  });
+});
+`)
+	tree, err := tspool.Parse(context.Background(), domain.LanguageJavaScript, source)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	defer tree.Close()
+
+	tests := []struct {
+		name string
+		loc  domain.Location
+		want string
+	}{
+		{"outermost node on the lines", domain.Location{StartLine: 4, EndLine: 6, StartCol: 2}, `it("totals"`},
+		{"first test on a shared line", domain.Location{StartLine: 2, EndLine: 2, StartCol: 2}, `it("adds"`},
+		{"second test on a shared line", domain.Location{StartLine: 2, EndLine: 2, StartCol: 32}, `it("removes"`},
+		{"column mismatch falls back to outermost", domain.Location{StartLine: 4, EndLine: 6}, `it("totals"`},
+		{"no line", domain.Location{}, ""},
+		{"no node on the lines", domain.Location{StartLine: 3, EndLine: 3}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := tspool.FindNode(tree.RootNode(), tt.loc)
+			if tt.want == "" {
+				if node != nil {
+					t.Errorf("FindNode() = %q, want nil", node.Content(source))
+				}
+				return
+			}
+			if node == nil {
+				t.Fatalf("FindNode() = nil, want node starting with %q", tt.want)
+			}
+			if got := node.Content(source); len(got) < len(tt.want) || got[:len(tt.want)] != tt.want {
+				t.Errorf("FindNode() = %q, want node starting with %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/cpp"
 	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/elixir"
 	"github.com/smacker/go-tree-sitter/golang"
 	"github.com/smacker/go-tree-sitter/java"
 	"github.com/smacker/go-tree-sitter/javascript"
@@ -29,6 +30,7 @@ import (
 	"github.com/smacker/go-tree-sitter/python"
 	"github.com/smacker/go-tree-sitter/ruby"
 	"github.com/smacker/go-tree-sitter/rust"
+	"github.com/smacker/go-tree-sitter/scala"
 	"github.com/smacker/go-tree-sitter/swift"
 	"github.com/smacker/go-tree-sitter/typescript/tsx"
 	"github.com/smacker/go-tree-sitter/typescript/typescript"
@@ -42,6 +44,7 @@ const MaxTreeDepth = 1000
 var (
 	cppLang   *sitter.Language
 	csLang    *sitter.Language
+	exLang    *sitter.Language
 	goLang    *sitter.Language
 	javaLang  *sitter.Language
 	jsLang    *sitter.Language
@@ -50,6 +53,7 @@ var (
 	pyLang    *sitter.Language
 	rbLang    *sitter.Language
 	rsLang    *sitter.Language
+	scalaLang *sitter.Language
 	swiftLang *sitter.Language
 	tsLang    *sitter.Language
 	tsxLang   *sitter.Language
@@ -61,6 +65,7 @@ func initLanguages() {
 	langOnce.Do(func() {
 		cppLang = cpp.GetLanguage()
 		csLang = csharp.GetLanguage()
+		exLang = elixir.GetLanguage()
 		goLang = golang.GetLanguage()
 		javaLang = java.GetLanguage()
 		jsLang = javascript.GetLanguage()
@@ -69,6 +74,7 @@ func initLanguages() {
 		pyLang = python.GetLanguage()
 		rbLang = ruby.GetLanguage()
 		rsLang = rust.GetLanguage()
+		scalaLang = scala.GetLanguage()
 		swiftLang = swift.GetLanguage()
 		tsLang = typescript.GetLanguage()
 		tsxLang = tsx.GetLanguage()
@@ -83,6 +89,8 @@ func GetLanguage(lang domain.Language) *sitter.Language {
 		return cppLang
 	case domain.LanguageCSharp:
 		return csLang
+	case domain.LanguageElixir:
+		return exLang
 	case domain.LanguageGo:
 		return goLang
 	case domain.LanguageJava:
@@ -99,6 +107,8 @@ func GetLanguage(lang domain.Language) *sitter.Language {
 		return rbLang
 	case domain.LanguageRust:
 		return rsLang
+	case domain.LanguageScala:
+		return scalaLang
 	case domain.LanguageSwift:
 		return swiftLang
 	case domain.LanguageTSX:
@@ -108,6 +118,20 @@ func GetLanguage(lang domain.Language) *sitter.Language {
 	}
 }
 
+// Supports reports whether lang has a tree-sitter grammar. GetLanguage and
+// Parse fall back to TypeScript for any other language.
+func Supports(lang domain.Language) bool {
+	switch lang {
+	case domain.LanguageCpp, domain.LanguageCSharp, domain.LanguageElixir,
+		domain.LanguageGo, domain.LanguageJava, domain.LanguageJavaScript,
+		domain.LanguageKotlin, domain.LanguagePHP, domain.LanguagePython,
+		domain.LanguageRuby, domain.LanguageRust, domain.LanguageScala,
+		domain.LanguageSwift, domain.LanguageTSX, domain.LanguageTypeScript:
+		return true
+	}
+	return false
+}
+
 // Get returns a parser for the given language.
 // The returned parser is NOT safe for concurrent use.
 // Caller MUST call parser.Close() when done to free resources.
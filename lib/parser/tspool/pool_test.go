@@ -77,6 +77,8 @@ func TestGetLanguage_ReturnsCorrectLanguages(t *testing.T) {
 		{"Go", domain.LanguageGo},
 		{"JavaScript", domain.LanguageJavaScript},
 		{"TypeScript", domain.LanguageTypeScript},
+		{"Elixir", domain.LanguageElixir},
+		{"Scala", domain.LanguageScala},
 	}
 
 	for _, tt := range tests {
@@ -91,6 +93,30 @@ func TestGetLanguage_ReturnsCorrectLanguages(t *testing.T) {
 	}
 }
 
+func TestSupports(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		lang domain.Language
+		want bool
+	}{
+		{"Go", domain.LanguageGo, true},
+		{"Swift", domain.LanguageSwift, true},
+		{"Dart", domain.LanguageDart, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tspool.Supports(tt.lang); got != tt.want {
+				t.Errorf("Supports(%v) = %v, want %v", tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParse_ValidOutput(t *testing.T) {
 	t.Parallel()
 
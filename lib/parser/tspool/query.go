@@ -2,6 +2,7 @@ package tspool
 
 import (
 	"fmt"
+	"regexp"
 	"sync"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -83,6 +84,12 @@ func QueryWithCache(root *sitter.Node, source []byte, lang domain.Language, quer
 			break
 		}
 
+		// Drop matches rejected by #eq?/#match? predicates.
+		match = cursor.FilterPredicates(match, source)
+		if len(match.Captures) == 0 {
+			continue
+		}
+
 		result := QueryResult{
 			Captures: make(map[string]*sitter.Node),
 		}
@@ -101,6 +108,32 @@ func QueryWithCache(root *sitter.Node, source []byte, lang domain.Language, quer
 	return results, nil
 }
 
+// ValidateQuery compiles a query for the language and checks its #match?
+// patterns, so user-supplied queries fail before they are executed.
+func ValidateQuery(lang domain.Language, queryStr string) error {
+	query, err := getCachedQuery(lang, queryStr)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	for i := uint32(0); i < query.PatternCount(); i++ {
+		for _, steps := range query.PredicatesForPattern(i) {
+			operator := query.StringValueForId(steps[0].ValueId)
+			if operator != "match?" && operator != "not-match?" {
+				continue
+			}
+			if len(steps) < 3 || steps[2].Type != sitter.QueryPredicateStepTypeString {
+				return fmt.Errorf("invalid query: #%s expects a capture and a pattern", operator)
+			}
+			if _, err := regexp.Compile(query.StringValueForId(steps[2].ValueId)); err != nil {
+				return fmt.Errorf("invalid query: #%s: %w", operator, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ClearQueryCache removes all cached queries. Only for testing.
 func ClearQueryCache() {
 	var toClose []*sitter.Query
@@ -0,0 +1,74 @@
+package tspool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubrickcode/specvital/lib/parser/domain"
+	"github.com/kubrickcode/specvital/lib/parser/tspool"
+)
+
+func TestQueryWithCache_Predicates(t *testing.T) {
+	source := []byte(`spec("a"); other("b"); caseA("c");`)
+
+	tree, err := tspool.Parse(context.Background(), domain.LanguageJavaScript, source)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	defer tree.Close()
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{
+			name:  "eq",
+			query: `(call_expression function: (identifier) @fn (#eq? @fn "spec")) @call`,
+			want:  1,
+		},
+		{
+			name:  "match",
+			query: `(call_expression function: (identifier) @fn (#match? @fn "^(spec|case)")) @call`,
+			want:  2,
+		},
+		{
+			name:  "no predicate",
+			query: `(call_expression) @call`,
+			want:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := tspool.QueryWithCache(tree.RootNode(), source, domain.LanguageJavaScript, tt.query)
+			if err != nil {
+				t.Fatalf("QueryWithCache failed: %v", err)
+			}
+			if len(results) != tt.want {
+				t.Errorf("got %d matches, want %d", len(results), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{name: "valid", query: `(call_expression) @call`},
+		{name: "unknown node", query: `(no_such_node) @call`, wantErr: true},
+		{name: "bad regex", query: `((identifier) @fn (#match? @fn "(unclosed"))`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tspool.ValidateQuery(domain.LanguageJavaScript, tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
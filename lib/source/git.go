@@ -27,15 +27,43 @@ type GitOptions struct {
 	Branch      string
 	Depth       int
 	Credentials *GitCredentials
+
+	// BaseRef is a commit SHA, branch or tag fetched in addition to the cloned
+	// branch, so that Changes can compare it with HEAD on a shallow clone.
+	// Only the commit itself is fetched, not its history.
+	BaseRef string
+}
+
+// ChangeSet lists the files that differ between the merge base of a base
+// commit and HEAD, and HEAD itself: the changes HEAD makes since it forked,
+// not those made on the base branch afterwards.
+// Paths are relative to the repository root and slash-separated.
+// Renames are reported as a deletion of the old path and a change of the new one.
+type ChangeSet struct {
+	// BaseSHA is the merge base of the resolved base commit and HEAD.
+	BaseSHA string
+
+	// Changed lists files added or modified in HEAD.
+	Changed []string
+
+	// Deleted lists files present in the merge base but not in HEAD.
+	Deleted []string
 }
 
 // Default clone depth for shallow clones.
 const defaultCloneDepth = 1
 
+// mergeBaseDeepenSteps are the numbers of commits a shallow clone is deepened
+// by, in turn, while base and HEAD have no common ancestor in it. The full
+// history is fetched once they are exhausted.
+var mergeBaseDeepenSteps = []int{64, 1024}
+
 // GitSource implements Source for Git repository access.
 // It clones the repository to a temporary directory and provides
 // filesystem-like access to its contents.
 type GitSource struct {
+	baseRef     string
+	baseSHA     string
 	branch      string
 	closeErr    error
 	closeOnce   sync.Once
@@ -107,6 +135,15 @@ func NewGitSource(ctx context.Context, repoURL string, opts *GitOptions) (*GitSo
 		)
 	}
 
+	var baseSHA string
+	if opts.BaseRef != "" {
+		baseSHA, err = fetchRef(ctx, tempDir, opts.BaseRef)
+		if err != nil {
+			os.RemoveAll(tempDir)
+			return nil, sanitizeError(err, repoURL, opts.Credentials)
+		}
+	}
+
 	branch := opts.Branch
 	if branch == "" {
 		branch, err = getBranchName(ctx, tempDir)
@@ -120,6 +157,8 @@ func NewGitSource(ctx context.Context, repoURL string, opts *GitOptions) (*GitSo
 	}
 
 	return &GitSource{
+		baseRef:     opts.BaseRef,
+		baseSHA:     baseSHA,
 		branch:      branch,
 		committedAt: committedAt,
 		commitSHA:   commitSHA,
@@ -148,6 +187,126 @@ func (s *GitSource) Branch() string {
 	return s.branch
 }
 
+// BaseCommitSHA returns the commit fetched for GitOptions.BaseRef,
+// or an empty string if no base was requested.
+func (s *GitSource) BaseCommitSHA() string {
+	return s.baseSHA
+}
+
+// Changes returns the files HEAD changed since it forked from base, like
+// "git diff base...HEAD".
+// base may be empty or the GitOptions.BaseRef value to use the fetched base commit,
+// or any commit-ish already present in the clone.
+// A shallow clone is deepened until the merge base of base and HEAD is part of it.
+// Returns ErrBaseCommitUnavailable if base cannot be resolved, which is the case
+// for most commits of a shallow clone unless they were fetched via GitOptions.BaseRef,
+// or if base and HEAD have no common ancestor.
+func (s *GitSource) Changes(ctx context.Context, base string) (*ChangeSet, error) {
+	baseSHA, err := s.resolveBase(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeBase, err := s.mergeBase(ctx, baseSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "diff-tree", "-r", "-z", "--no-renames", "--name-status", mergeBase, "HEAD")
+	cmd.Dir = s.tempDir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %v: %s", mergeBase, err, stderr.String())
+	}
+
+	changes := parseNameStatus(stdout.Bytes())
+	changes.BaseSHA = mergeBase
+	return changes, nil
+}
+
+// mergeBase returns the best common ancestor of baseSHA and HEAD. While the
+// clone is shallow and has none, it is deepened by mergeBaseDeepenSteps and
+// finally unshallowed.
+func (s *GitSource) mergeBase(ctx context.Context, baseSHA string) (string, error) {
+	for step := 0; ; step++ {
+		cmd := exec.CommandContext(ctx, "git", "merge-base", baseSHA, "HEAD")
+		cmd.Dir = s.tempDir
+		var stdout bytes.Buffer
+		cmd.Stdout = &stdout
+
+		if err := cmd.Run(); err == nil {
+			return strings.TrimSpace(stdout.String()), nil
+		}
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		if !isShallow(ctx, s.tempDir) {
+			return "", fmt.Errorf("%w: %s has no common ancestor with HEAD", ErrBaseCommitUnavailable, baseSHA)
+		}
+
+		deepen := "--unshallow"
+		if step < len(mergeBaseDeepenSteps) {
+			deepen = fmt.Sprintf("--deepen=%d", mergeBaseDeepenSteps[step])
+		}
+		if err := deepenHistory(ctx, s.tempDir, deepen, baseSHA, s.commitSHA); err != nil {
+			return "", err
+		}
+	}
+}
+
+func (s *GitSource) resolveBase(ctx context.Context, base string) (string, error) {
+	if base == "" || base == s.baseRef {
+		if s.baseSHA == "" {
+			return "", fmt.Errorf("%w: no base ref was fetched", ErrBaseCommitUnavailable)
+		}
+		return s.baseSHA, nil
+	}
+
+	if err := validateBranchName(base); err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", base+"^{commit}")
+	cmd.Dir = s.tempDir
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", fmt.Errorf("%w: %s", ErrBaseCommitUnavailable, base)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// parseNameStatus parses the NUL-separated output of "git diff-tree -z --name-status".
+func parseNameStatus(output []byte) *ChangeSet {
+	changes := &ChangeSet{
+		Changed: []string{},
+		Deleted: []string{},
+	}
+
+	fields := strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		status, path := fields[i], fields[i+1]
+		if strings.HasPrefix(status, "D") {
+			changes.Deleted = append(changes.Deleted, path)
+			continue
+		}
+		changes.Changed = append(changes.Changed, path)
+	}
+
+	return changes
+}
+
 // Open opens the file at the given path for reading.
 func (s *GitSource) Open(ctx context.Context, path string) (io.ReadCloser, error) {
 	return s.local.Open(ctx, path)
@@ -257,6 +416,80 @@ func cloneRepository(ctx context.Context, cloneURL, destDir string, opts *GitOpt
 	return nil
 }
 
+// fetchRef fetches a single commit from origin and returns its SHA.
+func fetchRef(ctx context.Context, repoDir, ref string) (string, error) {
+	if err := validateBranchName(ref); err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--depth", "1", "origin", ref)
+	cmd.Dir = repoDir
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ASKPASS=",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_CONFIG_GLOBAL=/dev/null",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("%w: %v", ErrGitCloneFailed, ctx.Err())
+		}
+		return "", fmt.Errorf("%w: fetch base ref %s: %s", ErrGitCloneFailed, ref, stderr.String())
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "rev-parse", "FETCH_HEAD^{commit}")
+	cmd.Dir = repoDir
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	stderr.Reset()
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: resolve base ref %s: %v: %s", ErrGitCloneFailed, ref, err, stderr.String())
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// isShallow reports whether the repository has a shallow history.
+func isShallow(ctx context.Context, repoDir string) bool {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--is-shallow-repository")
+	cmd.Dir = repoDir
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return false
+	}
+	return strings.TrimSpace(stdout.String()) == "true"
+}
+
+// deepenHistory fetches more history of the given commits from origin.
+// deepen is a "git fetch" depth option such as --deepen=64 or --unshallow.
+// The fetch output is not included in the error, since origin may carry credentials.
+func deepenHistory(ctx context.Context, repoDir, deepen string, shas ...string) error {
+	args := append([]string{"fetch", "--quiet", deepen, "origin"}, shas...)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repoDir
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ASKPASS=",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_CONFIG_GLOBAL=/dev/null",
+	)
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: fetch history for merge base: %v", ErrBaseCommitUnavailable, err)
+	}
+	return nil
+}
+
 // injectCredentials adds credentials to the repository URL if provided.
 func injectCredentials(repoURL string, creds *GitCredentials) (string, error) {
 	if creds == nil || (creds.Username == "" && creds.Password == "") {
@@ -408,6 +408,124 @@ func TestGitSource_Close(t *testing.T) {
 	})
 }
 
+func TestGitSource_Changes(t *testing.T) {
+	if !isGitInstalled() {
+		t.Skip("git not installed")
+	}
+
+	t.Run("should list changed and deleted files against fetched base ref", func(t *testing.T) {
+		// Given
+		repoDir := createLocalGitRepo(t)
+		baseSHA := gitOutput(t, repoDir, "rev-parse", "HEAD")
+		writeFile(t, repoDir, "a_test.go", "package a")
+		writeFile(t, repoDir, "test.txt", "changed")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "second")
+		runGit(t, repoDir, "rm", "-q", "test.txt")
+		writeFile(t, repoDir, "b_test.go", "package b")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "third")
+		ctx := context.Background()
+
+		src, err := NewGitSource(ctx, "file://"+repoDir, &GitOptions{BaseRef: baseSHA})
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		// When
+		changes, err := src.Changes(ctx, "")
+
+		// Then
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if src.BaseCommitSHA() != baseSHA || changes.BaseSHA != baseSHA {
+			t.Errorf("expected base %s, got %s / %s", baseSHA, src.BaseCommitSHA(), changes.BaseSHA)
+		}
+		if strings.Join(changes.Changed, ",") != "a_test.go,b_test.go" {
+			t.Errorf("unexpected changed files: %v", changes.Changed)
+		}
+		if strings.Join(changes.Deleted, ",") != "test.txt" {
+			t.Errorf("unexpected deleted files: %v", changes.Deleted)
+		}
+	})
+
+	t.Run("should ignore changes made on the base branch after the fork", func(t *testing.T) {
+		// Given
+		repoDir := createLocalGitRepo(t)
+		forkSHA := gitOutput(t, repoDir, "rev-parse", "HEAD")
+		baseBranch := gitOutput(t, repoDir, "rev-parse", "--abbrev-ref", "HEAD")
+		runGit(t, repoDir, "checkout", "-q", "-b", "feature")
+		writeFile(t, repoDir, "a_test.go", "package a")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "feature")
+		runGit(t, repoDir, "checkout", "-q", baseBranch)
+		writeFile(t, repoDir, "m_test.go", "package m")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "base")
+		ctx := context.Background()
+
+		src, err := NewGitSource(ctx, "file://"+repoDir, &GitOptions{Branch: "feature", BaseRef: baseBranch})
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		// When
+		changes, err := src.Changes(ctx, "")
+
+		// Then
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if changes.BaseSHA != forkSHA {
+			t.Errorf("expected merge base %s, got %s", forkSHA, changes.BaseSHA)
+		}
+		if strings.Join(changes.Changed, ",") != "a_test.go" {
+			t.Errorf("unexpected changed files: %v", changes.Changed)
+		}
+		if len(changes.Deleted) != 0 {
+			t.Errorf("expected no deleted files, got %v", changes.Deleted)
+		}
+	})
+
+	t.Run("should fail when base commit is not in shallow clone", func(t *testing.T) {
+		// Given
+		repoDir := createLocalGitRepo(t)
+		baseSHA := gitOutput(t, repoDir, "rev-parse", "HEAD")
+		writeFile(t, repoDir, "a_test.go", "package a")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "second")
+		ctx := context.Background()
+
+		src, err := NewGitSource(ctx, "file://"+repoDir, nil)
+		if err != nil {
+			t.Fatalf("failed to create git source: %v", err)
+		}
+		defer src.Close()
+
+		// When
+		_, err = src.Changes(ctx, baseSHA)
+
+		// Then
+		if !errors.Is(err, ErrBaseCommitUnavailable) {
+			t.Errorf("expected ErrBaseCommitUnavailable, got %v", err)
+		}
+	})
+}
+
+func Test_parseNameStatus(t *testing.T) {
+	changes := parseNameStatus([]byte("M\x00src/a_test.go\x00A\x00b test.go\x00D\x00old_test.go\x00T\x00link_test.go\x00"))
+
+	if strings.Join(changes.Changed, ",") != "src/a_test.go,b test.go,link_test.go" {
+		t.Errorf("unexpected changed files: %v", changes.Changed)
+	}
+	if strings.Join(changes.Deleted, ",") != "old_test.go" {
+		t.Errorf("unexpected deleted files: %v", changes.Deleted)
+	}
+}
+
 func TestVerifyGitInstalled(t *testing.T) {
 	t.Run("should succeed when git is installed", func(t *testing.T) {
 		if !isGitInstalled() {
@@ -768,6 +886,36 @@ func createLocalGitRepoWithBranch(t *testing.T, branchName string) string {
 	return repoDir
 }
 
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to run git %v: %v\n%s", args, err, out)
+	}
+}
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("failed to run git %v: %v", args, err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
 func isGitInstalled() bool {
 	_, err := exec.LookPath("git")
 	return err == nil
@@ -43,4 +43,7 @@ var (
 
 	// ErrRepositoryNotFound indicates the repository does not exist or is inaccessible.
 	ErrRepositoryNotFound = errors.New("source: repository not found")
+
+	// ErrBaseCommitUnavailable indicates the base commit for a change set is not present in the clone.
+	ErrBaseCommitUnavailable = errors.New("source: base commit unavailable")
 )